@@ -1,11 +1,16 @@
 package common
 
 const (
-	CollectionLocks        = "locks"
-	CollectionTransactions = "transactions"
-	CollectionRefunds      = "refunds"
-	CollectionMessages     = "messages"
-	CollectionNodes        = "nodes"
+	CollectionLocks           = "locks"
+	CollectionTransactions    = "transactions"
+	CollectionRefunds         = "refunds"
+	CollectionMessages        = "messages"
+	CollectionNodes           = "nodes"
+	CollectionCheckpoints     = "checkpoints"
+	CollectionAnomalies       = "anomalies"
+	CollectionSupplySnapshots = "supply_snapshots"
+	CollectionContractEvents  = "contract_events"
+	CollectionStatusEvents    = "status_events"
 )
 
 const (
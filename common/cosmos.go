@@ -3,6 +3,8 @@ package common
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
@@ -53,6 +55,22 @@ func CosmosPublicKeyHexFromMnemonic(mnemonic string) (string, error) {
 	return hex.EncodeToString(pubKey.Bytes()), nil
 }
 
+// OracleIDFromMnemonic names the oracle by the index of its public key in the multisig
+func OracleIDFromMnemonic(mnemonic string, multisigPublicKeys []string) (string, error) {
+	pubKeyHex, err := CosmosPublicKeyHexFromMnemonic(mnemonic)
+	if err != nil {
+		return "", err
+	}
+
+	for i, pk := range multisigPublicKeys {
+		if strings.EqualFold(pk, pubKeyHex) {
+			return fmt.Sprintf("oracle-%02d", i), nil
+		}
+	}
+
+	return "", errors.New("multisig public keys do not contain signer")
+}
+
 func CosmosPublicKeyFromHex(pubKeyHex string) (crypto.PubKey, error) {
 	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
 	if err != nil {
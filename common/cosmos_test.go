@@ -1,6 +1,7 @@
 package common
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
@@ -47,6 +48,21 @@ func TestCosmosPublicKeyHexFromMnemonic_Error(t *testing.T) {
 	assert.Empty(t, pubKeyHex)
 }
 
+func TestOracleIDFromMnemonic(t *testing.T) {
+	pubKeyHex, err := CosmosPublicKeyHexFromMnemonic(testMnemonic)
+	assert.NoError(t, err)
+
+	oracleID, err := OracleIDFromMnemonic(testMnemonic, []string{"0x00", strings.ToUpper(pubKeyHex)})
+	assert.NoError(t, err)
+	assert.Equal(t, "oracle-01", oracleID)
+
+	_, err = OracleIDFromMnemonic(testMnemonic, []string{"0x00"})
+	assert.Error(t, err)
+
+	_, err = OracleIDFromMnemonic("error", []string{pubKeyHex})
+	assert.Error(t, err)
+}
+
 func TestCosmosPublicKeyFromHex(t *testing.T) {
 	pubKeyHex, _ := CosmosPublicKeyHexFromMnemonic(testMnemonic)
 	pubKey, err := CosmosPublicKeyFromHex(pubKeyHex)
@@ -33,7 +33,6 @@ func loadConfigFromEnv(envFile string) (models.Config, error) {
 
 	// Set values from environment variables
 	config.HealthCheck.IntervalMS = getUint64Env("HEALTH_CHECK_INTERVAL_MS")
-	config.HealthCheck.ReadLastHealth = getBoolEnv("HEALTH_CHECK_READ_LAST_HEALTH")
 	config.Logger.Level = getStringEnv("LOGGER_LEVEL")
 	config.Logger.Format = getStringEnv("LOGGER_FORMAT")
 	config.MongoDB.URI = getStringEnv("MONGODB_URI")
@@ -60,8 +59,12 @@ func loadConfigFromEnv(envFile string) (models.Config, error) {
 			config.EthereumNetworks[i] = models.EthereumNetworkConfig{
 				StartBlockHeight:      getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_START_BLOCK_HEIGHT"),
 				Confirmations:         getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_CONFIRMATIONS"),
+				FinalityTag:           getStringEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_FINALITY_TAG"),
 				RPCURL:                getStringEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_RPC_URL"),
+				RPCURLs:               getStringArrayEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_RPC_URLS"),
+				RPCQuorum:             getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_RPC_QUORUM"),
 				TimeoutMS:             getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_TIMEOUT_MS"),
+				MaxQueryBlocks:        getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MAX_QUERY_BLOCKS"),
 				ChainID:               getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_CHAIN_ID"),
 				ChainName:             getStringEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_CHAIN_NAME"),
 				MailboxAddress:        getStringEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MAILBOX_ADDRESS"),
@@ -81,6 +84,26 @@ func loadConfigFromEnv(envFile string) (models.Config, error) {
 					Enabled:    getBoolEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_RELAYER_ENABLED"),
 					IntervalMS: getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_RELAYER_INTERVAL_MS"),
 				},
+				MessageAuditor: models.ServiceConfig{
+					Enabled:    getBoolEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_AUDITOR_ENABLED"),
+					IntervalMS: getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_AUDITOR_INTERVAL_MS"),
+				},
+				MessageFulfiller: models.FulfillerConfig{
+					Enabled:             getBoolEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_FULFILLER_ENABLED"),
+					IntervalMS:          getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_FULFILLER_INTERVAL_MS"),
+					PrivateKey:          getStringEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_FULFILLER_PRIVATE_KEY"),
+					DailySpendingCapWei: getStringEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_FULFILLER_DAILY_SPENDING_CAP_WEI"),
+					StuckTimeoutMS:      getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_FULFILLER_STUCK_TIMEOUT_MS"),
+					GasBumpPercent:      getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MESSAGE_FULFILLER_GAS_BUMP_PERCENT"),
+				},
+				MintAuditor: models.ServiceConfig{
+					Enabled:    getBoolEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MINT_AUDITOR_ENABLED"),
+					IntervalMS: getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_MINT_AUDITOR_INTERVAL_MS"),
+				},
+				SecurityWatch: models.ServiceConfig{
+					Enabled:    getBoolEnv("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_SECURITY_WATCH_ENABLED"),
+					IntervalMS: getUint64Env("ETHEREUM_NETWORKS_" + strconv.Itoa(i) + "_SECURITY_WATCH_INTERVAL_MS"),
+				},
 			}
 		}
 	}
@@ -93,6 +116,9 @@ func loadConfigFromEnv(envFile string) (models.Config, error) {
 		GRPCEnabled:        getBoolEnv("COSMOS_NETWORK_GRPC_ENABLED"),
 		GRPCHost:           getStringEnv("COSMOS_NETWORK_GRPC_HOST"),
 		GRPCPort:           getUint64Env("COSMOS_NETWORK_GRPC_PORT"),
+		RPCURLs:            getStringArrayEnv("COSMOS_NETWORK_RPC_URLS"),
+		GRPCURLs:           getStringArrayEnv("COSMOS_NETWORK_GRPC_URLS"),
+		VerifyResponses:    getBoolEnv("COSMOS_NETWORK_VERIFY_RESPONSES"),
 		TimeoutMS:          getUint64Env("COSMOS_NETWORK_TIMEOUT_MS"),
 		ChainID:            getStringEnv("COSMOS_NETWORK_CHAIN_ID"),
 		ChainName:          getStringEnv("COSMOS_NETWORK_CHAIN_NAME"),
@@ -114,6 +140,15 @@ func loadConfigFromEnv(envFile string) (models.Config, error) {
 			Enabled:    getBoolEnv("COSMOS_NETWORK_MESSAGE_RELAYER_ENABLED"),
 			IntervalMS: getUint64Env("COSMOS_NETWORK_MESSAGE_RELAYER_INTERVAL_MS"),
 		},
+		SupplyReconciler: models.ReconcilerConfig{
+			Enabled:    getBoolEnv("COSMOS_NETWORK_SUPPLY_RECONCILER_ENABLED"),
+			IntervalMS: getUint64Env("COSMOS_NETWORK_SUPPLY_RECONCILER_INTERVAL_MS"),
+			Tolerance:  getStringEnv("COSMOS_NETWORK_SUPPLY_RECONCILER_TOLERANCE"),
+		},
+		SpendAuditor: models.ServiceConfig{
+			Enabled:    getBoolEnv("COSMOS_NETWORK_SPEND_AUDITOR_ENABLED"),
+			IntervalMS: getUint64Env("COSMOS_NETWORK_SPEND_AUDITOR_INTERVAL_MS"),
+		},
 	}
 
 	logger.Debug("Config loaded from env")
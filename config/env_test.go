@@ -170,8 +170,14 @@ NUM_ETHEREUM_NETWORKS=2
 ETHEREUM_NETWORKS_0_CONFIRMATIONS=1
 ETHEREUM_NETWORKS_0_START_BLOCK_NUMBER=1
 ETHEREUM_NETWORKS_1_CONFIRMATIONS=2
+ETHEREUM_NETWORKS_1_FINALITY_TAG=finalized
+ETHEREUM_NETWORKS_1_MAX_QUERY_BLOCKS=2000
+ETHEREUM_NETWORKS_1_RPC_URLS=http://localhost:8546,http://localhost:8547
+ETHEREUM_NETWORKS_1_RPC_QUORUM=2
 ETHEREUM_NETWORKS_1_START_BLOCK_NUMBER=1
 COSMOS_NETWORK_CONFIRMATIONS=3
+COSMOS_NETWORK_GRPC_URLS=localhost:9091,localhost:9092
+COSMOS_NETWORK_VERIFY_RESPONSES=true
 `
 		err := os.WriteFile(".test.env", []byte(envContent), 0644)
 		assert.NoError(t, err)
@@ -183,11 +189,24 @@ COSMOS_NETWORK_CONFIRMATIONS=3
 		assert.Equal(t, 2, len(config.EthereumNetworks))
 		assert.Equal(t, uint64(1), config.EthereumNetworks[0].Confirmations)
 		assert.Equal(t, uint64(2), config.EthereumNetworks[1].Confirmations)
+		assert.Equal(t, "", config.EthereumNetworks[0].FinalityTag)
+		assert.Equal(t, "finalized", config.EthereumNetworks[1].FinalityTag)
+		assert.Equal(t, uint64(2000), config.EthereumNetworks[1].MaxQueryBlocks)
+		assert.Equal(t, []string{"http://localhost:8546", "http://localhost:8547"}, config.EthereumNetworks[1].RPCURLs)
+		assert.Equal(t, uint64(2), config.EthereumNetworks[1].RPCQuorum)
 		assert.Equal(t, uint64(3), config.CosmosNetwork.Confirmations)
+		assert.Equal(t, []string{"localhost:9091", "localhost:9092"}, config.CosmosNetwork.GRPCURLs)
+		assert.True(t, config.CosmosNetwork.VerifyResponses)
 
 		os.Unsetenv("NUM_ETHEREUM_NETWORKS")
 		os.Unsetenv("ETHEREUM_NETWORKS_0_CONFIRMATIONS")
 		os.Unsetenv("ETHEREUM_NETWORKS_1_CONFIRMATIONS")
+		os.Unsetenv("ETHEREUM_NETWORKS_1_FINALITY_TAG")
+		os.Unsetenv("ETHEREUM_NETWORKS_1_MAX_QUERY_BLOCKS")
+		os.Unsetenv("ETHEREUM_NETWORKS_1_RPC_URLS")
+		os.Unsetenv("ETHEREUM_NETWORKS_1_RPC_QUORUM")
 		os.Unsetenv("COSMOS_NETWORK_CONFIRMATIONS")
+		os.Unsetenv("COSMOS_NETWORK_GRPC_URLS")
+		os.Unsetenv("COSMOS_NETWORK_VERIFY_RESPONSES")
 	})
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"fmt"
 
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
@@ -64,7 +65,14 @@ func loadSecretsFromGSM(config models.Config) (models.Config, error) {
 	logger.Debugf("Loading secrets from GSM")
 	configWithSecrets := config
 
-	if !isGSMValue(config.MongoDB.URI) && !isGSMValue(config.Mnemonic) {
+	hasFulfillerSecret := false
+	for _, ethNetwork := range config.EthereumNetworks {
+		if isGSMValue(ethNetwork.MessageFulfiller.PrivateKey) {
+			hasFulfillerSecret = true
+		}
+	}
+
+	if !isGSMValue(config.MongoDB.URI) && !isGSMValue(config.Mnemonic) && !hasFulfillerSecret {
 		logger.Debugf("No secrets to load from GSM")
 		return configWithSecrets, nil
 	}
@@ -88,6 +96,15 @@ func loadSecretsFromGSM(config models.Config) (models.Config, error) {
 		return configWithSecrets, err
 	}
 
+	// copy the networks so the secrets are not written into the slice of the original config
+	configWithSecrets.EthereumNetworks = append([]models.EthereumNetworkConfig(nil), config.EthereumNetworks...)
+	for i, ethNetwork := range config.EthereumNetworks {
+		configWithSecrets.EthereumNetworks[i].MessageFulfiller.PrivateKey, err = readSecretFromGSM(client, fmt.Sprintf("EthereumNetworks[%d].MessageFulfiller.PrivateKey", i), ethNetwork.MessageFulfiller.PrivateKey)
+		if err != nil {
+			return configWithSecrets, err
+		}
+	}
+
 	logger.Debugf("Successfully loaded secrets from GSM")
 	return configWithSecrets, nil
 }
@@ -172,6 +172,54 @@ func TestLoadSecretsFromGSM_Error(t *testing.T) {
 		assert.Equal(t, "mnemonic", configWithSecrets.Mnemonic)
 		assert.NoError(t, err)
 	})
+
+	t.Run("Successfully read fulfiller private key", func(t *testing.T) {
+		oldNewSecretManagerClient := NewSecretManagerClient
+		NewSecretManagerClient = func() (SecretManagerClient, error) {
+			client := &mockSecretManagerClient{}
+			client.SetSecretValue("projects/project-id/secrets/fulfiller-key/versions/latest", "0xkey")
+			return client, nil
+		}
+		defer func() { NewSecretManagerClient = oldNewSecretManagerClient }()
+
+		config := models.Config{
+			MongoDB: models.MongoConfig{
+				URI: "mongodb://localhost:27017",
+			},
+			Mnemonic: "mnemonic",
+			EthereumNetworks: []models.EthereumNetworkConfig{
+				{},
+				{MessageFulfiller: models.FulfillerConfig{PrivateKey: "gsm:projects/project-id/secrets/fulfiller-key/versions/latest"}},
+			},
+		}
+
+		configWithSecrets, err := loadSecretsFromGSM(config)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "", configWithSecrets.EthereumNetworks[0].MessageFulfiller.PrivateKey)
+		assert.Equal(t, "0xkey", configWithSecrets.EthereumNetworks[1].MessageFulfiller.PrivateKey)
+		assert.Equal(t, "gsm:projects/project-id/secrets/fulfiller-key/versions/latest", config.EthereumNetworks[1].MessageFulfiller.PrivateKey)
+	})
+
+	t.Run("Failed to read fulfiller private key", func(t *testing.T) {
+		oldNewSecretManagerClient := NewSecretManagerClient
+		NewSecretManagerClient = func() (SecretManagerClient, error) {
+			client := &mockSecretManagerClient{}
+			client.SetError("projects/project-id/secrets/fulfiller-key/versions/latest", errors.New("error"))
+			return client, nil
+		}
+		defer func() { NewSecretManagerClient = oldNewSecretManagerClient }()
+
+		config := models.Config{
+			EthereumNetworks: []models.EthereumNetworkConfig{
+				{MessageFulfiller: models.FulfillerConfig{PrivateKey: "gsm:projects/project-id/secrets/fulfiller-key/versions/latest"}},
+			},
+		}
+
+		_, err := loadSecretsFromGSM(config)
+
+		assert.Error(t, err)
+	})
 }
 
 func TestReadSecretFromGSM(t *testing.T) {
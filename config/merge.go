@@ -15,9 +15,6 @@ func mergeConfigs(yamlConfig models.Config, envConfig models.Config) models.Conf
 	if envConfig.HealthCheck.IntervalMS != 0 {
 		mergedConfig.HealthCheck.IntervalMS = envConfig.HealthCheck.IntervalMS
 	}
-	if envConfig.HealthCheck.ReadLastHealth {
-		mergedConfig.HealthCheck.ReadLastHealth = envConfig.HealthCheck.ReadLastHealth
-	}
 
 	// Merge Logger
 	if envConfig.Logger.Level != "" {
@@ -51,12 +48,24 @@ func mergeConfigs(yamlConfig models.Config, envConfig models.Config) models.Conf
 			if envEthNet.Confirmations != 0 {
 				mergedConfig.EthereumNetworks[i].Confirmations = envEthNet.Confirmations
 			}
+			if envEthNet.FinalityTag != "" {
+				mergedConfig.EthereumNetworks[i].FinalityTag = envEthNet.FinalityTag
+			}
 			if envEthNet.RPCURL != "" {
 				mergedConfig.EthereumNetworks[i].RPCURL = envEthNet.RPCURL
 			}
+			if len(envEthNet.RPCURLs) != 0 {
+				mergedConfig.EthereumNetworks[i].RPCURLs = envEthNet.RPCURLs
+			}
+			if envEthNet.RPCQuorum != 0 {
+				mergedConfig.EthereumNetworks[i].RPCQuorum = envEthNet.RPCQuorum
+			}
 			if envEthNet.TimeoutMS != 0 {
 				mergedConfig.EthereumNetworks[i].TimeoutMS = envEthNet.TimeoutMS
 			}
+			if envEthNet.MaxQueryBlocks != 0 {
+				mergedConfig.EthereumNetworks[i].MaxQueryBlocks = envEthNet.MaxQueryBlocks
+			}
 			if envEthNet.ChainID != 0 {
 				mergedConfig.EthereumNetworks[i].ChainID = envEthNet.ChainID
 			}
@@ -96,6 +105,42 @@ func mergeConfigs(yamlConfig models.Config, envConfig models.Config) models.Conf
 			if envEthNet.MessageRelayer.IntervalMS != 0 {
 				mergedConfig.EthereumNetworks[i].MessageRelayer.IntervalMS = envEthNet.MessageRelayer.IntervalMS
 			}
+			if envEthNet.MessageAuditor.Enabled {
+				mergedConfig.EthereumNetworks[i].MessageAuditor.Enabled = envEthNet.MessageAuditor.Enabled
+			}
+			if envEthNet.MessageAuditor.IntervalMS != 0 {
+				mergedConfig.EthereumNetworks[i].MessageAuditor.IntervalMS = envEthNet.MessageAuditor.IntervalMS
+			}
+			if envEthNet.MessageFulfiller.Enabled {
+				mergedConfig.EthereumNetworks[i].MessageFulfiller.Enabled = envEthNet.MessageFulfiller.Enabled
+			}
+			if envEthNet.MessageFulfiller.IntervalMS != 0 {
+				mergedConfig.EthereumNetworks[i].MessageFulfiller.IntervalMS = envEthNet.MessageFulfiller.IntervalMS
+			}
+			if envEthNet.MessageFulfiller.PrivateKey != "" {
+				mergedConfig.EthereumNetworks[i].MessageFulfiller.PrivateKey = envEthNet.MessageFulfiller.PrivateKey
+			}
+			if envEthNet.MessageFulfiller.DailySpendingCapWei != "" {
+				mergedConfig.EthereumNetworks[i].MessageFulfiller.DailySpendingCapWei = envEthNet.MessageFulfiller.DailySpendingCapWei
+			}
+			if envEthNet.MessageFulfiller.StuckTimeoutMS != 0 {
+				mergedConfig.EthereumNetworks[i].MessageFulfiller.StuckTimeoutMS = envEthNet.MessageFulfiller.StuckTimeoutMS
+			}
+			if envEthNet.MessageFulfiller.GasBumpPercent != 0 {
+				mergedConfig.EthereumNetworks[i].MessageFulfiller.GasBumpPercent = envEthNet.MessageFulfiller.GasBumpPercent
+			}
+			if envEthNet.MintAuditor.Enabled {
+				mergedConfig.EthereumNetworks[i].MintAuditor.Enabled = envEthNet.MintAuditor.Enabled
+			}
+			if envEthNet.MintAuditor.IntervalMS != 0 {
+				mergedConfig.EthereumNetworks[i].MintAuditor.IntervalMS = envEthNet.MintAuditor.IntervalMS
+			}
+			if envEthNet.SecurityWatch.Enabled {
+				mergedConfig.EthereumNetworks[i].SecurityWatch.Enabled = envEthNet.SecurityWatch.Enabled
+			}
+			if envEthNet.SecurityWatch.IntervalMS != 0 {
+				mergedConfig.EthereumNetworks[i].SecurityWatch.IntervalMS = envEthNet.SecurityWatch.IntervalMS
+			}
 		} else {
 			mergedConfig.EthereumNetworks = append(mergedConfig.EthereumNetworks, envEthNet)
 		}
@@ -120,6 +165,15 @@ func mergeConfigs(yamlConfig models.Config, envConfig models.Config) models.Conf
 	if envConfig.CosmosNetwork.GRPCPort != 0 {
 		mergedConfig.CosmosNetwork.GRPCPort = envConfig.CosmosNetwork.GRPCPort
 	}
+	if len(envConfig.CosmosNetwork.RPCURLs) != 0 {
+		mergedConfig.CosmosNetwork.RPCURLs = envConfig.CosmosNetwork.RPCURLs
+	}
+	if len(envConfig.CosmosNetwork.GRPCURLs) != 0 {
+		mergedConfig.CosmosNetwork.GRPCURLs = envConfig.CosmosNetwork.GRPCURLs
+	}
+	if envConfig.CosmosNetwork.VerifyResponses {
+		mergedConfig.CosmosNetwork.VerifyResponses = envConfig.CosmosNetwork.VerifyResponses
+	}
 	if envConfig.CosmosNetwork.TimeoutMS != 0 {
 		mergedConfig.CosmosNetwork.TimeoutMS = envConfig.CosmosNetwork.TimeoutMS
 	}
@@ -165,6 +219,21 @@ func mergeConfigs(yamlConfig models.Config, envConfig models.Config) models.Conf
 	if envConfig.CosmosNetwork.MessageRelayer.IntervalMS != 0 {
 		mergedConfig.CosmosNetwork.MessageRelayer.IntervalMS = envConfig.CosmosNetwork.MessageRelayer.IntervalMS
 	}
+	if envConfig.CosmosNetwork.SupplyReconciler.Enabled {
+		mergedConfig.CosmosNetwork.SupplyReconciler.Enabled = envConfig.CosmosNetwork.SupplyReconciler.Enabled
+	}
+	if envConfig.CosmosNetwork.SupplyReconciler.IntervalMS != 0 {
+		mergedConfig.CosmosNetwork.SupplyReconciler.IntervalMS = envConfig.CosmosNetwork.SupplyReconciler.IntervalMS
+	}
+	if envConfig.CosmosNetwork.SupplyReconciler.Tolerance != "" {
+		mergedConfig.CosmosNetwork.SupplyReconciler.Tolerance = envConfig.CosmosNetwork.SupplyReconciler.Tolerance
+	}
+	if envConfig.CosmosNetwork.SpendAuditor.Enabled {
+		mergedConfig.CosmosNetwork.SpendAuditor.Enabled = envConfig.CosmosNetwork.SpendAuditor.Enabled
+	}
+	if envConfig.CosmosNetwork.SpendAuditor.IntervalMS != 0 {
+		mergedConfig.CosmosNetwork.SpendAuditor.IntervalMS = envConfig.CosmosNetwork.SpendAuditor.IntervalMS
+	}
 
 	logger.Debug("Config merged successfully")
 	return mergedConfig
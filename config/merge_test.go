@@ -10,12 +10,11 @@ import (
 func TestMergeConfigs(t *testing.T) {
 	t.Run("Merge HealthCheck", func(t *testing.T) {
 		yamlConfig := models.Config{}
-		envConfig := models.Config{HealthCheck: models.HealthCheckConfig{IntervalMS: 100, ReadLastHealth: true}}
+		envConfig := models.Config{HealthCheck: models.HealthCheckConfig{IntervalMS: 100}}
 
 		mergedConfig := mergeConfigs(yamlConfig, envConfig)
 
 		assert.Equal(t, uint64(100), mergedConfig.HealthCheck.IntervalMS)
-		assert.True(t, mergedConfig.HealthCheck.ReadLastHealth)
 	})
 
 	t.Run("Merge Logger", func(t *testing.T) {
@@ -65,8 +64,12 @@ func TestMergeConfigs(t *testing.T) {
 				{
 					StartBlockHeight:      100,
 					Confirmations:         12,
+					FinalityTag:           "safe",
 					RPCURL:                "http://localhost:8545",
+					RPCURLs:               []string{"http://localhost:8546"},
+					RPCQuorum:             2,
 					TimeoutMS:             3000,
+					MaxQueryBlocks:        5000,
 					ChainID:               1,
 					ChainName:             "Ethereum",
 					MailboxAddress:        "0xMailboxAddress",
@@ -86,6 +89,26 @@ func TestMergeConfigs(t *testing.T) {
 						Enabled:    true,
 						IntervalMS: 3000,
 					},
+					MessageAuditor: models.ServiceConfig{
+						Enabled:    true,
+						IntervalMS: 4000,
+					},
+					MessageFulfiller: models.FulfillerConfig{
+						Enabled:             true,
+						IntervalMS:          5000,
+						PrivateKey:          "0xkey",
+						DailySpendingCapWei: "1000000000000000000",
+						StuckTimeoutMS:      60000,
+						GasBumpPercent:      15,
+					},
+					MintAuditor: models.ServiceConfig{
+						Enabled:    true,
+						IntervalMS: 6000,
+					},
+					SecurityWatch: models.ServiceConfig{
+						Enabled:    true,
+						IntervalMS: 7000,
+					},
 				},
 				{
 					StartBlockHeight:      100,
@@ -119,6 +142,10 @@ func TestMergeConfigs(t *testing.T) {
 
 		assert.Equal(t, uint64(100), mergedConfig.EthereumNetworks[0].StartBlockHeight)
 		assert.Equal(t, uint64(12), mergedConfig.EthereumNetworks[0].Confirmations)
+		assert.Equal(t, "safe", mergedConfig.EthereumNetworks[0].FinalityTag)
+		assert.Equal(t, uint64(5000), mergedConfig.EthereumNetworks[0].MaxQueryBlocks)
+		assert.Equal(t, []string{"http://localhost:8546"}, mergedConfig.EthereumNetworks[0].RPCURLs)
+		assert.Equal(t, uint64(2), mergedConfig.EthereumNetworks[0].RPCQuorum)
 		assert.Equal(t, "http://localhost:8545", mergedConfig.EthereumNetworks[0].RPCURL)
 		assert.Equal(t, uint64(3000), mergedConfig.EthereumNetworks[0].TimeoutMS)
 		assert.Equal(t, uint64(1), mergedConfig.EthereumNetworks[0].ChainID)
@@ -134,6 +161,20 @@ func TestMergeConfigs(t *testing.T) {
 		assert.Equal(t, uint64(2000), mergedConfig.EthereumNetworks[0].MessageSigner.IntervalMS)
 		assert.True(t, mergedConfig.EthereumNetworks[0].MessageRelayer.Enabled)
 		assert.Equal(t, uint64(3000), mergedConfig.EthereumNetworks[0].MessageRelayer.IntervalMS)
+		assert.True(t, mergedConfig.EthereumNetworks[0].MessageAuditor.Enabled)
+		assert.Equal(t, uint64(4000), mergedConfig.EthereumNetworks[0].MessageAuditor.IntervalMS)
+		assert.Equal(t, models.FulfillerConfig{
+			Enabled:             true,
+			IntervalMS:          5000,
+			PrivateKey:          "0xkey",
+			DailySpendingCapWei: "1000000000000000000",
+			StuckTimeoutMS:      60000,
+			GasBumpPercent:      15,
+		}, mergedConfig.EthereumNetworks[0].MessageFulfiller)
+		assert.True(t, mergedConfig.EthereumNetworks[0].MintAuditor.Enabled)
+		assert.Equal(t, uint64(6000), mergedConfig.EthereumNetworks[0].MintAuditor.IntervalMS)
+		assert.True(t, mergedConfig.EthereumNetworks[0].SecurityWatch.Enabled)
+		assert.Equal(t, uint64(7000), mergedConfig.EthereumNetworks[0].SecurityWatch.IntervalMS)
 		assert.Equal(t, 2, len(mergedConfig.EthereumNetworks))
 		assert.Equal(t, uint64(2), mergedConfig.EthereumNetworks[1].ChainID)
 	})
@@ -148,6 +189,9 @@ func TestMergeConfigs(t *testing.T) {
 				GRPCEnabled:        true,
 				GRPCHost:           "localhost",
 				GRPCPort:           9090,
+				RPCURLs:            []string{"http://localhost:26658"},
+				GRPCURLs:           []string{"localhost:9091"},
+				VerifyResponses:    true,
 				TimeoutMS:          3000,
 				ChainID:            "cosmoshub-4",
 				ChainName:          "Cosmos Hub",
@@ -169,6 +213,15 @@ func TestMergeConfigs(t *testing.T) {
 					Enabled:    true,
 					IntervalMS: 3000,
 				},
+				SupplyReconciler: models.ReconcilerConfig{
+					Enabled:    true,
+					IntervalMS: 4000,
+					Tolerance:  "1000",
+				},
+				SpendAuditor: models.ServiceConfig{
+					Enabled:    true,
+					IntervalMS: 5000,
+				},
 			},
 		}
 
@@ -180,6 +233,9 @@ func TestMergeConfigs(t *testing.T) {
 		assert.True(t, mergedConfig.CosmosNetwork.GRPCEnabled)
 		assert.Equal(t, "localhost", mergedConfig.CosmosNetwork.GRPCHost)
 		assert.Equal(t, uint64(9090), mergedConfig.CosmosNetwork.GRPCPort)
+		assert.Equal(t, []string{"http://localhost:26658"}, mergedConfig.CosmosNetwork.RPCURLs)
+		assert.Equal(t, []string{"localhost:9091"}, mergedConfig.CosmosNetwork.GRPCURLs)
+		assert.True(t, mergedConfig.CosmosNetwork.VerifyResponses)
 		assert.Equal(t, uint64(3000), mergedConfig.CosmosNetwork.TimeoutMS)
 		assert.Equal(t, "cosmoshub-4", mergedConfig.CosmosNetwork.ChainID)
 		assert.Equal(t, "Cosmos Hub", mergedConfig.CosmosNetwork.ChainName)
@@ -195,5 +251,12 @@ func TestMergeConfigs(t *testing.T) {
 		assert.Equal(t, uint64(2000), mergedConfig.CosmosNetwork.MessageSigner.IntervalMS)
 		assert.True(t, mergedConfig.CosmosNetwork.MessageRelayer.Enabled)
 		assert.Equal(t, uint64(3000), mergedConfig.CosmosNetwork.MessageRelayer.IntervalMS)
+		assert.Equal(t, models.ReconcilerConfig{
+			Enabled:    true,
+			IntervalMS: 4000,
+			Tolerance:  "1000",
+		}, mergedConfig.CosmosNetwork.SupplyReconciler)
+		assert.True(t, mergedConfig.CosmosNetwork.SpendAuditor.Enabled)
+		assert.Equal(t, uint64(5000), mergedConfig.CosmosNetwork.SpendAuditor.IntervalMS)
 	})
 }
@@ -3,12 +3,14 @@ package config
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/go-bip39"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/dan13ram/wpokt-oracle/common"
 	"github.com/dan13ram/wpokt-oracle/models"
@@ -56,15 +58,28 @@ func validateConfig(config models.Config) error {
 		if ethNetwork.StartBlockHeight == 0 {
 			logger.Warnf("EthereumNetworks[%d].StartBlockHeight is 0", i)
 		}
-		if ethNetwork.Confirmations == 0 {
-			logger.Warnf("EthereumNetworks[%d].Confirmations is 0", i)
+		switch ethNetwork.FinalityTag {
+		case "":
+			if ethNetwork.Confirmations == 0 {
+				logger.Warnf("EthereumNetworks[%d].Confirmations is 0", i)
+			}
+		case models.FinalityTagSafe, models.FinalityTagFinalized:
+		default:
+			return fmt.Errorf("EthereumNetworks[%d].FinalityTag must be one of %q, %q or empty", i, models.FinalityTagSafe, models.FinalityTagFinalized)
 		}
-		if ethNetwork.RPCURL == "" {
+		rpcEndpoints := ethNetwork.RPCEndpoints()
+		if len(rpcEndpoints) == 0 {
 			return fmt.Errorf("EthereumNetworks[%d].RPCURL is required", i)
 		}
+		if ethNetwork.RPCQuorum > uint64(len(rpcEndpoints)) {
+			return fmt.Errorf("EthereumNetworks[%d].RPCQuorum cannot be more than the number of rpc urls", i)
+		}
 		if ethNetwork.TimeoutMS == 0 {
 			return fmt.Errorf("EthereumNetworks[%d].TimeoutMS is required", i)
 		}
+		if ethNetwork.MaxQueryBlocks == 0 {
+			logger.Warnf("EthereumNetworks[%d].MaxQueryBlocks is 0, using default", i)
+		}
 		if ethNetwork.ChainID == 0 {
 			return fmt.Errorf("EthereumNetworks[%d].ChainId is required", i)
 		}
@@ -112,6 +127,24 @@ func validateConfig(config models.Config) error {
 		if err := validateServiceConfig(fmt.Sprintf("EthereumNetworks[%d].MessageRelayer", i), ethNetwork.MessageRelayer); err != nil {
 			return err
 		}
+		if err := validateServiceConfig(fmt.Sprintf("EthereumNetworks[%d].MessageAuditor", i), ethNetwork.MessageAuditor); err != nil {
+			return err
+		}
+		if ethNetwork.MessageAuditor.Enabled && !ethNetwork.MessageMonitor.Enabled {
+			return fmt.Errorf("EthereumNetworks[%d].MessageAuditor requires MessageMonitor to be enabled", i)
+		}
+		if err := validateFulfillerConfig(fmt.Sprintf("EthereumNetworks[%d].MessageFulfiller", i), ethNetwork.MessageFulfiller); err != nil {
+			return err
+		}
+		if ethNetwork.MessageFulfiller.Enabled && !ethNetwork.MessageRelayer.Enabled {
+			return fmt.Errorf("EthereumNetworks[%d].MessageFulfiller requires MessageRelayer to be enabled", i)
+		}
+		if err := validateServiceConfig(fmt.Sprintf("EthereumNetworks[%d].MintAuditor", i), ethNetwork.MintAuditor); err != nil {
+			return err
+		}
+		if err := validateServiceConfig(fmt.Sprintf("EthereumNetworks[%d].SecurityWatch", i), ethNetwork.SecurityWatch); err != nil {
+			return err
+		}
 	}
 
 	logger.Debug("Ethereum validated")
@@ -135,6 +168,9 @@ func validateConfig(config models.Config) error {
 			return fmt.Errorf("CosmosNetwork.RPCURL is required when GRPCEnabled is false")
 		}
 	}
+	if config.CosmosNetwork.VerifyResponses && len(config.CosmosNetwork.Endpoints()) < 2 {
+		return fmt.Errorf("CosmosNetwork.VerifyResponses requires at least 2 endpoints")
+	}
 	if config.CosmosNetwork.TimeoutMS == 0 {
 		return fmt.Errorf("CosmosNetwork.TimeoutMS is required")
 	}
@@ -197,6 +233,12 @@ func validateConfig(config models.Config) error {
 	if err := validateServiceConfig("CosmosNetwork.MessageRelayer", config.CosmosNetwork.MessageRelayer); err != nil {
 		return err
 	}
+	if err := validateReconcilerConfig("CosmosNetwork.SupplyReconciler", config.CosmosNetwork.SupplyReconciler); err != nil {
+		return err
+	}
+	if err := validateServiceConfig("CosmosNetwork.SpendAuditor", config.CosmosNetwork.SpendAuditor); err != nil {
+		return err
+	}
 
 	logger.Debug("Cosmos validated")
 
@@ -218,3 +260,41 @@ func validateServiceConfig(label string, config models.ServiceConfig) error {
 	}
 	return nil
 }
+
+func validateFulfillerConfig(label string, config models.FulfillerConfig) error {
+	if !config.Enabled {
+		return nil
+	}
+	if config.IntervalMS == 0 {
+		return fmt.Errorf("%s.IntervalMS is required", label)
+	}
+	if _, err := ethcrypto.HexToECDSA(strings.TrimPrefix(config.PrivateKey, "0x")); err != nil {
+		return fmt.Errorf("%s.PrivateKey is invalid", label)
+	}
+	if spendingCap, ok := new(big.Int).SetString(config.DailySpendingCapWei, 10); !ok || spendingCap.Sign() <= 0 {
+		return fmt.Errorf("%s.DailySpendingCapWei must be a positive amount of wei", label)
+	}
+	if config.StuckTimeoutMS == 0 {
+		return fmt.Errorf("%s.StuckTimeoutMS is required", label)
+	}
+	// nodes reject replacements that raise the fees by less than 10%
+	if config.GasBumpPercent < 10 {
+		return fmt.Errorf("%s.GasBumpPercent must be at least 10", label)
+	}
+	return nil
+}
+
+func validateReconcilerConfig(label string, config models.ReconcilerConfig) error {
+	if !config.Enabled {
+		return nil
+	}
+	if config.IntervalMS == 0 {
+		return fmt.Errorf("%s.IntervalMS is required", label)
+	}
+	if config.Tolerance != "" {
+		if tolerance, ok := new(big.Int).SetString(config.Tolerance, 10); !ok || tolerance.Sign() < 0 {
+			return fmt.Errorf("%s.Tolerance must be a non-negative amount", label)
+		}
+	}
+	return nil
+}
@@ -24,6 +24,7 @@ func validConfig() models.Config {
 				StartBlockHeight:      1,
 				Confirmations:         1,
 				RPCURL:                "http://localhost:8545",
+				RPCURLs:               []string{"http://localhost:8546"},
 				TimeoutMS:             1000,
 				ChainID:               1,
 				ChainName:             "Ethereum",
@@ -53,6 +54,8 @@ func validConfig() models.Config {
 			GRPCEnabled:        true,
 			GRPCHost:           "localhost",
 			GRPCPort:           9090,
+			RPCURLs:            []string{"http://localhost:36658"},
+			GRPCURLs:           []string{"localhost:9091"},
 			TimeoutMS:          1000,
 			ChainID:            "poktroll",
 			ChainName:          "Poktroll",
@@ -76,8 +79,7 @@ func validConfig() models.Config {
 			},
 		},
 		HealthCheck: models.HealthCheckConfig{
-			IntervalMS:     1000,
-			ReadLastHealth: true,
+			IntervalMS: 1000,
 		},
 	}
 }
@@ -137,6 +139,7 @@ func TestValidateConfig(t *testing.T) {
 	t.Run("Invalid ethereum network rpc url", func(t *testing.T) {
 		config := validConfig()
 		config.EthereumNetworks[0].RPCURL = ""
+		config.EthereumNetworks[0].RPCURLs = nil
 		err := validateConfig(config)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "EthereumNetworks[0].RPCURL")
@@ -146,6 +149,7 @@ func TestValidateConfig(t *testing.T) {
 		config := validConfig()
 		config.EthereumNetworks[0].StartBlockHeight = 0
 		config.EthereumNetworks[0].RPCURL = ""
+		config.EthereumNetworks[0].RPCURLs = nil
 
 		testLogger, hook := test.NewNullLogger()
 		oldLogger := logger
@@ -164,6 +168,7 @@ func TestValidateConfig(t *testing.T) {
 		config := validConfig()
 		config.EthereumNetworks[0].Confirmations = 0
 		config.EthereumNetworks[0].RPCURL = ""
+		config.EthereumNetworks[0].RPCURLs = nil
 
 		testLogger, hook := test.NewNullLogger()
 		oldLogger := logger
@@ -178,6 +183,35 @@ func TestValidateConfig(t *testing.T) {
 		assert.Equal(t, hook.LastEntry().Level, log.WarnLevel)
 	})
 
+	t.Run("Ethereum network rpc urls", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].RPCURL = ""
+		err := validateConfig(config)
+		assert.NoError(t, err)
+
+		config.EthereumNetworks[0].RPCQuorum = 2
+		err = validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "EthereumNetworks[0].RPCQuorum")
+
+		config = validConfig()
+		config.EthereumNetworks[0].RPCQuorum = 2
+		err = validateConfig(config)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Ethereum network finality tag", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].FinalityTag = models.FinalityTagFinalized
+		err := validateConfig(config)
+		assert.NoError(t, err)
+
+		config.EthereumNetworks[0].FinalityTag = "latest"
+		err = validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "EthereumNetworks[0].FinalityTag")
+	})
+
 	t.Run("Invalid ethereum network timeout", func(t *testing.T) {
 		config := validConfig()
 		config.EthereumNetworks[0].TimeoutMS = 0
@@ -296,6 +330,83 @@ func TestValidateConfig(t *testing.T) {
 		assert.Contains(t, err.Error(), "EthereumNetworks[0].MessageRelayer")
 	})
 
+	t.Run("Invalid ethereum network message auditor", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].MessageAuditor = models.ServiceConfig{Enabled: true}
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "EthereumNetworks[0].MessageAuditor")
+	})
+
+	t.Run("Message auditor without message monitor", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].MessageAuditor = models.ServiceConfig{Enabled: true, IntervalMS: 1000}
+		config.EthereumNetworks[0].MessageMonitor.Enabled = false
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "requires MessageMonitor")
+	})
+
+	validFulfiller := models.FulfillerConfig{
+		Enabled:             true,
+		IntervalMS:          1000,
+		PrivateKey:          "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+		DailySpendingCapWei: "1000000000000000000",
+		StuckTimeoutMS:      60000,
+		GasBumpPercent:      10,
+	}
+
+	t.Run("Valid ethereum network message fulfiller", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].MessageFulfiller = validFulfiller
+		err := validateConfig(config)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid ethereum network message fulfiller", func(t *testing.T) {
+		invalid := map[string]func(*models.FulfillerConfig){
+			"IntervalMS":          func(c *models.FulfillerConfig) { c.IntervalMS = 0 },
+			"PrivateKey":          func(c *models.FulfillerConfig) { c.PrivateKey = "0xinvalid" },
+			"DailySpendingCapWei": func(c *models.FulfillerConfig) { c.DailySpendingCapWei = "-1" },
+			"StuckTimeoutMS":      func(c *models.FulfillerConfig) { c.StuckTimeoutMS = 0 },
+			"GasBumpPercent":      func(c *models.FulfillerConfig) { c.GasBumpPercent = 5 },
+		}
+		for field, invalidate := range invalid {
+			config := validConfig()
+			fulfiller := validFulfiller
+			invalidate(&fulfiller)
+			config.EthereumNetworks[0].MessageFulfiller = fulfiller
+			err := validateConfig(config)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "EthereumNetworks[0].MessageFulfiller."+field)
+		}
+	})
+
+	t.Run("Message fulfiller without message relayer", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].MessageFulfiller = validFulfiller
+		config.EthereumNetworks[0].MessageRelayer.Enabled = false
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "requires MessageRelayer")
+	})
+
+	t.Run("Invalid ethereum network mint auditor", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].MintAuditor = models.ServiceConfig{Enabled: true, IntervalMS: 0}
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "EthereumNetworks[0].MintAuditor.IntervalMS")
+	})
+
+	t.Run("Invalid ethereum network security watch", func(t *testing.T) {
+		config := validConfig()
+		config.EthereumNetworks[0].SecurityWatch = models.ServiceConfig{Enabled: true, IntervalMS: 0}
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "EthereumNetworks[0].SecurityWatch.IntervalMS")
+	})
+
 	t.Run("Invalid cosmos network rpc url", func(t *testing.T) {
 		config := validConfig()
 		config.CosmosNetwork.GRPCEnabled = false
@@ -363,6 +474,18 @@ func TestValidateConfig(t *testing.T) {
 		assert.Contains(t, err.Error(), "CosmosNetwork.GRPCPort")
 	})
 
+	t.Run("Cosmos network verify responses", func(t *testing.T) {
+		config := validConfig()
+		config.CosmosNetwork.VerifyResponses = true
+		err := validateConfig(config)
+		assert.NoError(t, err)
+
+		config.CosmosNetwork.GRPCURLs = nil
+		err = validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "CosmosNetwork.VerifyResponses")
+	})
+
 	t.Run("Invalid cosmos network timeout", func(t *testing.T) {
 		config := validConfig()
 		config.CosmosNetwork.TimeoutMS = 0
@@ -508,6 +631,33 @@ func TestValidateConfig(t *testing.T) {
 		assert.Contains(t, err.Error(), "CosmosNetwork.MessageRelayer")
 	})
 
+	t.Run("Supply reconciler", func(t *testing.T) {
+		config := validConfig()
+		config.CosmosNetwork.SupplyReconciler = models.ReconcilerConfig{Enabled: true, IntervalMS: 1000, Tolerance: "1000"}
+		assert.NoError(t, validateConfig(config))
+
+		config.CosmosNetwork.SupplyReconciler.Tolerance = ""
+		assert.NoError(t, validateConfig(config))
+
+		config.CosmosNetwork.SupplyReconciler.Tolerance = "-1"
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "CosmosNetwork.SupplyReconciler.Tolerance")
+
+		config.CosmosNetwork.SupplyReconciler = models.ReconcilerConfig{Enabled: true}
+		err = validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "CosmosNetwork.SupplyReconciler.IntervalMS")
+	})
+
+	t.Run("Invalid cosmos network spend auditor", func(t *testing.T) {
+		config := validConfig()
+		config.CosmosNetwork.SpendAuditor = models.ServiceConfig{Enabled: true, IntervalMS: 0}
+		err := validateConfig(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "CosmosNetwork.SpendAuditor.IntervalMS")
+	})
+
 	t.Run("Invalid health check interval", func(t *testing.T) {
 		config := validConfig()
 		config.HealthCheck.IntervalMS = 0
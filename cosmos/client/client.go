@@ -19,11 +19,15 @@ import (
 	"github.com/dan13ram/wpokt-oracle/models"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -34,6 +38,9 @@ const (
 	maxPageDepth = 100
 )
 
+// ErrTxNotFound is returned by GetTx when the endpoint does not know the transaction
+var ErrTxNotFound = errors.New("tx not found")
+
 type CosmosClient interface {
 	Chain() models.Chain
 	Confirmations() uint64
@@ -42,6 +49,7 @@ type CosmosClient interface {
 	GetTxsSentFromAddressAfterHeight(address string, height uint64) ([]*sdk.TxResponse, error)
 	GetTxsSentToAddressAfterHeight(address string, height uint64) ([]*sdk.TxResponse, error)
 	GetAccount(address string) (*auth.BaseAccount, error)
+	GetBalance(address string, denom string) (sdk.Coin, error)
 	BroadcastTx(txBytes []byte) (string, error)
 	GetTx(hash string) (*sdk.TxResponse, error)
 	ValidateNetwork() error
@@ -73,6 +81,7 @@ type cosmosClient struct {
 
 var cmtserviceNewServiceClient = cmtservice.NewServiceClient
 var authNewQueryClient = auth.NewQueryClient
+var bankNewQueryClient = bank.NewQueryClient
 var txNewServiceClient = tx.NewServiceClient
 
 func (c *cosmosClient) Chain() models.Chain {
@@ -235,6 +244,9 @@ func (c *cosmosClient) getTxGRPC(hash string) (*sdk.TxResponse, error) {
 	}
 
 	resp, err := client.GetTx(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		return nil, fmt.Errorf("%w: %s", ErrTxNotFound, err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tx: %s", err)
 	}
@@ -252,6 +264,10 @@ func (c *cosmosClient) getTxRPC(hash string) (*sdk.TxResponse, error) {
 	}
 
 	resTx, err := c.rpcClient.Tx(ctx, hashBytes, true)
+	if err != nil && strings.Contains(err.Error(), "not found") {
+		// cometbft only reports a missing tx in the error message
+		return nil, fmt.Errorf("%w: %s", ErrTxNotFound, err)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tx: %s", err)
 	}
@@ -339,6 +355,66 @@ func (c *cosmosClient) GetAccount(address string) (*auth.BaseAccount, error) {
 	return c.getAccountRPC(address)
 }
 
+func (c *cosmosClient) getBalanceGRPC(address string, denom string) (sdk.Coin, error) {
+	client := bankNewQueryClient(c.grpcConn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	req := bank.QueryBalanceRequest{
+		Address: address,
+		Denom:   denom,
+	}
+
+	resp, err := client.Balance(ctx, &req)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if resp.Balance == nil {
+		return sdk.NewInt64Coin(denom, 0), nil
+	}
+
+	return *resp.Balance, nil
+}
+
+func (c *cosmosClient) getBalanceRPC(address string, denom string) (sdk.Coin, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	reqBz, _ := util.NewProtoCodec(c.bech32Prefix).Marshal(&bank.QueryBalanceRequest{Address: address, Denom: denom}) // no reason to fail since address is validated
+
+	res, err := c.rpcClient.ABCIQuery(ctx, "/cosmos.bank.v1beta1.Query/Balance", reqBz)
+
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to get balance: %s", err)
+	}
+
+	if res.Response.Code != 0 {
+		return sdk.Coin{}, fmt.Errorf("failed to get balance, got code %d: %s", res.Response.Code, res.Response.Log)
+	}
+
+	var balance bank.QueryBalanceResponse
+	if err := balance.Unmarshal(res.Response.Value); err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to unmarshal balance: %s", err)
+	}
+	if balance.Balance == nil {
+		return sdk.NewInt64Coin(denom, 0), nil
+	}
+
+	return *balance.Balance, nil
+}
+
+// GetBalance returns the balance of denom held by address
+func (c *cosmosClient) GetBalance(address string, denom string) (sdk.Coin, error) {
+	if !common.IsValidBech32Address(c.bech32Prefix, address) {
+		return sdk.Coin{}, fmt.Errorf("invalid bech32 address")
+	}
+	if c.grpcEnabled {
+		return c.getBalanceGRPC(address, denom)
+	}
+	return c.getBalanceRPC(address, denom)
+}
+
 func (c *cosmosClient) broadcastTxGRPC(txBytes []byte) (string, error) {
 	client := txNewServiceClient(c.grpcConn)
 
@@ -422,19 +498,13 @@ var rpchttpNew = func(url, endpoint string) (CosmosHTTPClient, error) {
 	return rpchttp.New(url, endpoint)
 }
 
-func NewClient(config models.CosmosNetworkConfig) (CosmosClient, error) {
+// newEndpointClient connects to a single rpc or grpc endpoint of the network
+func newEndpointClient(config models.CosmosNetworkConfig, url string, logger *log.Entry) (*cosmosClient, error) {
 	var connection *grpc.ClientConn
 	var client CosmosHTTPClient
 
-	logger := log.
-		WithField("module", "cosmos").
-		WithField("package", "client").
-		WithField("chain_name", strings.ToLower(config.ChainName)).
-		WithField("chain_id", strings.ToLower(config.ChainID))
-
 	if config.GRPCEnabled {
-		grpcURL := fmt.Sprintf("%s:%d", config.GRPCHost, config.GRPCPort)
-		conn, err := grpcDial(grpcURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		conn, err := grpcDial(url, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
 			logger.WithError(err).Error("failed to connect to grpc")
 			return nil, fmt.Errorf("failed to connect to grpc")
@@ -442,7 +512,7 @@ func NewClient(config models.CosmosNetworkConfig) (CosmosClient, error) {
 		connection = conn
 		client = nil
 	} else {
-		c, err := rpchttpNew(config.RPCURL, "/websocket")
+		c, err := rpchttpNew(url, "/websocket")
 		if err != nil {
 			logger.WithError(err).Error("failed to connect to rpc")
 			return nil, fmt.Errorf("failed to connect to rpc")
@@ -451,7 +521,7 @@ func NewClient(config models.CosmosNetworkConfig) (CosmosClient, error) {
 		connection = nil
 	}
 
-	c := &cosmosClient{
+	return &cosmosClient{
 		grpcEnabled: config.GRPCEnabled,
 
 		timeout:      time.Duration(config.TimeoutMS) * time.Millisecond,
@@ -465,6 +535,34 @@ func NewClient(config models.CosmosNetworkConfig) (CosmosClient, error) {
 		rpcClient: client,
 
 		logger: logger,
+	}, nil
+}
+
+func NewClient(config models.CosmosNetworkConfig) (CosmosClient, error) {
+	logger := log.
+		WithField("module", "cosmos").
+		WithField("package", "client").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", strings.ToLower(config.ChainID))
+
+	urls := config.Endpoints()
+	if len(urls) == 0 {
+		logger.Error("no endpoints configured")
+		return nil, fmt.Errorf("no endpoints configured")
+	}
+
+	clients := make([]CosmosClient, 0, len(urls))
+	for i, url := range urls {
+		c, err := newEndpointClient(config, url, logger.WithField("endpoint", i))
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+
+	var c CosmosClient = clients[0]
+	if len(clients) > 1 || config.VerifyResponses {
+		c = newFailoverClient(clients, config.VerifyResponses, logger)
 	}
 
 	err := c.ValidateNetwork()
@@ -0,0 +1,1002 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MockBankQueryClient is an autogenerated mock type for the QueryClient type
+type MockBankQueryClient struct {
+	mock.Mock
+}
+
+type MockBankQueryClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBankQueryClient) EXPECT() *MockBankQueryClient_Expecter {
+	return &MockBankQueryClient_Expecter{mock: &_m.Mock}
+}
+
+// AllBalances provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) AllBalances(ctx context.Context, in *types.QueryAllBalancesRequest, opts ...grpc.CallOption) (*types.QueryAllBalancesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllBalances")
+	}
+
+	var r0 *types.QueryAllBalancesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllBalancesRequest, ...grpc.CallOption) (*types.QueryAllBalancesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAllBalancesRequest, ...grpc.CallOption) *types.QueryAllBalancesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAllBalancesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAllBalancesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_AllBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllBalances'
+type MockBankQueryClient_AllBalances_Call struct {
+	*mock.Call
+}
+
+// AllBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryAllBalancesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) AllBalances(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_AllBalances_Call {
+	return &MockBankQueryClient_AllBalances_Call{Call: _e.mock.On("AllBalances",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_AllBalances_Call) Run(run func(ctx context.Context, in *types.QueryAllBalancesRequest, opts ...grpc.CallOption)) *MockBankQueryClient_AllBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryAllBalancesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_AllBalances_Call) Return(_a0 *types.QueryAllBalancesResponse, _a1 error) *MockBankQueryClient_AllBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_AllBalances_Call) RunAndReturn(run func(context.Context, *types.QueryAllBalancesRequest, ...grpc.CallOption) (*types.QueryAllBalancesResponse, error)) *MockBankQueryClient_AllBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Balance provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) Balance(ctx context.Context, in *types.QueryBalanceRequest, opts ...grpc.CallOption) (*types.QueryBalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Balance")
+	}
+
+	var r0 *types.QueryBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) (*types.QueryBalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) *types.QueryBalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_Balance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Balance'
+type MockBankQueryClient_Balance_Call struct {
+	*mock.Call
+}
+
+// Balance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryBalanceRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) Balance(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_Balance_Call {
+	return &MockBankQueryClient_Balance_Call{Call: _e.mock.On("Balance",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_Balance_Call) Run(run func(ctx context.Context, in *types.QueryBalanceRequest, opts ...grpc.CallOption)) *MockBankQueryClient_Balance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryBalanceRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_Balance_Call) Return(_a0 *types.QueryBalanceResponse, _a1 error) *MockBankQueryClient_Balance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_Balance_Call) RunAndReturn(run func(context.Context, *types.QueryBalanceRequest, ...grpc.CallOption) (*types.QueryBalanceResponse, error)) *MockBankQueryClient_Balance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenomMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) DenomMetadata(ctx context.Context, in *types.QueryDenomMetadataRequest, opts ...grpc.CallOption) (*types.QueryDenomMetadataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenomMetadata")
+	}
+
+	var r0 *types.QueryDenomMetadataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomMetadataRequest, ...grpc.CallOption) (*types.QueryDenomMetadataResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomMetadataRequest, ...grpc.CallOption) *types.QueryDenomMetadataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDenomMetadataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDenomMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_DenomMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenomMetadata'
+type MockBankQueryClient_DenomMetadata_Call struct {
+	*mock.Call
+}
+
+// DenomMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryDenomMetadataRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) DenomMetadata(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_DenomMetadata_Call {
+	return &MockBankQueryClient_DenomMetadata_Call{Call: _e.mock.On("DenomMetadata",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_DenomMetadata_Call) Run(run func(ctx context.Context, in *types.QueryDenomMetadataRequest, opts ...grpc.CallOption)) *MockBankQueryClient_DenomMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryDenomMetadataRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomMetadata_Call) Return(_a0 *types.QueryDenomMetadataResponse, _a1 error) *MockBankQueryClient_DenomMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomMetadata_Call) RunAndReturn(run func(context.Context, *types.QueryDenomMetadataRequest, ...grpc.CallOption) (*types.QueryDenomMetadataResponse, error)) *MockBankQueryClient_DenomMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenomMetadataByQueryString provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) DenomMetadataByQueryString(ctx context.Context, in *types.QueryDenomMetadataByQueryStringRequest, opts ...grpc.CallOption) (*types.QueryDenomMetadataByQueryStringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenomMetadataByQueryString")
+	}
+
+	var r0 *types.QueryDenomMetadataByQueryStringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomMetadataByQueryStringRequest, ...grpc.CallOption) (*types.QueryDenomMetadataByQueryStringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomMetadataByQueryStringRequest, ...grpc.CallOption) *types.QueryDenomMetadataByQueryStringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDenomMetadataByQueryStringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDenomMetadataByQueryStringRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_DenomMetadataByQueryString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenomMetadataByQueryString'
+type MockBankQueryClient_DenomMetadataByQueryString_Call struct {
+	*mock.Call
+}
+
+// DenomMetadataByQueryString is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryDenomMetadataByQueryStringRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) DenomMetadataByQueryString(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_DenomMetadataByQueryString_Call {
+	return &MockBankQueryClient_DenomMetadataByQueryString_Call{Call: _e.mock.On("DenomMetadataByQueryString",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_DenomMetadataByQueryString_Call) Run(run func(ctx context.Context, in *types.QueryDenomMetadataByQueryStringRequest, opts ...grpc.CallOption)) *MockBankQueryClient_DenomMetadataByQueryString_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryDenomMetadataByQueryStringRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomMetadataByQueryString_Call) Return(_a0 *types.QueryDenomMetadataByQueryStringResponse, _a1 error) *MockBankQueryClient_DenomMetadataByQueryString_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomMetadataByQueryString_Call) RunAndReturn(run func(context.Context, *types.QueryDenomMetadataByQueryStringRequest, ...grpc.CallOption) (*types.QueryDenomMetadataByQueryStringResponse, error)) *MockBankQueryClient_DenomMetadataByQueryString_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenomOwners provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) DenomOwners(ctx context.Context, in *types.QueryDenomOwnersRequest, opts ...grpc.CallOption) (*types.QueryDenomOwnersResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenomOwners")
+	}
+
+	var r0 *types.QueryDenomOwnersResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomOwnersRequest, ...grpc.CallOption) (*types.QueryDenomOwnersResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomOwnersRequest, ...grpc.CallOption) *types.QueryDenomOwnersResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDenomOwnersResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDenomOwnersRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_DenomOwners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenomOwners'
+type MockBankQueryClient_DenomOwners_Call struct {
+	*mock.Call
+}
+
+// DenomOwners is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryDenomOwnersRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) DenomOwners(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_DenomOwners_Call {
+	return &MockBankQueryClient_DenomOwners_Call{Call: _e.mock.On("DenomOwners",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_DenomOwners_Call) Run(run func(ctx context.Context, in *types.QueryDenomOwnersRequest, opts ...grpc.CallOption)) *MockBankQueryClient_DenomOwners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryDenomOwnersRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomOwners_Call) Return(_a0 *types.QueryDenomOwnersResponse, _a1 error) *MockBankQueryClient_DenomOwners_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomOwners_Call) RunAndReturn(run func(context.Context, *types.QueryDenomOwnersRequest, ...grpc.CallOption) (*types.QueryDenomOwnersResponse, error)) *MockBankQueryClient_DenomOwners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenomOwnersByQuery provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) DenomOwnersByQuery(ctx context.Context, in *types.QueryDenomOwnersByQueryRequest, opts ...grpc.CallOption) (*types.QueryDenomOwnersByQueryResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenomOwnersByQuery")
+	}
+
+	var r0 *types.QueryDenomOwnersByQueryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomOwnersByQueryRequest, ...grpc.CallOption) (*types.QueryDenomOwnersByQueryResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomOwnersByQueryRequest, ...grpc.CallOption) *types.QueryDenomOwnersByQueryResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDenomOwnersByQueryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDenomOwnersByQueryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_DenomOwnersByQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenomOwnersByQuery'
+type MockBankQueryClient_DenomOwnersByQuery_Call struct {
+	*mock.Call
+}
+
+// DenomOwnersByQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryDenomOwnersByQueryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) DenomOwnersByQuery(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_DenomOwnersByQuery_Call {
+	return &MockBankQueryClient_DenomOwnersByQuery_Call{Call: _e.mock.On("DenomOwnersByQuery",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_DenomOwnersByQuery_Call) Run(run func(ctx context.Context, in *types.QueryDenomOwnersByQueryRequest, opts ...grpc.CallOption)) *MockBankQueryClient_DenomOwnersByQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryDenomOwnersByQueryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomOwnersByQuery_Call) Return(_a0 *types.QueryDenomOwnersByQueryResponse, _a1 error) *MockBankQueryClient_DenomOwnersByQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomOwnersByQuery_Call) RunAndReturn(run func(context.Context, *types.QueryDenomOwnersByQueryRequest, ...grpc.CallOption) (*types.QueryDenomOwnersByQueryResponse, error)) *MockBankQueryClient_DenomOwnersByQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DenomsMetadata provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) DenomsMetadata(ctx context.Context, in *types.QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*types.QueryDenomsMetadataResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DenomsMetadata")
+	}
+
+	var r0 *types.QueryDenomsMetadataResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomsMetadataRequest, ...grpc.CallOption) (*types.QueryDenomsMetadataResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryDenomsMetadataRequest, ...grpc.CallOption) *types.QueryDenomsMetadataResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryDenomsMetadataResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryDenomsMetadataRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_DenomsMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DenomsMetadata'
+type MockBankQueryClient_DenomsMetadata_Call struct {
+	*mock.Call
+}
+
+// DenomsMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryDenomsMetadataRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) DenomsMetadata(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_DenomsMetadata_Call {
+	return &MockBankQueryClient_DenomsMetadata_Call{Call: _e.mock.On("DenomsMetadata",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_DenomsMetadata_Call) Run(run func(ctx context.Context, in *types.QueryDenomsMetadataRequest, opts ...grpc.CallOption)) *MockBankQueryClient_DenomsMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryDenomsMetadataRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomsMetadata_Call) Return(_a0 *types.QueryDenomsMetadataResponse, _a1 error) *MockBankQueryClient_DenomsMetadata_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_DenomsMetadata_Call) RunAndReturn(run func(context.Context, *types.QueryDenomsMetadataRequest, ...grpc.CallOption) (*types.QueryDenomsMetadataResponse, error)) *MockBankQueryClient_DenomsMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Params provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Params")
+	}
+
+	var r0 *types.QueryParamsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) *types.QueryParamsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryParamsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_Params_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Params'
+type MockBankQueryClient_Params_Call struct {
+	*mock.Call
+}
+
+// Params is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryParamsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) Params(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_Params_Call {
+	return &MockBankQueryClient_Params_Call{Call: _e.mock.On("Params",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_Params_Call) Run(run func(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption)) *MockBankQueryClient_Params_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryParamsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_Params_Call) Return(_a0 *types.QueryParamsResponse, _a1 error) *MockBankQueryClient_Params_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_Params_Call) RunAndReturn(run func(context.Context, *types.QueryParamsRequest, ...grpc.CallOption) (*types.QueryParamsResponse, error)) *MockBankQueryClient_Params_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendEnabled provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) SendEnabled(ctx context.Context, in *types.QuerySendEnabledRequest, opts ...grpc.CallOption) (*types.QuerySendEnabledResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendEnabled")
+	}
+
+	var r0 *types.QuerySendEnabledResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySendEnabledRequest, ...grpc.CallOption) (*types.QuerySendEnabledResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySendEnabledRequest, ...grpc.CallOption) *types.QuerySendEnabledResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySendEnabledResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySendEnabledRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_SendEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendEnabled'
+type MockBankQueryClient_SendEnabled_Call struct {
+	*mock.Call
+}
+
+// SendEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QuerySendEnabledRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) SendEnabled(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_SendEnabled_Call {
+	return &MockBankQueryClient_SendEnabled_Call{Call: _e.mock.On("SendEnabled",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_SendEnabled_Call) Run(run func(ctx context.Context, in *types.QuerySendEnabledRequest, opts ...grpc.CallOption)) *MockBankQueryClient_SendEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QuerySendEnabledRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_SendEnabled_Call) Return(_a0 *types.QuerySendEnabledResponse, _a1 error) *MockBankQueryClient_SendEnabled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_SendEnabled_Call) RunAndReturn(run func(context.Context, *types.QuerySendEnabledRequest, ...grpc.CallOption) (*types.QuerySendEnabledResponse, error)) *MockBankQueryClient_SendEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpendableBalanceByDenom provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) SpendableBalanceByDenom(ctx context.Context, in *types.QuerySpendableBalanceByDenomRequest, opts ...grpc.CallOption) (*types.QuerySpendableBalanceByDenomResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpendableBalanceByDenom")
+	}
+
+	var r0 *types.QuerySpendableBalanceByDenomResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySpendableBalanceByDenomRequest, ...grpc.CallOption) (*types.QuerySpendableBalanceByDenomResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySpendableBalanceByDenomRequest, ...grpc.CallOption) *types.QuerySpendableBalanceByDenomResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySpendableBalanceByDenomResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySpendableBalanceByDenomRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_SpendableBalanceByDenom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpendableBalanceByDenom'
+type MockBankQueryClient_SpendableBalanceByDenom_Call struct {
+	*mock.Call
+}
+
+// SpendableBalanceByDenom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QuerySpendableBalanceByDenomRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) SpendableBalanceByDenom(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_SpendableBalanceByDenom_Call {
+	return &MockBankQueryClient_SpendableBalanceByDenom_Call{Call: _e.mock.On("SpendableBalanceByDenom",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_SpendableBalanceByDenom_Call) Run(run func(ctx context.Context, in *types.QuerySpendableBalanceByDenomRequest, opts ...grpc.CallOption)) *MockBankQueryClient_SpendableBalanceByDenom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QuerySpendableBalanceByDenomRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_SpendableBalanceByDenom_Call) Return(_a0 *types.QuerySpendableBalanceByDenomResponse, _a1 error) *MockBankQueryClient_SpendableBalanceByDenom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_SpendableBalanceByDenom_Call) RunAndReturn(run func(context.Context, *types.QuerySpendableBalanceByDenomRequest, ...grpc.CallOption) (*types.QuerySpendableBalanceByDenomResponse, error)) *MockBankQueryClient_SpendableBalanceByDenom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpendableBalances provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) SpendableBalances(ctx context.Context, in *types.QuerySpendableBalancesRequest, opts ...grpc.CallOption) (*types.QuerySpendableBalancesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpendableBalances")
+	}
+
+	var r0 *types.QuerySpendableBalancesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySpendableBalancesRequest, ...grpc.CallOption) (*types.QuerySpendableBalancesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySpendableBalancesRequest, ...grpc.CallOption) *types.QuerySpendableBalancesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySpendableBalancesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySpendableBalancesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_SpendableBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpendableBalances'
+type MockBankQueryClient_SpendableBalances_Call struct {
+	*mock.Call
+}
+
+// SpendableBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QuerySpendableBalancesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) SpendableBalances(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_SpendableBalances_Call {
+	return &MockBankQueryClient_SpendableBalances_Call{Call: _e.mock.On("SpendableBalances",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_SpendableBalances_Call) Run(run func(ctx context.Context, in *types.QuerySpendableBalancesRequest, opts ...grpc.CallOption)) *MockBankQueryClient_SpendableBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QuerySpendableBalancesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_SpendableBalances_Call) Return(_a0 *types.QuerySpendableBalancesResponse, _a1 error) *MockBankQueryClient_SpendableBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_SpendableBalances_Call) RunAndReturn(run func(context.Context, *types.QuerySpendableBalancesRequest, ...grpc.CallOption) (*types.QuerySpendableBalancesResponse, error)) *MockBankQueryClient_SpendableBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SupplyOf provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) SupplyOf(ctx context.Context, in *types.QuerySupplyOfRequest, opts ...grpc.CallOption) (*types.QuerySupplyOfResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SupplyOf")
+	}
+
+	var r0 *types.QuerySupplyOfResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySupplyOfRequest, ...grpc.CallOption) (*types.QuerySupplyOfResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QuerySupplyOfRequest, ...grpc.CallOption) *types.QuerySupplyOfResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QuerySupplyOfResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QuerySupplyOfRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_SupplyOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SupplyOf'
+type MockBankQueryClient_SupplyOf_Call struct {
+	*mock.Call
+}
+
+// SupplyOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QuerySupplyOfRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) SupplyOf(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_SupplyOf_Call {
+	return &MockBankQueryClient_SupplyOf_Call{Call: _e.mock.On("SupplyOf",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_SupplyOf_Call) Run(run func(ctx context.Context, in *types.QuerySupplyOfRequest, opts ...grpc.CallOption)) *MockBankQueryClient_SupplyOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QuerySupplyOfRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_SupplyOf_Call) Return(_a0 *types.QuerySupplyOfResponse, _a1 error) *MockBankQueryClient_SupplyOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_SupplyOf_Call) RunAndReturn(run func(context.Context, *types.QuerySupplyOfRequest, ...grpc.CallOption) (*types.QuerySupplyOfResponse, error)) *MockBankQueryClient_SupplyOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TotalSupply provides a mock function with given fields: ctx, in, opts
+func (_m *MockBankQueryClient) TotalSupply(ctx context.Context, in *types.QueryTotalSupplyRequest, opts ...grpc.CallOption) (*types.QueryTotalSupplyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalSupply")
+	}
+
+	var r0 *types.QueryTotalSupplyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTotalSupplyRequest, ...grpc.CallOption) (*types.QueryTotalSupplyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryTotalSupplyRequest, ...grpc.CallOption) *types.QueryTotalSupplyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryTotalSupplyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryTotalSupplyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBankQueryClient_TotalSupply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalSupply'
+type MockBankQueryClient_TotalSupply_Call struct {
+	*mock.Call
+}
+
+// TotalSupply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.QueryTotalSupplyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockBankQueryClient_Expecter) TotalSupply(ctx interface{}, in interface{}, opts ...interface{}) *MockBankQueryClient_TotalSupply_Call {
+	return &MockBankQueryClient_TotalSupply_Call{Call: _e.mock.On("TotalSupply",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockBankQueryClient_TotalSupply_Call) Run(run func(ctx context.Context, in *types.QueryTotalSupplyRequest, opts ...grpc.CallOption)) *MockBankQueryClient_TotalSupply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.QueryTotalSupplyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockBankQueryClient_TotalSupply_Call) Return(_a0 *types.QueryTotalSupplyResponse, _a1 error) *MockBankQueryClient_TotalSupply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBankQueryClient_TotalSupply_Call) RunAndReturn(run func(context.Context, *types.QueryTotalSupplyRequest, ...grpc.CallOption) (*types.QueryTotalSupplyResponse, error)) *MockBankQueryClient_TotalSupply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockBankQueryClient creates a new instance of MockBankQueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBankQueryClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBankQueryClient {
+	mock := &MockBankQueryClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
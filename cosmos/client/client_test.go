@@ -22,6 +22,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/dan13ram/wpokt-oracle/common"
 	mocks "github.com/dan13ram/wpokt-oracle/cosmos/client/client_mocks"
 	"github.com/dan13ram/wpokt-oracle/cosmos/util"
@@ -29,6 +30,8 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	log "github.com/sirupsen/logrus"
 	goGRPC "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestChain(t *testing.T) {
@@ -1207,6 +1210,28 @@ func TestGetTx_GRPC_Error(t *testing.T) {
 	mockGRPCClient.AssertExpectations(t)
 }
 
+func TestGetTx_GRPC_NotFound(t *testing.T) {
+	originalTxNewServiceClient := txNewServiceClient
+	defer func() { txNewServiceClient = originalTxNewServiceClient }()
+
+	mockGRPCClient := mocks.NewMockTxServiceClient(t)
+	txNewServiceClient = func(conn grpc.ClientConn) tx.ServiceClient {
+		return mockGRPCClient
+	}
+
+	client := &cosmosClient{
+		grpcEnabled: true,
+		timeout:     5 * time.Second,
+		logger:      log.NewEntry(log.New()),
+	}
+
+	mockGRPCClient.On("GetTx", mock.Anything, &tx.GetTxRequest{Hash: "txHash"}).Return(nil, status.Error(codes.NotFound, "tx not found: txHash"))
+
+	result, err := client.GetTx("txHash")
+	assert.ErrorIs(t, err, ErrTxNotFound)
+	assert.Nil(t, result)
+}
+
 func TestGetTx_RPC(t *testing.T) {
 	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
 
@@ -1302,6 +1327,23 @@ func TestGetTx_RPC_ClientError(t *testing.T) {
 	mockHTTPClient.AssertExpectations(t)
 }
 
+func TestGetTx_RPC_NotFound(t *testing.T) {
+	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
+
+	client := &cosmosClient{
+		grpcEnabled: false,
+		timeout:     5 * time.Second,
+		rpcClient:   mockHTTPClient,
+		logger:      log.NewEntry(log.New()),
+	}
+
+	mockHTTPClient.On("Tx", mock.Anything, []byte{0x01, 0x02}, true).Return(nil, errors.New("tx (0102) not found"))
+
+	result, err := client.GetTx("0102")
+	assert.ErrorIs(t, err, ErrTxNotFound)
+	assert.Nil(t, result)
+}
+
 func TestGetTx_RPC_BlockError(t *testing.T) {
 	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
 
@@ -1645,6 +1687,8 @@ func TestNewClient(t *testing.T) {
 
 	config := models.CosmosNetworkConfig{
 		GRPCEnabled: true,
+		GRPCHost:    "localhost",
+		GRPCPort:    9090,
 		TimeoutMS:   5000,
 		ChainName:   "TestChain",
 		ChainID:     "TestChainID",
@@ -1678,6 +1722,8 @@ func TestNewClient_ValidateError(t *testing.T) {
 
 	config := models.CosmosNetworkConfig{
 		GRPCEnabled: true,
+		GRPCHost:    "localhost",
+		GRPCPort:    9090,
 		TimeoutMS:   5000,
 		ChainName:   "TestChain",
 		ChainID:     "TestChainID",
@@ -1745,3 +1791,128 @@ func TestNewClient_RPCDialError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, client)
 }
+
+func TestNewClient_MultipleEndpoints(t *testing.T) {
+	originalGRPCDial := grpcDial
+	dialed := []string{}
+	grpcDial = func(target string, opts ...goGRPC.DialOption) (*goGRPC.ClientConn, error) {
+		dialed = append(dialed, target)
+		return nil, nil
+	}
+	defer func() { grpcDial = originalGRPCDial }()
+
+	originalCmtserviceNewServiceClient := cmtserviceNewServiceClient
+	defer func() { cmtserviceNewServiceClient = originalCmtserviceNewServiceClient }()
+
+	mockGRPCClient := mocks.NewMockCMTServiceClient(t)
+
+	cmtserviceNewServiceClient = func(conn grpc.ClientConn) cmtservice.ServiceClient {
+		return mockGRPCClient
+	}
+
+	config := models.CosmosNetworkConfig{
+		GRPCEnabled:     true,
+		GRPCHost:        "localhost",
+		GRPCPort:        9090,
+		GRPCURLs:        []string{"localhost:9091"},
+		VerifyResponses: true,
+		TimeoutMS:       5000,
+		ChainName:       "TestChain",
+		ChainID:         "TestChainID",
+	}
+
+	block := &cmtservice.Block{Header: cmtservice.Header{Height: 100, ChainID: config.ChainID}}
+	mockGRPCClient.On("GetLatestBlock", mock.Anything, mock.Anything).Return(&cmtservice.GetLatestBlockResponse{SdkBlock: block}, nil).Times(2)
+
+	client, err := NewClient(config)
+	assert.NoError(t, err)
+	assert.IsType(t, &failoverClient{}, client)
+	assert.Equal(t, []string{"localhost:9090", "localhost:9091"}, dialed)
+
+	mockGRPCClient.AssertExpectations(t)
+}
+
+func TestGetBalance_AddressError(t *testing.T) {
+	client := &cosmosClient{
+		bech32Prefix: "cosmos",
+		logger:       log.NewEntry(log.New()),
+	}
+
+	_, err := client.GetBalance("cosmos1account", "upokt")
+	assert.EqualError(t, err, "invalid bech32 address")
+}
+
+func TestGetBalance_GRPC(t *testing.T) {
+	originalBankNewQueryClient := bankNewQueryClient
+	defer func() { bankNewQueryClient = originalBankNewQueryClient }()
+
+	mockGRPCClient := mocks.NewMockBankQueryClient(t)
+	bankNewQueryClient = func(conn grpc.ClientConn) bank.QueryClient {
+		return mockGRPCClient
+	}
+
+	client := &cosmosClient{
+		grpcEnabled:  true,
+		timeout:      5 * time.Second,
+		bech32Prefix: "cosmos",
+		logger:       log.NewEntry(log.New()),
+	}
+
+	accountBech32, _ := common.Bech32FromBytes("cosmos", ethcommon.BytesToAddress([]byte("cosmos1account")).Bytes())
+	balance := sdk.NewInt64Coin("upokt", 100)
+
+	mockGRPCClient.EXPECT().Balance(mock.Anything, &bank.QueryBalanceRequest{Address: accountBech32, Denom: "upokt"}).Return(&bank.QueryBalanceResponse{Balance: &balance}, nil).Once()
+
+	result, err := client.GetBalance(accountBech32, "upokt")
+	assert.NoError(t, err)
+	assert.Equal(t, balance, result)
+
+	mockGRPCClient.EXPECT().Balance(mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+
+	_, err = client.GetBalance(accountBech32, "upokt")
+	assert.Error(t, err)
+}
+
+func TestGetBalance_RPC(t *testing.T) {
+	mockHTTPClient := mocks.NewMockCosmosHTTPClient(t)
+
+	client := &cosmosClient{
+		grpcEnabled:  false,
+		timeout:      5 * time.Second,
+		bech32Prefix: "cosmos",
+		rpcClient:    mockHTTPClient,
+		logger:       log.NewEntry(log.New()),
+	}
+
+	accountBech32, _ := common.Bech32FromBytes("cosmos", ethcommon.BytesToAddress([]byte("cosmos1account")).Bytes())
+	balance := sdk.NewInt64Coin("upokt", 100)
+
+	queryPath := "/cosmos.bank.v1beta1.Query/Balance"
+	queryData, err := util.NewProtoCodec("cosmos").Marshal(&bank.QueryBalanceRequest{Address: accountBech32, Denom: "upokt"})
+	assert.NoError(t, err)
+	var queryDataHex bytes.HexBytes = queryData
+
+	response := bank.QueryBalanceResponse{Balance: &balance}
+	responseBytes, err := response.Marshal()
+	assert.NoError(t, err)
+
+	mockHTTPClient.EXPECT().ABCIQuery(mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: responseBytes}}, nil).Once()
+
+	result, err := client.GetBalance(accountBech32, "upokt")
+	assert.NoError(t, err)
+	assert.Equal(t, balance.String(), result.String())
+
+	// an account without the denom has no balance in the response
+	emptyBytes, err := (&bank.QueryBalanceResponse{}).Marshal()
+	assert.NoError(t, err)
+	mockHTTPClient.EXPECT().ABCIQuery(mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: emptyBytes}}, nil).Once()
+
+	result, err = client.GetBalance(accountBech32, "upokt")
+	assert.NoError(t, err)
+	assert.Equal(t, "0upokt", result.String())
+
+	mockHTTPClient.EXPECT().ABCIQuery(mock.Anything, queryPath, queryDataHex).Return(&rpctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: "error"}}, nil).Once()
+
+	_, err = client.GetBalance(accountBech32, "upokt")
+	assert.ErrorContains(t, err, "failed to get balance, got code 1")
+}
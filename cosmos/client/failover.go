@@ -0,0 +1,317 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// endpointCooldown is how long a failing endpoint is tried only after the healthy ones
+const endpointCooldown = 30 * time.Second
+
+type endpointHealth struct {
+	failures       uint64
+	unhealthyUntil time.Time
+}
+
+// failoverClient spreads calls over several endpoints of the same network.
+// Healthy endpoints are tried first and an endpoint that fails is moved to the back for a cooldown.
+// When verify is set, txs, accounts and block heights are cross-checked against a second endpoint.
+type failoverClient struct {
+	mu      sync.Mutex
+	clients []CosmosClient
+	health  []endpointHealth
+	verify  bool
+
+	logger *log.Entry
+}
+
+func newFailoverClient(clients []CosmosClient, verify bool, logger *log.Entry) *failoverClient {
+	return &failoverClient{
+		clients: clients,
+		health:  make([]endpointHealth, len(clients)),
+		verify:  verify,
+		logger:  logger,
+	}
+}
+
+// order returns the endpoint indexes with healthy endpoints first
+func (f *failoverClient) order() []int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	healthy := []int{}
+	unhealthy := []int{}
+	for i := range f.clients {
+		if now.Before(f.health[i].unhealthyUntil) {
+			unhealthy = append(unhealthy, i)
+		} else {
+			healthy = append(healthy, i)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (f *failoverClient) markHealthy(index int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.health[index] = endpointHealth{}
+}
+
+func (f *failoverClient) markFailed(index int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.health[index].failures++
+	f.health[index].unhealthyUntil = time.Now().Add(endpointCooldown)
+	f.logger.WithError(err).
+		WithField("endpoint", index).
+		WithField("failures", f.health[index].failures).
+		Warn("endpoint call failed")
+}
+
+// do runs call against the endpoints in order of health, skipping the endpoint at skip,
+// and returns the index of the endpoint that succeeded.
+// A tx not found answer is a valid response and is returned without marking the endpoint as failed
+func (f *failoverClient) do(skip int, call func(client CosmosClient) error) (int, error) {
+	err := fmt.Errorf("no endpoints available")
+	for _, index := range f.order() {
+		if index == skip {
+			continue
+		}
+		err = call(f.clients[index])
+		if err == nil {
+			f.markHealthy(index)
+			return index, nil
+		}
+		if errors.Is(err, ErrTxNotFound) {
+			return index, err
+		}
+		f.markFailed(index, err)
+	}
+	return -1, err
+}
+
+func (f *failoverClient) Chain() models.Chain {
+	return f.clients[0].Chain()
+}
+
+func (f *failoverClient) Confirmations() uint64 {
+	return f.clients[0].Confirmations()
+}
+
+func (f *failoverClient) GetLatestBlockHeight() (int64, error) {
+	var height int64
+	index, err := f.do(-1, func(client CosmosClient) (err error) {
+		height, err = client.GetLatestBlockHeight()
+		return
+	})
+	if err != nil || !f.verify {
+		return height, err
+	}
+
+	var otherHeight int64
+	_, err = f.do(index, func(client CosmosClient) (err error) {
+		otherHeight, err = client.GetLatestBlockHeight()
+		return
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to verify block height: %w", err)
+	}
+
+	// only heights both endpoints have reached are trusted
+	if otherHeight < height {
+		return otherHeight, nil
+	}
+	return height, nil
+}
+
+func (f *failoverClient) GetChainID() (chainID string, err error) {
+	_, err = f.do(-1, func(client CosmosClient) (err error) {
+		chainID, err = client.GetChainID()
+		return
+	})
+	return
+}
+
+func (f *failoverClient) GetTxsSentFromAddressAfterHeight(address string, height uint64) (txs []*sdk.TxResponse, err error) {
+	_, err = f.do(-1, func(client CosmosClient) (err error) {
+		txs, err = client.GetTxsSentFromAddressAfterHeight(address, height)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) GetTxsSentToAddressAfterHeight(address string, height uint64) (txs []*sdk.TxResponse, err error) {
+	_, err = f.do(-1, func(client CosmosClient) (err error) {
+		txs, err = client.GetTxsSentToAddressAfterHeight(address, height)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) GetAccount(address string) (*auth.BaseAccount, error) {
+	var account *auth.BaseAccount
+	index, err := f.do(-1, func(client CosmosClient) (err error) {
+		account, err = client.GetAccount(address)
+		return
+	})
+	if err != nil || !f.verify {
+		return account, err
+	}
+
+	var otherAccount *auth.BaseAccount
+	_, err = f.do(index, func(client CosmosClient) (err error) {
+		otherAccount, err = client.GetAccount(address)
+		return
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify account: %w", err)
+	}
+
+	if account.Address != otherAccount.Address ||
+		account.AccountNumber != otherAccount.AccountNumber ||
+		account.Sequence != otherAccount.Sequence {
+		return nil, fmt.Errorf("account %s does not match across endpoints", address)
+	}
+
+	return account, nil
+}
+
+func (f *failoverClient) GetBalance(address string, denom string) (sdk.Coin, error) {
+	var balance sdk.Coin
+	index, err := f.do(-1, func(client CosmosClient) (err error) {
+		balance, err = client.GetBalance(address, denom)
+		return
+	})
+	if err != nil || !f.verify {
+		return balance, err
+	}
+
+	var otherBalance sdk.Coin
+	_, err = f.do(index, func(client CosmosClient) (err error) {
+		otherBalance, err = client.GetBalance(address, denom)
+		return
+	})
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("failed to verify balance: %w", err)
+	}
+
+	if !balance.IsEqual(otherBalance) {
+		return sdk.Coin{}, fmt.Errorf("balance of %s does not match across endpoints", address)
+	}
+
+	return balance, nil
+}
+
+func (f *failoverClient) BroadcastTx(txBytes []byte) (hash string, err error) {
+	_, err = f.do(-1, func(client CosmosClient) (err error) {
+		hash, err = client.BroadcastTx(txBytes)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) GetTx(hash string) (*sdk.TxResponse, error) {
+	var tx *sdk.TxResponse
+	index, err := f.do(-1, func(client CosmosClient) (err error) {
+		tx, err = client.GetTx(hash)
+		return
+	})
+	if err != nil || !f.verify {
+		return tx, err
+	}
+
+	var otherTx *sdk.TxResponse
+	_, err = f.do(index, func(client CosmosClient) (err error) {
+		otherTx, err = client.GetTx(hash)
+		return
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify tx: %w", err)
+	}
+
+	if !txResponsesMatch(tx, otherTx) {
+		return nil, fmt.Errorf("tx %s does not match across endpoints", hash)
+	}
+
+	return tx, nil
+}
+
+// canonicalTxResponse returns the parts of a tx response that the oracle acts on.
+// RawLog, Info and Timestamp are formatted by the node and may differ between node versions
+func canonicalTxResponse(tx *sdk.TxResponse) *sdk.TxResponse {
+	return &sdk.TxResponse{
+		Height:    tx.Height,
+		TxHash:    tx.TxHash,
+		Codespace: tx.Codespace,
+		Code:      tx.Code,
+		Data:      tx.Data,
+		Logs:      tx.Logs,
+		GasWanted: tx.GasWanted,
+		GasUsed:   tx.GasUsed,
+		Tx:        tx.Tx,
+		Events:    tx.Events,
+	}
+}
+
+// txResponsesMatch compares the canonical encoding of two tx responses
+func txResponsesMatch(a *sdk.TxResponse, b *sdk.TxResponse) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aBz, err := canonicalTxResponse(a).Marshal()
+	if err != nil {
+		return false
+	}
+	bBz, err := canonicalTxResponse(b).Marshal()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aBz, bBz)
+}
+
+// ValidateNetwork validates every endpoint, failing if any endpoint is on another chain
+// or if too few endpoints respond to verify responses
+func (f *failoverClient) ValidateNetwork() error {
+	f.logger.Debugf("Validating network")
+
+	chain := f.Chain()
+	var lastErr error
+	validEndpoints := 0
+	for i, client := range f.clients {
+		chainID, err := client.GetChainID()
+		if err != nil {
+			f.logger.WithError(err).WithField("endpoint", i).Warn("failed to get chain id")
+			lastErr = err
+			continue
+		}
+		if chainID != chain.ChainID {
+			return fmt.Errorf("expected chain id %s, got %s from endpoint %d", chain.ChainID, chainID, i)
+		}
+		validEndpoints++
+	}
+
+	required := 1
+	if f.verify {
+		required = 2
+	}
+	if validEndpoints < required {
+		if lastErr != nil {
+			return fmt.Errorf("%d of %d endpoints responded: %w", validEndpoints, len(f.clients), lastErr)
+		}
+		return fmt.Errorf("%d of %d endpoints responded", validEndpoints, len(f.clients))
+	}
+
+	f.logger.Debugf("Validated network")
+	return nil
+}
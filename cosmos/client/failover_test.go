@@ -0,0 +1,248 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dan13ram/wpokt-oracle/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestFailoverClient_NoEndpoints(t *testing.T) {
+	client := newFailoverClient([]CosmosClient{}, false, log.NewEntry(log.New()))
+
+	_, err := client.GetChainID()
+	assert.Error(t, err)
+}
+
+func TestFailoverClient_FailsOver(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, false, log.NewEntry(log.New()))
+
+	mockClientOne.EXPECT().GetLatestBlockHeight().Return(0, errors.New("error")).Once()
+	mockClientTwo.EXPECT().GetLatestBlockHeight().Return(100, nil).Once()
+
+	height, err := client.GetLatestBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), height)
+	assert.Equal(t, uint64(1), client.health[0].failures)
+
+	// the failing endpoint is only tried after the healthy one
+	assert.Equal(t, []int{1, 0}, client.order())
+
+	mockClientTwo.EXPECT().BroadcastTx([]byte("tx")).Return("hash", nil).Once()
+
+	hash, err := client.BroadcastTx([]byte("tx"))
+	assert.NoError(t, err)
+	assert.Equal(t, "hash", hash)
+}
+
+func TestFailoverClient_AllFail(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, false, log.NewEntry(log.New()))
+
+	mockClientOne.EXPECT().GetTxsSentToAddressAfterHeight("address", uint64(10)).Return(nil, errors.New("error one")).Once()
+	mockClientTwo.EXPECT().GetTxsSentToAddressAfterHeight("address", uint64(10)).Return(nil, errors.New("error two")).Once()
+
+	txs, err := client.GetTxsSentToAddressAfterHeight("address", 10)
+	assert.EqualError(t, err, "error two")
+	assert.Nil(t, txs)
+}
+
+func TestFailoverClient_Passthrough(t *testing.T) {
+	mockClient := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClient}, false, log.NewEntry(log.New()))
+
+	chain := models.Chain{ChainID: "chain-id", ChainType: models.ChainTypeCosmos}
+	mockClient.EXPECT().Chain().Return(chain).Once()
+	mockClient.EXPECT().Confirmations().Return(uint64(3)).Once()
+	mockClient.EXPECT().GetTxsSentFromAddressAfterHeight("address", uint64(10)).Return([]*sdk.TxResponse{{TxHash: "hash"}}, nil).Once()
+	mockClient.EXPECT().GetAccount("address").Return(&auth.BaseAccount{Address: "address"}, nil).Once()
+	mockClient.EXPECT().GetTx("hash").Return(&sdk.TxResponse{TxHash: "hash"}, nil).Once()
+
+	assert.Equal(t, chain, client.Chain())
+	assert.Equal(t, uint64(3), client.Confirmations())
+
+	txs, err := client.GetTxsSentFromAddressAfterHeight("address", 10)
+	assert.NoError(t, err)
+	assert.Len(t, txs, 1)
+
+	account, err := client.GetAccount("address")
+	assert.NoError(t, err)
+	assert.Equal(t, "address", account.Address)
+
+	tx, err := client.GetTx("hash")
+	assert.NoError(t, err)
+	assert.Equal(t, "hash", tx.TxHash)
+}
+
+func TestFailoverClient_VerifyBlockHeight(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, true, log.NewEntry(log.New()))
+
+	mockClientOne.EXPECT().GetLatestBlockHeight().Return(105, nil).Once()
+	mockClientTwo.EXPECT().GetLatestBlockHeight().Return(100, nil).Once()
+
+	height, err := client.GetLatestBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), height)
+
+	mockClientOne.EXPECT().GetLatestBlockHeight().Return(105, nil).Once()
+	mockClientTwo.EXPECT().GetLatestBlockHeight().Return(0, errors.New("error")).Once()
+
+	_, err = client.GetLatestBlockHeight()
+	assert.ErrorContains(t, err, "failed to verify block height")
+}
+
+func TestFailoverClient_VerifyAccount(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, true, log.NewEntry(log.New()))
+
+	account := &auth.BaseAccount{Address: "address", AccountNumber: 1, Sequence: 2}
+
+	mockClientOne.EXPECT().GetAccount("address").Return(account, nil).Once()
+	mockClientTwo.EXPECT().GetAccount("address").Return(&auth.BaseAccount{Address: "address", AccountNumber: 1, Sequence: 2}, nil).Once()
+
+	gotAccount, err := client.GetAccount("address")
+	assert.NoError(t, err)
+	assert.Equal(t, account, gotAccount)
+
+	mockClientOne.EXPECT().GetAccount("address").Return(account, nil).Once()
+	mockClientTwo.EXPECT().GetAccount("address").Return(&auth.BaseAccount{Address: "address", AccountNumber: 1, Sequence: 3}, nil).Once()
+
+	gotAccount, err = client.GetAccount("address")
+	assert.ErrorContains(t, err, "does not match across endpoints")
+	assert.Nil(t, gotAccount)
+}
+
+func TestFailoverClient_VerifyBalance(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, true, log.NewEntry(log.New()))
+
+	balance := sdk.NewInt64Coin("upokt", 100)
+
+	mockClientOne.EXPECT().GetBalance("address", "upokt").Return(balance, nil).Once()
+	mockClientTwo.EXPECT().GetBalance("address", "upokt").Return(sdk.NewInt64Coin("upokt", 100), nil).Once()
+
+	gotBalance, err := client.GetBalance("address", "upokt")
+	assert.NoError(t, err)
+	assert.Equal(t, balance, gotBalance)
+
+	mockClientOne.EXPECT().GetBalance("address", "upokt").Return(balance, nil).Once()
+	mockClientTwo.EXPECT().GetBalance("address", "upokt").Return(sdk.NewInt64Coin("upokt", 101), nil).Once()
+
+	_, err = client.GetBalance("address", "upokt")
+	assert.ErrorContains(t, err, "does not match across endpoints")
+}
+
+func TestFailoverClient_VerifyTx(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, true, log.NewEntry(log.New()))
+
+	tx := &sdk.TxResponse{TxHash: "hash", Height: 10, Tx: &codectypes.Any{Value: []byte("tx")}}
+
+	mockClientOne.EXPECT().GetTx("hash").Return(tx, nil).Once()
+	mockClientTwo.EXPECT().GetTx("hash").Return(&sdk.TxResponse{TxHash: "hash", Height: 10, Tx: &codectypes.Any{Value: []byte("tx")}}, nil).Once()
+
+	gotTx, err := client.GetTx("hash")
+	assert.NoError(t, err)
+	assert.Equal(t, tx, gotTx)
+
+	mockClientOne.EXPECT().GetTx("hash").Return(tx, nil).Once()
+	mockClientTwo.EXPECT().GetTx("hash").Return(&sdk.TxResponse{TxHash: "hash", Height: 10, Tx: &codectypes.Any{Value: []byte("other")}}, nil).Once()
+
+	gotTx, err = client.GetTx("hash")
+	assert.ErrorContains(t, err, "does not match across endpoints")
+	assert.Nil(t, gotTx)
+
+	mockClientOne.EXPECT().GetTx("hash").Return(tx, nil).Once()
+	mockClientTwo.EXPECT().GetTx("hash").Return(nil, errors.New("error")).Once()
+
+	_, err = client.GetTx("hash")
+	assert.ErrorContains(t, err, "failed to verify tx")
+}
+
+func TestTxResponsesMatch(t *testing.T) {
+	assert.True(t, txResponsesMatch(nil, nil))
+	assert.False(t, txResponsesMatch(&sdk.TxResponse{}, nil))
+	assert.True(t, txResponsesMatch(&sdk.TxResponse{TxHash: "hash"}, &sdk.TxResponse{TxHash: "hash"}))
+	assert.False(t, txResponsesMatch(&sdk.TxResponse{Code: 0}, &sdk.TxResponse{Code: 1}))
+	assert.False(t, txResponsesMatch(&sdk.TxResponse{Tx: &codectypes.Any{}}, &sdk.TxResponse{}))
+	assert.True(t, txResponsesMatch(&sdk.TxResponse{RawLog: "log", Timestamp: "1"}, &sdk.TxResponse{RawLog: "other", Timestamp: "2"}))
+
+	event := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "100upokt"}}}
+	otherEvent := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{{Key: "amount", Value: "200upokt"}}}
+	assert.True(t, txResponsesMatch(&sdk.TxResponse{Events: []abci.Event{event}}, &sdk.TxResponse{Events: []abci.Event{event}}))
+	assert.False(t, txResponsesMatch(&sdk.TxResponse{Events: []abci.Event{event}}, &sdk.TxResponse{Events: []abci.Event{otherEvent}}))
+
+	logs := sdk.ABCIMessageLogs{{MsgIndex: 0, Events: sdk.StringEvents{{Type: "transfer"}}}}
+	assert.False(t, txResponsesMatch(&sdk.TxResponse{Logs: logs}, &sdk.TxResponse{}))
+}
+
+func TestFailoverClient_TxNotFound(t *testing.T) {
+	mockClientOne := mocks.NewMockCosmosClient(t)
+	mockClientTwo := mocks.NewMockCosmosClient(t)
+	client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, false, log.NewEntry(log.New()))
+
+	mockClientOne.EXPECT().GetTx("hash").Return(nil, fmt.Errorf("%w: hash", ErrTxNotFound)).Once()
+
+	tx, err := client.GetTx("hash")
+	assert.ErrorIs(t, err, ErrTxNotFound)
+	assert.Nil(t, tx)
+	assert.Equal(t, uint64(0), client.health[0].failures)
+	assert.Equal(t, []int{0, 1}, client.order())
+}
+
+func TestFailoverClient_ValidateNetwork(t *testing.T) {
+	chain := models.Chain{ChainID: "chain-id", ChainType: models.ChainTypeCosmos}
+
+	t.Run("Valid", func(t *testing.T) {
+		mockClientOne := mocks.NewMockCosmosClient(t)
+		mockClientTwo := mocks.NewMockCosmosClient(t)
+		client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, true, log.NewEntry(log.New()))
+
+		mockClientOne.EXPECT().Chain().Return(chain).Once()
+		mockClientOne.EXPECT().GetChainID().Return("chain-id", nil).Once()
+		mockClientTwo.EXPECT().GetChainID().Return("chain-id", nil).Once()
+
+		assert.NoError(t, client.ValidateNetwork())
+	})
+
+	t.Run("Wrong chain", func(t *testing.T) {
+		mockClientOne := mocks.NewMockCosmosClient(t)
+		mockClientTwo := mocks.NewMockCosmosClient(t)
+		client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, false, log.NewEntry(log.New()))
+
+		mockClientOne.EXPECT().Chain().Return(chain).Once()
+		mockClientOne.EXPECT().GetChainID().Return("chain-id", nil).Once()
+		mockClientTwo.EXPECT().GetChainID().Return("other-chain", nil).Once()
+
+		assert.EqualError(t, client.ValidateNetwork(), "expected chain id chain-id, got other-chain from endpoint 1")
+	})
+
+	t.Run("Not enough endpoints to verify", func(t *testing.T) {
+		mockClientOne := mocks.NewMockCosmosClient(t)
+		mockClientTwo := mocks.NewMockCosmosClient(t)
+		client := newFailoverClient([]CosmosClient{mockClientOne, mockClientTwo}, true, log.NewEntry(log.New()))
+
+		mockClientOne.EXPECT().Chain().Return(chain).Once()
+		mockClientOne.EXPECT().GetChainID().Return("chain-id", nil).Once()
+		mockClientTwo.EXPECT().GetChainID().Return("", errors.New("error")).Once()
+
+		assert.EqualError(t, client.ValidateNetwork(), "1 of 2 endpoints responded: error")
+	})
+}
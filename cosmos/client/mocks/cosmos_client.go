@@ -228,6 +228,63 @@ func (_c *MockCosmosClient_GetAccount_Call) RunAndReturn(run func(string) (*type
 	return _c
 }
 
+// GetBalance provides a mock function with given fields: address, denom
+func (_m *MockCosmosClient) GetBalance(address string, denom string) (cosmos_sdktypes.Coin, error) {
+	ret := _m.Called(address, denom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 cosmos_sdktypes.Coin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (cosmos_sdktypes.Coin, error)); ok {
+		return rf(address, denom)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) cosmos_sdktypes.Coin); ok {
+		r0 = rf(address, denom)
+	} else {
+		r0 = ret.Get(0).(cosmos_sdktypes.Coin)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(address, denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCosmosClient_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type MockCosmosClient_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - address string
+//   - denom string
+func (_e *MockCosmosClient_Expecter) GetBalance(address interface{}, denom interface{}) *MockCosmosClient_GetBalance_Call {
+	return &MockCosmosClient_GetBalance_Call{Call: _e.mock.On("GetBalance", address, denom)}
+}
+
+func (_c *MockCosmosClient_GetBalance_Call) Run(run func(address string, denom string)) *MockCosmosClient_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCosmosClient_GetBalance_Call) Return(_a0 cosmos_sdktypes.Coin, _a1 error) *MockCosmosClient_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCosmosClient_GetBalance_Call) RunAndReturn(run func(string, string) (cosmos_sdktypes.Coin, error)) *MockCosmosClient_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *MockCosmosClient) GetChainID() (string, error) {
 	ret := _m.Called()
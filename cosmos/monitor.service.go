@@ -82,7 +82,7 @@ func (x *CosmosMessageMonitorRunnable) CreateRefund(
 		return false
 	}
 
-	err = x.db.UpdateTransaction(txDoc.ID, bson.M{"refund": insertedID})
+	err = x.db.UpdateTransaction(txDoc.ID, txDoc.Status, bson.M{"refund": insertedID})
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating transaction")
 		return false
@@ -156,7 +156,7 @@ func (x *CosmosMessageMonitorRunnable) CreateMessage(
 
 	txDoc.Messages = append(txDoc.Messages, messageID)
 
-	err = x.db.UpdateTransaction(txDoc.ID, bson.M{"messages": common.RemoveDuplicates(txDoc.Messages)})
+	err = x.db.UpdateTransaction(txDoc.ID, txDoc.Status, bson.M{"messages": common.RemoveDuplicates(txDoc.Messages)})
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating transaction")
 		return false
@@ -165,14 +165,10 @@ func (x *CosmosMessageMonitorRunnable) CreateMessage(
 	return true
 }
 
-func (x *CosmosMessageMonitorRunnable) SyncNewTxs() bool {
-	x.logger.Infof("Syncing new txs")
-	if x.currentBlockHeight <= x.startBlockHeight {
-		x.logger.Infof("No new blocks to sync")
-		return true
-	}
-
-	txResponses, err := x.client.GetTxsSentToAddressAfterHeight(x.config.MultisigAddress, x.startBlockHeight)
+// SyncTxs inserts the txs sent to the multisig address within the block range, inclusive.
+// Txs that are already stored are left as they are.
+func (x *CosmosMessageMonitorRunnable) SyncTxs(startBlockHeight uint64, endBlockHeight uint64) bool {
+	txResponses, err := x.client.GetTxsSentToAddressAfterHeight(x.config.MultisigAddress, startBlockHeight)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error getting new txs")
 		return false
@@ -180,6 +176,11 @@ func (x *CosmosMessageMonitorRunnable) SyncNewTxs() bool {
 	x.logger.Infof("Found %d txs to sync", len(txResponses))
 	success := true
 	for _, txResponse := range txResponses {
+		if uint64(txResponse.Height) > endBlockHeight {
+			// picked up by the next sync
+			continue
+		}
+
 		logger := x.logger.WithField("tx_hash", txResponse.TxHash).WithField("section", "sync")
 
 		result, err := utilValidateTxToCosmosMultisig(txResponse, x.config, x.supportedChainIDsEthereum, x.currentBlockHeight)
@@ -195,6 +196,11 @@ func (x *CosmosMessageMonitorRunnable) SyncNewTxs() bool {
 			success = false
 			continue
 		}
+		if result.StatusCode != "" {
+			transaction.StatusCode = result.StatusCode
+			transaction.StatusReason = result.StatusReason
+			transaction.StatusOracleID = db.OracleID()
+		}
 
 		_, err = x.db.InsertTransaction(transaction)
 		if err != nil {
@@ -204,11 +210,21 @@ func (x *CosmosMessageMonitorRunnable) SyncNewTxs() bool {
 		}
 	}
 
-	if success {
-		x.startBlockHeight = x.currentBlockHeight
+	return success
+}
+
+func (x *CosmosMessageMonitorRunnable) SyncNewTxs() bool {
+	x.logger.Infof("Syncing new txs")
+	if x.currentBlockHeight <= x.startBlockHeight {
+		x.logger.Infof("No new blocks to sync")
+		return true
 	}
 
-	return success
+	if !x.SyncTxs(x.startBlockHeight, x.currentBlockHeight) {
+		return false
+	}
+
+	return x.UpdateCheckpoint(x.currentBlockHeight)
 }
 
 func (x *CosmosMessageMonitorRunnable) ValidateAndConfirmTx(txDoc *models.Transaction) bool {
@@ -225,11 +241,9 @@ func (x *CosmosMessageMonitorRunnable) ValidateAndConfirmTx(txDoc *models.Transa
 		return false
 	}
 
-	update := bson.M{
-		"confirmations": result.Confirmations,
-		"status":        result.TxStatus,
-	}
-	err = x.db.UpdateTransaction(txDoc.ID, update)
+	update := db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason)
+	update["confirmations"] = result.Confirmations
+	err = x.db.UpdateTransaction(txDoc.ID, txDoc.Status, update)
 	if err != nil {
 		logger.WithError(err).Errorf("Error updating transaction")
 		return false
@@ -273,8 +287,8 @@ func (x *CosmosMessageMonitorRunnable) ValidateTxAndCreate(txDoc *models.Transac
 	}
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
-		logger.Warnf("Found tx with status %s", result.TxStatus)
-		err = x.db.UpdateTransaction(txDoc.ID, bson.M{"status": result.TxStatus})
+		logger.WithField("status_code", result.StatusCode).Warnf("Found tx with status %s", result.TxStatus)
+		err = x.db.UpdateTransaction(txDoc.ID, txDoc.Status, db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason))
 		if err != nil {
 			logger.WithError(err).Errorf("Error updating transaction")
 			return false
@@ -313,12 +327,27 @@ func (x *CosmosMessageMonitorRunnable) CreateRefundsOrMessagesForConfirmedTxs()
 	return success
 }
 
-func (x *CosmosMessageMonitorRunnable) InitStartBlockHeight(lastHealth *models.RunnerServiceStatus) {
-	if lastHealth == nil || lastHealth.BlockHeight == 0 {
-		x.logger.Debugf("Invalid last health")
+func (x *CosmosMessageMonitorRunnable) UpdateCheckpoint(blockHeight uint64) bool {
+	err := x.db.UpsertCheckpoint(x.chain, models.CheckpointRunnerMonitor, blockHeight)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating checkpoint")
+		return false
+	}
+	x.startBlockHeight = blockHeight
+	return true
+}
+
+func (x *CosmosMessageMonitorRunnable) InitStartBlockHeight() {
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerMonitor)
+	if err != nil {
+		x.logger.WithError(err).Fatalf("Error finding checkpoint")
+		return
+	}
+	if checkpoint == nil {
+		x.logger.Debugf("No checkpoint found")
 	} else {
-		x.logger.Debugf("Last block height: %d", lastHealth.BlockHeight)
-		x.startBlockHeight = lastHealth.BlockHeight
+		x.logger.Debugf("Checkpoint block height: %d", checkpoint.BlockHeight)
+		x.startBlockHeight = checkpoint.BlockHeight
 	}
 	if x.startBlockHeight == 0 {
 		x.logger.Debugf("Start block height is zero")
@@ -337,7 +366,6 @@ func NewMessageMonitor(
 	config models.CosmosNetworkConfig,
 	mintControllerMap map[uint32][]byte,
 	ethNetworks []models.EthereumNetworkConfig,
-	lastHealth *models.RunnerServiceStatus,
 ) service.Runnable {
 	logger := log.
 		WithField("module", "cosmos").
@@ -377,7 +405,7 @@ func NewMessageMonitor(
 		supportedChainIDsEthereum[uint32(ethNetwork.ChainID)] = true
 	}
 
-	// TODO: check max amount for corresponding chain and disallow if too high
+	// amounts over the mint limit of the destination chain are held by its signer
 
 	x := &CosmosMessageMonitorRunnable{
 		multisigAddressBytes: multisigPk.Address().Bytes(),
@@ -394,12 +422,12 @@ func NewMessageMonitor(
 
 		logger: logger,
 
-		db: dbNewDB(),
+		db: dbNewDB("monitor"),
 	}
 
 	x.UpdateCurrentHeight()
 
-	x.InitStartBlockHeight(lastHealth)
+	x.InitStartBlockHeight()
 
 	logger.Infof("Initialized")
 
@@ -95,7 +95,7 @@ func TestCreateRefund(t *testing.T) {
 
 	mockDB.EXPECT().NewRefund(txRes, txDoc, toAddr, amount).Return(models.Refund{}, nil)
 	mockDB.EXPECT().InsertRefund(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	result := monitor.CreateRefund(txRes, txDoc, toAddr, amount)
 
@@ -164,7 +164,7 @@ func TestCreateRefund_UpdateError(t *testing.T) {
 
 	mockDB.EXPECT().NewRefund(txRes, txDoc, toAddr, amount).Return(models.Refund{}, nil)
 	mockDB.EXPECT().InsertRefund(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(assert.AnError)
 
 	result := monitor.CreateRefund(txRes, txDoc, toAddr, amount)
 
@@ -198,7 +198,7 @@ func TestCreateMessage(t *testing.T) {
 	mockDB.EXPECT().NewMessageContent(uint32(1), uint32(0), senderAddress[:], uint32(1), mintControllerAddress[:], models.MessageBody{}).Return(models.MessageContent{}, nil)
 	mockDB.EXPECT().NewMessage(txDoc, mock.Anything, models.MessageStatusPending).Return(models.Message{}, nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	result := monitor.CreateMessage(txRes, tx, txDoc, senderAddress[:], amountCoin, memo)
 
@@ -443,7 +443,7 @@ func TestCreateMessage_UpdateTxError(t *testing.T) {
 	mockDB.EXPECT().NewMessageContent(uint32(1), uint32(0), senderAddress[:], uint32(1), mintControllerAddress[:], models.MessageBody{}).Return(models.MessageContent{}, nil)
 	mockDB.EXPECT().NewMessage(txDoc, mock.Anything, models.MessageStatusPending).Return(models.Message{}, nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(assert.AnError)
 
 	result := monitor.CreateMessage(txRes, tx, txDoc, senderAddress[:], amountCoin, memo)
 
@@ -487,11 +487,79 @@ func TestSyncNewTxs(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
+	mockDB.EXPECT().UpsertCheckpoint(monitor.chain, models.CheckpointRunnerMonitor, uint64(10)).Return(nil).Once()
+
 	success := monitor.SyncNewTxs()
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
 	assert.True(t, success)
+	assert.Equal(t, uint64(10), monitor.startBlockHeight)
+}
+
+func TestSyncTxs_SkipsTxsAfterEndBlock(t *testing.T) {
+	mockDB := dbMocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "monitor")
+	multisigAddress := ethcommon.BytesToAddress([]byte("multisigAddress"))
+
+	monitor := &CosmosMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 30,
+		config: models.CosmosNetworkConfig{
+			MultisigAddress: multisigAddress.Hex(),
+		},
+		multisigAddressBytes: multisigAddress.Bytes(),
+	}
+
+	txResponses := []*sdk.TxResponse{
+		{TxHash: "tx1", Height: 15},
+		{TxHash: "tx2", Height: 21},
+	}
+
+	mockClient.EXPECT().GetTxsSentToAddressAfterHeight(multisigAddress.Hex(), uint64(10)).Return(txResponses, nil).Once()
+	mockDB.EXPECT().NewCosmosTransaction(txResponses[0], mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(models.Transaction{}, nil).Once()
+	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil).Once()
+
+	utilValidateTxToCosmosMultisig = func(*sdk.TxResponse, models.CosmosNetworkConfig, map[uint32]bool, uint64) (*util.ValidateTxResult, error) {
+		return &util.ValidateTxResult{TxStatus: models.TransactionStatusPending}, nil
+	}
+	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
+
+	success := monitor.SyncTxs(10, 20)
+
+	assert.True(t, success)
+}
+
+func TestSyncNewTxs_CheckpointError(t *testing.T) {
+	mockDB := dbMocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "monitor")
+	multisigAddress := ethcommon.BytesToAddress([]byte("multisigAddress"))
+
+	monitor := &CosmosMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		startBlockHeight:   1,
+		currentBlockHeight: 10,
+		config: models.CosmosNetworkConfig{
+			MultisigAddress: multisigAddress.Hex(),
+		},
+		multisigAddressBytes: multisigAddress.Bytes(),
+	}
+
+	mockClient.EXPECT().GetTxsSentToAddressAfterHeight(multisigAddress.Hex(), uint64(1)).Return([]*sdk.TxResponse{}, nil).Once()
+	mockDB.EXPECT().UpsertCheckpoint(monitor.chain, models.CheckpointRunnerMonitor, uint64(10)).Return(assert.AnError).Once()
+
+	success := monitor.SyncNewTxs()
+
+	mockClient.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+	assert.False(t, success)
+	assert.Equal(t, uint64(1), monitor.startBlockHeight)
 }
 
 func TestSyncNewTxs_NoNewBlocks(t *testing.T) {
@@ -710,9 +778,12 @@ func TestValidateAndConfirmTx(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, bson.M{
-		"confirmations": uint64(2),
-		"status":        models.TransactionStatusConfirmed,
+	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, mock.Anything, bson.M{
+		"confirmations":    uint64(2),
+		"status":           models.TransactionStatusConfirmed,
+		"status_code":      models.StatusCode(""),
+		"status_reason":    "",
+		"status_oracle_id": "",
 	}).Return(nil)
 
 	valid := monitor.ValidateAndConfirmTx(txDoc)
@@ -816,9 +887,12 @@ func TestValidateAndConfirmTx_UpdateError(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, bson.M{
-		"confirmations": uint64(2),
-		"status":        models.TransactionStatusConfirmed,
+	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, mock.Anything, bson.M{
+		"confirmations":    uint64(2),
+		"status":           models.TransactionStatusConfirmed,
+		"status_code":      models.StatusCode(""),
+		"status_reason":    "",
+		"status_oracle_id": "",
 	}).Return(assert.AnError)
 
 	valid := monitor.ValidateAndConfirmTx(txDoc)
@@ -848,8 +922,8 @@ func TestConfirmTxs(t *testing.T) {
 	mockClient.EXPECT().GetTx("hash1").Return(&sdk.TxResponse{}, nil)
 	mockClient.EXPECT().GetTx("hash2").Return(&sdk.TxResponse{}, nil)
 
-	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, mock.Anything).Return(nil)
-	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(&primitive.ObjectID{}, mock.Anything, mock.Anything).Return(nil)
 
 	utilValidateTxToCosmosMultisig = func(*sdk.TxResponse, models.CosmosNetworkConfig, map[uint32]bool, uint64) (*util.ValidateTxResult, error) {
 		return &util.ValidateTxResult{
@@ -942,7 +1016,7 @@ func TestValidateTxAndCreate(t *testing.T) {
 	mockDB.EXPECT().NewMessageContent(uint32(1), uint32(0), senderAddress[:], uint32(1), mintControllerAddress[:], models.MessageBody{}).Return(models.MessageContent{}, nil)
 	mockDB.EXPECT().NewMessage(txDoc, mock.Anything, models.MessageStatusPending).Return(models.Message{}, nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	success := monitor.ValidateTxAndCreate(txDoc)
 
@@ -1116,6 +1190,8 @@ func TestValidateTxAndCreate_TxInvalid(t *testing.T) {
 	result := &util.ValidateTxResult{
 		Confirmations: 2,
 		TxStatus:      models.TransactionStatusInvalid,
+		StatusCode:    models.StatusCodeZeroAmount,
+		StatusReason:  "tx has zero coins",
 		NeedsRefund:   false,
 		SenderAddress: senderAddress.Bytes(),
 		Amount:        sdk.NewCoin("token", math.NewInt(100)),
@@ -1130,7 +1206,7 @@ func TestValidateTxAndCreate_TxInvalid(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, bson.M{"status": result.TxStatus}).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason)).Return(nil)
 
 	success := monitor.ValidateTxAndCreate(txDoc)
 
@@ -1169,6 +1245,8 @@ func TestValidateTxAndCreate_TxInvalidError(t *testing.T) {
 	result := &util.ValidateTxResult{
 		Confirmations: 2,
 		TxStatus:      models.TransactionStatusInvalid,
+		StatusCode:    models.StatusCodeZeroAmount,
+		StatusReason:  "tx has zero coins",
 		NeedsRefund:   false,
 		SenderAddress: senderAddress.Bytes(),
 		Amount:        sdk.NewCoin("token", math.NewInt(100)),
@@ -1183,7 +1261,7 @@ func TestValidateTxAndCreate_TxInvalidError(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, bson.M{"status": result.TxStatus}).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason)).Return(assert.AnError)
 
 	success := monitor.ValidateTxAndCreate(txDoc)
 
@@ -1294,7 +1372,7 @@ func TestValidateTxAndCreate_Refund(t *testing.T) {
 
 	mockDB.EXPECT().NewRefund(txResponse, txDoc, senderAddress.Bytes(), amount).Return(models.Refund{}, nil)
 	mockDB.EXPECT().InsertRefund(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	success := monitor.ValidateTxAndCreate(txDoc)
 
@@ -1350,7 +1428,7 @@ func TestCreateRefundsOrMessagesForConfirmedTxs(t *testing.T) {
 	mockDB.EXPECT().NewMessageContent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(models.MessageContent{}, nil)
 	mockDB.EXPECT().NewMessage(mock.Anything, mock.Anything, models.MessageStatusPending).Return(models.Message{}, nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	success := monitor.CreateRefundsOrMessagesForConfirmedTxs()
 
@@ -1388,38 +1466,58 @@ func TestCreateRefundsOrMessagesForConfirmedTxs_ClientError(t *testing.T) {
 }
 
 func TestMonitorInitStartBlockHeight(t *testing.T) {
+	mockDB := dbMocks.NewMockDB(t)
 	logger := log.New().WithField("test", "monitor")
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
 
 	monitor := &CosmosMessageMonitorRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
 
-	monitor.InitStartBlockHeight(lastHealth)
-
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 100}, nil).Once()
+	monitor.InitStartBlockHeight()
 	assert.Equal(t, uint64(100), monitor.startBlockHeight)
 
 	monitor = &CosmosMessageMonitorRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = nil
-	monitor.InitStartBlockHeight(lastHealth)
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(nil, nil).Once()
+	monitor.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), monitor.startBlockHeight)
 
 	monitor = &CosmosMessageMonitorRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = &models.RunnerServiceStatus{BlockHeight: 300}
-	monitor.InitStartBlockHeight(lastHealth)
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 300}, nil).Once()
+	monitor.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), monitor.startBlockHeight)
 }
 
+func TestMonitorInitStartBlockHeight_Error(t *testing.T) {
+	mockDB := dbMocks.NewMockDB(t)
+	logger := log.New().WithField("test", "monitor")
+	logger.Logger.ExitFunc = func(int) {}
+
+	monitor := &CosmosMessageMonitorRunnable{
+		db:                 mockDB,
+		logger:             logger,
+		startBlockHeight:   10,
+		currentBlockHeight: 200,
+	}
+
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(nil, assert.AnError).Once()
+	monitor.InitStartBlockHeight()
+	assert.Equal(t, uint64(10), monitor.startBlockHeight)
+}
+
 func TestMonitorRun(t *testing.T) {
 	mockDB := dbMocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockCosmosClient(t)
@@ -1433,6 +1531,7 @@ func TestMonitorRun(t *testing.T) {
 
 	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil)
 	mockClient.EXPECT().GetTxsSentToAddressAfterHeight(mock.Anything, mock.Anything).Return([]*sdk.TxResponse{}, nil)
+	mockDB.EXPECT().UpsertCheckpoint(mock.Anything, models.CheckpointRunnerMonitor, uint64(100)).Return(nil)
 	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
 	mockDB.EXPECT().GetConfirmedTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
 
@@ -1504,8 +1603,6 @@ func TestNewMessageMonitor(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := dbMocks.NewMockDB(t)
 
@@ -1514,7 +1611,7 @@ func TestNewMessageMonitor(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1524,13 +1621,15 @@ func TestNewMessageMonitor(t *testing.T) {
 		return mockClient, nil
 	}
 
-	runnable := NewMessageMonitor(config, mintControllerMap, ethNetworks, lastHealth)
+	mockDB.EXPECT().FindCheckpoint(util.ParseChain(config), models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 50}, nil)
+
+	runnable := NewMessageMonitor(config, mintControllerMap, ethNetworks)
 
 	assert.NotNil(t, runnable)
 	monitor, ok := runnable.(*CosmosMessageMonitorRunnable)
 	assert.True(t, ok)
 
-	assert.Equal(t, uint64(100), monitor.startBlockHeight)
+	assert.Equal(t, uint64(50), monitor.startBlockHeight)
 	assert.Equal(t, uint64(100), monitor.currentBlockHeight)
 	assert.Equal(t, config, monitor.config)
 	assert.Equal(t, util.ParseChain(config), monitor.chain)
@@ -1608,8 +1707,6 @@ func TestNewMessageMonitor_Disabled(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := dbMocks.NewMockDB(t)
 
@@ -1618,7 +1715,7 @@ func TestNewMessageMonitor_Disabled(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1629,7 +1726,7 @@ func TestNewMessageMonitor_Disabled(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageMonitor(config, mintControllerMap, ethNetworks, lastHealth)
+		NewMessageMonitor(config, mintControllerMap, ethNetworks)
 	})
 
 }
@@ -1699,8 +1796,6 @@ func TestNewMessageMonitor_MultisigPublicKeyError(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := dbMocks.NewMockDB(t)
 
@@ -1709,7 +1804,7 @@ func TestNewMessageMonitor_MultisigPublicKeyError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1720,7 +1815,7 @@ func TestNewMessageMonitor_MultisigPublicKeyError(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageMonitor(config, mintControllerMap, ethNetworks, lastHealth)
+		NewMessageMonitor(config, mintControllerMap, ethNetworks)
 	})
 
 }
@@ -1790,8 +1885,6 @@ func TestNewMessageMonitor_MultisigAddressError(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := dbMocks.NewMockDB(t)
 
@@ -1800,7 +1893,7 @@ func TestNewMessageMonitor_MultisigAddressError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1811,7 +1904,7 @@ func TestNewMessageMonitor_MultisigAddressError(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageMonitor(config, mintControllerMap, ethNetworks, lastHealth)
+		NewMessageMonitor(config, mintControllerMap, ethNetworks)
 	})
 
 }
@@ -1881,8 +1974,6 @@ func TestNewMessageMonitor_ClientError(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := dbMocks.NewMockDB(t)
 
@@ -1891,7 +1982,7 @@ func TestNewMessageMonitor_ClientError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1902,7 +1993,7 @@ func TestNewMessageMonitor_ClientError(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageMonitor(config, mintControllerMap, ethNetworks, lastHealth)
+		NewMessageMonitor(config, mintControllerMap, ethNetworks)
 	})
 
 }
@@ -0,0 +1,274 @@
+package cosmos
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
+	"github.com/dan13ram/wpokt-oracle/db"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// supplyToken is the OmniToken of an ethereum network whose supply is backed by the multisig
+type supplyToken struct {
+	chain     models.Chain
+	omniToken eth.OmniTokenContract
+	timeout   time.Duration
+}
+
+type CosmosSupplyReconcilerRunnable struct {
+	config models.CosmosNetworkConfig
+	chain  models.Chain
+	client cosmos.CosmosClient
+
+	tokens    []supplyToken
+	tolerance *big.Int
+
+	logger *log.Entry
+
+	currentBlockHeight uint64
+
+	db db.DB
+}
+
+func (x *CosmosSupplyReconcilerRunnable) Run() {
+	x.UpdateCurrentHeight()
+	x.Reconcile()
+}
+
+func (x *CosmosSupplyReconcilerRunnable) Height() uint64 {
+	return uint64(x.currentBlockHeight)
+}
+
+func (x *CosmosSupplyReconcilerRunnable) UpdateCurrentHeight() {
+	height, err := x.client.GetLatestBlockHeight()
+	if err != nil {
+		x.logger.WithError(err).Error("could not get current block height")
+		return
+	}
+	x.currentBlockHeight = uint64(height)
+	x.logger.WithField("current_block_height", x.currentBlockHeight).Info("updated current block height")
+}
+
+// InFlightAmounts sums the amounts of the messages and refunds that are not paid out yet.
+// Messages to the cosmos network are releases from the multisig, all others are mints of wPOKT
+func (x *CosmosSupplyReconcilerRunnable) InFlightAmounts() (mints *big.Int, releases *big.Int, refunds *big.Int, ok bool) {
+	messages, err := x.db.GetInFlightMessages()
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting in-flight messages")
+		return nil, nil, nil, false
+	}
+
+	mints = big.NewInt(0)
+	releases = big.NewInt(0)
+	for _, messageDoc := range messages {
+		amount, valid := new(big.Int).SetString(messageDoc.Content.MessageBody.Amount, 10)
+		if !valid {
+			x.logger.WithField("message_id", messageDoc.MessageID).Error("Error parsing message amount")
+			return nil, nil, nil, false
+		}
+		if messageDoc.Content.DestinationDomain == x.chain.ChainDomain {
+			releases.Add(releases, amount)
+		} else {
+			mints.Add(mints, amount)
+		}
+	}
+
+	refundDocs, err := x.db.GetInFlightRefunds()
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting in-flight refunds")
+		return nil, nil, nil, false
+	}
+
+	refunds = big.NewInt(0)
+	for _, refundDoc := range refundDocs {
+		amount, valid := new(big.Int).SetString(refundDoc.Amount, 10)
+		if !valid {
+			x.logger.WithField("refund_id", refundDoc.ID.Hex()).Error("Error parsing refund amount")
+			return nil, nil, nil, false
+		}
+		refunds.Add(refunds, amount)
+	}
+
+	return mints, releases, refunds, true
+}
+
+// TokenSupplies reads the total supply of the OmniToken on every ethereum network
+func (x *CosmosSupplyReconcilerRunnable) TokenSupplies() ([]models.TokenSupply, *big.Int, bool) {
+	supplies := []models.TokenSupply{}
+	total := big.NewInt(0)
+
+	for _, token := range x.tokens {
+		ctx, cancel := context.WithTimeout(context.Background(), token.timeout)
+		supply, err := token.omniToken.TotalSupply(&bind.CallOpts{Context: ctx, Pending: false})
+		cancel()
+		if err != nil {
+			x.logger.WithError(err).
+				WithField("token_chain_id", token.chain.ChainID).
+				Error("Error fetching omni token total supply")
+			return nil, nil, false
+		}
+		supplies = append(supplies, models.TokenSupply{Chain: token.chain, TotalSupply: supply.String()})
+		total.Add(total, supply)
+	}
+
+	return supplies, total, true
+}
+
+// Snapshot compares the POKT locked in the multisig with the wPOKT minted against it
+func (x *CosmosSupplyReconcilerRunnable) Snapshot() (models.SupplySnapshot, bool) {
+	// in-flight amounts are read before the chain state, so a transfer completing in between
+	// is counted on both sides and shows up as a discrepancy until the next run
+	mints, releases, refunds, ok := x.InFlightAmounts()
+	if !ok {
+		return models.SupplySnapshot{}, false
+	}
+
+	balance, err := x.client.GetBalance(x.config.MultisigAddress, x.config.CoinDenom)
+	if err != nil {
+		x.logger.WithError(err).Error("Error fetching multisig balance")
+		return models.SupplySnapshot{}, false
+	}
+
+	supplies, totalSupply, ok := x.TokenSupplies()
+	if !ok {
+		return models.SupplySnapshot{}, false
+	}
+
+	locked := new(big.Int).Set(balance.Amount.BigInt())
+	locked.Sub(locked, releases)
+	locked.Sub(locked, refunds)
+
+	expected := new(big.Int).Add(totalSupply, mints)
+
+	discrepancy := new(big.Int).Sub(locked, expected)
+
+	return models.SupplySnapshot{
+		Chain:            x.chain,
+		BlockHeight:      x.currentBlockHeight,
+		MultisigAddress:  x.config.MultisigAddress,
+		MultisigBalance:  balance.Amount.String(),
+		TokenSupplies:    supplies,
+		TotalSupply:      totalSupply.String(),
+		InFlightMints:    mints.String(),
+		InFlightReleases: releases.String(),
+		InFlightRefunds:  refunds.String(),
+		Locked:           locked.String(),
+		Expected:         expected.String(),
+		Discrepancy:      discrepancy.String(),
+		Tolerance:        x.tolerance.String(),
+		WithinTolerance:  new(big.Int).Abs(discrepancy).Cmp(x.tolerance) <= 0,
+		CreatedAt:        time.Now(),
+	}, true
+}
+
+// Reconcile stores a supply snapshot and raises an alert if the discrepancy exceeds the tolerance
+func (x *CosmosSupplyReconcilerRunnable) Reconcile() bool {
+	snapshot, ok := x.Snapshot()
+	if !ok {
+		return false
+	}
+
+	logger := x.logger.
+		WithField("locked", snapshot.Locked).
+		WithField("expected", snapshot.Expected).
+		WithField("discrepancy", snapshot.Discrepancy)
+
+	if _, err := x.db.InsertSupplySnapshot(snapshot); err != nil {
+		logger.WithError(err).Error("Error inserting supply snapshot")
+		return false
+	}
+
+	if !snapshot.WithinTolerance {
+		logger.
+			WithField("alert", "supply_discrepancy").
+			WithField("tolerance", snapshot.Tolerance).
+			Error("Multisig balance does not match the wPOKT supply")
+		return true
+	}
+
+	logger.Info("Reconciled multisig balance with the wPOKT supply")
+	return true
+}
+
+var ethNewOmniTokenContract = eth.NewOmniTokenContract
+
+func NewSupplyReconciler(
+	config models.CosmosNetworkConfig,
+	ethNetworks []models.EthereumNetworkConfig,
+) service.Runnable {
+	logger := log.
+		WithField("module", "cosmos").
+		WithField("service", "reconciler").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", strings.ToLower(config.ChainID))
+
+	if !config.SupplyReconciler.Enabled {
+		logger.Fatalf("Supply reconciler is not enabled")
+	}
+
+	logger.Debugf("Initializing")
+
+	tolerance := big.NewInt(0)
+	if config.SupplyReconciler.Tolerance != "" {
+		if _, ok := tolerance.SetString(config.SupplyReconciler.Tolerance, 10); !ok || tolerance.Sign() < 0 {
+			logger.Fatalf("Invalid supply reconciler tolerance")
+		}
+	}
+
+	client, err := cosmosNewClient(config)
+	if err != nil {
+		logger.WithError(err).Fatalf("Error creating cosmos client")
+	}
+
+	var tokens []supplyToken
+	for _, ethConfig := range ethNetworks {
+		ethClient, err := ethNewClient(ethConfig)
+		if err != nil {
+			logger.WithError(err).
+				WithField("chain_name", ethConfig.ChainName).
+				WithField("chain_id", ethConfig.ChainID).
+				Fatalf("Error creating ethereum client")
+		}
+		omniToken, err := ethNewOmniTokenContract(common.HexToAddress(ethConfig.OmniTokenAddress), ethClient.GetClient())
+		if err != nil {
+			logger.WithError(err).
+				WithField("chain_name", ethConfig.ChainName).
+				WithField("chain_id", ethConfig.ChainID).
+				Fatalf("Error creating omni token contract")
+		}
+		tokens = append(tokens, supplyToken{
+			chain:     ethClient.Chain(),
+			omniToken: omniToken,
+			timeout:   time.Duration(ethConfig.TimeoutMS) * time.Millisecond,
+		})
+	}
+
+	x := &CosmosSupplyReconcilerRunnable{
+		client: client,
+
+		tokens:    tokens,
+		tolerance: tolerance,
+
+		chain:  utilParseChain(config),
+		config: config,
+
+		logger: logger,
+
+		db: dbNewDB("reconciler"),
+	}
+
+	x.UpdateCurrentHeight()
+
+	logger.Infof("Initialized")
+
+	return x
+}
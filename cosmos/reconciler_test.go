@@ -0,0 +1,357 @@
+package cosmos
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
+	clientMocks "github.com/dan13ram/wpokt-oracle/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/cosmos/util"
+	"github.com/dan13ram/wpokt-oracle/db"
+	dbMocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	ethMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func newTestReconciler(t *testing.T) (*CosmosSupplyReconcilerRunnable, *clientMocks.MockCosmosClient, *dbMocks.MockDB, *ethMocks.MockOmniTokenContract) {
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	mockDB := dbMocks.NewMockDB(t)
+	mockOmniToken := ethMocks.NewMockOmniTokenContract(t)
+
+	reconciler := &CosmosSupplyReconcilerRunnable{
+		config: models.CosmosNetworkConfig{
+			MultisigAddress: "pokt1multisig",
+			CoinDenom:       "upokt",
+		},
+		chain:  models.Chain{ChainDomain: 100},
+		client: mockClient,
+		tokens: []supplyToken{
+			{chain: models.Chain{ChainID: "1", ChainDomain: 1}, omniToken: mockOmniToken, timeout: time.Second},
+		},
+		tolerance: big.NewInt(5),
+		logger:    log.NewEntry(log.New()),
+		db:        mockDB,
+	}
+
+	return reconciler, mockClient, mockDB, mockOmniToken
+}
+
+func inFlightMessage(destinationDomain uint32, amount string) models.Message {
+	return models.Message{
+		Content: models.MessageContent{
+			DestinationDomain: destinationDomain,
+			MessageBody:       models.MessageBody{Amount: amount},
+		},
+	}
+}
+
+func TestReconcilerHeight(t *testing.T) {
+	reconciler := &CosmosSupplyReconcilerRunnable{currentBlockHeight: 100}
+
+	assert.Equal(t, uint64(100), reconciler.Height())
+}
+
+func TestReconcilerUpdateCurrentHeight(t *testing.T) {
+	reconciler, mockClient, _, _ := newTestReconciler(t)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil).Once()
+
+	reconciler.UpdateCurrentHeight()
+
+	assert.Equal(t, uint64(100), reconciler.currentBlockHeight)
+}
+
+func TestReconcilerUpdateCurrentHeight_Error(t *testing.T) {
+	reconciler, mockClient, _, _ := newTestReconciler(t)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(0), errors.New("error")).Once()
+
+	reconciler.UpdateCurrentHeight()
+
+	assert.Equal(t, uint64(0), reconciler.currentBlockHeight)
+}
+
+func TestReconcilerInFlightAmounts(t *testing.T) {
+	reconciler, _, mockDB, _ := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{
+		inFlightMessage(1, "30"),
+		inFlightMessage(2, "20"),
+		inFlightMessage(100, "15"),
+	}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{{Amount: "7"}, {Amount: "3"}}, nil).Once()
+
+	mints, releases, refunds, ok := reconciler.InFlightAmounts()
+
+	assert.True(t, ok)
+	assert.Equal(t, "50", mints.String())
+	assert.Equal(t, "15", releases.String())
+	assert.Equal(t, "10", refunds.String())
+}
+
+func TestReconcilerInFlightAmounts_MessagesError(t *testing.T) {
+	reconciler, _, mockDB, _ := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return(nil, errors.New("error")).Once()
+
+	_, _, _, ok := reconciler.InFlightAmounts()
+
+	assert.False(t, ok)
+}
+
+func TestReconcilerInFlightAmounts_InvalidAmount(t *testing.T) {
+	reconciler, _, mockDB, _ := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{inFlightMessage(1, "invalid")}, nil).Once()
+
+	_, _, _, ok := reconciler.InFlightAmounts()
+
+	assert.False(t, ok)
+}
+
+func TestReconcilerInFlightAmounts_RefundsError(t *testing.T) {
+	reconciler, _, mockDB, _ := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return(nil, errors.New("error")).Once()
+
+	_, _, _, ok := reconciler.InFlightAmounts()
+
+	assert.False(t, ok)
+}
+
+func TestReconcilerTokenSupplies(t *testing.T) {
+	reconciler, _, _, mockOmniToken := newTestReconciler(t)
+	otherOmniToken := ethMocks.NewMockOmniTokenContract(t)
+	reconciler.tokens = append(reconciler.tokens, supplyToken{chain: models.Chain{ChainID: "2", ChainDomain: 2}, omniToken: otherOmniToken, timeout: time.Second})
+
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(40), nil).Once()
+	otherOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(25), nil).Once()
+
+	supplies, total, ok := reconciler.TokenSupplies()
+
+	assert.True(t, ok)
+	assert.Equal(t, "65", total.String())
+	assert.Equal(t, []models.TokenSupply{
+		{Chain: models.Chain{ChainID: "1", ChainDomain: 1}, TotalSupply: "40"},
+		{Chain: models.Chain{ChainID: "2", ChainDomain: 2}, TotalSupply: "25"},
+	}, supplies)
+}
+
+func TestReconcilerTokenSupplies_Error(t *testing.T) {
+	reconciler, _, _, mockOmniToken := newTestReconciler(t)
+
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(nil, errors.New("error")).Once()
+
+	_, _, ok := reconciler.TokenSupplies()
+
+	assert.False(t, ok)
+}
+
+func TestReconcilerSnapshot(t *testing.T) {
+	reconciler, mockClient, mockDB, mockOmniToken := newTestReconciler(t)
+	reconciler.currentBlockHeight = 10
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{
+		inFlightMessage(1, "30"),
+		inFlightMessage(100, "15"),
+	}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{{Amount: "10"}}, nil).Once()
+	mockClient.EXPECT().GetBalance("pokt1multisig", "upokt").Return(sdk.NewInt64Coin("upokt", 200), nil).Once()
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(142), nil).Once()
+
+	snapshot, ok := reconciler.Snapshot()
+
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), snapshot.BlockHeight)
+	assert.Equal(t, "pokt1multisig", snapshot.MultisigAddress)
+	assert.Equal(t, "200", snapshot.MultisigBalance)
+	assert.Equal(t, "142", snapshot.TotalSupply)
+	assert.Equal(t, "30", snapshot.InFlightMints)
+	assert.Equal(t, "15", snapshot.InFlightReleases)
+	assert.Equal(t, "10", snapshot.InFlightRefunds)
+	assert.Equal(t, "175", snapshot.Locked)
+	assert.Equal(t, "172", snapshot.Expected)
+	assert.Equal(t, "3", snapshot.Discrepancy)
+	assert.Equal(t, "5", snapshot.Tolerance)
+	assert.True(t, snapshot.WithinTolerance)
+}
+
+func TestReconcilerSnapshot_OutsideTolerance(t *testing.T) {
+	reconciler, mockClient, mockDB, mockOmniToken := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{}, nil).Once()
+	mockClient.EXPECT().GetBalance("pokt1multisig", "upokt").Return(sdk.NewInt64Coin("upokt", 100), nil).Once()
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(110), nil).Once()
+
+	snapshot, ok := reconciler.Snapshot()
+
+	assert.True(t, ok)
+	assert.Equal(t, "-10", snapshot.Discrepancy)
+	assert.False(t, snapshot.WithinTolerance)
+}
+
+func TestReconcilerSnapshot_BalanceError(t *testing.T) {
+	reconciler, mockClient, mockDB, _ := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{}, nil).Once()
+	mockClient.EXPECT().GetBalance("pokt1multisig", "upokt").Return(sdk.Coin{}, errors.New("error")).Once()
+
+	_, ok := reconciler.Snapshot()
+
+	assert.False(t, ok)
+}
+
+func TestReconcilerReconcile(t *testing.T) {
+	reconciler, mockClient, mockDB, mockOmniToken := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{}, nil).Once()
+	mockClient.EXPECT().GetBalance("pokt1multisig", "upokt").Return(sdk.NewInt64Coin("upokt", 100), nil).Once()
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(100), nil).Once()
+	mockDB.EXPECT().InsertSupplySnapshot(mock.Anything).Run(func(snapshot models.SupplySnapshot) {
+		assert.Equal(t, "0", snapshot.Discrepancy)
+		assert.True(t, snapshot.WithinTolerance)
+	}).Return(primitive.NewObjectID(), nil).Once()
+
+	assert.True(t, reconciler.Reconcile())
+}
+
+func TestReconcilerReconcile_InsertError(t *testing.T) {
+	reconciler, mockClient, mockDB, mockOmniToken := newTestReconciler(t)
+
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{}, nil).Once()
+	mockClient.EXPECT().GetBalance("pokt1multisig", "upokt").Return(sdk.NewInt64Coin("upokt", 100), nil).Once()
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(100), nil).Once()
+	mockDB.EXPECT().InsertSupplySnapshot(mock.Anything).Return(primitive.ObjectID{}, errors.New("error")).Once()
+
+	assert.False(t, reconciler.Reconcile())
+}
+
+func TestReconcilerRun(t *testing.T) {
+	reconciler, mockClient, mockDB, mockOmniToken := newTestReconciler(t)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil).Once()
+	mockDB.EXPECT().GetInFlightMessages().Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetInFlightRefunds().Return([]models.Refund{}, nil).Once()
+	mockClient.EXPECT().GetBalance("pokt1multisig", "upokt").Return(sdk.NewInt64Coin("upokt", 100), nil).Once()
+	mockOmniToken.EXPECT().TotalSupply(mock.Anything).Return(big.NewInt(120), nil).Once()
+	mockDB.EXPECT().InsertSupplySnapshot(mock.Anything).Run(func(snapshot models.SupplySnapshot) {
+		assert.Equal(t, uint64(100), snapshot.BlockHeight)
+		assert.False(t, snapshot.WithinTolerance)
+	}).Return(primitive.NewObjectID(), nil).Once()
+
+	reconciler.Run()
+}
+
+func TestNewSupplyReconciler(t *testing.T) {
+	config := models.CosmosNetworkConfig{
+		ChainID:         "poktroll",
+		ChainName:       "Poktroll",
+		CoinDenom:       "upokt",
+		MultisigAddress: "pokt13tsl3aglfyzf02n7x28x2ajzw94muu6y57k2ar",
+		SupplyReconciler: models.ReconcilerConfig{
+			Enabled:    true,
+			IntervalMS: 1000,
+			Tolerance:  "1000",
+		},
+	}
+
+	ethNetworks := []models.EthereumNetworkConfig{
+		{
+			TimeoutMS:        1000,
+			ChainID:          1,
+			ChainName:        "Ethereum",
+			OmniTokenAddress: "0x0000000000000000000000000000000000000001",
+		},
+	}
+
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	mockDB := dbMocks.NewMockDB(t)
+	mockOmniToken := ethMocks.NewMockOmniTokenContract(t)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil)
+
+	originalNewDB := dbNewDB
+	defer func() { dbNewDB = originalNewDB }()
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+
+	originalCosmosNewClient := cosmosNewClient
+	defer func() { cosmosNewClient = originalCosmosNewClient }()
+	cosmosNewClient = func(config models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
+		return mockClient, nil
+	}
+
+	originEthNewClient := ethNewClient
+	defer func() { ethNewClient = originEthNewClient }()
+	ethNewClient = func(config models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+		mockEthClient := ethMocks.NewMockEthereumClient(t)
+		mockEthClient.EXPECT().Chain().Return(models.Chain{ChainDomain: uint32(config.ChainID)})
+		mockEthClient.EXPECT().GetClient().Return(nil)
+
+		return mockEthClient, nil
+	}
+
+	originalEthNewOmniTokenContract := ethNewOmniTokenContract
+	defer func() { ethNewOmniTokenContract = originalEthNewOmniTokenContract }()
+	ethNewOmniTokenContract = func(address ethcommon.Address, _ bind.ContractBackend) (eth.OmniTokenContract, error) {
+		assert.Equal(t, ethcommon.HexToAddress("0x0000000000000000000000000000000000000001"), address)
+		return mockOmniToken, nil
+	}
+
+	runnable := NewSupplyReconciler(config, ethNetworks)
+
+	assert.NotNil(t, runnable)
+	reconciler, ok := runnable.(*CosmosSupplyReconcilerRunnable)
+	assert.True(t, ok)
+
+	assert.Equal(t, uint64(100), reconciler.currentBlockHeight)
+	assert.Equal(t, config, reconciler.config)
+	assert.Equal(t, util.ParseChain(config), reconciler.chain)
+	assert.Equal(t, "1000", reconciler.tolerance.String())
+	assert.Equal(t, []supplyToken{{chain: models.Chain{ChainDomain: 1}, omniToken: mockOmniToken, timeout: time.Second}}, reconciler.tokens)
+	assert.NotNil(t, reconciler.db)
+}
+
+func TestNewSupplyReconciler_Disabled(t *testing.T) {
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+	config := models.CosmosNetworkConfig{ChainID: "poktroll", ChainName: "Poktroll"}
+
+	assert.Panics(t, func() {
+		NewSupplyReconciler(config, nil)
+	})
+}
+
+func TestNewSupplyReconciler_InvalidTolerance(t *testing.T) {
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+	config := models.CosmosNetworkConfig{
+		ChainID:          "poktroll",
+		ChainName:        "Poktroll",
+		SupplyReconciler: models.ReconcilerConfig{Enabled: true, Tolerance: "-1"},
+	}
+
+	assert.Panics(t, func() {
+		NewSupplyReconciler(config, nil)
+	})
+}
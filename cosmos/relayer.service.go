@@ -1,6 +1,7 @@
 package cosmos
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
@@ -55,9 +56,10 @@ func (x *CosmosMessageRelayerRunnable) UpdateCurrentHeight() {
 
 func (x *CosmosMessageRelayerRunnable) UpdateRefund(
 	refundID *primitive.ObjectID,
+	currentStatus models.RefundStatus,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateRefund(refundID, update)
+	err := x.db.UpdateRefund(refundID, currentStatus, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating refund")
 		return false
@@ -67,9 +69,10 @@ func (x *CosmosMessageRelayerRunnable) UpdateRefund(
 
 func (x *CosmosMessageRelayerRunnable) UpdateMessage(
 	messageID *primitive.ObjectID,
+	currentStatus models.MessageStatus,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateMessage(messageID, update)
+	err := x.db.UpdateMessage(messageID, currentStatus, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating message")
 		return false
@@ -116,7 +119,7 @@ func (x *CosmosMessageRelayerRunnable) CreateMessageTransaction(
 		return false
 	}
 
-	return x.UpdateMessage(messageDoc.ID, bson.M{"transaction": insertedID})
+	return x.UpdateMessage(messageDoc.ID, messageDoc.Status, bson.M{"transaction": insertedID})
 }
 
 func (x *CosmosMessageRelayerRunnable) CreateRefundTransaction(
@@ -156,7 +159,7 @@ func (x *CosmosMessageRelayerRunnable) CreateRefundTransaction(
 		return false
 	}
 
-	return x.UpdateRefund(refundDoc.ID, bson.M{"transaction": insertedID})
+	return x.UpdateRefund(refundDoc.ID, refundDoc.Status, bson.M{"transaction": insertedID})
 }
 
 func (x *CosmosMessageRelayerRunnable) CreateTxForRefunds() bool {
@@ -195,7 +198,7 @@ func (x *CosmosMessageRelayerRunnable) UpdateTransaction(
 	tx *models.Transaction,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateTransaction(tx.ID, update)
+	err := x.db.UpdateTransaction(tx.ID, tx.Status, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating transaction")
 		return false
@@ -218,7 +221,7 @@ func (x *CosmosMessageRelayerRunnable) ResetRefund(
 		"transaction_hash": "",
 	}
 
-	return x.UpdateRefund(refundID, update)
+	return x.UpdateRefund(refundID, models.RefundStatusBroadcasted, update)
 }
 
 func (x *CosmosMessageRelayerRunnable) ResetMessage(
@@ -237,7 +240,7 @@ func (x *CosmosMessageRelayerRunnable) ResetMessage(
 		"transaction_hash": "",
 	}
 
-	return x.UpdateMessage(messageID, update)
+	return x.UpdateMessage(messageID, models.MessageStatusBroadcasted, update)
 }
 
 func (x *CosmosMessageRelayerRunnable) ConfirmTransactions() bool {
@@ -267,7 +270,8 @@ func (x *CosmosMessageRelayerRunnable) ConfirmTransactions() bool {
 
 		if txResponse.Code != 0 {
 			logger.Infof("Found tx with error")
-			updateSuccesful := x.UpdateTransaction(&txDoc, bson.M{"status": models.TransactionStatusFailed})
+			update := db.StatusUpdate(models.TransactionStatusFailed, models.StatusCodeTxFailed, fmt.Sprintf("tx failed with code %d", txResponse.Code))
+			updateSuccesful := x.UpdateTransaction(&txDoc, update)
 			if !updateSuccesful {
 				success = false
 				continue
@@ -310,25 +314,22 @@ func (x *CosmosMessageRelayerRunnable) ConfirmTransactions() bool {
 			"transaction_hash": txDoc.Hash,
 		}
 
+		// the refund or messages of a pending tx from the multisig are broadcasted,
+		// so anything else means another runner has already moved them on
 		if txDoc.Refund != nil {
-			success = x.UpdateRefund(txDoc.Refund, update) && success
+			success = x.UpdateRefund(txDoc.Refund, models.RefundStatusBroadcasted, update) && success
 			continue
 		}
 		for _, messageObjectID := range txDoc.Messages {
-			success = x.UpdateMessage(&messageObjectID, update) && success
+			success = x.UpdateMessage(&messageObjectID, models.MessageStatusBroadcasted, update) && success
 		}
 	}
 
 	return success
 }
 
-func (x *CosmosMessageRelayerRunnable) InitStartBlockHeight(lastHealth *models.RunnerServiceStatus) {
-	if lastHealth == nil || lastHealth.BlockHeight == 0 {
-		x.logger.Debugf("Invalid last health")
-	} else {
-		x.logger.Debugf("Last block height: %d", lastHealth.BlockHeight)
-		x.startBlockHeight = lastHealth.BlockHeight
-	}
+// the cosmos relayer does not scan blocks, so it keeps no checkpoint
+func (x *CosmosMessageRelayerRunnable) InitStartBlockHeight() {
 	if x.startBlockHeight == 0 {
 		x.logger.Debugf("Start block height is zero")
 		x.startBlockHeight = x.currentBlockHeight
@@ -339,7 +340,7 @@ func (x *CosmosMessageRelayerRunnable) InitStartBlockHeight(lastHealth *models.R
 	x.logger.Infof("Initialized start block height: %d", x.startBlockHeight)
 }
 
-func NewMessageRelayer(config models.CosmosNetworkConfig, lastHealth *models.RunnerServiceStatus) service.Runnable {
+func NewMessageRelayer(config models.CosmosNetworkConfig) service.Runnable {
 	logger := log.
 		WithField("module", "cosmos").
 		WithField("service", "relayer").
@@ -385,12 +386,12 @@ func NewMessageRelayer(config models.CosmosNetworkConfig, lastHealth *models.Run
 
 		logger: logger,
 
-		db: dbNewDB(),
+		db: dbNewDB("relayer"),
 	}
 
 	x.UpdateCurrentHeight()
 
-	x.InitStartBlockHeight(lastHealth)
+	x.InitStartBlockHeight()
 
 	logger.Infof("Initialized")
 
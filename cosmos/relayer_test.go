@@ -92,9 +92,9 @@ func TestRelayerUpdateRefund(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateRefund(refundID, update).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refundID, models.RefundStatusBroadcasted, update).Return(nil)
 
-	result := relayer.UpdateRefund(refundID, update)
+	result := relayer.UpdateRefund(refundID, models.RefundStatusBroadcasted, update)
 
 	mockDB.AssertExpectations(t)
 	assert.True(t, result)
@@ -112,9 +112,9 @@ func TestRelayerUpdateRefund_Error(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateRefund(refundID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateRefund(refundID, models.RefundStatusBroadcasted, update).Return(assert.AnError)
 
-	result := relayer.UpdateRefund(refundID, update)
+	result := relayer.UpdateRefund(refundID, models.RefundStatusBroadcasted, update)
 
 	mockDB.AssertExpectations(t)
 	assert.False(t, result)
@@ -132,9 +132,9 @@ func TestRelayerUpdateMessage(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateMessage(messageID, update).Return(nil)
+	mockDB.EXPECT().UpdateMessage(messageID, models.MessageStatusBroadcasted, update).Return(nil)
 
-	result := relayer.UpdateMessage(messageID, update)
+	result := relayer.UpdateMessage(messageID, models.MessageStatusBroadcasted, update)
 
 	mockDB.AssertExpectations(t)
 	assert.True(t, result)
@@ -152,9 +152,9 @@ func TestRelayerUpdateMessage_Error(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateMessage(messageID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateMessage(messageID, models.MessageStatusBroadcasted, update).Return(assert.AnError)
 
-	result := relayer.UpdateMessage(messageID, update)
+	result := relayer.UpdateMessage(messageID, models.MessageStatusBroadcasted, update)
 
 	mockDB.AssertExpectations(t)
 	assert.False(t, result)
@@ -189,7 +189,7 @@ func TestCreateMessageTransaction(t *testing.T) {
 	mockClient.EXPECT().GetTx("0x010203").Return(tx, nil)
 	mockDB.EXPECT().NewCosmosTransaction(tx, mock.Anything, mock.Anything, mock.Anything, models.TransactionStatusPending).Return(models.Transaction{}, nil)
 	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	result := relayer.CreateMessageTransaction(message)
 
@@ -362,7 +362,7 @@ func TestCreateRefundTransaction(t *testing.T) {
 	mockClient.EXPECT().GetTx("txHash").Return(tx, nil)
 	mockDB.EXPECT().NewCosmosTransaction(tx, mock.Anything, mock.Anything, mock.Anything, models.TransactionStatusPending).Return(models.Transaction{}, nil)
 	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateRefund(refund.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, mock.Anything).Return(nil)
 
 	result := relayer.CreateRefundTransaction(refund)
 
@@ -552,7 +552,7 @@ func TestCreateTxForRefunds(t *testing.T) {
 	mockClient.EXPECT().GetTx("txHash").Return(tx, nil)
 	mockDB.EXPECT().NewCosmosTransaction(tx, mock.Anything, mock.Anything, mock.Anything, models.TransactionStatusPending).Return(models.Transaction{}, nil)
 	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateRefund(refund.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, mock.Anything).Return(nil)
 
 	result := relayer.CreateTxForRefunds()
 
@@ -613,7 +613,7 @@ func TestCreateTxForMessages(t *testing.T) {
 	mockClient.EXPECT().GetTx("0x010203").Return(tx, nil)
 	mockDB.EXPECT().NewCosmosTransaction(tx, mock.Anything, mock.Anything, mock.Anything, models.TransactionStatusPending).Return(models.Transaction{}, nil)
 	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	result := relayer.CreateTxForMessages()
 
@@ -633,7 +633,7 @@ func TestUpdateTransaction(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, update).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, update).Return(nil)
 
 	result := relayer.UpdateTransaction(transaction, update)
 
@@ -675,7 +675,7 @@ func TestResetRefund(t *testing.T) {
 		"transaction_hash": "",
 	}
 
-	mockDB.EXPECT().UpdateRefund(refundID, update).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refundID, models.RefundStatusBroadcasted, update).Return(nil)
 
 	result := relayer.ResetRefund(refundID)
 
@@ -717,7 +717,7 @@ func TestResetMessage(t *testing.T) {
 		"transaction_hash": "",
 	}
 
-	mockDB.EXPECT().UpdateMessage(messageID, update).Return(nil)
+	mockDB.EXPECT().UpdateMessage(messageID, models.MessageStatusBroadcasted, update).Return(nil)
 
 	result := relayer.ResetMessage(messageID)
 
@@ -841,7 +841,7 @@ func TestConfirmTransactions_FailedTx_Error(t *testing.T) {
 
 	mockDB.EXPECT().GetPendingTransactionsFrom(mock.Anything, mock.Anything).Return([]models.Transaction{transaction}, nil)
 	mockClient.EXPECT().GetTx("txHash").Return(txResponse, nil)
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, bson.M{"status": models.TransactionStatusFailed}).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, bson.M{"status": models.TransactionStatusFailed, "status_code": models.StatusCodeTxFailed, "status_reason": "tx failed with code 1", "status_oracle_id": ""}).Return(assert.AnError)
 
 	result := relayer.ConfirmTransactions()
 
@@ -879,7 +879,7 @@ func TestConfirmTransactions_FailedTx_Message(t *testing.T) {
 
 	mockDB.EXPECT().GetPendingTransactionsFrom(mock.Anything, mock.Anything).Return([]models.Transaction{transaction}, nil)
 	mockClient.EXPECT().GetTx("txHash").Return(txResponse, nil)
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, bson.M{"status": models.TransactionStatusFailed}).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, bson.M{"status": models.TransactionStatusFailed, "status_code": models.StatusCodeTxFailed, "status_reason": "tx failed with code 1", "status_oracle_id": ""}).Return(nil)
 	update := bson.M{
 		"status":           models.MessageStatusPending,
 		"signatures":       []models.Signature{},
@@ -887,7 +887,7 @@ func TestConfirmTransactions_FailedTx_Message(t *testing.T) {
 		"transaction":      nil,
 		"transaction_hash": "",
 	}
-	mockDB.EXPECT().UpdateMessage(mock.Anything, update).Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(mock.Anything, models.MessageStatusBroadcasted, update).Return(nil).Once()
 
 	result := relayer.ConfirmTransactions()
 
@@ -925,7 +925,7 @@ func TestConfirmTransactions_FailedTx_Refund(t *testing.T) {
 
 	mockDB.EXPECT().GetPendingTransactionsFrom(mock.Anything, mock.Anything).Return([]models.Transaction{transaction}, nil)
 	mockClient.EXPECT().GetTx("txHash").Return(txResponse, nil)
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, bson.M{"status": models.TransactionStatusFailed}).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, bson.M{"status": models.TransactionStatusFailed, "status_code": models.StatusCodeTxFailed, "status_reason": "tx failed with code 1", "status_oracle_id": ""}).Return(nil)
 	update := bson.M{
 		"status":           models.RefundStatusPending,
 		"signatures":       []models.Signature{},
@@ -933,7 +933,7 @@ func TestConfirmTransactions_FailedTx_Refund(t *testing.T) {
 		"transaction":      nil,
 		"transaction_hash": "",
 	}
-	mockDB.EXPECT().UpdateRefund(mock.Anything, update).Return(nil)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, models.RefundStatusBroadcasted, update).Return(nil)
 
 	result := relayer.ConfirmTransactions()
 
@@ -976,7 +976,7 @@ func TestConfirmTransactions_NotConfirmed(t *testing.T) {
 		"status":        models.TransactionStatusPending,
 		"confirmations": uint64(0),
 	}
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, update).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, update).Return(nil)
 
 	result := relayer.ConfirmTransactions()
 
@@ -1019,7 +1019,7 @@ func TestConfirmTransactions_NotConfirmed_Error(t *testing.T) {
 		"status":        models.TransactionStatusPending,
 		"confirmations": uint64(0),
 	}
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, update).Return(assert.AnError)
 
 	result := relayer.ConfirmTransactions()
 
@@ -1062,7 +1062,7 @@ func TestConfirmTransactions_Confirmed_Error(t *testing.T) {
 		"status":        models.TransactionStatusConfirmed,
 		"confirmations": uint64(10),
 	}
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, update).Return(assert.AnError)
 
 	result := relayer.ConfirmTransactions()
 
@@ -1105,13 +1105,13 @@ func TestConfirmTransactions_Message(t *testing.T) {
 		"status":        models.TransactionStatusConfirmed,
 		"confirmations": uint64(10),
 	}
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, txUpdate).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, txUpdate).Return(nil)
 	msgUpdate := bson.M{
 		"status":           models.MessageStatusSuccess,
 		"transaction":      &primitive.ObjectID{},
 		"transaction_hash": "txHash",
 	}
-	mockDB.EXPECT().UpdateMessage(mock.Anything, msgUpdate).Return(nil).Twice()
+	mockDB.EXPECT().UpdateMessage(mock.Anything, models.MessageStatusBroadcasted, msgUpdate).Return(nil).Twice()
 
 	result := relayer.ConfirmTransactions()
 
@@ -1154,13 +1154,13 @@ func TestConfirmTransactions_Refund(t *testing.T) {
 		"status":        models.TransactionStatusConfirmed,
 		"confirmations": uint64(10),
 	}
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, txUpdate).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, txUpdate).Return(nil)
 	refundUpdate := bson.M{
 		"status":           models.MessageStatusSuccess,
 		"transaction":      &primitive.ObjectID{},
 		"transaction_hash": "txHash",
 	}
-	mockDB.EXPECT().UpdateRefund(mock.Anything, refundUpdate).Return(nil)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, models.RefundStatusBroadcasted, refundUpdate).Return(nil)
 
 	result := relayer.ConfirmTransactions()
 
@@ -1203,13 +1203,13 @@ func TestConfirmTransactions_Refund_Error(t *testing.T) {
 		"status":        models.TransactionStatusConfirmed,
 		"confirmations": uint64(10),
 	}
-	mockDB.EXPECT().UpdateTransaction(transaction.ID, txUpdate).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(transaction.ID, transaction.Status, txUpdate).Return(nil)
 	refundUpdate := bson.M{
 		"status":           models.MessageStatusSuccess,
 		"transaction":      &primitive.ObjectID{},
 		"transaction_hash": "txHash",
 	}
-	mockDB.EXPECT().UpdateRefund(mock.Anything, refundUpdate).Return(assert.AnError)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, models.RefundStatusBroadcasted, refundUpdate).Return(assert.AnError)
 
 	result := relayer.ConfirmTransactions()
 
@@ -1220,15 +1220,14 @@ func TestConfirmTransactions_Refund_Error(t *testing.T) {
 
 func TestRelayerInitStartBlockHeight(t *testing.T) {
 	logger := logrus.New().WithField("test", "relayer")
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
 
 	relayer := &CosmosMessageRelayerRunnable{
 		logger:             logger,
-		startBlockHeight:   0,
+		startBlockHeight:   100,
 		currentBlockHeight: 200,
 	}
 
-	relayer.InitStartBlockHeight(lastHealth)
+	relayer.InitStartBlockHeight()
 
 	assert.Equal(t, uint64(100), relayer.startBlockHeight)
 
@@ -1237,17 +1236,15 @@ func TestRelayerInitStartBlockHeight(t *testing.T) {
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = nil
-	relayer.InitStartBlockHeight(lastHealth)
+	relayer.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), relayer.startBlockHeight)
 
 	relayer = &CosmosMessageRelayerRunnable{
 		logger:             logger,
-		startBlockHeight:   0,
+		startBlockHeight:   300,
 		currentBlockHeight: 200,
 	}
-	lastHealth = &models.RunnerServiceStatus{BlockHeight: 300}
-	relayer.InitStartBlockHeight(lastHealth)
+	relayer.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), relayer.startBlockHeight)
 }
 
@@ -1307,8 +1304,6 @@ func TestNewMessageRelayer(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := mocks.NewMockDB(t)
 
@@ -1317,7 +1312,7 @@ func TestNewMessageRelayer(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1327,13 +1322,13 @@ func TestNewMessageRelayer(t *testing.T) {
 		return mockClient, nil
 	}
 
-	runnable := NewMessageRelayer(config, lastHealth)
+	runnable := NewMessageRelayer(config)
 
 	assert.NotNil(t, runnable)
 	relayer, ok := runnable.(*CosmosMessageRelayerRunnable)
 	assert.True(t, ok)
 
-	assert.Equal(t, uint64(100), relayer.startBlockHeight)
+	assert.Equal(t, config.StartBlockHeight, relayer.startBlockHeight)
 	assert.Equal(t, uint64(100), relayer.currentBlockHeight)
 	assert.Equal(t, config, relayer.config)
 	assert.Equal(t, util.ParseChain(config), relayer.chain)
@@ -1379,14 +1374,12 @@ func TestNewMessageRelayer_Disabled(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := mocks.NewMockDB(t)
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1397,7 +1390,7 @@ func TestNewMessageRelayer_Disabled(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageRelayer(config, lastHealth)
+		NewMessageRelayer(config)
 	})
 
 }
@@ -1436,14 +1429,12 @@ func TestNewMessageRelayer_InvalidPublicKey(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := mocks.NewMockDB(t)
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1454,7 +1445,7 @@ func TestNewMessageRelayer_InvalidPublicKey(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageRelayer(config, lastHealth)
+		NewMessageRelayer(config)
 	})
 
 }
@@ -1493,14 +1484,12 @@ func TestNewMessageRelayer_InvalidMultisigAddress(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := mocks.NewMockDB(t)
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1511,7 +1500,7 @@ func TestNewMessageRelayer_InvalidMultisigAddress(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageRelayer(config, lastHealth)
+		NewMessageRelayer(config)
 	})
 
 }
@@ -1550,14 +1539,12 @@ func TestNewMessageRelayer_ClientError(t *testing.T) {
 		},
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
-
 	mockClient := clientMocks.NewMockCosmosClient(t)
 	mockDB := mocks.NewMockDB(t)
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1568,7 +1555,7 @@ func TestNewMessageRelayer_ClientError(t *testing.T) {
 	}
 
 	assert.Panics(t, func() {
-		NewMessageRelayer(config, lastHealth)
+		NewMessageRelayer(config)
 	})
 
 }
@@ -0,0 +1,98 @@
+package cosmos
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// Rescan syncs a block range of the network again with the monitor if it is enabled in config,
+// inserting the txs that were missed and reporting them apart from the ones already known.
+// An end block height of zero rescans up to the latest block.
+func Rescan(
+	config models.CosmosNetworkConfig,
+	mintControllerMap map[uint32][]byte,
+	ethNetworks []models.EthereumNetworkConfig,
+	startBlockHeight uint64,
+	endBlockHeight uint64,
+) ([]*service.RescanResult, error) {
+	logger := log.
+		WithField("module", "cosmos").
+		WithField("service", "rescan").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", strings.ToLower(config.ChainID))
+
+	results := []*service.RescanResult{}
+	if !config.MessageMonitor.Enabled {
+		return results, nil
+	}
+
+	multisigAddressBytes, err := common.AddressBytesFromBech32(config.Bech32Prefix, config.MultisigAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing multisig address: %w", err)
+	}
+
+	client, err := cosmosNewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cosmos client: %w", err)
+	}
+
+	height, err := client.GetLatestBlockHeight()
+	if err != nil {
+		return nil, fmt.Errorf("error getting current block height: %w", err)
+	}
+	currentBlockHeight := uint64(height)
+
+	if endBlockHeight == 0 {
+		endBlockHeight = currentBlockHeight
+	}
+
+	if startBlockHeight > endBlockHeight {
+		return nil, fmt.Errorf("start block height %d is after end block height %d", startBlockHeight, endBlockHeight)
+	}
+
+	supportedChainIDsEthereum := make(map[uint32]bool)
+	for _, ethNetwork := range ethNetworks {
+		supportedChainIDsEthereum[uint32(ethNetwork.ChainID)] = true
+	}
+
+	chain := utilParseChain(config)
+	database := dbNewDB("rescan")
+
+	monitor := &CosmosMessageMonitorRunnable{
+		multisigAddressBytes: multisigAddressBytes,
+
+		currentBlockHeight: currentBlockHeight,
+		client:             client,
+
+		mintControllerMap:         mintControllerMap,
+		supportedChainIDsEthereum: supportedChainIDsEthereum,
+
+		chain:  chain,
+		config: config,
+
+		logger: logger.WithField("runner", models.CheckpointRunnerMonitor),
+
+		db: database,
+	}
+
+	logger.Infof("Rescanning txs from block %d to %d", startBlockHeight, endBlockHeight)
+	result, err := service.Rescan(chain, models.CheckpointRunnerMonitor, startBlockHeight, endBlockHeight,
+		func() ([]models.Transaction, error) {
+			return database.GetTransactionsToInRange(chain, multisigAddressBytes, startBlockHeight, endBlockHeight)
+		},
+		func() bool {
+			return monitor.SyncTxs(startBlockHeight, endBlockHeight)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(results, result), nil
+}
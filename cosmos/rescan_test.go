@@ -0,0 +1,125 @@
+package cosmos
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
+	clientMocks "github.com/dan13ram/wpokt-oracle/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/cosmos/util"
+	"github.com/dan13ram/wpokt-oracle/db"
+	dbMocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func rescanConfig() models.CosmosNetworkConfig {
+	return models.CosmosNetworkConfig{
+		ChainID:         "poktroll",
+		ChainName:       "Poktroll",
+		Bech32Prefix:    "pokt",
+		MultisigAddress: "pokt13tsl3aglfyzf02n7x28x2ajzw94muu6y57k2ar",
+		MessageMonitor:  models.ServiceConfig{Enabled: true},
+	}
+}
+
+func TestRescan(t *testing.T) {
+	mockDB := dbMocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	config := rescanConfig()
+	chain := utilParseChain(config)
+	multisigAddressBytes, _ := common.AddressBytesFromBech32(config.Bech32Prefix, config.MultisigAddress)
+
+	cosmosNewClient = func(models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
+		return mockClient, nil
+	}
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+	utilValidateTxToCosmosMultisig = func(*sdk.TxResponse, models.CosmosNetworkConfig, map[uint32]bool, uint64) (*util.ValidateTxResult, error) {
+		return &util.ValidateTxResult{TxStatus: models.TransactionStatusPending}, nil
+	}
+	defer func() {
+		cosmosNewClient = cosmos.NewClient
+		dbNewDB = db.NewDB
+		utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig
+	}()
+
+	txResponses := []*sdk.TxResponse{{TxHash: "tx1", Height: 15}, {TxHash: "tx2", Height: 18}}
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil).Once()
+	mockClient.EXPECT().GetTxsSentToAddressAfterHeight(config.MultisigAddress, uint64(10)).Return(txResponses, nil).Once()
+	mockDB.EXPECT().NewCosmosTransaction(mock.Anything, chain, mock.Anything, multisigAddressBytes, models.TransactionStatusPending).Return(models.Transaction{}, nil).Twice()
+	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil).Twice()
+
+	mockDB.EXPECT().GetTransactionsToInRange(chain, multisigAddressBytes, uint64(10), uint64(20)).
+		Return([]models.Transaction{{Hash: "tx1"}}, nil).Once()
+	mockDB.EXPECT().GetTransactionsToInRange(chain, multisigAddressBytes, uint64(10), uint64(20)).
+		Return([]models.Transaction{{Hash: "tx1"}, {Hash: "tx2"}}, nil).Once()
+
+	results, err := Rescan(config, nil, nil, 10, 20)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, models.CheckpointRunnerMonitor, results[0].Runner)
+	assert.True(t, results[0].Success)
+	assert.Equal(t, []string{"tx2"}, results[0].Discovered)
+	assert.Equal(t, []string{"tx1"}, results[0].Known)
+}
+
+func TestRescan_MonitorDisabled(t *testing.T) {
+	config := rescanConfig()
+	config.MessageMonitor.Enabled = false
+
+	results, err := Rescan(config, nil, nil, 10, 20)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestRescan_Errors(t *testing.T) {
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	defer func() { cosmosNewClient = cosmos.NewClient }()
+
+	t.Run("invalid multisig address", func(t *testing.T) {
+		config := rescanConfig()
+		config.MultisigAddress = "invalid"
+
+		results, err := Rescan(config, nil, nil, 10, 20)
+		assert.ErrorContains(t, err, "error parsing multisig address")
+		assert.Nil(t, results)
+	})
+
+	t.Run("client error", func(t *testing.T) {
+		cosmosNewClient = func(models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
+			return nil, errors.New("error")
+		}
+
+		results, err := Rescan(rescanConfig(), nil, nil, 10, 20)
+		assert.ErrorContains(t, err, "error creating cosmos client")
+		assert.Nil(t, results)
+	})
+
+	cosmosNewClient = func(models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
+		return mockClient, nil
+	}
+
+	t.Run("block height error", func(t *testing.T) {
+		mockClient.EXPECT().GetLatestBlockHeight().Return(0, errors.New("error")).Once()
+
+		results, err := Rescan(rescanConfig(), nil, nil, 10, 20)
+		assert.ErrorContains(t, err, "error getting current block height")
+		assert.Nil(t, results)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		mockClient.EXPECT().GetLatestBlockHeight().Return(15, nil).Once()
+
+		results, err := Rescan(rescanConfig(), nil, nil, 30, 0)
+		assert.ErrorContains(t, err, "start block height 30 is after end block height 15")
+		assert.Nil(t, results)
+	})
+}
@@ -15,6 +15,7 @@ var utilSignWithPrivKey = util.SignWithPrivKey
 var utilNewSendTx = util.NewSendTx
 var utilParseChain = util.ParseChain
 var utilParseTxBody = util.ParseTxBody
+var utilSignerSequence = util.SignerSequence
 
 func NewCosmosChainService(
 	config models.CosmosNetworkConfig,
@@ -22,24 +23,13 @@ func NewCosmosChainService(
 	mnemonic string,
 	ethNetworks []models.EthereumNetworkConfig,
 	wg *sync.WaitGroup,
-	nodeHealth *models.Node,
 ) service.ChainService {
 
-	var chainHealth models.ChainServiceHealth
-	if nodeHealth != nil {
-		for _, health := range nodeHealth.Health {
-			if health.Chain.ChainID == config.ChainID && health.Chain.ChainType == models.ChainTypeCosmos {
-				chainHealth = health
-				break
-			}
-		}
-	}
-
 	chain := util.ParseChain(config)
 
 	var monitorRunnable service.Runnable = &service.EmptyRunnable{}
 	if config.MessageMonitor.Enabled {
-		monitorRunnable = NewMessageMonitor(config, mintControllerMap, ethNetworks, chainHealth.MessageMonitor)
+		monitorRunnable = NewMessageMonitor(config, mintControllerMap, ethNetworks)
 	}
 	monitorRunnerService := service.NewRunnerService(
 		"monitor",
@@ -64,7 +54,7 @@ func NewCosmosChainService(
 
 	var relayerRunnable service.Runnable = &service.EmptyRunnable{}
 	if config.MessageRelayer.Enabled {
-		relayerRunnable = NewMessageRelayer(config, chainHealth.MessageRelayer)
+		relayerRunnable = NewMessageRelayer(config)
 	}
 
 	relayerRunnerService := service.NewRunnerService(
@@ -75,11 +65,34 @@ func NewCosmosChainService(
 		chain,
 	)
 
+	options := []service.ChainServiceOption{}
+
+	if config.SupplyReconciler.Enabled {
+		options = append(options, service.WithReconciler(service.NewRunnerService(
+			"reconciler",
+			NewSupplyReconciler(config, ethNetworks),
+			true,
+			time.Duration(config.SupplyReconciler.IntervalMS)*time.Millisecond,
+			chain,
+		)))
+	}
+
+	if config.SpendAuditor.Enabled {
+		options = append(options, service.WithSpendAuditor(service.NewRunnerService(
+			"spend_auditor",
+			NewSpendAuditor(config),
+			true,
+			time.Duration(config.SpendAuditor.IntervalMS)*time.Millisecond,
+			chain,
+		)))
+	}
+
 	return service.NewChainService(
 		chain,
 		monitorRunnerService,
 		signerRunnerService,
 		relayerRunnerService,
 		wg,
+		options...,
 	)
 }
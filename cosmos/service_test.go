@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
 	clientMocks "github.com/dan13ram/wpokt-oracle/cosmos/client/mocks"
@@ -93,10 +94,11 @@ func TestNewCosmosService(t *testing.T) {
 	mockDB := dbMocks.NewMockDB(t)
 
 	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil)
+	mockDB.EXPECT().FindCheckpoint(mock.Anything, models.CheckpointRunnerMonitor).Return(nil, nil)
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -129,33 +131,16 @@ func TestNewCosmosService(t *testing.T) {
 			mnemonic,
 			ethNetworks,
 			nil,
-			nil,
 		)
 	})
 
 	wg := &sync.WaitGroup{}
-	lastHealth := models.ChainServiceHealth{
-		Chain: models.Chain{
-			ChainID:   "poktroll",
-			ChainName: "Poktroll",
-			ChainType: models.ChainTypeCosmos,
-		},
-		MessageMonitor: &models.RunnerServiceStatus{BlockHeight: 100},
-		MessageSigner:  &models.RunnerServiceStatus{BlockHeight: 100},
-		MessageRelayer: &models.RunnerServiceStatus{BlockHeight: 100},
-	}
-
-	node := &models.Node{
-		Health: []models.ChainServiceHealth{lastHealth},
-	}
-
 	service := NewCosmosChainService(
 		config,
 		mintControllerMap,
 		mnemonic,
 		ethNetworks,
 		wg,
-		node,
 	)
 	assert.NotNil(t, service)
 
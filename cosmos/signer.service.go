@@ -45,6 +45,10 @@ type CosmosMessageSignerRunnable struct {
 	chain  models.Chain
 	client cosmos.CosmosClient
 
+	// receipts holds the receipts prefetched for the batch of messages being signed
+	// and the block heights of their origin chains, read once per run
+	receipts *eth.ReceiptCache
+
 	logger *log.Entry
 
 	currentBlockHeight uint64
@@ -82,13 +86,24 @@ func (x *CosmosMessageSignerRunnable) UpdateMessage(
 	message *models.Message,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateMessage(message.ID, update)
+	err := x.db.UpdateMessage(message.ID, message.Status, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating message")
 		return false
 	}
 	return true
 }
+
+// InvalidateMessage marks a message whose origin transaction provably does not back it as invalid
+func (x *CosmosMessageSignerRunnable) InvalidateMessage(messageDoc *models.Message, code models.StatusCode, reason string) bool {
+	x.logger.
+		WithField("tx_hash", messageDoc.OriginTransactionHash).
+		WithField("status_code", code).
+		WithField("reason", reason).
+		Warnf("Marking message invalid")
+	return x.UpdateMessage(messageDoc, db.StatusUpdate(models.MessageStatusInvalid, code, reason))
+}
+
 func (x *CosmosMessageSignerRunnable) Sign(
 	sequence *uint64,
 	signatures []models.Signature,
@@ -144,6 +159,22 @@ func (x *CosmosMessageSignerRunnable) SignMessage(
 		WithField("tx_hash", messageDoc.OriginTransactionHash).
 		WithField("section", "sign-message")
 
+	// the message may have been reorged or signed by another oracle since it was read
+	current, err := x.db.FindMessage(bson.M{"_id": messageDoc.ID})
+	if err != nil {
+		logger.WithError(err).Errorf("Error finding message")
+		return false
+	}
+	if current.Status == models.MessageStatusReorged {
+		logger.Warnf("Origin transaction was reorged, not signing message")
+		return false
+	}
+	if current.Status != messageDoc.Status {
+		logger.WithField("status", current.Status).Debugf("Message status changed, not signing message")
+		return false
+	}
+	messageDoc.Signatures = current.Signatures
+
 	toAddr, err := common.BytesFromAddressHex(messageDoc.Content.MessageBody.RecipientAddress)
 	if err != nil {
 		logger.WithError(err).Errorf("Error parsing to address")
@@ -182,7 +213,7 @@ func (x *CosmosMessageSignerRunnable) SignMessage(
 		defer x.db.Unlock(lockID)
 	}
 
-	err = x.db.UpdateMessage(messageDoc.ID, update)
+	err = x.db.UpdateMessage(messageDoc.ID, messageDoc.Status, update)
 	if err != nil {
 		logger.WithError(err).Errorf("Error updating message")
 		return false
@@ -195,6 +226,8 @@ type ValidateTransactionAndParseDispatchIDEventsResult struct {
 	Event         *autogen.MailboxDispatchId
 	Confirmations uint64
 	TxStatus      models.TransactionStatus
+	StatusCode    models.StatusCode // cause of a failed or invalid status
+	StatusReason  string
 }
 
 func (x *CosmosMessageSignerRunnable) ValidateAndFindDispatchIDEvent(messageDoc *models.Message) (*ValidateTransactionAndParseDispatchIDEventsResult, error) {
@@ -214,13 +247,15 @@ func (x *CosmosMessageSignerRunnable) ValidateAndFindDispatchIDEvent(messageDoc
 		return nil, fmt.Errorf("mailbox not found")
 	}
 
-	receipt, err := ethClient.GetTransactionReceipt(txHash)
+	receipt, err := x.receipts.GetTransactionReceipt(chainDomain, ethClient, txHash)
 	if err != nil {
 		return nil, fmt.Errorf("error getting transaction receipt: %w", err)
 	}
 	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
 		return &ValidateTransactionAndParseDispatchIDEventsResult{
-			TxStatus: models.TransactionStatusFailed,
+			TxStatus:     models.TransactionStatusFailed,
+			StatusCode:   models.StatusCodeTxFailed,
+			StatusReason: "origin tx failed",
 		}, nil
 	}
 	var dispatchEvent *autogen.MailboxDispatchId
@@ -237,22 +272,24 @@ func (x *CosmosMessageSignerRunnable) ValidateAndFindDispatchIDEvent(messageDoc
 		}
 	}
 
-	currentBlockHeight, err := ethClient.GetBlockHeight()
+	heights, err := x.receipts.GetBlockHeights(chainDomain, ethClient)
 	if err != nil {
-		return nil, fmt.Errorf("error getting current block height: %w", err)
+		return nil, err
 	}
 
 	result := &ValidateTransactionAndParseDispatchIDEventsResult{
 		Event:         dispatchEvent,
-		Confirmations: currentBlockHeight - receipt.BlockNumber.Uint64(),
+		Confirmations: eth.Confirmations(receipt.BlockNumber.Uint64(), heights.Current),
 		TxStatus:      models.TransactionStatusPending,
 	}
 
-	if result.Confirmations >= ethClient.Confirmations() {
+	if eth.IsBlockConfirmed(ethClient, receipt.BlockNumber.Uint64(), heights.Current, heights.Finalized, ethClient.Confirmations()) {
 		result.TxStatus = models.TransactionStatusConfirmed
 	}
 	if dispatchEvent == nil {
 		result.TxStatus = models.TransactionStatusInvalid
+		result.StatusCode = models.StatusCodeNoDispatchEvent
+		result.StatusReason = "origin tx has no dispatch event for the message"
 	}
 	return result, nil
 }
@@ -273,8 +310,7 @@ func (x *CosmosMessageSignerRunnable) ValidateEthereumTxAndSignMessage(messageDo
 	}
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
-		logger.Debugf("Found tx with status %s", result.TxStatus)
-		return x.UpdateMessage(messageDoc, bson.M{"status": models.MessageStatusInvalid})
+		return x.InvalidateMessage(messageDoc, result.StatusCode, result.StatusReason)
 	}
 
 	if lockID, err := x.db.LockWriteMessage(messageDoc); err != nil {
@@ -298,19 +334,19 @@ func (x *CosmosMessageSignerRunnable) SignMessages() bool {
 		return false
 	}
 	x.logger.Infof("Found %d pending messages", len(messages))
-	success := true
-	for _, messageDoc := range messages {
-		success = x.ValidateEthereumTxAndSignMessage(&messageDoc) && success
-	}
+	x.receipts = eth.NewReceiptCache()
+	defer func() { x.receipts = nil }()
 
-	return success
+	return eth.ProcessInBatches(messages, func(batch []models.Message) {
+		x.receipts.PrefetchMessageReceipts(x.ethClientMap, batch, x.logger)
+	}, x.ValidateEthereumTxAndSignMessage)
 }
 
 func (x *CosmosMessageSignerRunnable) UpdateRefund(
 	refund *models.Refund,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateRefund(refund.ID, update)
+	err := x.db.UpdateRefund(refund.ID, refund.Status, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating refund")
 		return false
@@ -318,46 +354,38 @@ func (x *CosmosMessageSignerRunnable) UpdateRefund(
 	return true
 }
 
+// ValidateRefund returns a models.ValidationError when the refund does not match the tx it refunds
 func (x *CosmosMessageSignerRunnable) ValidateRefund(
 	refundDoc *models.Refund,
 	spenderAddress []byte,
 	amount sdk.Coin,
-) bool {
-	logger := x.logger.
-		WithField("tx_hash", refundDoc.OriginTransactionHash).
-		WithField("section", "validate-refund")
-
+) error {
 	recipientAddress, err := common.BytesFromAddressHex(refundDoc.Recipient)
 	if err != nil {
-		logger.WithError(err).Errorf("Error parsing recipient address")
-		return false
+		return models.NewValidationError(models.StatusCodeRecipientMismatch, "error parsing recipient address: %s", err)
 	}
 
 	if !bytes.Equal(spenderAddress, recipientAddress) {
-		logger.Errorf("Spender address does not match recipient address")
-		return false
+		return models.NewValidationError(models.StatusCodeRecipientMismatch, "spender address does not match recipient address")
 	}
 
 	coinAmount, ok := math.NewIntFromString(refundDoc.Amount)
 	if !ok {
-		logger.Errorf("Error parsing amount")
-		return false
+		return models.NewValidationError(models.StatusCodeInvalidAmount, "error parsing amount")
 	}
 
 	refundAmount := sdk.NewCoin(x.config.CoinDenom, coinAmount)
 	if !amount.IsEqual(refundAmount) {
-		logger.Errorf("Amount does not match refund amount")
-		return false
+		return models.NewValidationError(models.StatusCodeAmountMismatch, "amount does not match refund amount")
 	}
 
 	if refundDoc.TransactionBody == "" {
-		return true
+		return nil
 	}
 
 	tx, err := utilParseTxBody(x.config.Bech32Prefix, refundDoc.TransactionBody)
 	if err != nil {
-		logger.WithError(err).Errorf("Error parsing tx body")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "error parsing tx body: %s", err)
 	}
 
 	msgs := tx.GetMsgs()
@@ -365,45 +393,48 @@ func (x *CosmosMessageSignerRunnable) ValidateRefund(
 	msg, ok := msgs[0].(*banktypes.MsgSend)
 
 	if !ok {
-		logger.Errorf("Invalid message type")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "invalid message type")
 	}
 
 	if len(msg.Amount) != 1 {
-		logger.Errorf("Invalid amount")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "invalid amount")
 	}
 
 	refundFinalAmount := refundAmount.Sub(sdk.NewCoin(x.config.CoinDenom, math.NewIntFromUint64(x.config.TxFee)))
 
 	if !msg.Amount[0].IsEqual(refundFinalAmount) {
-		logger.Errorf("Amount does not match refund final amount")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "amount does not match refund final amount")
 	}
 
 	fromAddress, err := common.AddressBytesFromBech32(x.config.Bech32Prefix, msg.FromAddress)
 	if err != nil {
-		logger.WithError(err).Errorf("Error parsing from address")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "error parsing from address: %s", err)
 	}
 
 	if !bytes.Equal(fromAddress, x.multisigPk.Address().Bytes()) {
-		logger.Errorf("From address does not match multisig address")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "from address does not match multisig address")
 	}
 
 	toAddress, err := common.AddressBytesFromBech32(x.config.Bech32Prefix, msg.ToAddress)
 	if err != nil {
-		logger.WithError(err).Errorf("Error parsing to address")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "error parsing to address: %s", err)
 	}
 
 	if !bytes.Equal(toAddress, recipientAddress) {
-		logger.Errorf("To address does not match recipient address")
-		return false
+		return models.NewValidationError(models.StatusCodeRefundMismatch, "to address does not match recipient address")
 	}
 
-	return true
+	return nil
+}
+
+// InvalidateRefund marks a refund whose origin transaction provably does not back it as invalid
+func (x *CosmosMessageSignerRunnable) InvalidateRefund(refundDoc *models.Refund, code models.StatusCode, reason string) bool {
+	x.logger.
+		WithField("tx_hash", refundDoc.OriginTransactionHash).
+		WithField("status_code", code).
+		WithField("reason", reason).
+		Warnf("Marking refund invalid")
+	return x.UpdateRefund(refundDoc, db.StatusUpdate(models.RefundStatusInvalid, code, reason))
 }
 
 func isTxSigner(user []byte, signers [][]byte) bool {
@@ -491,7 +522,7 @@ func (x *CosmosMessageSignerRunnable) SignRefund(
 		defer x.db.Unlock(lockID)
 	}
 
-	err = x.db.UpdateRefund(refundDoc.ID, update)
+	err = x.db.UpdateRefund(refundDoc.ID, refundDoc.Status, update)
 	if err != nil {
 		logger.WithError(err).Errorf("Error updating refund")
 		return false
@@ -562,8 +593,7 @@ func (x *CosmosMessageSignerRunnable) ValidateEthereumTxAndBroadcastMessage(mess
 	}
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
-		logger.Debugf("Found tx with status %s", result.TxStatus)
-		return x.UpdateMessage(messageDoc, bson.M{"status": models.MessageStatusInvalid})
+		return x.InvalidateMessage(messageDoc, result.StatusCode, result.StatusReason)
 	}
 
 	if lockID, err := x.db.LockWriteMessage(messageDoc); err != nil {
@@ -770,6 +800,7 @@ func (x *CosmosMessageSignerRunnable) ValidateCosmosTx(
 	logger := x.logger.WithField("tx_hash", refundDoc.OriginTransactionHash).WithField("section", "validateCosmosTxAndSignRefund")
 	txResponse, err := x.client.GetTx(refundDoc.OriginTransactionHash)
 	if err != nil {
+		// retried on the next run
 		logger.WithError(err).Errorf("Error getting tx")
 		return false
 	}
@@ -777,8 +808,8 @@ func (x *CosmosMessageSignerRunnable) ValidateCosmosTx(
 	result, err := utilValidateTxToCosmosMultisig(txResponse, x.config, x.supportedChainIDsEthereum, x.currentBlockHeight)
 
 	if err != nil {
-		logger.WithError(err).Errorf("Error validating tx")
-		x.UpdateRefund(&refundDoc, bson.M{"status": models.RefundStatusInvalid})
+		// the tx was read, so an error parsing its events is permanent
+		x.InvalidateRefund(&refundDoc, models.StatusCodeInvalidTx, fmt.Sprintf("error validating tx: %s", err))
 		return false
 	}
 
@@ -788,20 +819,18 @@ func (x *CosmosMessageSignerRunnable) ValidateCosmosTx(
 	}
 
 	if !result.NeedsRefund {
-		logger.Debugf("Tx does not need refund")
-		x.UpdateRefund(&refundDoc, bson.M{"status": models.RefundStatusInvalid})
+		x.InvalidateRefund(&refundDoc, models.StatusCodeRefundNotNeeded, "tx does not need refund")
 		return false
 	}
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
-		logger.Debugf("Tx is invalid")
-		x.UpdateRefund(&refundDoc, bson.M{"status": models.RefundStatusInvalid})
+		x.InvalidateRefund(&refundDoc, result.StatusCode, fmt.Sprintf("tx is invalid: %s", result.StatusReason))
 		return false
 	}
 
-	if !x.ValidateRefund(&refundDoc, result.SenderAddress, result.Amount) {
-		logger.Warnf("Invalid refund")
-		x.UpdateRefund(&refundDoc, bson.M{"status": models.RefundStatusInvalid})
+	if err := x.ValidateRefund(&refundDoc, result.SenderAddress, result.Amount); err != nil {
+		validationErr, _ := models.AsValidationError(err)
+		x.InvalidateRefund(&refundDoc, validationErr.Code, validationErr.Reason)
 		return false
 	}
 
@@ -937,7 +966,7 @@ func NewMessageSigner(
 
 		logger: logger,
 
-		db: dbNewDB(),
+		db: dbNewDB("signer"),
 	}
 
 	x.UpdateCurrentHeight()
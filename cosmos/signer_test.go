@@ -118,7 +118,7 @@ func TestSignerUpdateMessage(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateMessage(message.ID, update).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, update).Return(nil)
 
 	result := signer.UpdateMessage(message, update)
 
@@ -138,7 +138,7 @@ func TestSignerUpdateMessage_Error(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateMessage(message.ID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, update).Return(assert.AnError)
 
 	result := signer.UpdateMessage(message, update)
 
@@ -449,6 +449,32 @@ func TestSign_AlreadySigned(t *testing.T) {
 	assert.Equal(t, ErrAlreadySigned, err)
 }
 
+func TestSignMessage_Reorged(t *testing.T) {
+	mockDB := dbMocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "signer")
+
+	message := &models.Message{
+		ID:                    &primitive.ObjectID{},
+		OriginTransactionHash: "hash1",
+		Status:                models.MessageStatusPending,
+	}
+
+	signer := &CosmosMessageSignerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	// the origin transaction was reorged after the message was read
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(models.Message{ID: message.ID, Status: models.MessageStatusReorged}, nil)
+
+	result := signer.SignMessage(message)
+
+	mockDB.AssertExpectations(t)
+	assert.False(t, result)
+}
+
 func TestSignMessage_AddressError(t *testing.T) {
 	mockDB := dbMocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockCosmosClient(t)
@@ -480,6 +506,8 @@ func TestSignMessage_AddressError(t *testing.T) {
 		},
 	}
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.SignMessage(message)
 
 	mockDB.AssertExpectations(t)
@@ -519,6 +547,8 @@ func TestSignMessage_AmountError(t *testing.T) {
 		},
 	}
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.SignMessage(message)
 
 	mockDB.AssertExpectations(t)
@@ -574,6 +604,8 @@ func TestSignMessage_AlreadySigned(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.SignMessage(message)
 
 	mockDB.AssertExpectations(t)
@@ -629,6 +661,8 @@ func TestSignMessage_ErrorSigning(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.SignMessage(message)
 
 	mockDB.AssertExpectations(t)
@@ -686,6 +720,8 @@ func TestSignMessage_ErrorLocking(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.SignMessage(message)
 
 	mockDB.AssertExpectations(t)
@@ -744,7 +780,9 @@ func TestSignMessage_ErrorUpdating(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(assert.AnError)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(assert.AnError)
+
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 
 	result := signer.SignMessage(message)
 
@@ -804,7 +842,9 @@ func TestSignMessage(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
+
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 
 	result := signer.SignMessage(message)
 
@@ -1113,7 +1153,7 @@ func TestSignRefund_UpdateError(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, mock.Anything).Return(assert.AnError)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, mock.Anything).Return(assert.AnError)
 
 	result := signer.SignRefund(refund)
 
@@ -1174,7 +1214,7 @@ func TestSignRefund(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, mock.Anything).Return(nil)
 
 	result := signer.SignRefund(refund)
 
@@ -1451,6 +1491,7 @@ func TestValidateAndFindDispatchIDEvent_NoEvent(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -1504,6 +1545,7 @@ func TestValidateAndFindDispatchIDEvent(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -1612,6 +1654,7 @@ func TestValidateEthereumTxAndSignMessage_Pending(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -1653,6 +1696,7 @@ func TestValidateEthereumTxAndSignMessage_Pending(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(91), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -1717,7 +1761,7 @@ func TestValidateEthereumTxAndSignMessage_FailedTx(t *testing.T) {
 		Logs:        []*types.Log{{Address: mailbox.Address()}},
 	}, nil)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, bson.M{"status": models.MessageStatusInvalid}).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, db.StatusUpdate(models.MessageStatusInvalid, models.StatusCodeTxFailed, "origin tx failed")).Return(nil)
 
 	result := signer.ValidateEthereumTxAndSignMessage(message)
 
@@ -1741,6 +1785,7 @@ func TestValidateEthereumTxAndSignMessage_LockError(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -1782,6 +1827,7 @@ func TestValidateEthereumTxAndSignMessage_LockError(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -1809,6 +1855,7 @@ func TestValidateEthereumTxAndSignMessage(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -1850,6 +1897,7 @@ func TestValidateEthereumTxAndSignMessage(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -1901,13 +1949,15 @@ func TestValidateEthereumTxAndSignMessage(t *testing.T) {
 
 	txConfig.EXPECT().TxJSONEncoder().Return(encoder)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
 	mockDB.EXPECT().LockWriteMessage(mock.Anything).Return("lock-id", nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.ValidateEthereumTxAndSignMessage(message)
 
 	mockDB.AssertExpectations(t)
@@ -1930,6 +1980,7 @@ func TestSignMessages(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -1964,6 +2015,7 @@ func TestSignMessages(t *testing.T) {
 		},
 	}
 
+	ethClient.EXPECT().GetTransactionReceipts([]string{"hash1"}).Return(map[ethcommon.Hash]*types.Receipt{}, nil)
 	ethClient.EXPECT().GetTransactionReceipt("hash1").Return(&types.Receipt{
 		BlockNumber: big.NewInt(90),
 		Status:      types.ReceiptStatusSuccessful,
@@ -1971,6 +2023,7 @@ func TestSignMessages(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -2022,7 +2075,7 @@ func TestSignMessages(t *testing.T) {
 
 	txConfig.EXPECT().TxJSONEncoder().Return(encoder)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
@@ -2031,6 +2084,8 @@ func TestSignMessages(t *testing.T) {
 
 	mockDB.EXPECT().GetPendingMessages(mock.Anything, mock.Anything).Return([]models.Message{*message}, nil)
 
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+
 	result := signer.SignMessages()
 
 	mockDB.AssertExpectations(t)
@@ -2072,7 +2127,7 @@ func TestSignerUpdateRefund(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, update).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, update).Return(nil)
 
 	result := signer.UpdateRefund(refund, update)
 
@@ -2092,7 +2147,7 @@ func TestSignerUpdateRefund_Error(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, update).Return(assert.AnError)
 
 	result := signer.UpdateRefund(refund, update)
 
@@ -2836,7 +2891,7 @@ func TestBroadcastMessage(t *testing.T) {
 		"transaction_body": "encoded tx",
 	}
 
-	mockDB.EXPECT().UpdateMessage(message.ID, expectedUpdate).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, expectedUpdate).Return(nil)
 
 	result := signer.BroadcastMessage(message)
 
@@ -2919,7 +2974,7 @@ func TestBroadcastMessage_Invalid(t *testing.T) {
 		"signatures":       []models.Signature{},
 	}
 
-	mockDB.EXPECT().UpdateMessage(message.ID, expectedUpdate).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, expectedUpdate).Return(nil)
 
 	result := signer.BroadcastMessage(message)
 
@@ -3235,6 +3290,7 @@ func TestValidateEthereumTxAndBroadcastMessage_Pending(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -3276,6 +3332,7 @@ func TestValidateEthereumTxAndBroadcastMessage_Pending(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(91), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -3340,7 +3397,7 @@ func TestValidateEthereumTxAndBroadcastMessage_FailedTx(t *testing.T) {
 		Logs:        []*types.Log{{Address: mailbox.Address()}},
 	}, nil)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, bson.M{"status": models.MessageStatusInvalid}).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, db.StatusUpdate(models.MessageStatusInvalid, models.StatusCodeTxFailed, "origin tx failed")).Return(nil)
 
 	result := signer.ValidateEthereumTxAndBroadcastMessage(message)
 
@@ -3364,6 +3421,7 @@ func TestValidateEthereumTxAndBroadcastMessage_LockError(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -3405,6 +3463,7 @@ func TestValidateEthereumTxAndBroadcastMessage_LockError(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -3432,6 +3491,7 @@ func TestValidateEthereumTxAndBroadcastMessage(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -3473,6 +3533,7 @@ func TestValidateEthereumTxAndBroadcastMessage(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -3483,7 +3544,7 @@ func TestValidateEthereumTxAndBroadcastMessage(t *testing.T) {
 	txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 	txBuilder.EXPECT().GetTx().Return(tx)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
@@ -3527,7 +3588,7 @@ func TestValidateEthereumTxAndBroadcastMessage(t *testing.T) {
 		"transaction_body": "encoded tx",
 	}
 
-	mockDB.EXPECT().UpdateMessage(message.ID, expectedUpdate).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, expectedUpdate).Return(nil)
 
 	result := signer.ValidateEthereumTxAndBroadcastMessage(message)
 
@@ -3551,6 +3612,7 @@ func TestBroadcastMessages(t *testing.T) {
 
 	mailbox.EXPECT().Address().Return(ethcommon.BytesToAddress([]byte("mailbox")))
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	ethClientMap := map[uint32]eth.EthereumClient{1: ethClient}
 	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
@@ -3592,6 +3654,7 @@ func TestBroadcastMessages(t *testing.T) {
 	}, nil)
 	ethClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	ethClient.EXPECT().Confirmations().Return(uint64(10))
+	ethClient.EXPECT().FinalityTag().Return("")
 
 	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: [32]byte{}}, nil)
 
@@ -3602,7 +3665,7 @@ func TestBroadcastMessages(t *testing.T) {
 	txBuilder.EXPECT().SetSignatures(mock.Anything).Return(nil)
 	txBuilder.EXPECT().GetTx().Return(tx)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	mockClient.EXPECT().GetAccount(multisigAddr).Return(&authtypes.BaseAccount{AccountNumber: 1, Sequence: 1}, nil)
 
@@ -3646,7 +3709,7 @@ func TestBroadcastMessages(t *testing.T) {
 		"transaction_body": "encoded tx",
 	}
 
-	mockDB.EXPECT().UpdateMessage(message.ID, expectedUpdate).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, expectedUpdate).Return(nil)
 
 	mockDB.EXPECT().GetSignedMessages(mock.Anything).Return([]models.Message{*message}, nil)
 
@@ -3790,7 +3853,7 @@ func TestBroadcastRefund(t *testing.T) {
 		"transaction_body": "encoded tx",
 	}
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, expectedUpdate).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, expectedUpdate).Return(nil)
 
 	result := signer.BroadcastRefund(refund)
 
@@ -3874,7 +3937,7 @@ func TestBroadcastRefund_Invalid(t *testing.T) {
 		"signatures":       []models.Signature{},
 	}
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, expectedUpdate).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, expectedUpdate).Return(nil)
 
 	result := signer.BroadcastRefund(refund)
 
@@ -4164,11 +4227,11 @@ func TestValidateRefund_EmptyBody(t *testing.T) {
 		},
 	}
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.True(t, result)
+	assert.NoError(t, err)
 }
 
 func TestValidateRefund_InvalidAddress(t *testing.T) {
@@ -4206,11 +4269,11 @@ func TestValidateRefund_InvalidAddress(t *testing.T) {
 		},
 	}
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_AddressError(t *testing.T) {
@@ -4250,11 +4313,11 @@ func TestValidateRefund_AddressError(t *testing.T) {
 
 	spenderAddr := ethcommon.BytesToAddress([]byte("spender"))
 
-	result := signer.ValidateRefund(refund, spenderAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, spenderAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_InvalidAmount(t *testing.T) {
@@ -4292,11 +4355,11 @@ func TestValidateRefund_InvalidAmount(t *testing.T) {
 		},
 	}
 
-	result := signer.ValidateRefund(refund, recipientAddr[:], amount)
+	err := signer.ValidateRefund(refund, recipientAddr[:], amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_AmountError(t *testing.T) {
@@ -4334,11 +4397,11 @@ func TestValidateRefund_AmountError(t *testing.T) {
 		},
 	}
 
-	result := signer.ValidateRefund(refund, recipientAddr[:], amount)
+	err := signer.ValidateRefund(refund, recipientAddr[:], amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody(t *testing.T) {
@@ -4387,11 +4450,11 @@ func TestValidateRefund_WithBody(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.True(t, result)
+	assert.NoError(t, err)
 }
 
 func TestValidateRefund_WithBody_ParseError(t *testing.T) {
@@ -4433,11 +4496,11 @@ func TestValidateRefund_WithBody_ParseError(t *testing.T) {
 	utilParseTxBody = func(string, string) (sdk.Tx, error) {
 		return nil, assert.AnError
 	}
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_InvalidMsg(t *testing.T) {
@@ -4493,11 +4556,11 @@ func TestValidateRefund_WithBody_InvalidMsg(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_InvalidAmount(t *testing.T) {
@@ -4548,11 +4611,11 @@ func TestValidateRefund_WithBody_InvalidAmount(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_DifferentAmount(t *testing.T) {
@@ -4601,11 +4664,11 @@ func TestValidateRefund_WithBody_DifferentAmount(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_FromAddressError(t *testing.T) {
@@ -4654,11 +4717,11 @@ func TestValidateRefund_WithBody_FromAddressError(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_FromAddressDifferentError(t *testing.T) {
@@ -4707,11 +4770,11 @@ func TestValidateRefund_WithBody_FromAddressDifferentError(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_ToAddressError(t *testing.T) {
@@ -4759,11 +4822,11 @@ func TestValidateRefund_WithBody_ToAddressError(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateRefund_WithBody_ToAddressDifferentError(t *testing.T) {
@@ -4811,11 +4874,11 @@ func TestValidateRefund_WithBody_ToAddressDifferentError(t *testing.T) {
 
 	mockTx.EXPECT().GetMsgs().Return([]sdk.Msg{validMsg})
 
-	result := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
+	err := signer.ValidateRefund(refund, recipientAddr.Bytes(), amount)
 
 	mockClient.AssertExpectations(t)
 	mockDB.AssertExpectations(t)
-	assert.False(t, result)
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateCosmosTx_ClientError(t *testing.T) {
@@ -4902,7 +4965,7 @@ func TestValidateCosmosTx_ValidateError(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, db.StatusUpdate(models.RefundStatusInvalid, models.StatusCodeInvalidTx, "error validating tx: "+assert.AnError.Error())).Return(nil)
 
 	result := signer.ValidateCosmosTx(refund)
 
@@ -5009,7 +5072,7 @@ func TestValidateCosmosTx_NoRefund(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, db.StatusUpdate(models.RefundStatusInvalid, models.StatusCodeRefundNotNeeded, "tx does not need refund")).Return(nil)
 
 	result := signer.ValidateCosmosTx(refund)
 
@@ -5059,12 +5122,14 @@ func TestValidateCosmosTx_Failed(t *testing.T) {
 			Confirmations: 0,
 			NeedsRefund:   true,
 			TxStatus:      models.TransactionStatusFailed,
+			StatusCode:    models.StatusCodeTxFailed,
+			StatusReason:  "tx failed with code 1",
 		}
 		return result, nil
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, db.StatusUpdate(models.RefundStatusInvalid, models.StatusCodeTxFailed, "tx is invalid: tx failed with code 1")).Return(nil)
 
 	result := signer.ValidateCosmosTx(refund)
 
@@ -5177,7 +5242,7 @@ func TestValidateCosmosTx_Invalid(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, db.StatusUpdate(models.RefundStatusInvalid, models.StatusCodeRecipientMismatch, "spender address does not match recipient address")).Return(nil)
 
 	result := signer.ValidateCosmosTx(refund)
 
@@ -5234,7 +5299,7 @@ func TestValidateCosmosTxAndBroadcastRefund_Invalid(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	result := signer.ValidateCosmosTxAndBroadcastRefund(refund)
 
@@ -5390,7 +5455,7 @@ func TestValidateCosmosTxAndBroadcastRefund(t *testing.T) {
 
 	mockClient.EXPECT().BroadcastTx([]byte("encoded tx as bytes")).Return("0x0102", nil)
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	result := signer.ValidateCosmosTxAndBroadcastRefund(refund)
 
@@ -5488,7 +5553,7 @@ func TestBroadcastRefunds(t *testing.T) {
 
 	mockClient.EXPECT().BroadcastTx([]byte("encoded tx as bytes")).Return("0x0102", nil)
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	mockDB.EXPECT().GetSignedRefunds().Return([]models.Refund{refund}, nil)
 
@@ -5611,7 +5676,7 @@ func TestValidateCosmosTxAndSignRefund_Invalid(t *testing.T) {
 	}
 	defer func() { utilValidateTxToCosmosMultisig = util.ValidateTxToCosmosMultisig }()
 
-	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 	result := signer.ValidateCosmosTxAndSignRefund(refund)
 
@@ -5749,7 +5814,7 @@ func TestValidateCosmosTxAndSignRefund(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, mock.Anything).Return(nil)
 
 	result := signer.ValidateCosmosTxAndSignRefund(refund)
 
@@ -5829,7 +5894,7 @@ func TestSignRefunds(t *testing.T) {
 	}
 	defer func() { CosmosSignTx = oldCosmosSignTx }()
 
-	mockDB.EXPECT().UpdateRefund(refund.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateRefund(refund.ID, refund.Status, mock.Anything).Return(nil)
 
 	mockDB.EXPECT().GetPendingRefunds(mock.Anything).Return([]models.Refund{refund}, nil)
 
@@ -5944,7 +6009,7 @@ func TestNewMessageSigner(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6063,7 +6128,7 @@ func TestNewMessageSigner_Disabled(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6169,7 +6234,7 @@ func TestNewMessageSigner_InvalidPublicKey(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6275,7 +6340,7 @@ func TestNewMessageSigner_InvalidMultisigAddress(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6381,7 +6446,7 @@ func TestNewMessageSigner_ClientError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6487,7 +6552,7 @@ func TestNewMessageSigner_MnemonicError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6593,7 +6658,7 @@ func TestNewMessageSigner_EthClientError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -6699,7 +6764,7 @@ func TestNewMessageSigner_EthMailboxError(t *testing.T) {
 
 	originalNewDB := dbNewDB
 	defer func() { dbNewDB = originalNewDB }()
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
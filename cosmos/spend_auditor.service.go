@@ -0,0 +1,309 @@
+package cosmos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
+	"github.com/dan13ram/wpokt-oracle/cosmos/util"
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+type CosmosSpendAuditorRunnable struct {
+	multisigAddressBytes []byte
+
+	config models.CosmosNetworkConfig
+	chain  models.Chain
+	client cosmos.CosmosClient
+
+	logger *log.Entry
+
+	startBlockHeight   uint64
+	currentBlockHeight uint64
+
+	db db.DB
+}
+
+func (x *CosmosSpendAuditorRunnable) Run() {
+	x.UpdateCurrentHeight()
+	x.AuditNewSpends()
+}
+
+func (x *CosmosSpendAuditorRunnable) Height() uint64 {
+	return uint64(x.currentBlockHeight)
+}
+
+func (x *CosmosSpendAuditorRunnable) UpdateCurrentHeight() {
+	height, err := x.client.GetLatestBlockHeight()
+	if err != nil {
+		x.logger.WithError(err).Error("could not get current block height")
+		return
+	}
+	x.currentBlockHeight = uint64(height)
+	x.logger.WithField("current_block_height", x.currentBlockHeight).Info("updated current block height")
+}
+
+// IsKnownSpend reports whether the multisig signed a message to this network or a refund with the sequence,
+// and if so whether the tx paid its recipient and amount. The reason explains a spend that is not known
+func (x *CosmosSpendAuditorRunnable) IsKnownSpend(txResponse *sdk.TxResponse, sequence uint64) (known bool, reason string, err error) {
+	messageDoc, err := x.db.FindMessage(bson.M{"content.destination_domain": x.chain.ChainDomain, "sequence": sequence})
+	if err == nil {
+		known, reason := x.MatchSpend(txResponse, messageDoc.Content.MessageBody.RecipientAddress, messageDoc.Content.MessageBody.Amount)
+		return known, reason, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return false, "", err
+	}
+
+	refundDoc, err := x.db.FindRefund(bson.M{"sequence": sequence})
+	if err == nil {
+		known, reason := x.MatchSpend(txResponse, refundDoc.Recipient, refundDoc.Amount)
+		return known, reason, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return false, "", err
+	}
+
+	return false, "no message or refund was signed with the sequence", nil
+}
+
+// MatchSpend checks that the tx sent the signed amount, less the tx fee, to the recipient
+// and that the multisig spent no more than the signed amount including the fee.
+// It returns why the tx does not match otherwise
+func (x *CosmosSpendAuditorRunnable) MatchSpend(txResponse *sdk.TxResponse, recipientHex string, amount string) (bool, string) {
+	recipientBytes, err := common.BytesFromAddressHex(recipientHex)
+	if err != nil {
+		return false, fmt.Sprintf("error parsing signed recipient: %s", err)
+	}
+	recipient, err := common.Bech32FromBytes(x.config.Bech32Prefix, recipientBytes)
+	if err != nil {
+		return false, fmt.Sprintf("error converting signed recipient: %s", err)
+	}
+	signedAmount, ok := math.NewIntFromString(amount)
+	fee := math.NewIntFromUint64(x.config.TxFee)
+	if !ok || signedAmount.LT(fee) {
+		return false, fmt.Sprintf("invalid signed amount: %s", amount)
+	}
+	signed := sdk.NewCoin(x.config.CoinDenom, signedAmount)
+	expected := sdk.NewCoin(x.config.CoinDenom, signedAmount.Sub(fee))
+
+	received, err := util.ParseCoinsReceivedEvents(x.config.CoinDenom, recipient, txResponse.Events)
+	if err != nil {
+		return false, fmt.Sprintf("error parsing coins received by the recipient: %s", err)
+	}
+	if !received.IsEqual(expected) {
+		return false, fmt.Sprintf("recipient %s received %s instead of %s", recipient, received, expected)
+	}
+
+	spent, err := util.ParseCoinsSpentByEvents(x.config.MultisigAddress, txResponse.Events)
+	if err != nil {
+		return false, fmt.Sprintf("error parsing coins spent by the multisig: %s", err)
+	}
+	if !spent.IsAllLTE(sdk.NewCoins(signed)) {
+		return false, fmt.Sprintf("multisig spent %s, more than the signed %s", spent, signed)
+	}
+
+	return true, ""
+}
+
+func (x *CosmosSpendAuditorRunnable) RecordUnauthorizedSpend(txResponse *sdk.TxResponse, sequence *uint64, reason string) bool {
+	logger := x.logger.WithField("tx_hash", txResponse.TxHash).WithField("section", "audit")
+
+	anomaly := models.Anomaly{
+		Chain:           x.chain,
+		Type:            models.AnomalyTypeUnauthorizedSpend,
+		Contract:        x.config.MultisigAddress,
+		TransactionHash: strings.ToLower(txResponse.TxHash),
+		BlockHeight:     uint64(txResponse.Height),
+		Sequence:        sequence,
+		CreatedAt:       time.Now(),
+	}
+
+	// the amount is only kept for the investigation, so a parsing error does not stop the anomaly
+	amount, err := util.ParseCoinsSpentByEvents(x.config.MultisigAddress, txResponse.Events)
+	if err != nil {
+		logger.WithError(err).Warn("Error parsing coins spent by the multisig")
+	} else {
+		anomaly.Amount = amount.String()
+	}
+
+	logger.
+		WithField("alert", "anomaly").
+		WithField("severity", "critical").
+		WithField("amount", anomaly.Amount).
+		WithField("reason", reason).
+		Error("Spend from the multisig that does not match a signed message or refund")
+
+	if err := x.db.InsertAnomaly(anomaly); err != nil {
+		logger.WithError(err).Error("Error inserting anomaly")
+		return false
+	}
+	return true
+}
+
+// AuditSpend matches a tx sent from the multisig to the message or refund signed with the same sequence
+func (x *CosmosSpendAuditorRunnable) AuditSpend(txResponse *sdk.TxResponse) bool {
+	logger := x.logger.WithField("tx_hash", txResponse.TxHash).WithField("section", "audit")
+
+	if txResponse.Code != 0 {
+		// a failed tx only spends the fee
+		logger.Debugf("Skipping failed tx")
+		return true
+	}
+
+	sequence, err := utilSignerSequence(x.config.Bech32Prefix, txResponse, x.multisigAddressBytes)
+	if err != nil {
+		// funds left the multisig without its signature, through authz or a similar module
+		return x.RecordUnauthorizedSpend(txResponse, nil, err.Error())
+	}
+
+	known, reason, err := x.IsKnownSpend(txResponse, sequence)
+	if err != nil {
+		logger.WithError(err).Error("Error matching spend to a message or refund by sequence")
+		return false
+	}
+	if known {
+		logger.WithField("sequence", sequence).Debugf("Found spend of a signed message or refund")
+		return true
+	}
+
+	return x.RecordUnauthorizedSpend(txResponse, &sequence, reason)
+}
+
+// AuditSpends audits the txs sent from the multisig within the block range, inclusive
+func (x *CosmosSpendAuditorRunnable) AuditSpends(startBlockHeight uint64, endBlockHeight uint64) bool {
+	txResponses, err := x.client.GetTxsSentFromAddressAfterHeight(x.config.MultisigAddress, startBlockHeight)
+	if err != nil {
+		x.logger.WithError(err).Errorf("Error getting txs sent from the multisig")
+		return false
+	}
+	x.logger.Infof("Found %d txs sent from the multisig", len(txResponses))
+
+	success := true
+	for _, txResponse := range txResponses {
+		if uint64(txResponse.Height) > endBlockHeight {
+			// picked up by the next audit
+			continue
+		}
+		success = x.AuditSpend(txResponse) && success
+	}
+
+	return success
+}
+
+func (x *CosmosSpendAuditorRunnable) AuditNewSpends() bool {
+	x.logger.Infof("Auditing spends from the multisig")
+	if x.currentBlockHeight <= x.startBlockHeight {
+		x.logger.Infof("No new blocks to audit")
+		return true
+	}
+
+	if !x.AuditSpends(x.startBlockHeight, x.currentBlockHeight) {
+		return false
+	}
+
+	return x.UpdateCheckpoint(x.currentBlockHeight)
+}
+
+func (x *CosmosSpendAuditorRunnable) UpdateCheckpoint(blockHeight uint64) bool {
+	err := x.db.UpsertCheckpoint(x.chain, models.CheckpointRunnerSpendAuditor, blockHeight)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating checkpoint")
+		return false
+	}
+	x.startBlockHeight = blockHeight
+	return true
+}
+
+func (x *CosmosSpendAuditorRunnable) InitStartBlockHeight() {
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerSpendAuditor)
+	if err != nil {
+		x.logger.WithError(err).Fatalf("Error finding checkpoint")
+		return
+	}
+	if checkpoint == nil {
+		x.logger.Debugf("No checkpoint found")
+	} else {
+		x.logger.Debugf("Checkpoint block height: %d", checkpoint.BlockHeight)
+		x.startBlockHeight = checkpoint.BlockHeight
+	}
+	if x.startBlockHeight == 0 {
+		x.logger.Debugf("Start block height is zero")
+		x.startBlockHeight = x.currentBlockHeight
+	} else if x.startBlockHeight > x.currentBlockHeight {
+		x.logger.Debugf("Start block height is greater than current block height")
+		x.startBlockHeight = x.currentBlockHeight
+	}
+	x.logger.Infof("Initialized start block height: %d", x.startBlockHeight)
+}
+
+func NewSpendAuditor(config models.CosmosNetworkConfig) service.Runnable {
+	logger := log.
+		WithField("module", "cosmos").
+		WithField("service", "spend_auditor").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", strings.ToLower(config.ChainID))
+
+	if !config.SpendAuditor.Enabled {
+		logger.Fatalf("Spend auditor is not enabled")
+	}
+
+	logger.Debugf("Initializing")
+
+	var pks []crypto.PubKey
+	for _, pk := range config.MultisigPublicKeys {
+		pKey, err := common.CosmosPublicKeyFromHex(pk)
+		if err != nil {
+			logger.WithError(err).Fatalf("Error parsing public key")
+		}
+		pks = append(pks, pKey)
+	}
+
+	multisigPk := multisig.NewLegacyAminoPubKey(int(config.MultisigThreshold), pks)
+	multisigAddress, _ := common.Bech32FromBytes(config.Bech32Prefix, multisigPk.Address().Bytes())
+
+	if !strings.EqualFold(multisigAddress, config.MultisigAddress) {
+		logger.Fatalf("Multisig address does not match config")
+	}
+
+	client, err := cosmosNewClient(config)
+	if err != nil {
+		logger.WithError(err).Fatalf("Error creating cosmos client")
+	}
+
+	x := &CosmosSpendAuditorRunnable{
+		multisigAddressBytes: multisigPk.Address().Bytes(),
+
+		startBlockHeight:   config.StartBlockHeight,
+		currentBlockHeight: 0,
+		client:             client,
+
+		chain:  utilParseChain(config),
+		config: config,
+
+		logger: logger,
+
+		db: dbNewDB("spend_auditor"),
+	}
+
+	x.UpdateCurrentHeight()
+
+	x.InitStartBlockHeight()
+
+	logger.Infof("Initialized")
+
+	return x
+}
@@ -0,0 +1,348 @@
+package cosmos
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
+	clientMocks "github.com/dan13ram/wpokt-oracle/cosmos/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/cosmos/util"
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func newTestSpendAuditor(t *testing.T) (*CosmosSpendAuditorRunnable, *clientMocks.MockCosmosClient, *mocks.MockDB) {
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	mockDB := mocks.NewMockDB(t)
+
+	auditor := &CosmosSpendAuditorRunnable{
+		multisigAddressBytes: []byte{1, 2, 3},
+		config: models.CosmosNetworkConfig{
+			Bech32Prefix:    "pokt",
+			MultisigAddress: "pokt1multisig",
+			CoinDenom:       "upokt",
+			TxFee:           10,
+		},
+		chain:  models.Chain{ChainID: "poktroll", ChainDomain: 1},
+		client: mockClient,
+		logger: log.New().WithField("test", "spend_auditor"),
+		db:     mockDB,
+	}
+
+	return auditor, mockClient, mockDB
+}
+
+func spendTxResponse(txHash string, height int64) *sdk.TxResponse {
+	return &sdk.TxResponse{
+		TxHash: txHash,
+		Height: height,
+		Events: []abci.Event{
+			{Type: "coin_spent", Attributes: []abci.EventAttribute{
+				{Key: "spender", Value: "pokt1multisig"},
+				{Key: "amount", Value: "100upokt"},
+			}},
+		},
+	}
+}
+
+const spendRecipientHex = "0x0000000000000000000000000000000000000001"
+
+// paidTxResponse is a tx from the multisig that paid the recipient amount less the tx fee of 10
+func paidTxResponse(t *testing.T, txHash string, height int64, amount string, received string) *sdk.TxResponse {
+	recipientBytes, err := common.BytesFromAddressHex(spendRecipientHex)
+	assert.NoError(t, err)
+	recipient, err := common.Bech32FromBytes("pokt", recipientBytes)
+	assert.NoError(t, err)
+
+	txResponse := spendTxResponse(txHash, height)
+	txResponse.Events = []abci.Event{
+		{Type: "coin_spent", Attributes: []abci.EventAttribute{
+			{Key: "spender", Value: "pokt1multisig"},
+			{Key: "amount", Value: amount},
+		}},
+		{Type: "coin_received", Attributes: []abci.EventAttribute{
+			{Key: "receiver", Value: recipient},
+			{Key: "amount", Value: received},
+		}},
+	}
+	return txResponse
+}
+
+func signedSpendMessage(amount string) models.Message {
+	return models.Message{Content: models.MessageContent{MessageBody: models.MessageBody{
+		RecipientAddress: spendRecipientHex,
+		Amount:           amount,
+	}}}
+}
+
+func mockSignerSequence(t *testing.T, sequences map[string]uint64) {
+	original := utilSignerSequence
+	t.Cleanup(func() { utilSignerSequence = original })
+	utilSignerSequence = func(bech32Prefix string, txResponse *sdk.TxResponse, address []byte) (uint64, error) {
+		assert.Equal(t, "pokt", bech32Prefix)
+		assert.Equal(t, []byte{1, 2, 3}, address)
+		sequence, ok := sequences[txResponse.TxHash]
+		if !ok {
+			return 0, errors.New("address is not a signer of the tx")
+		}
+		return sequence, nil
+	}
+}
+
+func TestSpendAuditorHeight(t *testing.T) {
+	auditor := &CosmosSpendAuditorRunnable{currentBlockHeight: 100}
+
+	assert.Equal(t, uint64(100), auditor.Height())
+}
+
+func TestSpendAuditorUpdateCurrentHeight(t *testing.T) {
+	auditor, mockClient, _ := newTestSpendAuditor(t)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil).Once()
+	auditor.UpdateCurrentHeight()
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(0), errors.New("error")).Once()
+	auditor.UpdateCurrentHeight()
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+}
+
+func TestSpendAuditorIsKnownSpend(t *testing.T) {
+	auditor, _, mockDB := newTestSpendAuditor(t)
+
+	messageFilter := bson.M{"content.destination_domain": uint32(1), "sequence": uint64(5)}
+	refundFilter := bson.M{"sequence": uint64(5)}
+	txResponse := paidTxResponse(t, "HASH", 10, "100upokt", "90upokt")
+
+	mockDB.EXPECT().FindMessage(messageFilter).Return(signedSpendMessage("100"), nil).Once()
+	known, reason, err := auditor.IsKnownSpend(txResponse, 5)
+	assert.NoError(t, err)
+	assert.True(t, known)
+	assert.Empty(t, reason)
+
+	mockDB.EXPECT().FindMessage(messageFilter).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindRefund(refundFilter).Return(models.Refund{Recipient: spendRecipientHex, Amount: "100"}, nil).Once()
+	known, _, err = auditor.IsKnownSpend(txResponse, 5)
+	assert.NoError(t, err)
+	assert.True(t, known)
+
+	// a spend with the sequence of a refund that sent a different amount
+	mockDB.EXPECT().FindMessage(messageFilter).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindRefund(refundFilter).Return(models.Refund{Recipient: spendRecipientHex, Amount: "50"}, nil).Once()
+	known, reason, err = auditor.IsKnownSpend(txResponse, 5)
+	assert.NoError(t, err)
+	assert.False(t, known)
+	assert.Contains(t, reason, "received 90upokt instead of 40upokt")
+
+	mockDB.EXPECT().FindMessage(messageFilter).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindRefund(refundFilter).Return(models.Refund{}, mongo.ErrNoDocuments).Once()
+	known, reason, err = auditor.IsKnownSpend(txResponse, 5)
+	assert.NoError(t, err)
+	assert.False(t, known)
+	assert.Equal(t, "no message or refund was signed with the sequence", reason)
+
+	mockDB.EXPECT().FindMessage(messageFilter).Return(models.Message{}, errors.New("error")).Once()
+	_, _, err = auditor.IsKnownSpend(txResponse, 5)
+	assert.Error(t, err)
+
+	mockDB.EXPECT().FindMessage(messageFilter).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindRefund(refundFilter).Return(models.Refund{}, errors.New("error")).Once()
+	_, _, err = auditor.IsKnownSpend(txResponse, 5)
+	assert.Error(t, err)
+}
+
+func TestSpendAuditorMatchSpend(t *testing.T) {
+	auditor, _, _ := newTestSpendAuditor(t)
+
+	matched, reason := auditor.MatchSpend(paidTxResponse(t, "HASH", 10, "100upokt", "90upokt"), spendRecipientHex, "100")
+	assert.True(t, matched)
+	assert.Empty(t, reason)
+
+	// the signed amount went to another address
+	matched, reason = auditor.MatchSpend(paidTxResponse(t, "HASH", 10, "100upokt", "90upokt"), "0x0000000000000000000000000000000000000002", "100")
+	assert.False(t, matched)
+	assert.Contains(t, reason, "received 0upokt instead of 90upokt")
+
+	// the multisig spent more than was signed
+	matched, reason = auditor.MatchSpend(paidTxResponse(t, "HASH", 10, "1000upokt", "90upokt"), spendRecipientHex, "100")
+	assert.False(t, matched)
+	assert.Contains(t, reason, "multisig spent 1000upokt")
+
+	matched, reason = auditor.MatchSpend(paidTxResponse(t, "HASH", 10, "100upokt", "90upokt"), "invalid", "100")
+	assert.False(t, matched)
+	assert.Contains(t, reason, "error parsing signed recipient")
+
+	matched, reason = auditor.MatchSpend(paidTxResponse(t, "HASH", 10, "100upokt", "90upokt"), spendRecipientHex, "5")
+	assert.False(t, matched)
+	assert.Equal(t, "invalid signed amount: 5", reason)
+
+	matched, reason = auditor.MatchSpend(paidTxResponse(t, "HASH", 10, "100upokt", "90uatom"), spendRecipientHex, "100")
+	assert.False(t, matched)
+	assert.Contains(t, reason, "error parsing coins received by the recipient")
+}
+
+func TestSpendAuditorAuditSpend(t *testing.T) {
+	auditor, _, mockDB := newTestSpendAuditor(t)
+	mockSignerSequence(t, map[string]uint64{"KNOWN": 5, "UNKNOWN": 6})
+
+	// failed txs are skipped
+	failed := spendTxResponse("FAILED", 10)
+	failed.Code = 1
+	assert.True(t, auditor.AuditSpend(failed))
+
+	mockDB.EXPECT().FindMessage(bson.M{"content.destination_domain": uint32(1), "sequence": uint64(5)}).Return(signedSpendMessage("100"), nil).Once()
+	assert.True(t, auditor.AuditSpend(paidTxResponse(t, "KNOWN", 10, "100upokt", "90upokt")))
+
+	var anomalies []models.Anomaly
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).Run(func(anomaly models.Anomaly) {
+		anomalies = append(anomalies, anomaly)
+	}).Return(nil).Twice()
+
+	mockDB.EXPECT().FindMessage(bson.M{"content.destination_domain": uint32(1), "sequence": uint64(6)}).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindRefund(bson.M{"sequence": uint64(6)}).Return(models.Refund{}, mongo.ErrNoDocuments).Once()
+	assert.True(t, auditor.AuditSpend(spendTxResponse("UNKNOWN", 11)))
+
+	// the multisig did not sign the tx at all
+	assert.True(t, auditor.AuditSpend(spendTxResponse("NOT_SIGNED", 12)))
+
+	assert.Len(t, anomalies, 2)
+	assert.Equal(t, models.AnomalyTypeUnauthorizedSpend, anomalies[0].Type)
+	assert.Equal(t, "unknown", anomalies[0].TransactionHash)
+	assert.Equal(t, uint64(11), anomalies[0].BlockHeight)
+	assert.Equal(t, "pokt1multisig", anomalies[0].Contract)
+	assert.Equal(t, "100upokt", anomalies[0].Amount)
+	assert.Equal(t, uint64(6), *anomalies[0].Sequence)
+	assert.Equal(t, "not_signed", anomalies[1].TransactionHash)
+	assert.Nil(t, anomalies[1].Sequence)
+}
+
+func TestSpendAuditorAuditSpend_Errors(t *testing.T) {
+	auditor, _, mockDB := newTestSpendAuditor(t)
+	mockSignerSequence(t, map[string]uint64{"HASH": 5})
+
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{}, errors.New("error")).Once()
+	assert.False(t, auditor.AuditSpend(spendTxResponse("HASH", 10)))
+
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().FindRefund(mock.Anything).Return(models.Refund{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).Return(errors.New("error")).Once()
+	assert.False(t, auditor.AuditSpend(spendTxResponse("HASH", 10)))
+}
+
+func TestSpendAuditorAuditSpends(t *testing.T) {
+	auditor, mockClient, mockDB := newTestSpendAuditor(t)
+	mockSignerSequence(t, map[string]uint64{"HASH1": 5, "HASH2": 6})
+
+	mockClient.EXPECT().GetTxsSentFromAddressAfterHeight("pokt1multisig", uint64(10)).Return([]*sdk.TxResponse{
+		paidTxResponse(t, "HASH1", 10, "100upokt", "90upokt"),
+		spendTxResponse("HASH2", 25),
+	}, nil).Once()
+
+	// the second tx is past the end of the range
+	mockDB.EXPECT().FindMessage(bson.M{"content.destination_domain": uint32(1), "sequence": uint64(5)}).Return(signedSpendMessage("100"), nil).Once()
+
+	assert.True(t, auditor.AuditSpends(10, 20))
+
+	mockClient.EXPECT().GetTxsSentFromAddressAfterHeight("pokt1multisig", uint64(10)).Return(nil, errors.New("error")).Once()
+	assert.False(t, auditor.AuditSpends(10, 20))
+}
+
+func TestSpendAuditorAuditNewSpends(t *testing.T) {
+	auditor, mockClient, mockDB := newTestSpendAuditor(t)
+	auditor.startBlockHeight = 10
+	auditor.currentBlockHeight = 10
+
+	assert.True(t, auditor.AuditNewSpends())
+
+	auditor.currentBlockHeight = 20
+	mockClient.EXPECT().GetTxsSentFromAddressAfterHeight("pokt1multisig", uint64(10)).Return([]*sdk.TxResponse{}, nil).Once()
+	mockDB.EXPECT().UpsertCheckpoint(auditor.chain, models.CheckpointRunnerSpendAuditor, uint64(20)).Return(nil).Once()
+
+	assert.True(t, auditor.AuditNewSpends())
+	assert.Equal(t, uint64(20), auditor.startBlockHeight)
+
+	auditor.currentBlockHeight = 30
+	mockClient.EXPECT().GetTxsSentFromAddressAfterHeight("pokt1multisig", uint64(20)).Return([]*sdk.TxResponse{}, nil).Once()
+	mockDB.EXPECT().UpsertCheckpoint(auditor.chain, models.CheckpointRunnerSpendAuditor, uint64(30)).Return(errors.New("error")).Once()
+
+	assert.False(t, auditor.AuditNewSpends())
+	assert.Equal(t, uint64(20), auditor.startBlockHeight)
+}
+
+func TestSpendAuditorInitStartBlockHeight(t *testing.T) {
+	auditor, _, mockDB := newTestSpendAuditor(t)
+	auditor.currentBlockHeight = 100
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerSpendAuditor).Return(&models.Checkpoint{BlockHeight: 50}, nil).Once()
+	auditor.InitStartBlockHeight()
+	assert.Equal(t, uint64(50), auditor.startBlockHeight)
+
+	auditor.startBlockHeight = 0
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerSpendAuditor).Return(nil, nil).Once()
+	auditor.InitStartBlockHeight()
+	assert.Equal(t, uint64(100), auditor.startBlockHeight)
+}
+
+func TestNewSpendAuditor(t *testing.T) {
+	config := models.CosmosNetworkConfig{
+		StartBlockHeight:   1,
+		ChainID:            "poktroll",
+		ChainName:          "Poktroll",
+		Bech32Prefix:       "pokt",
+		CoinDenom:          "upokt",
+		MultisigAddress:    "pokt13tsl3aglfyzf02n7x28x2ajzw94muu6y57k2ar",
+		MultisigPublicKeys: []string{"026892de2ec7fdf3125bc1bfd2ff2590d2c9ba756f98a05e9e843ac4d2a1acd4d9", "02faaaf0f385bb17381f36dcd86ab2486e8ff8d93440436496665ac007953076c2", "02cae233806460db75a941a269490ca5165a620b43241edb8bc72e169f4143a6df"},
+		MultisigThreshold:  2,
+		SpendAuditor: models.ServiceConfig{
+			Enabled:    true,
+			IntervalMS: 1000,
+		},
+	}
+
+	mockClient := clientMocks.NewMockCosmosClient(t)
+	mockDB := mocks.NewMockDB(t)
+
+	mockClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil).Once()
+	mockDB.EXPECT().FindCheckpoint(util.ParseChain(config), models.CheckpointRunnerSpendAuditor).Return(nil, nil).Once()
+
+	originalNewDB := dbNewDB
+	defer func() { dbNewDB = originalNewDB }()
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+
+	originalCosmosNewClient := cosmosNewClient
+	defer func() { cosmosNewClient = originalCosmosNewClient }()
+	cosmosNewClient = func(config models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
+		return mockClient, nil
+	}
+
+	runnable := NewSpendAuditor(config)
+
+	auditor, ok := runnable.(*CosmosSpendAuditorRunnable)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), auditor.startBlockHeight)
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+	assert.Equal(t, util.ParseChain(config), auditor.chain)
+	assert.Len(t, auditor.multisigAddressBytes, 20)
+}
+
+func TestNewSpendAuditor_Disabled(t *testing.T) {
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+	assert.Panics(t, func() {
+		NewSpendAuditor(models.CosmosNetworkConfig{ChainName: "Poktroll"})
+	})
+}
@@ -87,3 +87,29 @@ func ParseCoinsSpentEvents(
 	}
 	return spender, total, nil
 }
+
+// ParseCoinsSpentByEvents sums the coins of every denom spent by the given spender
+func ParseCoinsSpentByEvents(
+	spender string,
+	events []abci.Event,
+) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	for _, event := range events {
+		if strings.EqualFold(event.Type, "coin_spent") {
+			for _, attr := range event.Attributes {
+				if strings.EqualFold(string(attr.Key), "spender") && strings.EqualFold(string(attr.Value), spender) {
+					for _, attr := range event.Attributes {
+						if strings.EqualFold(string(attr.Key), "amount") {
+							amount, err := sdk.ParseCoinsNormalized(string(attr.Value))
+							if err != nil {
+								return total, fmt.Errorf("unable to parse coin amount: %v", err)
+							}
+							total = total.Add(amount...)
+						}
+					}
+				}
+			}
+		}
+	}
+	return total, nil
+}
@@ -198,3 +198,60 @@ func TestParseCoinsSpentEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCoinsSpentByEvents(t *testing.T) {
+	tests := []struct {
+		name        string
+		events      []abci.Event
+		expectedAmt sdk.Coins
+		expectedErr string
+	}{
+		{
+			name: "Coins Spent",
+			events: []abci.Event{
+				{Type: "coin_spent", Attributes: []abci.EventAttribute{
+					{Key: ("spender"), Value: ("pokt1abcd")},
+					{Key: ("amount"), Value: ("100upokt")},
+				}},
+				{Type: "coin_spent", Attributes: []abci.EventAttribute{
+					{Key: ("spender"), Value: ("pokt1abcd")},
+					{Key: ("amount"), Value: ("20upokt,5other")},
+				}},
+			},
+			expectedAmt: sdk.NewCoins(sdk.NewCoin("upokt", math.NewInt(120)), sdk.NewCoin("other", math.NewInt(5))),
+		},
+		{
+			name: "Other Spenders",
+			events: []abci.Event{
+				{Type: "coin_spent", Attributes: []abci.EventAttribute{
+					{Key: ("spender"), Value: ("pokt1efgh")},
+					{Key: ("amount"), Value: ("100upokt")},
+				}},
+			},
+			expectedAmt: sdk.NewCoins(),
+		},
+		{
+			name: "Invalid Amount",
+			events: []abci.Event{
+				{Type: "coin_spent", Attributes: []abci.EventAttribute{
+					{Key: ("spender"), Value: ("pokt1abcd")},
+					{Key: ("amount"), Value: ("invalid")},
+				}},
+			},
+			expectedErr: "unable to parse coin amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := ParseCoinsSpentByEvents("pokt1abcd", tt.events)
+			if tt.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedAmt, amount)
+			}
+		})
+	}
+}
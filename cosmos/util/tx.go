@@ -1,6 +1,7 @@
 package util
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/dan13ram/wpokt-oracle/common"
@@ -8,7 +9,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 const (
@@ -94,3 +97,40 @@ func WrapTxBuilder(
 	txBuilder, err := txConfig.WrapTxBuilder(tx)
 	return txBuilder, txConfig, err
 }
+
+// SignerSequence returns the account sequence the given address signed the tx with.
+// Signers that omit their public key cannot be identified and are skipped
+func SignerSequence(
+	bech32Prefix string,
+	txResponse *sdk.TxResponse,
+	address []byte,
+) (uint64, error) {
+	if txResponse.Tx == nil {
+		return 0, fmt.Errorf("tx is missing")
+	}
+
+	decodedTx := &tx.Tx{}
+	if err := decodedTx.Unmarshal(txResponse.Tx.Value); err != nil {
+		return 0, fmt.Errorf("error unmarshalling tx: %w", err)
+	}
+	if decodedTx.AuthInfo == nil {
+		return 0, fmt.Errorf("tx has no auth info")
+	}
+
+	codec := NewProtoCodec(bech32Prefix)
+
+	for _, signerInfo := range decodedTx.AuthInfo.SignerInfos {
+		if signerInfo.PublicKey == nil {
+			continue
+		}
+		var pubKey crypto.PubKey
+		if err := codec.UnpackAny(signerInfo.PublicKey, &pubKey); err != nil {
+			return 0, fmt.Errorf("error unpacking signer public key: %w", err)
+		}
+		if bytes.Equal(pubKey.Address().Bytes(), address) {
+			return signerInfo.Sequence, nil
+		}
+	}
+
+	return 0, fmt.Errorf("address is not a signer of the tx")
+}
@@ -12,7 +12,12 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -240,3 +245,61 @@ func TestWrapTxBuilder_ErrorParsingTxBody(t *testing.T) {
 	assert.Nil(t, txBuilder)
 	assert.Nil(t, txConfig)
 }
+
+func signedTxResponse(t *testing.T, signers map[crypto.PubKey]uint64) *sdk.TxResponse {
+	var signerInfos []*tx.SignerInfo
+	for pubKey, sequence := range signers {
+		signerInfo := &tx.SignerInfo{Sequence: sequence}
+		if pubKey != nil {
+			anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+			assert.NoError(t, err)
+			signerInfo.PublicKey = anyPubKey
+		}
+		signerInfos = append(signerInfos, signerInfo)
+	}
+	txBytes, err := (&tx.Tx{AuthInfo: &tx.AuthInfo{SignerInfos: signerInfos}}).Marshal()
+	assert.NoError(t, err)
+	return &sdk.TxResponse{Tx: &codectypes.Any{Value: txBytes}}
+}
+
+func TestSignerSequence(t *testing.T) {
+	key1 := secp256k1.GenPrivKey().PubKey()
+	key2 := secp256k1.GenPrivKey().PubKey()
+	multisigPk := multisig.NewLegacyAminoPubKey(2, []crypto.PubKey{key1, key2})
+
+	txResponse := signedTxResponse(t, map[crypto.PubKey]uint64{key1: 3, multisigPk: 7})
+
+	sequence, err := SignerSequence("pokt", txResponse, multisigPk.Address().Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), sequence)
+
+	sequence, err = SignerSequence("pokt", txResponse, key1.Address().Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), sequence)
+
+	_, err = SignerSequence("pokt", txResponse, key2.Address().Bytes())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "address is not a signer of the tx")
+}
+
+func TestSignerSequence_MissingPublicKey(t *testing.T) {
+	key := secp256k1.GenPrivKey().PubKey()
+
+	_, err := SignerSequence("pokt", signedTxResponse(t, map[crypto.PubKey]uint64{nil: 3}), key.Address().Bytes())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "address is not a signer of the tx")
+}
+
+func TestSignerSequence_InvalidTx(t *testing.T) {
+	_, err := SignerSequence("pokt", &sdk.TxResponse{}, []byte{1})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "tx is missing")
+
+	_, err = SignerSequence("pokt", &sdk.TxResponse{Tx: &codectypes.Any{Value: []byte("invalid")}}, []byte{1})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error unmarshalling tx")
+
+	_, err = SignerSequence("pokt", &sdk.TxResponse{Tx: &codectypes.Any{}}, []byte{1})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "tx has no auth info")
+}
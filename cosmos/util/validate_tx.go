@@ -2,6 +2,7 @@ package util
 
 import (
 	"bytes"
+	"fmt"
 
 	"cosmossdk.io/math"
 	"github.com/dan13ram/wpokt-oracle/common"
@@ -22,6 +23,8 @@ type ValidateTxResult struct {
 	Amount        sdk.Coin
 	SenderAddress []byte
 	NeedsRefund   bool
+	StatusCode    models.StatusCode // cause of a failed or invalid status, or of a refund
+	StatusReason  string
 }
 
 func ValidateTxToCosmosMultisig(
@@ -60,38 +63,52 @@ func ValidateTxToCosmosMultisig(
 	if txResponse.Code != 0 {
 		logger.Debugf("Found tx with non-zero code")
 		result.TxStatus = models.TransactionStatusFailed
+		result.StatusCode = models.StatusCodeTxFailed
+		result.StatusReason = fmt.Sprintf("tx failed with code %d", txResponse.Code)
 		return &result, nil
 	}
 
 	coinsReceived, err := ParseCoinsReceivedEvents(config.CoinDenom, config.MultisigAddress, txResponse.Events)
 	if err != nil {
 		logger.WithError(err).Debugf("Error parsing coins received events")
+		result.StatusCode = models.StatusCodeInvalidTx
+		result.StatusReason = fmt.Sprintf("error parsing coins received events: %s", err)
 		return &result, nil
 	}
 
 	coinsSpentSender, coinsSpent, err := ParseCoinsSpentEvents(config.CoinDenom, txResponse.Events)
 	if err != nil {
 		logger.WithError(err).Debugf("Error parsing coins spent events")
+		result.StatusCode = models.StatusCodeInvalidTx
+		result.StatusReason = fmt.Sprintf("error parsing coins spent events: %s", err)
 		return &result, nil
 	}
 
 	if coinsReceived.IsZero() || coinsSpent.IsZero() {
 		logger.Debugf("Found tx with zero coins")
+		result.StatusCode = models.StatusCodeZeroAmount
+		result.StatusReason = "tx has zero coins"
 		return &result, nil
 	}
 
 	if coinsReceived.Amount.LTE(math.NewIntFromUint64(config.TxFee)) {
 		logger.Debugf("Found tx with amount too low")
+		result.StatusCode = models.StatusCodeAmountTooLow
+		result.StatusReason = fmt.Sprintf("amount %s does not cover the tx fee of %d", coinsReceived.Amount, config.TxFee)
 		return &result, nil
 	}
 
 	spenderAddress, err := common.AddressBytesFromBech32(config.Bech32Prefix, coinsSpentSender)
 	if err != nil {
 		logger.WithError(err).Errorf("Error parsing spender address")
+		result.StatusCode = models.StatusCodeInvalidTx
+		result.StatusReason = fmt.Sprintf("error parsing spender address: %s", err)
 		return &result, nil
 	}
 	if !bytes.Equal(senderAddress, spenderAddress) {
 		logger.Errorf("Sender address does not match spender address")
+		result.StatusCode = models.StatusCodeSpenderMismatch
+		result.StatusReason = "sender address does not match spender address"
 		return &result, nil
 	}
 
@@ -99,6 +116,8 @@ func ValidateTxToCosmosMultisig(
 	err = tx.Unmarshal(txResponse.Tx.Value)
 	if err != nil {
 		logger.WithError(err).Errorf("Error unmarshalling tx")
+		result.StatusCode = models.StatusCodeInvalidTx
+		result.StatusReason = fmt.Sprintf("error unmarshalling tx: %s", err)
 		return &result, nil
 	}
 	result.Tx = tx
@@ -116,6 +135,8 @@ func ValidateTxToCosmosMultisig(
 		logger.Debugf("Found tx with invalid coins")
 		// refund
 		result.NeedsRefund = true
+		result.StatusCode = models.StatusCodeCoinsMismatch
+		result.StatusReason = fmt.Sprintf("coins spent %s do not match coins received %s", coinsSpent, coinsReceived)
 		return &result, nil
 	}
 
@@ -124,6 +145,8 @@ func ValidateTxToCosmosMultisig(
 		logger.WithError(err).WithField("memo", tx.Body.Memo).Debugf("Found invalid memo")
 		// refund
 		result.NeedsRefund = true
+		result.StatusCode = models.StatusCodeInvalidMemo
+		result.StatusReason = fmt.Sprintf("invalid memo: %s", err)
 		return &result, nil
 	}
 
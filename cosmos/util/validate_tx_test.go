@@ -82,6 +82,7 @@ func TestValidateTxToCosmosMultisig(t *testing.T) {
 	result, err := ValidateTxToCosmosMultisig(txResponse, config, supportedChainIDsEthereum, currentCosmosBlockHeight)
 	assert.NoError(t, err)
 	assert.Equal(t, models.TransactionStatusConfirmed, result.TxStatus)
+	assert.Empty(t, result.StatusCode)
 	assert.Equal(t, uint64(10), result.Confirmations)
 	assert.Equal(t, strings.ToLower("0xAb5801a7D398351b8bE11C439e05C5b3259aec9B"), result.Memo.Address)
 	assert.Equal(t, "1", result.Memo.ChainID)
@@ -162,6 +163,7 @@ func TestValidateTxToCosmosMultisig_TxWithNonZeroCode(t *testing.T) {
 	result, err := ValidateTxToCosmosMultisig(txResponse, config, supportedChainIDsEthereum, currentCosmosBlockHeight)
 	assert.NoError(t, err)
 	assert.Equal(t, models.TransactionStatusFailed, result.TxStatus)
+	assert.Equal(t, models.StatusCodeTxFailed, result.StatusCode)
 }
 
 func TestValidateTxToCosmosMultisig_ErrorParsingCoinsReceived(t *testing.T) {
@@ -321,6 +323,7 @@ func TestValidateTxToCosmosMultisig_ZeroCoins(t *testing.T) {
 	result, err := ValidateTxToCosmosMultisig(txResponse, config, supportedChainIDsEthereum, currentCosmosBlockHeight)
 	assert.NoError(t, err)
 	assert.Equal(t, models.TransactionStatusInvalid, result.TxStatus)
+	assert.Equal(t, models.StatusCodeZeroAmount, result.StatusCode)
 }
 
 func TestValidateTxToCosmosMultisig_AmountTooLow(t *testing.T) {
@@ -375,6 +378,7 @@ func TestValidateTxToCosmosMultisig_AmountTooLow(t *testing.T) {
 	result, err := ValidateTxToCosmosMultisig(txResponse, config, supportedChainIDsEthereum, currentCosmosBlockHeight)
 	assert.NoError(t, err)
 	assert.Equal(t, models.TransactionStatusInvalid, result.TxStatus)
+	assert.Equal(t, models.StatusCodeAmountTooLow, result.StatusCode)
 }
 
 func TestValidateTxToCosmosMultisig_InvalidSpender(t *testing.T) {
@@ -544,6 +548,7 @@ func TestValidateTxToCosmosMultisig_InvalidCoins(t *testing.T) {
 	result, err := ValidateTxToCosmosMultisig(txResponse, config, supportedChainIDsEthereum, currentCosmosBlockHeight)
 	assert.NoError(t, err)
 	assert.Equal(t, models.TransactionStatusConfirmed, result.TxStatus)
+	assert.Equal(t, models.StatusCodeCoinsMismatch, result.StatusCode)
 	assert.True(t, result.NeedsRefund)
 }
 
@@ -607,6 +612,7 @@ func TestValidateTxToCosmosMultisig_InvalidMemo(t *testing.T) {
 	result, err := ValidateTxToCosmosMultisig(txResponse, config, supportedChainIDsEthereum, currentCosmosBlockHeight)
 	assert.NoError(t, err)
 	assert.Equal(t, models.TransactionStatusConfirmed, result.TxStatus)
+	assert.Equal(t, models.StatusCodeInvalidMemo, result.StatusCode)
 	assert.True(t, result.NeedsRefund)
 }
 
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+type AnomalyDB interface {
+	InsertAnomaly(anomaly models.Anomaly) error
+	GetUnresolvedAnomalies() ([]models.Anomaly, error)
+}
+
+// insertAnomaly stores the anomaly once per event, since every oracle sees the same events
+func insertAnomaly(anomaly models.Anomaly) error {
+	filter := bson.M{
+		"chain.chain_id":   anomaly.Chain.ChainID,
+		"chain.chain_type": anomaly.Chain.ChainType,
+		"transaction_hash": anomaly.TransactionHash,
+		"log_index":        anomaly.LogIndex,
+		"type":             anomaly.Type,
+	}
+	update := bson.M{"$setOnInsert": anomaly}
+
+	_, err := mongoDB.UpsertOne(common.CollectionAnomalies, filter, update)
+	return err
+}
+
+func getUnresolvedAnomalies() ([]models.Anomaly, error) {
+	anomalies := []models.Anomaly{}
+	filter := bson.M{"resolved": false}
+
+	err := mongoDB.FindMany(common.CollectionAnomalies, filter, &anomalies)
+
+	return anomalies, err
+}
+
+type anomalyDB struct{}
+
+func (db *anomalyDB) InsertAnomaly(anomaly models.Anomaly) error {
+	return insertAnomaly(anomaly)
+}
+
+func (db *anomalyDB) GetUnresolvedAnomalies() ([]models.Anomaly, error) {
+	return getUnresolvedAnomalies()
+}
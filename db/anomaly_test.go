@@ -0,0 +1,81 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	mocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AnomalyTestSuite struct {
+	suite.Suite
+	mockDB     *mocks.MockDatabase
+	oldMongoDB Database
+	db         AnomalyDB
+}
+
+func (suite *AnomalyTestSuite) SetupTest() {
+	suite.mockDB = mocks.NewMockDatabase(suite.T())
+	suite.oldMongoDB = mongoDB
+	mongoDB = suite.mockDB
+	suite.db = &anomalyDB{}
+}
+
+func (suite *AnomalyTestSuite) TearDownTest() {
+	mongoDB = suite.oldMongoDB
+}
+
+func (suite *AnomalyTestSuite) TestInsertAnomaly() {
+	anomaly := models.Anomaly{
+		Chain:           models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum},
+		Type:            models.AnomalyTypeUnknownOrder,
+		TransactionHash: "0x01",
+		LogIndex:        2,
+	}
+	filter := bson.M{
+		"chain.chain_id":   "1",
+		"chain.chain_type": models.ChainTypeEthereum,
+		"transaction_hash": "0x01",
+		"log_index":        uint64(2),
+		"type":             models.AnomalyTypeUnknownOrder,
+	}
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionAnomalies, filter, bson.M{"$setOnInsert": anomaly}).Return(primitive.ObjectID{}, nil).Once()
+
+	err := suite.db.InsertAnomaly(anomaly)
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *AnomalyTestSuite) TestInsertAnomaly_SomeError() {
+	expectedError := errors.New("some error")
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionAnomalies, mock.Anything, mock.Anything).Return(primitive.ObjectID{}, expectedError).Once()
+
+	err := suite.db.InsertAnomaly(models.Anomaly{})
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *AnomalyTestSuite) TestGetUnresolvedAnomalies() {
+	suite.mockDB.EXPECT().FindMany(common.CollectionAnomalies, bson.M{"resolved": false}, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+		anomalies := args.Get(2).(*[]models.Anomaly)
+		*anomalies = append(*anomalies, models.Anomaly{Type: models.AnomalyTypeUnsignedOrder})
+	})
+
+	anomalies, err := suite.db.GetUnresolvedAnomalies()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), anomalies, 1)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func TestAnomalyTestSuite(t *testing.T) {
+	suite.Run(t, new(AnomalyTestSuite))
+}
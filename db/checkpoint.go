@@ -0,0 +1,85 @@
+package db
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+type CheckpointDB interface {
+	FindCheckpoint(chain models.Chain, runner string) (*models.Checkpoint, error)
+	UpsertCheckpoint(chain models.Chain, runner string, blockHeight uint64) error
+	SeedCheckpoint(chain models.Chain, runner string, blockHeight uint64) error
+}
+
+func checkpointFilter(chain models.Chain, runner string) bson.M {
+	return bson.M{
+		"chain.chain_id":   chain.ChainID,
+		"chain.chain_type": chain.ChainType,
+		"runner":           runner,
+	}
+}
+
+// findCheckpoint returns nil if no checkpoint has been stored yet
+func findCheckpoint(chain models.Chain, runner string) (*models.Checkpoint, error) {
+	var checkpoint models.Checkpoint
+	err := mongoDB.FindOne(common.CollectionCheckpoints, checkpointFilter(chain, runner), &checkpoint)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+func upsertCheckpoint(chain models.Chain, runner string, blockHeight uint64) error {
+	now := time.Now()
+	update := bson.M{
+		"$set": bson.M{
+			"chain":        chain,
+			"block_height": blockHeight,
+			"updated_at":   now,
+		},
+		"$setOnInsert": bson.M{
+			"created_at": now,
+		},
+	}
+
+	_, err := mongoDB.UpsertOne(common.CollectionCheckpoints, checkpointFilter(chain, runner), update)
+	return err
+}
+
+// seedCheckpoint stores the checkpoint only if the runner has none yet
+func seedCheckpoint(chain models.Chain, runner string, blockHeight uint64) error {
+	now := time.Now()
+	update := bson.M{
+		"$setOnInsert": bson.M{
+			"chain":        chain,
+			"block_height": blockHeight,
+			"created_at":   now,
+			"updated_at":   now,
+		},
+	}
+
+	_, err := mongoDB.UpsertOne(common.CollectionCheckpoints, checkpointFilter(chain, runner), update)
+	return err
+}
+
+type checkpointDB struct{}
+
+func (db *checkpointDB) FindCheckpoint(chain models.Chain, runner string) (*models.Checkpoint, error) {
+	return findCheckpoint(chain, runner)
+}
+
+func (db *checkpointDB) UpsertCheckpoint(chain models.Chain, runner string, blockHeight uint64) error {
+	return upsertCheckpoint(chain, runner, blockHeight)
+}
+
+func (db *checkpointDB) SeedCheckpoint(chain models.Chain, runner string, blockHeight uint64) error {
+	return seedCheckpoint(chain, runner, blockHeight)
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	mocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type CheckpointTestSuite struct {
+	suite.Suite
+	mockDB     *mocks.MockDatabase
+	oldMongoDB Database
+	db         CheckpointDB
+}
+
+func (suite *CheckpointTestSuite) SetupTest() {
+	suite.mockDB = mocks.NewMockDatabase(suite.T())
+	suite.oldMongoDB = mongoDB
+	mongoDB = suite.mockDB
+	suite.db = &checkpointDB{}
+}
+
+func (suite *CheckpointTestSuite) TearDownTest() {
+	mongoDB = suite.oldMongoDB
+}
+
+func (suite *CheckpointTestSuite) TestFindCheckpoint() {
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	filter := bson.M{
+		"chain.chain_id":   "1",
+		"chain.chain_type": models.ChainTypeEthereum,
+		"runner":           models.CheckpointRunnerMonitor,
+	}
+
+	suite.mockDB.EXPECT().FindOne(common.CollectionCheckpoints, filter, &models.Checkpoint{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		checkpoint := args.Get(2).(*models.Checkpoint)
+		checkpoint.BlockHeight = 100
+	})
+
+	gotCheckpoint, err := suite.db.FindCheckpoint(chain, models.CheckpointRunnerMonitor)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), uint64(100), gotCheckpoint.BlockHeight)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CheckpointTestSuite) TestFindCheckpoint_NotFound() {
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+
+	suite.mockDB.EXPECT().FindOne(common.CollectionCheckpoints, mock.Anything, mock.Anything).Return(mongo.ErrNoDocuments).Once()
+
+	gotCheckpoint, err := suite.db.FindCheckpoint(chain, models.CheckpointRunnerMonitor)
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), gotCheckpoint)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CheckpointTestSuite) TestFindCheckpoint_SomeError() {
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	expectedError := errors.New("some error")
+
+	suite.mockDB.EXPECT().FindOne(common.CollectionCheckpoints, mock.Anything, mock.Anything).Return(expectedError).Once()
+
+	gotCheckpoint, err := suite.db.FindCheckpoint(chain, models.CheckpointRunnerMonitor)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedError, err)
+	assert.Nil(suite.T(), gotCheckpoint)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CheckpointTestSuite) TestUpsertCheckpoint() {
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	filter := bson.M{
+		"chain.chain_id":   "1",
+		"chain.chain_type": models.ChainTypeEthereum,
+		"runner":           models.CheckpointRunnerRelayer,
+	}
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionCheckpoints, filter, mock.Anything).Return(primitive.ObjectID{}, nil).Once().Run(func(args mock.Arguments) {
+		update := args.Get(2).(bson.M)
+		set := update["$set"].(bson.M)
+		assert.Equal(suite.T(), chain, set["chain"])
+		assert.Equal(suite.T(), uint64(200), set["block_height"])
+		assert.Contains(suite.T(), update, "$setOnInsert")
+	})
+
+	err := suite.db.UpsertCheckpoint(chain, models.CheckpointRunnerRelayer, 200)
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CheckpointTestSuite) TestUpsertCheckpoint_SomeError() {
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	expectedError := errors.New("some error")
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionCheckpoints, mock.Anything, mock.Anything).Return(primitive.ObjectID{}, expectedError).Once()
+
+	err := suite.db.UpsertCheckpoint(chain, models.CheckpointRunnerRelayer, 200)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *CheckpointTestSuite) TestSeedCheckpoint() {
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	filter := bson.M{
+		"chain.chain_id":   "1",
+		"chain.chain_type": models.ChainTypeEthereum,
+		"runner":           models.CheckpointRunnerMonitor,
+	}
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionCheckpoints, filter, mock.Anything).Return(primitive.ObjectID{}, nil).Once().Run(func(args mock.Arguments) {
+		update := args.Get(2).(bson.M)
+		assert.NotContains(suite.T(), update, "$set")
+		onInsert := update["$setOnInsert"].(bson.M)
+		assert.Equal(suite.T(), chain, onInsert["chain"])
+		assert.Equal(suite.T(), uint64(300), onInsert["block_height"])
+	})
+
+	err := suite.db.SeedCheckpoint(chain, models.CheckpointRunnerMonitor, 300)
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func TestCheckpointTestSuite(t *testing.T) {
+	suite.Run(t, new(CheckpointTestSuite))
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+type ContractEventDB interface {
+	InsertContractEvent(event models.ContractEvent) error
+}
+
+// insertContractEvent stores the event once, since every oracle sees the same events
+func insertContractEvent(event models.ContractEvent) error {
+	filter := bson.M{
+		"chain.chain_id":   event.Chain.ChainID,
+		"chain.chain_type": event.Chain.ChainType,
+		"transaction_hash": event.TransactionHash,
+		"log_index":        event.LogIndex,
+	}
+	update := bson.M{"$setOnInsert": event}
+
+	_, err := mongoDB.UpsertOne(common.CollectionContractEvents, filter, update)
+	return err
+}
+
+type contractEventDB struct{}
+
+func (db *contractEventDB) InsertContractEvent(event models.ContractEvent) error {
+	return insertContractEvent(event)
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	mocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ContractEventTestSuite struct {
+	suite.Suite
+	mockDB     *mocks.MockDatabase
+	oldMongoDB Database
+	db         ContractEventDB
+}
+
+func (suite *ContractEventTestSuite) SetupTest() {
+	suite.mockDB = mocks.NewMockDatabase(suite.T())
+	suite.oldMongoDB = mongoDB
+	mongoDB = suite.mockDB
+	suite.db = &contractEventDB{}
+}
+
+func (suite *ContractEventTestSuite) TearDownTest() {
+	mongoDB = suite.oldMongoDB
+}
+
+func (suite *ContractEventTestSuite) TestInsertContractEvent() {
+	event := models.ContractEvent{
+		Chain:           models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum},
+		ContractName:    "mailbox",
+		Event:           "DefaultIsmSet",
+		TransactionHash: "0x01",
+		LogIndex:        2,
+	}
+	filter := bson.M{
+		"chain.chain_id":   "1",
+		"chain.chain_type": models.ChainTypeEthereum,
+		"transaction_hash": "0x01",
+		"log_index":        uint64(2),
+	}
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionContractEvents, filter, bson.M{"$setOnInsert": event}).Return(primitive.ObjectID{}, nil).Once()
+
+	err := suite.db.InsertContractEvent(event)
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *ContractEventTestSuite) TestInsertContractEvent_SomeError() {
+	expectedError := errors.New("some error")
+
+	suite.mockDB.EXPECT().UpsertOne(common.CollectionContractEvents, mock.Anything, mock.Anything).Return(primitive.ObjectID{}, expectedError).Once()
+
+	err := suite.db.InsertContractEvent(models.ContractEvent{})
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func TestContractEventTestSuite(t *testing.T) {
+	suite.Run(t, new(ContractEventTestSuite))
+}
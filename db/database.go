@@ -29,6 +29,7 @@ type Database interface {
 	Disconnect() error
 
 	InsertOne(collection string, data interface{}) (primitive.ObjectID, error)
+	InsertMany(collection string, data []interface{}) error
 	FindOne(collection string, filter interface{}, result interface{}) error
 	FindMany(collection string, filter interface{}, result interface{}) error
 	FindManySorted(collection string, filter interface{}, sort interface{}, result interface{}) error
@@ -36,6 +37,8 @@ type Database interface {
 	AggregateMany(collection string, pipeline interface{}, result interface{}) error
 
 	UpdateOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error)
+	FindOneAndUpdate(collection string, filter interface{}, update interface{}, result interface{}) error
+	UpdateMany(collection string, filter interface{}, update interface{}) (int64, error)
 	UpsertOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error)
 
 	XLock(resourceID string) (string, error)
@@ -212,6 +215,70 @@ func (d *MongoDatabase) SetupIndexes() error {
 		return err
 	}
 
+	d.logger.Debug("Setting up indexes for checkpoints")
+	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	_, err = d.db.Collection(common.CollectionCheckpoints).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "chain.chain_id", Value: 1}, {Key: "chain.chain_type", Value: 1}, {Key: "runner", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.logger.Debug("Setting up indexes for anomalies")
+	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	_, err = d.db.Collection(common.CollectionAnomalies).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "chain.chain_id", Value: 1},
+			{Key: "chain.chain_type", Value: 1},
+			{Key: "transaction_hash", Value: 1},
+			{Key: "log_index", Value: 1},
+			{Key: "type", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.logger.Debug("Setting up indexes for supply snapshots")
+	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	_, err = d.db.Collection(common.CollectionSupplySnapshots).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
+	d.logger.Debug("Setting up indexes for contract events")
+	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	_, err = d.db.Collection(common.CollectionContractEvents).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "chain.chain_id", Value: 1},
+			{Key: "chain.chain_type", Value: 1},
+			{Key: "transaction_hash", Value: 1},
+			{Key: "log_index", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.logger.Debug("Setting up indexes for status events")
+	ctx, cancel = context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	_, err = d.db.Collection(common.CollectionStatusEvents).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "document_id", Value: 1}, {Key: "created_at", Value: 1}},
+	})
+	if err != nil {
+		return err
+	}
+
 	d.logger.Info("Indexes setup")
 
 	return nil
@@ -238,6 +305,14 @@ func (d *MongoDatabase) InsertOne(collection string, data interface{}) (primitiv
 	return result.InsertedID.(primitive.ObjectID), err
 }
 
+// InsertMany inserts the documents in one request, continuing past the ones that fail
+func (d *MongoDatabase) InsertMany(collection string, data []interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+	_, err := d.db.Collection(collection).InsertMany(ctx, data, options.InsertMany().SetOrdered(false))
+	return err
+}
+
 // method for find single value in a collection
 func (d *MongoDatabase) FindOne(collection string, filter interface{}, result interface{}) error {
 	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
@@ -366,6 +441,29 @@ func (d *MongoDatabase) UpdateOne(collection string, filter interface{}, update
 	return updatedID, nil
 }
 
+// FindOneAndUpdate updates a document and decodes it as it was before the update
+func (d *MongoDatabase) FindOneAndUpdate(collection string, filter interface{}, update interface{}, result interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.Before)
+
+	return d.db.Collection(collection).FindOneAndUpdate(ctx, filter, update, opts).Decode(result)
+}
+
+// method for update multiple values in a collection
+func (d *MongoDatabase) UpdateMany(collection string, filter interface{}, update interface{}) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	result, err := d.db.Collection(collection).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.ModifiedCount, nil
+}
+
 // method for upsert single value in a collection
 func (d *MongoDatabase) UpsertOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
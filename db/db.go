@@ -7,6 +7,10 @@ type DB interface {
 	RefundDB
 	SequenceDB
 	LockDB
+	CheckpointDB
+	AnomalyDB
+	SupplyDB
+	ContractEventDB
 }
 
 type db struct {
@@ -16,8 +20,17 @@ type db struct {
 	refundDB
 	sequenceDB
 	lockDB
+	checkpointDB
+	anomalyDB
+	supplyDB
+	contractEventDB
 }
 
-func NewDB() DB {
-	return &db{}
+// NewDB returns the database of a runner, named in the status events it records
+func NewDB(runner string) DB {
+	return &db{
+		transactionDB: transactionDB{runner: runner},
+		messageDB:     messageDB{runner: runner},
+		refundDB:      refundDB{runner: runner},
+	}
 }
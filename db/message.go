@@ -37,17 +37,34 @@ type MessageDB interface {
 
 	FindMessage(filter bson.M) (models.Message, error)
 
-	UpdateMessage(messageID *primitive.ObjectID, update bson.M) error
+	UpdateMessage(messageID *primitive.ObjectID, currentStatus models.MessageStatus, update bson.M) error
 
-	UpdateMessageByMessageID(messageID [32]byte, update bson.M) (primitive.ObjectID, error)
+	UpdateMessageByMessageID(messageID [32]byte, currentStatus models.MessageStatus, update bson.M) (primitive.ObjectID, error)
+
+	UpdateMessages(filter bson.M, update bson.M) error
+
+	UpdateReorgedMessages(filter bson.M, update bson.M) error
+
+	SetFulfillmentFeePaid(messageID *primitive.ObjectID, index int, txHash string, feePaid string) error
 
 	InsertMessage(tx models.Message) (primitive.ObjectID, error)
 
 	GetPendingMessages(signerToExclude string, chain models.Chain) ([]models.Message, error)
+	GetHeldMessages(signerToExclude string, chain models.Chain) ([]models.Message, error)
 
 	GetSignedMessages(chain models.Chain) ([]models.Message, error)
 
+	GetPendingAndSignedMessages(chain models.Chain) ([]models.Message, error)
+
+	GetPausedMessages(chain models.Chain) ([]models.Message, error)
+
 	GetBroadcastedMessages(chain models.Chain) ([]models.Message, error)
+
+	GetFulfillments(chain models.Chain, submitter string, since time.Time) ([]models.Message, error)
+
+	GetDispatchedNonces(chain models.Chain, fromNonce uint32) ([]models.DispatchedNonce, error)
+
+	GetInFlightMessages() ([]models.Message, error)
 }
 
 func newMessageBody(
@@ -139,28 +156,94 @@ func findMessage(filter bson.M) (models.Message, error) {
 	return message, err
 }
 
-func updateMessage(messageID *primitive.ObjectID, update bson.M) error {
+// updateMessage updates the message only if it still has the current status read by the caller
+func updateMessage(runner string, messageID *primitive.ObjectID, currentStatus models.MessageStatus, update bson.M) error {
 	if messageID == nil {
 		return fmt.Errorf("messageID is nil")
 	}
-	_, err := mongoDB.UpdateOne(
+	if err := checkTransition(messageTransitions, currentStatus, update); err != nil {
+		return err
+	}
+	_, err := updateWithStatusEvent(
 		common.CollectionMessages,
-		bson.M{"_id": messageID},
-		bson.M{"$set": update},
+		models.StatusEventDocumentMessage,
+		runner,
+		bson.M{"_id": messageID, "status": currentStatus},
+		update,
 	)
+	if err == mongo.ErrNoDocuments {
+		return statusError(common.CollectionMessages, bson.M{"_id": messageID}, string(currentStatus), update)
+	}
 	return err
 }
 
-func updateMessageByMessageID(messageID [32]byte, update bson.M) (primitive.ObjectID, error) {
+// updateMessageByMessageID is updateMessage for a message found by its message id
+func updateMessageByMessageID(runner string, messageID [32]byte, currentStatus models.MessageStatus, update bson.M) (primitive.ObjectID, error) {
+	if err := checkTransition(messageTransitions, currentStatus, update); err != nil {
+		return primitive.NilObjectID, err
+	}
+
 	messageIDHex := common.Ensure0xPrefix(common.HexFromBytes(messageID[:]))
+	docID, err := updateWithStatusEvent(
+		common.CollectionMessages,
+		models.StatusEventDocumentMessage,
+		runner,
+		bson.M{"message_id": messageIDHex, "status": currentStatus},
+		update,
+	)
+	if err == mongo.ErrNoDocuments {
+		return docID, statusError(common.CollectionMessages, bson.M{"message_id": messageIDHex}, string(currentStatus), update)
+	}
+	return docID, err
+}
 
-	return mongoDB.UpdateOne(
+// updateMessages skips the messages whose status cannot move to the status set by the update
+func updateMessages(runner string, filter bson.M, update bson.M) error {
+	filter, err := transitionFilter(messageTransitions, filter, update)
+	if err != nil {
+		return err
+	}
+	return updateManyWithStatusEvents(
 		common.CollectionMessages,
-		bson.M{"message_id": messageIDHex},
-		bson.M{"$set": update},
+		models.StatusEventDocumentMessage,
+		runner,
+		filter,
+		update,
 	)
 }
 
+// updateReorgedMessages is updateMessages for the statuses a reorg moves messages between
+func updateReorgedMessages(runner string, filter bson.M, update bson.M) error {
+	filter, err := transitionFilter(reorgMessageTransitions, filter, update)
+	if err != nil {
+		return err
+	}
+	return updateManyWithStatusEvents(
+		common.CollectionMessages,
+		models.StatusEventDocumentMessage,
+		runner,
+		filter,
+		update,
+	)
+}
+
+// setFulfillmentFeePaid stores the fee paid for the fulfillment at index if txHash is one of its transactions.
+// It does not depend on the status of the message, which the relayer may have marked successful already
+func setFulfillmentFeePaid(runner string, messageID *primitive.ObjectID, index int, txHash string, feePaid string) error {
+	if messageID == nil {
+		return fmt.Errorf("messageID is nil")
+	}
+	filter := bson.M{
+		"_id": messageID,
+		fmt.Sprintf("fulfillments.%d.tx_hashes", index): txHash,
+	}
+	update := bson.M{
+		fmt.Sprintf("fulfillments.%d.fee_paid", index): feePaid,
+	}
+	_, err := updateWithStatusEvent(common.CollectionMessages, models.StatusEventDocumentMessage, runner, filter, update)
+	return err
+}
+
 func insertMessage(tx models.Message) (primitive.ObjectID, error) {
 	insertedID, err := mongoDB.InsertOne(common.CollectionMessages, tx)
 	if err != nil {
@@ -200,6 +283,31 @@ func getPendingMessages(signerToExclude string, chain models.Chain) ([]models.Me
 	return messages, err
 }
 
+// getHeldMessages returns the messages to the chain held for exceeding its mint limit
+// or while its token is paused, that have not been signed by signerToExclude
+func getHeldMessages(signerToExclude string, chain models.Chain) ([]models.Message, error) {
+	messages := []models.Message{}
+	filter := bson.M{
+		"$and": []bson.M{
+			{"content.destination_domain": chain.ChainDomain},
+			{"status": bson.M{"$in": []models.MessageStatus{
+				models.MessageStatusOverLimit,
+				models.MessageStatusDestinationPaused,
+			}}},
+			{"$nor": []bson.M{
+				{"signatures": bson.M{
+					"$elemMatch": bson.M{"signer": signerToExclude},
+				}},
+			}},
+		},
+	}
+	sort := bson.M{"content.nonce": 1}
+
+	err := mongoDB.FindManySorted(common.CollectionMessages, filter, sort, &messages)
+
+	return messages, err
+}
+
 func getSignedMessages(chain models.Chain) ([]models.Message, error) {
 	messages := []models.Message{}
 	sort := bson.M{"sequence": 1}
@@ -213,6 +321,38 @@ func getSignedMessages(chain models.Chain) ([]models.Message, error) {
 	return messages, err
 }
 
+// getPendingAndSignedMessages returns the messages to the chain that have not been broadcasted yet,
+// whoever signed them
+func getPendingAndSignedMessages(chain models.Chain) ([]models.Message, error) {
+	messages := []models.Message{}
+	filter := bson.M{
+		"content.destination_domain": chain.ChainDomain,
+		"status": bson.M{"$in": []models.MessageStatus{
+			models.MessageStatusPending,
+			models.MessageStatusSigned,
+		}},
+	}
+	sort := bson.M{"content.nonce": 1}
+
+	err := mongoDB.FindManySorted(common.CollectionMessages, filter, sort, &messages)
+
+	return messages, err
+}
+
+// getPausedMessages returns the messages to the chain held while its token is paused, whoever signed them
+func getPausedMessages(chain models.Chain) ([]models.Message, error) {
+	messages := []models.Message{}
+	filter := bson.M{
+		"content.destination_domain": chain.ChainDomain,
+		"status":                     models.MessageStatusDestinationPaused,
+	}
+	sort := bson.M{"content.nonce": 1}
+
+	err := mongoDB.FindManySorted(common.CollectionMessages, filter, sort, &messages)
+
+	return messages, err
+}
+
 func getBroadcastedMessages(chain models.Chain) ([]models.Message, error) {
 	messages := []models.Message{}
 	filter := bson.M{
@@ -226,7 +366,74 @@ func getBroadcastedMessages(chain models.Chain) ([]models.Message, error) {
 	return messages, err
 }
 
-type messageDB struct{}
+// getFulfillments returns the messages to the chain with a fulfillment submitted by submitter since the given time
+func getFulfillments(chain models.Chain, submitter string, since time.Time) ([]models.Message, error) {
+	messages := []models.Message{}
+	filter := bson.M{
+		"content.destination_domain": chain.ChainDomain,
+		"fulfillments": bson.M{"$elemMatch": bson.M{
+			"submitter":    submitter,
+			"submitted_at": bson.M{"$gte": since},
+		}},
+	}
+
+	err := mongoDB.FindMany(common.CollectionMessages, filter, &messages)
+
+	return messages, err
+}
+
+// getDispatchedNonces returns the nonces of the messages dispatched on the chain from fromNonce onwards,
+// sorted by nonce, with the block height of their origin transaction
+func getDispatchedNonces(chain models.Chain, fromNonce uint32) ([]models.DispatchedNonce, error) {
+	nonces := []models.DispatchedNonce{}
+	filter := bson.M{
+		"content.origin_domain": chain.ChainDomain,
+		"content.nonce":         bson.M{"$gte": fromNonce},
+		"status":                bson.M{"$ne": models.MessageStatusReorged},
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: common.CollectionTransactions},
+			{Key: "localField", Value: "origin_transaction"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "origin"},
+		}}},
+		{{Key: "$unwind", Value: "$origin"}},
+		{{Key: "$project", Value: bson.D{
+			{Key: "_id", Value: 0},
+			{Key: "nonce", Value: "$content.nonce"},
+			{Key: "message_id", Value: "$message_id"},
+			{Key: "block_height", Value: "$origin.block_height"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "nonce", Value: 1}}}},
+	}
+
+	err := mongoDB.AggregateMany(common.CollectionMessages, pipeline, &nonces)
+
+	return nonces, err
+}
+
+// getInFlightMessages returns the messages whose amount has left the origin chain
+// but has not been paid out on the destination chain
+func getInFlightMessages() ([]models.Message, error) {
+	messages := []models.Message{}
+	filter := bson.M{
+		"status": bson.M{"$nin": []models.MessageStatus{
+			models.MessageStatusSuccess,
+			models.MessageStatusInvalid,
+			models.MessageStatusReorged,
+		}},
+	}
+
+	err := mongoDB.FindMany(common.CollectionMessages, filter, &messages)
+
+	return messages, err
+}
+
+type messageDB struct {
+	runner string
+}
 
 func (db *messageDB) NewMessageBody(
 	senderAddress []byte,
@@ -259,12 +466,24 @@ func (db *messageDB) FindMessage(filter bson.M) (models.Message, error) {
 	return findMessage(filter)
 }
 
-func (db *messageDB) UpdateMessage(messageID *primitive.ObjectID, update bson.M) error {
-	return updateMessage(messageID, update)
+func (db *messageDB) UpdateMessage(messageID *primitive.ObjectID, currentStatus models.MessageStatus, update bson.M) error {
+	return updateMessage(db.runner, messageID, currentStatus, update)
+}
+
+func (db *messageDB) UpdateMessageByMessageID(messageID [32]byte, currentStatus models.MessageStatus, update bson.M) (primitive.ObjectID, error) {
+	return updateMessageByMessageID(db.runner, messageID, currentStatus, update)
+}
+
+func (db *messageDB) UpdateMessages(filter bson.M, update bson.M) error {
+	return updateMessages(db.runner, filter, update)
 }
 
-func (db *messageDB) UpdateMessageByMessageID(messageID [32]byte, update bson.M) (primitive.ObjectID, error) {
-	return updateMessageByMessageID(messageID, update)
+func (db *messageDB) UpdateReorgedMessages(filter bson.M, update bson.M) error {
+	return updateReorgedMessages(db.runner, filter, update)
+}
+
+func (db *messageDB) SetFulfillmentFeePaid(messageID *primitive.ObjectID, index int, txHash string, feePaid string) error {
+	return setFulfillmentFeePaid(db.runner, messageID, index, txHash, feePaid)
 }
 
 func (db *messageDB) InsertMessage(tx models.Message) (primitive.ObjectID, error) {
@@ -275,10 +494,34 @@ func (db *messageDB) GetPendingMessages(signerToExclude string, chain models.Cha
 	return getPendingMessages(signerToExclude, chain)
 }
 
+func (db *messageDB) GetHeldMessages(signerToExclude string, chain models.Chain) ([]models.Message, error) {
+	return getHeldMessages(signerToExclude, chain)
+}
+
 func (db *messageDB) GetSignedMessages(chain models.Chain) ([]models.Message, error) {
 	return getSignedMessages(chain)
 }
 
+func (db *messageDB) GetPendingAndSignedMessages(chain models.Chain) ([]models.Message, error) {
+	return getPendingAndSignedMessages(chain)
+}
+
+func (db *messageDB) GetPausedMessages(chain models.Chain) ([]models.Message, error) {
+	return getPausedMessages(chain)
+}
+
 func (db *messageDB) GetBroadcastedMessages(chain models.Chain) ([]models.Message, error) {
 	return getBroadcastedMessages(chain)
 }
+
+func (db *messageDB) GetFulfillments(chain models.Chain, submitter string, since time.Time) ([]models.Message, error) {
+	return getFulfillments(chain, submitter, since)
+}
+
+func (db *messageDB) GetDispatchedNonces(chain models.Chain, fromNonce uint32) ([]models.DispatchedNonce, error) {
+	return getDispatchedNonces(chain, fromNonce)
+}
+
+func (db *messageDB) GetInFlightMessages() ([]models.Message, error) {
+	return getInFlightMessages()
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dan13ram/wpokt-oracle/common"
 	mocks "github.com/dan13ram/wpokt-oracle/db/mocks"
@@ -217,30 +218,182 @@ func (suite *MessageTestSuite) TestUpdateMessage() {
 	messageID := primitive.NewObjectID()
 	update := bson.M{"status": models.MessageStatusSigned}
 
-	suite.mockDB.EXPECT().UpdateOne(common.CollectionMessages, bson.M{"_id": &messageID}, bson.M{"$set": update}).Return(primitive.ObjectID{}, nil).Once()
-
-	err := suite.db.UpdateMessage(&messageID, update)
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, bson.M{"_id": &messageID, "status": models.MessageStatusPending}, bson.M{"$set": update}, mock.Anything).
+		Run(func(_ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: messageID, Status: string(models.MessageStatusPending)}
+		}).Return(nil).Once()
+	suite.mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.MatchedBy(func(events []interface{}) bool {
+		event := events[0].(models.StatusEvent)
+		return len(events) == 1 &&
+			event.DocumentID == messageID &&
+			event.OldStatus == string(models.MessageStatusPending) &&
+			event.NewStatus == string(models.MessageStatusSigned)
+	})).Return(nil).Once()
+
+	err := suite.db.UpdateMessage(&messageID, models.MessageStatusPending, update)
 	assert.NoError(suite.T(), err)
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
+func (suite *MessageTestSuite) TestUpdateMessage_IllegalTransition() {
+	messageID := primitive.NewObjectID()
+	update := bson.M{"status": models.MessageStatusPending}
+
+	err := suite.db.UpdateMessage(&messageID, models.MessageStatusSuccess, update)
+	assert.ErrorIs(suite.T(), err, ErrStatusTransition)
+}
+
+func (suite *MessageTestSuite) TestUpdateMessage_StatusConflict() {
+	messageID := primitive.NewObjectID()
+	update := bson.M{"status": models.MessageStatusPending, "signatures": []models.Signature{}}
+
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, bson.M{"_id": &messageID, "status": models.MessageStatusBroadcasted}, bson.M{"$set": update}, mock.Anything).
+		Return(mongo.ErrNoDocuments).Once()
+	suite.mockDB.EXPECT().FindOne(common.CollectionMessages, bson.M{"_id": &messageID}, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: messageID, Status: string(models.MessageStatusSuccess)}
+		}).Return(nil).Once()
+
+	err := suite.db.UpdateMessage(&messageID, models.MessageStatusBroadcasted, update)
+	assert.ErrorIs(suite.T(), err, ErrStatusConflict)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func (suite *MessageTestSuite) TestUpdateMessage_NilMessageID() {
 	update := bson.M{"status": models.MessageStatusSigned}
 
-	err := suite.db.UpdateMessage(nil, update)
+	err := suite.db.UpdateMessage(nil, models.MessageStatusPending, update)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), fmt.Errorf("messageID is nil"), err)
 }
 
+func (suite *MessageTestSuite) TestSetFulfillmentFeePaid() {
+	messageID := primitive.NewObjectID()
+
+	// the message status is not part of the filter
+	suite.mockDB.EXPECT().UpdateOne(common.CollectionMessages, bson.M{
+		"_id":                      &messageID,
+		"fulfillments.1.tx_hashes": "0x01",
+	}, bson.M{"$set": bson.M{"fulfillments.1.fee_paid": "1000"}}).Return(messageID, nil).Once()
+
+	err := suite.db.SetFulfillmentFeePaid(&messageID, 1, "0x01", "1000")
+	assert.NoError(suite.T(), err)
+
+	err = suite.db.SetFulfillmentFeePaid(nil, 1, "0x01", "1000")
+	assert.Error(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestUpdateMessages() {
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusInvalid}
+
+	messageID := primitive.NewObjectID()
+	transitionFilter := bson.M{"$and": []bson.M{filter, {"status": bson.M{"$in": []models.MessageStatus{
+		models.MessageStatusDestinationPaused,
+		models.MessageStatusInvalid,
+		models.MessageStatusOverLimit,
+		models.MessageStatusPending,
+		models.MessageStatusSigned,
+	}}}}}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionMessages, transitionFilter, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]statusSnapshot) = []statusSnapshot{{ID: messageID, Status: string(models.MessageStatusPending)}}
+		}).Return(nil).Once()
+	groupFilter := bson.M{"$and": []bson.M{transitionFilter, {"_id": bson.M{"$in": []primitive.ObjectID{messageID}}}, {"status": string(models.MessageStatusPending)}}}
+	suite.mockDB.EXPECT().UpdateMany(common.CollectionMessages, groupFilter, setWithUpdatedAt(update, nil)).Return(int64(1), nil).Once()
+	suite.mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.Anything).Return(nil).Once()
+
+	err := suite.db.UpdateMessages(filter, update)
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestUpdateReorgedMessages() {
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusReorged}
+
+	messageID := primitive.NewObjectID()
+	transitionFilter := bson.M{"$and": []bson.M{filter, {"status": bson.M{"$in": []models.MessageStatus{
+		models.MessageStatusPending,
+		models.MessageStatusReorged,
+		models.MessageStatusSigned,
+	}}}}}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionMessages, transitionFilter, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]statusSnapshot) = []statusSnapshot{{ID: messageID, Status: string(models.MessageStatusPending)}}
+		}).Return(nil).Once()
+	groupFilter := bson.M{"$and": []bson.M{transitionFilter, {"_id": bson.M{"$in": []primitive.ObjectID{messageID}}}, {"status": string(models.MessageStatusPending)}}}
+	suite.mockDB.EXPECT().UpdateMany(common.CollectionMessages, groupFilter, setWithUpdatedAt(update, nil)).Return(int64(1), nil).Once()
+	suite.mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.Anything).Return(nil).Once()
+
+	err := suite.db.UpdateReorgedMessages(filter, update)
+	assert.NoError(suite.T(), err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func (suite *MessageTestSuite) TestUpdateMessageByMessageID() {
 	messageID := [32]byte{}
-	update := bson.M{"status": models.MessageStatusSigned}
+	update := bson.M{"status": models.MessageStatusSuccess}
 	messageIDHex := common.Ensure0xPrefix(common.HexFromBytes(messageID[:]))
 
-	suite.mockDB.EXPECT().UpdateOne(common.CollectionMessages, bson.M{"message_id": messageIDHex}, bson.M{"$set": update}).Return(primitive.ObjectID{}, nil).Once()
+	docID := primitive.NewObjectID()
+	filter := bson.M{"message_id": messageIDHex, "status": models.MessageStatusSigned}
 
-	_, err := suite.db.UpdateMessageByMessageID(messageID, update)
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, filter, bson.M{"$set": update}, mock.Anything).
+		Run(func(_ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: docID, Status: string(models.MessageStatusSigned)}
+		}).Return(nil).Once()
+	suite.mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.Anything).Return(nil).Once()
+
+	gotID, err := suite.db.UpdateMessageByMessageID(messageID, models.MessageStatusSigned, update)
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), docID, gotID)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestUpdateMessageByMessageID_IllegalTransition() {
+	messageID := [32]byte{}
+	update := bson.M{"status": models.MessageStatusSuccess}
+
+	// the update is rejected without a request
+	_, err := suite.db.UpdateMessageByMessageID(messageID, models.MessageStatusInvalid, update)
+	assert.ErrorIs(suite.T(), err, ErrStatusTransition)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestUpdateMessageByMessageID_StatusChanged() {
+	messageID := [32]byte{}
+	update := bson.M{"status": models.MessageStatusSuccess}
+	messageIDHex := common.Ensure0xPrefix(common.HexFromBytes(messageID[:]))
+
+	// the message was reorged after the caller read it as signed
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, bson.M{"message_id": messageIDHex, "status": models.MessageStatusSigned}, bson.M{"$set": update}, mock.Anything).
+		Return(mongo.ErrNoDocuments).Once()
+	suite.mockDB.EXPECT().FindOne(common.CollectionMessages, bson.M{"message_id": messageIDHex}, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{Status: string(models.MessageStatusReorged)}
+		}).Return(nil).Once()
+
+	_, err := suite.db.UpdateMessageByMessageID(messageID, models.MessageStatusSigned, update)
+	assert.ErrorIs(suite.T(), err, ErrStatusConflict)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestUpdateMessageByMessageID_NotFound() {
+	messageID := [32]byte{}
+	update := bson.M{"status": models.MessageStatusSuccess}
+	messageIDHex := common.Ensure0xPrefix(common.HexFromBytes(messageID[:]))
+
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, mock.Anything, bson.M{"$set": update}, mock.Anything).
+		Return(mongo.ErrNoDocuments).Once()
+	suite.mockDB.EXPECT().FindOne(common.CollectionMessages, bson.M{"message_id": messageIDHex}, mock.Anything).
+		Return(mongo.ErrNoDocuments).Once()
+
+	_, err := suite.db.UpdateMessageByMessageID(messageID, models.MessageStatusSigned, update)
+	assert.ErrorIs(suite.T(), err, mongo.ErrNoDocuments)
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
@@ -350,6 +503,46 @@ func (suite *MessageTestSuite) TestGetPendingMessages() {
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
+func (suite *MessageTestSuite) TestGetHeldMessages() {
+	signerToExclude := "signer1"
+	chain := models.Chain{ChainDomain: 1}
+	messages := []models.Message{
+		{
+			ID:     &primitive.ObjectID{},
+			Status: models.MessageStatusOverLimit,
+		},
+		{
+			ID:     &primitive.ObjectID{},
+			Status: models.MessageStatusDestinationPaused,
+		},
+	}
+	filter := bson.M{
+		"$and": []bson.M{
+			{"content.destination_domain": chain.ChainDomain},
+			{"status": bson.M{"$in": []models.MessageStatus{
+				models.MessageStatusOverLimit,
+				models.MessageStatusDestinationPaused,
+			}}},
+			{"$nor": []bson.M{
+				{"signatures": bson.M{
+					"$elemMatch": bson.M{"signer": signerToExclude},
+				}},
+			}},
+		},
+	}
+	sort := bson.M{"content.nonce": 1}
+
+	suite.mockDB.EXPECT().FindManySorted(common.CollectionMessages, filter, sort, &[]models.Message{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(3).(*[]models.Message)
+		*arg = messages
+	})
+
+	gotMessages, err := suite.db.GetHeldMessages(signerToExclude, chain)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), messages, gotMessages)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func (suite *MessageTestSuite) TestGetSignedMessages() {
 	chain := models.Chain{ChainDomain: 1}
 	messages := []models.Message{
@@ -375,6 +568,53 @@ func (suite *MessageTestSuite) TestGetSignedMessages() {
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
+func (suite *MessageTestSuite) TestGetPendingAndSignedMessages() {
+	chain := models.Chain{ChainDomain: 1}
+	messages := []models.Message{
+		{ID: &primitive.ObjectID{}, Status: models.MessageStatusPending},
+		{ID: &primitive.ObjectID{}, Status: models.MessageStatusSigned},
+	}
+	filter := bson.M{
+		"content.destination_domain": chain.ChainDomain,
+		"status": bson.M{"$in": []models.MessageStatus{
+			models.MessageStatusPending,
+			models.MessageStatusSigned,
+		}},
+	}
+	sort := bson.M{"content.nonce": 1}
+
+	suite.mockDB.EXPECT().FindManySorted(common.CollectionMessages, filter, sort, &[]models.Message{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(3).(*[]models.Message)
+		*arg = messages
+	})
+
+	gotMessages, err := suite.db.GetPendingAndSignedMessages(chain)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), messages, gotMessages)
+}
+
+func (suite *MessageTestSuite) TestGetPausedMessages() {
+	chain := models.Chain{ChainDomain: 1}
+	messages := []models.Message{
+		{ID: &primitive.ObjectID{}, Status: models.MessageStatusDestinationPaused},
+	}
+	filter := bson.M{
+		"content.destination_domain": chain.ChainDomain,
+		"status":                     models.MessageStatusDestinationPaused,
+	}
+	sort := bson.M{"content.nonce": 1}
+
+	suite.mockDB.EXPECT().FindManySorted(common.CollectionMessages, filter, sort, &[]models.Message{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(3).(*[]models.Message)
+		*arg = messages
+	})
+
+	gotMessages, err := suite.db.GetPausedMessages(chain)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), messages, gotMessages)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func (suite *MessageTestSuite) TestGetBroadcastedMessages() {
 	chain := models.Chain{ChainDomain: 1}
 	messages := []models.Message{
@@ -400,6 +640,92 @@ func (suite *MessageTestSuite) TestGetBroadcastedMessages() {
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
+func (suite *MessageTestSuite) TestGetInFlightMessages() {
+	messages := []models.Message{
+		{
+			ID:     &primitive.ObjectID{},
+			Status: models.MessageStatusPending,
+		},
+	}
+	filter := bson.M{
+		"status": bson.M{"$nin": []models.MessageStatus{
+			models.MessageStatusSuccess,
+			models.MessageStatusInvalid,
+			models.MessageStatusReorged,
+		}},
+	}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionMessages, filter, &[]models.Message{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(2).(*[]models.Message)
+		*arg = messages
+	})
+
+	gotMessages, err := suite.db.GetInFlightMessages()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), messages, gotMessages)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestGetFulfillments() {
+	chain := models.Chain{ChainDomain: 1}
+	submitter := "0x0000000000000000000000000000000000000001"
+	since := time.Unix(1700000000, 0)
+	messages := []models.Message{
+		{
+			ID:           &primitive.ObjectID{},
+			Status:       models.MessageStatusBroadcasted,
+			Fulfillments: []models.Fulfillment{{Submitter: submitter, SubmittedAt: since}},
+		},
+	}
+	filter := bson.M{
+		"content.destination_domain": chain.ChainDomain,
+		"fulfillments": bson.M{"$elemMatch": bson.M{
+			"submitter":    submitter,
+			"submitted_at": bson.M{"$gte": since},
+		}},
+	}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionMessages, filter, &[]models.Message{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(2).(*[]models.Message)
+		*arg = messages
+	})
+
+	gotMessages, err := suite.db.GetFulfillments(chain, submitter, since)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), messages, gotMessages)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *MessageTestSuite) TestGetDispatchedNonces() {
+	chain := models.Chain{ChainDomain: 1}
+	nonces := []models.DispatchedNonce{
+		{Nonce: 5, MessageID: "0x05", BlockHeight: 100},
+		{Nonce: 7, MessageID: "0x07", BlockHeight: 110},
+	}
+
+	suite.mockDB.EXPECT().AggregateMany(common.CollectionMessages, mock.MatchedBy(func(pipeline mongo.Pipeline) bool {
+		match := pipeline[0][0].Value.(bson.M)
+		return match["content.origin_domain"] == chain.ChainDomain &&
+			match["content.nonce"].(bson.M)["$gte"] == uint32(5)
+	}), &[]models.DispatchedNonce{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(2).(*[]models.DispatchedNonce)
+		*arg = nonces
+	})
+
+	gotNonces, err := suite.db.GetDispatchedNonces(chain, 5)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), nonces, gotNonces)
+}
+
+func (suite *MessageTestSuite) TestGetDispatchedNonces_Error() {
+	chain := models.Chain{ChainDomain: 1}
+
+	suite.mockDB.EXPECT().AggregateMany(common.CollectionMessages, mock.Anything, mock.Anything).Return(fmt.Errorf("error")).Once()
+
+	_, err := suite.db.GetDispatchedNonces(chain, 0)
+	assert.Error(suite.T(), err)
+}
+
 func TestMessageTestSuite(t *testing.T) {
 	suite.Run(t, new(MessageTestSuite))
 }
@@ -351,6 +351,102 @@ func (_c *MockDatabase_FindOne_Call) RunAndReturn(run func(string, interface{},
 	return _c
 }
 
+// FindOneAndUpdate provides a mock function with given fields: collection, filter, update, result
+func (_m *MockDatabase) FindOneAndUpdate(collection string, filter interface{}, update interface{}, result interface{}) error {
+	ret := _m.Called(collection, filter, update, result)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindOneAndUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}, interface{}) error); ok {
+		r0 = rf(collection, filter, update, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_FindOneAndUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOneAndUpdate'
+type MockDatabase_FindOneAndUpdate_Call struct {
+	*mock.Call
+}
+
+// FindOneAndUpdate is a helper method to define mock.On call
+//   - collection string
+//   - filter interface{}
+//   - update interface{}
+//   - result interface{}
+func (_e *MockDatabase_Expecter) FindOneAndUpdate(collection interface{}, filter interface{}, update interface{}, result interface{}) *MockDatabase_FindOneAndUpdate_Call {
+	return &MockDatabase_FindOneAndUpdate_Call{Call: _e.mock.On("FindOneAndUpdate", collection, filter, update, result)}
+}
+
+func (_c *MockDatabase_FindOneAndUpdate_Call) Run(run func(collection string, filter interface{}, update interface{}, result interface{})) *MockDatabase_FindOneAndUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}), args[2].(interface{}), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_FindOneAndUpdate_Call) Return(_a0 error) *MockDatabase_FindOneAndUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_FindOneAndUpdate_Call) RunAndReturn(run func(string, interface{}, interface{}, interface{}) error) *MockDatabase_FindOneAndUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertMany provides a mock function with given fields: collection, data
+func (_m *MockDatabase) InsertMany(collection string, data []interface{}) error {
+	ret := _m.Called(collection, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []interface{}) error); ok {
+		r0 = rf(collection, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDatabase_InsertMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertMany'
+type MockDatabase_InsertMany_Call struct {
+	*mock.Call
+}
+
+// InsertMany is a helper method to define mock.On call
+//   - collection string
+//   - data []interface{}
+func (_e *MockDatabase_Expecter) InsertMany(collection interface{}, data interface{}) *MockDatabase_InsertMany_Call {
+	return &MockDatabase_InsertMany_Call{Call: _e.mock.On("InsertMany", collection, data)}
+}
+
+func (_c *MockDatabase_InsertMany_Call) Run(run func(collection string, data []interface{})) *MockDatabase_InsertMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_InsertMany_Call) Return(_a0 error) *MockDatabase_InsertMany_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDatabase_InsertMany_Call) RunAndReturn(run func(string, []interface{}) error) *MockDatabase_InsertMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertOne provides a mock function with given fields: collection, data
 func (_m *MockDatabase) InsertOne(collection string, data interface{}) (primitive.ObjectID, error) {
 	ret := _m.Called(collection, data)
@@ -512,6 +608,64 @@ func (_c *MockDatabase_Unlock_Call) RunAndReturn(run func(string) error) *MockDa
 	return _c
 }
 
+// UpdateMany provides a mock function with given fields: collection, filter, update
+func (_m *MockDatabase) UpdateMany(collection string, filter interface{}, update interface{}) (int64, error) {
+	ret := _m.Called(collection, filter, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMany")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) (int64, error)); ok {
+		return rf(collection, filter, update)
+	}
+	if rf, ok := ret.Get(0).(func(string, interface{}, interface{}) int64); ok {
+		r0 = rf(collection, filter, update)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, interface{}, interface{}) error); ok {
+		r1 = rf(collection, filter, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDatabase_UpdateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMany'
+type MockDatabase_UpdateMany_Call struct {
+	*mock.Call
+}
+
+// UpdateMany is a helper method to define mock.On call
+//   - collection string
+//   - filter interface{}
+//   - update interface{}
+func (_e *MockDatabase_Expecter) UpdateMany(collection interface{}, filter interface{}, update interface{}) *MockDatabase_UpdateMany_Call {
+	return &MockDatabase_UpdateMany_Call{Call: _e.mock.On("UpdateMany", collection, filter, update)}
+}
+
+func (_c *MockDatabase_UpdateMany_Call) Run(run func(collection string, filter interface{}, update interface{})) *MockDatabase_UpdateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockDatabase_UpdateMany_Call) Return(_a0 int64, _a1 error) *MockDatabase_UpdateMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDatabase_UpdateMany_Call) RunAndReturn(run func(string, interface{}, interface{}) (int64, error)) *MockDatabase_UpdateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateOne provides a mock function with given fields: collection, filter, update
 func (_m *MockDatabase) UpdateOne(collection string, filter interface{}, update interface{}) (primitive.ObjectID, error) {
 	ret := _m.Called(collection, filter, update)
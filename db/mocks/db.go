@@ -13,6 +13,8 @@ import (
 
 	primitive "go.mongodb.org/mongo-driver/bson/primitive"
 
+	time "time"
+
 	types "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -29,6 +31,65 @@ func (_m *MockDB) EXPECT() *MockDB_Expecter {
 	return &MockDB_Expecter{mock: &_m.Mock}
 }
 
+// FindCheckpoint provides a mock function with given fields: chain, runner
+func (_m *MockDB) FindCheckpoint(chain models.Chain, runner string) (*models.Checkpoint, error) {
+	ret := _m.Called(chain, runner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCheckpoint")
+	}
+
+	var r0 *models.Checkpoint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain, string) (*models.Checkpoint, error)); ok {
+		return rf(chain, runner)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain, string) *models.Checkpoint); ok {
+		r0 = rf(chain, runner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Checkpoint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain, string) error); ok {
+		r1 = rf(chain, runner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_FindCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCheckpoint'
+type MockDB_FindCheckpoint_Call struct {
+	*mock.Call
+}
+
+// FindCheckpoint is a helper method to define mock.On call
+//   - chain models.Chain
+//   - runner string
+func (_e *MockDB_Expecter) FindCheckpoint(chain interface{}, runner interface{}) *MockDB_FindCheckpoint_Call {
+	return &MockDB_FindCheckpoint_Call{Call: _e.mock.On("FindCheckpoint", chain, runner)}
+}
+
+func (_c *MockDB_FindCheckpoint_Call) Run(run func(chain models.Chain, runner string)) *MockDB_FindCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_FindCheckpoint_Call) Return(_a0 *models.Checkpoint, _a1 error) *MockDB_FindCheckpoint_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_FindCheckpoint_Call) RunAndReturn(run func(models.Chain, string) (*models.Checkpoint, error)) *MockDB_FindCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindMaxSequence provides a mock function with given fields: chain
 func (_m *MockDB) FindMaxSequence(chain models.Chain) (*uint64, error) {
 	ret := _m.Called(chain)
@@ -201,6 +262,62 @@ func (_c *MockDB_FindNode_Call) RunAndReturn(run func(interface{}) (*models.Node
 	return _c
 }
 
+// FindRefund provides a mock function with given fields: filter
+func (_m *MockDB) FindRefund(filter primitive.M) (models.Refund, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRefund")
+	}
+
+	var r0 models.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(primitive.M) (models.Refund, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(primitive.M) models.Refund); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(models.Refund)
+	}
+
+	if rf, ok := ret.Get(1).(func(primitive.M) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_FindRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRefund'
+type MockDB_FindRefund_Call struct {
+	*mock.Call
+}
+
+// FindRefund is a helper method to define mock.On call
+//   - filter primitive.M
+func (_e *MockDB_Expecter) FindRefund(filter interface{}) *MockDB_FindRefund_Call {
+	return &MockDB_FindRefund_Call{Call: _e.mock.On("FindRefund", filter)}
+}
+
+func (_c *MockDB_FindRefund_Call) Run(run func(filter primitive.M)) *MockDB_FindRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(primitive.M))
+	})
+	return _c
+}
+
+func (_c *MockDB_FindRefund_Call) Return(_a0 models.Refund, _a1 error) *MockDB_FindRefund_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_FindRefund_Call) RunAndReturn(run func(primitive.M) (models.Refund, error)) *MockDB_FindRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBroadcastedMessages provides a mock function with given fields: chain
 func (_m *MockDB) GetBroadcastedMessages(chain models.Chain) ([]models.Message, error) {
 	ret := _m.Called(chain)
@@ -375,29 +492,88 @@ func (_c *MockDB_GetConfirmedTransactionsTo_Call) RunAndReturn(run func(models.C
 	return _c
 }
 
-// GetPendingMessages provides a mock function with given fields: signerToExclude, chain
-func (_m *MockDB) GetPendingMessages(signerToExclude string, chain models.Chain) ([]models.Message, error) {
-	ret := _m.Called(signerToExclude, chain)
+// GetDispatchedNonces provides a mock function with given fields: chain, fromNonce
+func (_m *MockDB) GetDispatchedNonces(chain models.Chain, fromNonce uint32) ([]models.DispatchedNonce, error) {
+	ret := _m.Called(chain, fromNonce)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPendingMessages")
+		panic("no return value specified for GetDispatchedNonces")
+	}
+
+	var r0 []models.DispatchedNonce
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain, uint32) ([]models.DispatchedNonce, error)); ok {
+		return rf(chain, fromNonce)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain, uint32) []models.DispatchedNonce); ok {
+		r0 = rf(chain, fromNonce)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DispatchedNonce)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain, uint32) error); ok {
+		r1 = rf(chain, fromNonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetDispatchedNonces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDispatchedNonces'
+type MockDB_GetDispatchedNonces_Call struct {
+	*mock.Call
+}
+
+// GetDispatchedNonces is a helper method to define mock.On call
+//   - chain models.Chain
+//   - fromNonce uint32
+func (_e *MockDB_Expecter) GetDispatchedNonces(chain interface{}, fromNonce interface{}) *MockDB_GetDispatchedNonces_Call {
+	return &MockDB_GetDispatchedNonces_Call{Call: _e.mock.On("GetDispatchedNonces", chain, fromNonce)}
+}
+
+func (_c *MockDB_GetDispatchedNonces_Call) Run(run func(chain models.Chain, fromNonce uint32)) *MockDB_GetDispatchedNonces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].(uint32))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetDispatchedNonces_Call) Return(_a0 []models.DispatchedNonce, _a1 error) *MockDB_GetDispatchedNonces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetDispatchedNonces_Call) RunAndReturn(run func(models.Chain, uint32) ([]models.DispatchedNonce, error)) *MockDB_GetDispatchedNonces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFulfillments provides a mock function with given fields: chain, submitter, since
+func (_m *MockDB) GetFulfillments(chain models.Chain, submitter string, since time.Time) ([]models.Message, error) {
+	ret := _m.Called(chain, submitter, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFulfillments")
 	}
 
 	var r0 []models.Message
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, models.Chain) ([]models.Message, error)); ok {
-		return rf(signerToExclude, chain)
+	if rf, ok := ret.Get(0).(func(models.Chain, string, time.Time) ([]models.Message, error)); ok {
+		return rf(chain, submitter, since)
 	}
-	if rf, ok := ret.Get(0).(func(string, models.Chain) []models.Message); ok {
-		r0 = rf(signerToExclude, chain)
+	if rf, ok := ret.Get(0).(func(models.Chain, string, time.Time) []models.Message); ok {
+		r0 = rf(chain, submitter, since)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Message)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, models.Chain) error); ok {
-		r1 = rf(signerToExclude, chain)
+	if rf, ok := ret.Get(1).(func(models.Chain, string, time.Time) error); ok {
+		r1 = rf(chain, submitter, since)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -405,58 +581,59 @@ func (_m *MockDB) GetPendingMessages(signerToExclude string, chain models.Chain)
 	return r0, r1
 }
 
-// MockDB_GetPendingMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingMessages'
-type MockDB_GetPendingMessages_Call struct {
+// MockDB_GetFulfillments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFulfillments'
+type MockDB_GetFulfillments_Call struct {
 	*mock.Call
 }
 
-// GetPendingMessages is a helper method to define mock.On call
-//   - signerToExclude string
+// GetFulfillments is a helper method to define mock.On call
 //   - chain models.Chain
-func (_e *MockDB_Expecter) GetPendingMessages(signerToExclude interface{}, chain interface{}) *MockDB_GetPendingMessages_Call {
-	return &MockDB_GetPendingMessages_Call{Call: _e.mock.On("GetPendingMessages", signerToExclude, chain)}
+//   - submitter string
+//   - since time.Time
+func (_e *MockDB_Expecter) GetFulfillments(chain interface{}, submitter interface{}, since interface{}) *MockDB_GetFulfillments_Call {
+	return &MockDB_GetFulfillments_Call{Call: _e.mock.On("GetFulfillments", chain, submitter, since)}
 }
 
-func (_c *MockDB_GetPendingMessages_Call) Run(run func(signerToExclude string, chain models.Chain)) *MockDB_GetPendingMessages_Call {
+func (_c *MockDB_GetFulfillments_Call) Run(run func(chain models.Chain, submitter string, since time.Time)) *MockDB_GetFulfillments_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(models.Chain))
+		run(args[0].(models.Chain), args[1].(string), args[2].(time.Time))
 	})
 	return _c
 }
 
-func (_c *MockDB_GetPendingMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetPendingMessages_Call {
+func (_c *MockDB_GetFulfillments_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetFulfillments_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDB_GetPendingMessages_Call) RunAndReturn(run func(string, models.Chain) ([]models.Message, error)) *MockDB_GetPendingMessages_Call {
+func (_c *MockDB_GetFulfillments_Call) RunAndReturn(run func(models.Chain, string, time.Time) ([]models.Message, error)) *MockDB_GetFulfillments_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPendingRefunds provides a mock function with given fields: signerToExclude
-func (_m *MockDB) GetPendingRefunds(signerToExclude string) ([]models.Refund, error) {
-	ret := _m.Called(signerToExclude)
+// GetHeldMessages provides a mock function with given fields: signerToExclude, chain
+func (_m *MockDB) GetHeldMessages(signerToExclude string, chain models.Chain) ([]models.Message, error) {
+	ret := _m.Called(signerToExclude, chain)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPendingRefunds")
+		panic("no return value specified for GetHeldMessages")
 	}
 
-	var r0 []models.Refund
+	var r0 []models.Message
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]models.Refund, error)); ok {
-		return rf(signerToExclude)
+	if rf, ok := ret.Get(0).(func(string, models.Chain) ([]models.Message, error)); ok {
+		return rf(signerToExclude, chain)
 	}
-	if rf, ok := ret.Get(0).(func(string) []models.Refund); ok {
-		r0 = rf(signerToExclude)
+	if rf, ok := ret.Get(0).(func(string, models.Chain) []models.Message); ok {
+		r0 = rf(signerToExclude, chain)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Refund)
+			r0 = ret.Get(0).([]models.Message)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(signerToExclude)
+	if rf, ok := ret.Get(1).(func(string, models.Chain) error); ok {
+		r1 = rf(signerToExclude, chain)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -464,57 +641,58 @@ func (_m *MockDB) GetPendingRefunds(signerToExclude string) ([]models.Refund, er
 	return r0, r1
 }
 
-// MockDB_GetPendingRefunds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingRefunds'
-type MockDB_GetPendingRefunds_Call struct {
+// MockDB_GetHeldMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeldMessages'
+type MockDB_GetHeldMessages_Call struct {
 	*mock.Call
 }
 
-// GetPendingRefunds is a helper method to define mock.On call
+// GetHeldMessages is a helper method to define mock.On call
 //   - signerToExclude string
-func (_e *MockDB_Expecter) GetPendingRefunds(signerToExclude interface{}) *MockDB_GetPendingRefunds_Call {
-	return &MockDB_GetPendingRefunds_Call{Call: _e.mock.On("GetPendingRefunds", signerToExclude)}
+//   - chain models.Chain
+func (_e *MockDB_Expecter) GetHeldMessages(signerToExclude interface{}, chain interface{}) *MockDB_GetHeldMessages_Call {
+	return &MockDB_GetHeldMessages_Call{Call: _e.mock.On("GetHeldMessages", signerToExclude, chain)}
 }
 
-func (_c *MockDB_GetPendingRefunds_Call) Run(run func(signerToExclude string)) *MockDB_GetPendingRefunds_Call {
+func (_c *MockDB_GetHeldMessages_Call) Run(run func(signerToExclude string, chain models.Chain)) *MockDB_GetHeldMessages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(string), args[1].(models.Chain))
 	})
 	return _c
 }
 
-func (_c *MockDB_GetPendingRefunds_Call) Return(_a0 []models.Refund, _a1 error) *MockDB_GetPendingRefunds_Call {
+func (_c *MockDB_GetHeldMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetHeldMessages_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDB_GetPendingRefunds_Call) RunAndReturn(run func(string) ([]models.Refund, error)) *MockDB_GetPendingRefunds_Call {
+func (_c *MockDB_GetHeldMessages_Call) RunAndReturn(run func(string, models.Chain) ([]models.Message, error)) *MockDB_GetHeldMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPendingTransactionsFrom provides a mock function with given fields: chain, fromAddress
-func (_m *MockDB) GetPendingTransactionsFrom(chain models.Chain, fromAddress []byte) ([]models.Transaction, error) {
-	ret := _m.Called(chain, fromAddress)
+// GetInFlightMessages provides a mock function with given fields:
+func (_m *MockDB) GetInFlightMessages() ([]models.Message, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPendingTransactionsFrom")
+		panic("no return value specified for GetInFlightMessages")
 	}
 
-	var r0 []models.Transaction
+	var r0 []models.Message
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Chain, []byte) ([]models.Transaction, error)); ok {
-		return rf(chain, fromAddress)
+	if rf, ok := ret.Get(0).(func() ([]models.Message, error)); ok {
+		return rf()
 	}
-	if rf, ok := ret.Get(0).(func(models.Chain, []byte) []models.Transaction); ok {
-		r0 = rf(chain, fromAddress)
+	if rf, ok := ret.Get(0).(func() []models.Message); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Transaction)
+			r0 = ret.Get(0).([]models.Message)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Chain, []byte) error); ok {
-		r1 = rf(chain, fromAddress)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -522,58 +700,56 @@ func (_m *MockDB) GetPendingTransactionsFrom(chain models.Chain, fromAddress []b
 	return r0, r1
 }
 
-// MockDB_GetPendingTransactionsFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingTransactionsFrom'
-type MockDB_GetPendingTransactionsFrom_Call struct {
+// MockDB_GetInFlightMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInFlightMessages'
+type MockDB_GetInFlightMessages_Call struct {
 	*mock.Call
 }
 
-// GetPendingTransactionsFrom is a helper method to define mock.On call
-//   - chain models.Chain
-//   - fromAddress []byte
-func (_e *MockDB_Expecter) GetPendingTransactionsFrom(chain interface{}, fromAddress interface{}) *MockDB_GetPendingTransactionsFrom_Call {
-	return &MockDB_GetPendingTransactionsFrom_Call{Call: _e.mock.On("GetPendingTransactionsFrom", chain, fromAddress)}
+// GetInFlightMessages is a helper method to define mock.On call
+func (_e *MockDB_Expecter) GetInFlightMessages() *MockDB_GetInFlightMessages_Call {
+	return &MockDB_GetInFlightMessages_Call{Call: _e.mock.On("GetInFlightMessages")}
 }
 
-func (_c *MockDB_GetPendingTransactionsFrom_Call) Run(run func(chain models.Chain, fromAddress []byte)) *MockDB_GetPendingTransactionsFrom_Call {
+func (_c *MockDB_GetInFlightMessages_Call) Run(run func()) *MockDB_GetInFlightMessages_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Chain), args[1].([]byte))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockDB_GetPendingTransactionsFrom_Call) Return(_a0 []models.Transaction, _a1 error) *MockDB_GetPendingTransactionsFrom_Call {
+func (_c *MockDB_GetInFlightMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetInFlightMessages_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDB_GetPendingTransactionsFrom_Call) RunAndReturn(run func(models.Chain, []byte) ([]models.Transaction, error)) *MockDB_GetPendingTransactionsFrom_Call {
+func (_c *MockDB_GetInFlightMessages_Call) RunAndReturn(run func() ([]models.Message, error)) *MockDB_GetInFlightMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPendingTransactionsTo provides a mock function with given fields: chain, toAddress
-func (_m *MockDB) GetPendingTransactionsTo(chain models.Chain, toAddress []byte) ([]models.Transaction, error) {
-	ret := _m.Called(chain, toAddress)
+// GetInFlightRefunds provides a mock function with given fields:
+func (_m *MockDB) GetInFlightRefunds() ([]models.Refund, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPendingTransactionsTo")
+		panic("no return value specified for GetInFlightRefunds")
 	}
 
-	var r0 []models.Transaction
+	var r0 []models.Refund
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Chain, []byte) ([]models.Transaction, error)); ok {
-		return rf(chain, toAddress)
+	if rf, ok := ret.Get(0).(func() ([]models.Refund, error)); ok {
+		return rf()
 	}
-	if rf, ok := ret.Get(0).(func(models.Chain, []byte) []models.Transaction); ok {
-		r0 = rf(chain, toAddress)
+	if rf, ok := ret.Get(0).(func() []models.Refund); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Transaction)
+			r0 = ret.Get(0).([]models.Refund)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Chain, []byte) error); ok {
-		r1 = rf(chain, toAddress)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -581,41 +757,39 @@ func (_m *MockDB) GetPendingTransactionsTo(chain models.Chain, toAddress []byte)
 	return r0, r1
 }
 
-// MockDB_GetPendingTransactionsTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingTransactionsTo'
-type MockDB_GetPendingTransactionsTo_Call struct {
+// MockDB_GetInFlightRefunds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInFlightRefunds'
+type MockDB_GetInFlightRefunds_Call struct {
 	*mock.Call
 }
 
-// GetPendingTransactionsTo is a helper method to define mock.On call
-//   - chain models.Chain
-//   - toAddress []byte
-func (_e *MockDB_Expecter) GetPendingTransactionsTo(chain interface{}, toAddress interface{}) *MockDB_GetPendingTransactionsTo_Call {
-	return &MockDB_GetPendingTransactionsTo_Call{Call: _e.mock.On("GetPendingTransactionsTo", chain, toAddress)}
+// GetInFlightRefunds is a helper method to define mock.On call
+func (_e *MockDB_Expecter) GetInFlightRefunds() *MockDB_GetInFlightRefunds_Call {
+	return &MockDB_GetInFlightRefunds_Call{Call: _e.mock.On("GetInFlightRefunds")}
 }
 
-func (_c *MockDB_GetPendingTransactionsTo_Call) Run(run func(chain models.Chain, toAddress []byte)) *MockDB_GetPendingTransactionsTo_Call {
+func (_c *MockDB_GetInFlightRefunds_Call) Run(run func()) *MockDB_GetInFlightRefunds_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Chain), args[1].([]byte))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockDB_GetPendingTransactionsTo_Call) Return(_a0 []models.Transaction, _a1 error) *MockDB_GetPendingTransactionsTo_Call {
+func (_c *MockDB_GetInFlightRefunds_Call) Return(_a0 []models.Refund, _a1 error) *MockDB_GetInFlightRefunds_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDB_GetPendingTransactionsTo_Call) RunAndReturn(run func(models.Chain, []byte) ([]models.Transaction, error)) *MockDB_GetPendingTransactionsTo_Call {
+func (_c *MockDB_GetInFlightRefunds_Call) RunAndReturn(run func() ([]models.Refund, error)) *MockDB_GetInFlightRefunds_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSignedMessages provides a mock function with given fields: chain
-func (_m *MockDB) GetSignedMessages(chain models.Chain) ([]models.Message, error) {
+// GetPausedMessages provides a mock function with given fields: chain
+func (_m *MockDB) GetPausedMessages(chain models.Chain) ([]models.Message, error) {
 	ret := _m.Called(chain)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSignedMessages")
+		panic("no return value specified for GetPausedMessages")
 	}
 
 	var r0 []models.Message
@@ -631,96 +805,717 @@ func (_m *MockDB) GetSignedMessages(chain models.Chain) ([]models.Message, error
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Chain) error); ok {
-		r1 = rf(chain)
+	if rf, ok := ret.Get(1).(func(models.Chain) error); ok {
+		r1 = rf(chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPausedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPausedMessages'
+type MockDB_GetPausedMessages_Call struct {
+	*mock.Call
+}
+
+// GetPausedMessages is a helper method to define mock.On call
+//   - chain models.Chain
+func (_e *MockDB_Expecter) GetPausedMessages(chain interface{}) *MockDB_GetPausedMessages_Call {
+	return &MockDB_GetPausedMessages_Call{Call: _e.mock.On("GetPausedMessages", chain)}
+}
+
+func (_c *MockDB_GetPausedMessages_Call) Run(run func(chain models.Chain)) *MockDB_GetPausedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPausedMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetPausedMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPausedMessages_Call) RunAndReturn(run func(models.Chain) ([]models.Message, error)) *MockDB_GetPausedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingAndSignedMessages provides a mock function with given fields: chain
+func (_m *MockDB) GetPendingAndSignedMessages(chain models.Chain) ([]models.Message, error) {
+	ret := _m.Called(chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingAndSignedMessages")
+	}
+
+	var r0 []models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain) ([]models.Message, error)); ok {
+		return rf(chain)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain) []models.Message); ok {
+		r0 = rf(chain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain) error); ok {
+		r1 = rf(chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPendingAndSignedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingAndSignedMessages'
+type MockDB_GetPendingAndSignedMessages_Call struct {
+	*mock.Call
+}
+
+// GetPendingAndSignedMessages is a helper method to define mock.On call
+//   - chain models.Chain
+func (_e *MockDB_Expecter) GetPendingAndSignedMessages(chain interface{}) *MockDB_GetPendingAndSignedMessages_Call {
+	return &MockDB_GetPendingAndSignedMessages_Call{Call: _e.mock.On("GetPendingAndSignedMessages", chain)}
+}
+
+func (_c *MockDB_GetPendingAndSignedMessages_Call) Run(run func(chain models.Chain)) *MockDB_GetPendingAndSignedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPendingAndSignedMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetPendingAndSignedMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPendingAndSignedMessages_Call) RunAndReturn(run func(models.Chain) ([]models.Message, error)) *MockDB_GetPendingAndSignedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingMessages provides a mock function with given fields: signerToExclude, chain
+func (_m *MockDB) GetPendingMessages(signerToExclude string, chain models.Chain) ([]models.Message, error) {
+	ret := _m.Called(signerToExclude, chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingMessages")
+	}
+
+	var r0 []models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.Chain) ([]models.Message, error)); ok {
+		return rf(signerToExclude, chain)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.Chain) []models.Message); ok {
+		r0 = rf(signerToExclude, chain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.Chain) error); ok {
+		r1 = rf(signerToExclude, chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPendingMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingMessages'
+type MockDB_GetPendingMessages_Call struct {
+	*mock.Call
+}
+
+// GetPendingMessages is a helper method to define mock.On call
+//   - signerToExclude string
+//   - chain models.Chain
+func (_e *MockDB_Expecter) GetPendingMessages(signerToExclude interface{}, chain interface{}) *MockDB_GetPendingMessages_Call {
+	return &MockDB_GetPendingMessages_Call{Call: _e.mock.On("GetPendingMessages", signerToExclude, chain)}
+}
+
+func (_c *MockDB_GetPendingMessages_Call) Run(run func(signerToExclude string, chain models.Chain)) *MockDB_GetPendingMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(models.Chain))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPendingMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetPendingMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPendingMessages_Call) RunAndReturn(run func(string, models.Chain) ([]models.Message, error)) *MockDB_GetPendingMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingRefunds provides a mock function with given fields: signerToExclude
+func (_m *MockDB) GetPendingRefunds(signerToExclude string) ([]models.Refund, error) {
+	ret := _m.Called(signerToExclude)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingRefunds")
+	}
+
+	var r0 []models.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Refund, error)); ok {
+		return rf(signerToExclude)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Refund); ok {
+		r0 = rf(signerToExclude)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(signerToExclude)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPendingRefunds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingRefunds'
+type MockDB_GetPendingRefunds_Call struct {
+	*mock.Call
+}
+
+// GetPendingRefunds is a helper method to define mock.On call
+//   - signerToExclude string
+func (_e *MockDB_Expecter) GetPendingRefunds(signerToExclude interface{}) *MockDB_GetPendingRefunds_Call {
+	return &MockDB_GetPendingRefunds_Call{Call: _e.mock.On("GetPendingRefunds", signerToExclude)}
+}
+
+func (_c *MockDB_GetPendingRefunds_Call) Run(run func(signerToExclude string)) *MockDB_GetPendingRefunds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPendingRefunds_Call) Return(_a0 []models.Refund, _a1 error) *MockDB_GetPendingRefunds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPendingRefunds_Call) RunAndReturn(run func(string) ([]models.Refund, error)) *MockDB_GetPendingRefunds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingTransactionsFrom provides a mock function with given fields: chain, fromAddress
+func (_m *MockDB) GetPendingTransactionsFrom(chain models.Chain, fromAddress []byte) ([]models.Transaction, error) {
+	ret := _m.Called(chain, fromAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTransactionsFrom")
+	}
+
+	var r0 []models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte) ([]models.Transaction, error)); ok {
+		return rf(chain, fromAddress)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte) []models.Transaction); ok {
+		r0 = rf(chain, fromAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain, []byte) error); ok {
+		r1 = rf(chain, fromAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPendingTransactionsFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingTransactionsFrom'
+type MockDB_GetPendingTransactionsFrom_Call struct {
+	*mock.Call
+}
+
+// GetPendingTransactionsFrom is a helper method to define mock.On call
+//   - chain models.Chain
+//   - fromAddress []byte
+func (_e *MockDB_Expecter) GetPendingTransactionsFrom(chain interface{}, fromAddress interface{}) *MockDB_GetPendingTransactionsFrom_Call {
+	return &MockDB_GetPendingTransactionsFrom_Call{Call: _e.mock.On("GetPendingTransactionsFrom", chain, fromAddress)}
+}
+
+func (_c *MockDB_GetPendingTransactionsFrom_Call) Run(run func(chain models.Chain, fromAddress []byte)) *MockDB_GetPendingTransactionsFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPendingTransactionsFrom_Call) Return(_a0 []models.Transaction, _a1 error) *MockDB_GetPendingTransactionsFrom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPendingTransactionsFrom_Call) RunAndReturn(run func(models.Chain, []byte) ([]models.Transaction, error)) *MockDB_GetPendingTransactionsFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingTransactionsTo provides a mock function with given fields: chain, toAddress
+func (_m *MockDB) GetPendingTransactionsTo(chain models.Chain, toAddress []byte) ([]models.Transaction, error) {
+	ret := _m.Called(chain, toAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingTransactionsTo")
+	}
+
+	var r0 []models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte) ([]models.Transaction, error)); ok {
+		return rf(chain, toAddress)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte) []models.Transaction); ok {
+		r0 = rf(chain, toAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain, []byte) error); ok {
+		r1 = rf(chain, toAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetPendingTransactionsTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingTransactionsTo'
+type MockDB_GetPendingTransactionsTo_Call struct {
+	*mock.Call
+}
+
+// GetPendingTransactionsTo is a helper method to define mock.On call
+//   - chain models.Chain
+//   - toAddress []byte
+func (_e *MockDB_Expecter) GetPendingTransactionsTo(chain interface{}, toAddress interface{}) *MockDB_GetPendingTransactionsTo_Call {
+	return &MockDB_GetPendingTransactionsTo_Call{Call: _e.mock.On("GetPendingTransactionsTo", chain, toAddress)}
+}
+
+func (_c *MockDB_GetPendingTransactionsTo_Call) Run(run func(chain models.Chain, toAddress []byte)) *MockDB_GetPendingTransactionsTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetPendingTransactionsTo_Call) Return(_a0 []models.Transaction, _a1 error) *MockDB_GetPendingTransactionsTo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetPendingTransactionsTo_Call) RunAndReturn(run func(models.Chain, []byte) ([]models.Transaction, error)) *MockDB_GetPendingTransactionsTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecentTransactionsTo provides a mock function with given fields: chain, toAddress, fromBlockHeight
+func (_m *MockDB) GetRecentTransactionsTo(chain models.Chain, toAddress []byte, fromBlockHeight uint64) ([]models.Transaction, error) {
+	ret := _m.Called(chain, toAddress, fromBlockHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentTransactionsTo")
+	}
+
+	var r0 []models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte, uint64) ([]models.Transaction, error)); ok {
+		return rf(chain, toAddress, fromBlockHeight)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte, uint64) []models.Transaction); ok {
+		r0 = rf(chain, toAddress, fromBlockHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain, []byte, uint64) error); ok {
+		r1 = rf(chain, toAddress, fromBlockHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetRecentTransactionsTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentTransactionsTo'
+type MockDB_GetRecentTransactionsTo_Call struct {
+	*mock.Call
+}
+
+// GetRecentTransactionsTo is a helper method to define mock.On call
+//   - chain models.Chain
+//   - toAddress []byte
+//   - fromBlockHeight uint64
+func (_e *MockDB_Expecter) GetRecentTransactionsTo(chain interface{}, toAddress interface{}, fromBlockHeight interface{}) *MockDB_GetRecentTransactionsTo_Call {
+	return &MockDB_GetRecentTransactionsTo_Call{Call: _e.mock.On("GetRecentTransactionsTo", chain, toAddress, fromBlockHeight)}
+}
+
+func (_c *MockDB_GetRecentTransactionsTo_Call) Run(run func(chain models.Chain, toAddress []byte, fromBlockHeight uint64)) *MockDB_GetRecentTransactionsTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].([]byte), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetRecentTransactionsTo_Call) Return(_a0 []models.Transaction, _a1 error) *MockDB_GetRecentTransactionsTo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetRecentTransactionsTo_Call) RunAndReturn(run func(models.Chain, []byte, uint64) ([]models.Transaction, error)) *MockDB_GetRecentTransactionsTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSignedMessages provides a mock function with given fields: chain
+func (_m *MockDB) GetSignedMessages(chain models.Chain) ([]models.Message, error) {
+	ret := _m.Called(chain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignedMessages")
+	}
+
+	var r0 []models.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain) ([]models.Message, error)); ok {
+		return rf(chain)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain) []models.Message); ok {
+		r0 = rf(chain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain) error); ok {
+		r1 = rf(chain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetSignedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedMessages'
+type MockDB_GetSignedMessages_Call struct {
+	*mock.Call
+}
+
+// GetSignedMessages is a helper method to define mock.On call
+//   - chain models.Chain
+func (_e *MockDB_Expecter) GetSignedMessages(chain interface{}) *MockDB_GetSignedMessages_Call {
+	return &MockDB_GetSignedMessages_Call{Call: _e.mock.On("GetSignedMessages", chain)}
+}
+
+func (_c *MockDB_GetSignedMessages_Call) Run(run func(chain models.Chain)) *MockDB_GetSignedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetSignedMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetSignedMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetSignedMessages_Call) RunAndReturn(run func(models.Chain) ([]models.Message, error)) *MockDB_GetSignedMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSignedRefunds provides a mock function with given fields:
+func (_m *MockDB) GetSignedRefunds() ([]models.Refund, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignedRefunds")
+	}
+
+	var r0 []models.Refund
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Refund, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Refund); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Refund)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetSignedRefunds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedRefunds'
+type MockDB_GetSignedRefunds_Call struct {
+	*mock.Call
+}
+
+// GetSignedRefunds is a helper method to define mock.On call
+func (_e *MockDB_Expecter) GetSignedRefunds() *MockDB_GetSignedRefunds_Call {
+	return &MockDB_GetSignedRefunds_Call{Call: _e.mock.On("GetSignedRefunds")}
+}
+
+func (_c *MockDB_GetSignedRefunds_Call) Run(run func()) *MockDB_GetSignedRefunds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDB_GetSignedRefunds_Call) Return(_a0 []models.Refund, _a1 error) *MockDB_GetSignedRefunds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetSignedRefunds_Call) RunAndReturn(run func() ([]models.Refund, error)) *MockDB_GetSignedRefunds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransactionsToInRange provides a mock function with given fields: chain, toAddress, fromBlockHeight, toBlockHeight
+func (_m *MockDB) GetTransactionsToInRange(chain models.Chain, toAddress []byte, fromBlockHeight uint64, toBlockHeight uint64) ([]models.Transaction, error) {
+	ret := _m.Called(chain, toAddress, fromBlockHeight, toBlockHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionsToInRange")
+	}
+
+	var r0 []models.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte, uint64, uint64) ([]models.Transaction, error)); ok {
+		return rf(chain, toAddress, fromBlockHeight, toBlockHeight)
+	}
+	if rf, ok := ret.Get(0).(func(models.Chain, []byte, uint64, uint64) []models.Transaction); ok {
+		r0 = rf(chain, toAddress, fromBlockHeight, toBlockHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Chain, []byte, uint64, uint64) error); ok {
+		r1 = rf(chain, toAddress, fromBlockHeight, toBlockHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetTransactionsToInRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactionsToInRange'
+type MockDB_GetTransactionsToInRange_Call struct {
+	*mock.Call
+}
+
+// GetTransactionsToInRange is a helper method to define mock.On call
+//   - chain models.Chain
+//   - toAddress []byte
+//   - fromBlockHeight uint64
+//   - toBlockHeight uint64
+func (_e *MockDB_Expecter) GetTransactionsToInRange(chain interface{}, toAddress interface{}, fromBlockHeight interface{}, toBlockHeight interface{}) *MockDB_GetTransactionsToInRange_Call {
+	return &MockDB_GetTransactionsToInRange_Call{Call: _e.mock.On("GetTransactionsToInRange", chain, toAddress, fromBlockHeight, toBlockHeight)}
+}
+
+func (_c *MockDB_GetTransactionsToInRange_Call) Run(run func(chain models.Chain, toAddress []byte, fromBlockHeight uint64, toBlockHeight uint64)) *MockDB_GetTransactionsToInRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].([]byte), args[2].(uint64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDB_GetTransactionsToInRange_Call) Return(_a0 []models.Transaction, _a1 error) *MockDB_GetTransactionsToInRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetTransactionsToInRange_Call) RunAndReturn(run func(models.Chain, []byte, uint64, uint64) ([]models.Transaction, error)) *MockDB_GetTransactionsToInRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnresolvedAnomalies provides a mock function with given fields:
+func (_m *MockDB) GetUnresolvedAnomalies() ([]models.Anomaly, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnresolvedAnomalies")
+	}
+
+	var r0 []models.Anomaly
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Anomaly, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Anomaly); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Anomaly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_GetUnresolvedAnomalies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnresolvedAnomalies'
+type MockDB_GetUnresolvedAnomalies_Call struct {
+	*mock.Call
+}
+
+// GetUnresolvedAnomalies is a helper method to define mock.On call
+func (_e *MockDB_Expecter) GetUnresolvedAnomalies() *MockDB_GetUnresolvedAnomalies_Call {
+	return &MockDB_GetUnresolvedAnomalies_Call{Call: _e.mock.On("GetUnresolvedAnomalies")}
+}
+
+func (_c *MockDB_GetUnresolvedAnomalies_Call) Run(run func()) *MockDB_GetUnresolvedAnomalies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockDB_GetUnresolvedAnomalies_Call) Return(_a0 []models.Anomaly, _a1 error) *MockDB_GetUnresolvedAnomalies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_GetUnresolvedAnomalies_Call) RunAndReturn(run func() ([]models.Anomaly, error)) *MockDB_GetUnresolvedAnomalies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InsertAnomaly provides a mock function with given fields: anomaly
+func (_m *MockDB) InsertAnomaly(anomaly models.Anomaly) error {
+	ret := _m.Called(anomaly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertAnomaly")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Anomaly) error); ok {
+		r0 = rf(anomaly)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDB_GetSignedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedMessages'
-type MockDB_GetSignedMessages_Call struct {
+// MockDB_InsertAnomaly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertAnomaly'
+type MockDB_InsertAnomaly_Call struct {
 	*mock.Call
 }
 
-// GetSignedMessages is a helper method to define mock.On call
-//   - chain models.Chain
-func (_e *MockDB_Expecter) GetSignedMessages(chain interface{}) *MockDB_GetSignedMessages_Call {
-	return &MockDB_GetSignedMessages_Call{Call: _e.mock.On("GetSignedMessages", chain)}
+// InsertAnomaly is a helper method to define mock.On call
+//   - anomaly models.Anomaly
+func (_e *MockDB_Expecter) InsertAnomaly(anomaly interface{}) *MockDB_InsertAnomaly_Call {
+	return &MockDB_InsertAnomaly_Call{Call: _e.mock.On("InsertAnomaly", anomaly)}
 }
 
-func (_c *MockDB_GetSignedMessages_Call) Run(run func(chain models.Chain)) *MockDB_GetSignedMessages_Call {
+func (_c *MockDB_InsertAnomaly_Call) Run(run func(anomaly models.Anomaly)) *MockDB_InsertAnomaly_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(models.Chain))
+		run(args[0].(models.Anomaly))
 	})
 	return _c
 }
 
-func (_c *MockDB_GetSignedMessages_Call) Return(_a0 []models.Message, _a1 error) *MockDB_GetSignedMessages_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDB_InsertAnomaly_Call) Return(_a0 error) *MockDB_InsertAnomaly_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDB_GetSignedMessages_Call) RunAndReturn(run func(models.Chain) ([]models.Message, error)) *MockDB_GetSignedMessages_Call {
+func (_c *MockDB_InsertAnomaly_Call) RunAndReturn(run func(models.Anomaly) error) *MockDB_InsertAnomaly_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSignedRefunds provides a mock function with given fields:
-func (_m *MockDB) GetSignedRefunds() ([]models.Refund, error) {
-	ret := _m.Called()
+// InsertContractEvent provides a mock function with given fields: event
+func (_m *MockDB) InsertContractEvent(event models.ContractEvent) error {
+	ret := _m.Called(event)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSignedRefunds")
-	}
-
-	var r0 []models.Refund
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Refund, error)); ok {
-		return rf()
-	}
-	if rf, ok := ret.Get(0).(func() []models.Refund); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Refund)
-		}
+		panic("no return value specified for InsertContractEvent")
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.ContractEvent) error); ok {
+		r0 = rf(event)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDB_GetSignedRefunds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSignedRefunds'
-type MockDB_GetSignedRefunds_Call struct {
+// MockDB_InsertContractEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertContractEvent'
+type MockDB_InsertContractEvent_Call struct {
 	*mock.Call
 }
 
-// GetSignedRefunds is a helper method to define mock.On call
-func (_e *MockDB_Expecter) GetSignedRefunds() *MockDB_GetSignedRefunds_Call {
-	return &MockDB_GetSignedRefunds_Call{Call: _e.mock.On("GetSignedRefunds")}
+// InsertContractEvent is a helper method to define mock.On call
+//   - event models.ContractEvent
+func (_e *MockDB_Expecter) InsertContractEvent(event interface{}) *MockDB_InsertContractEvent_Call {
+	return &MockDB_InsertContractEvent_Call{Call: _e.mock.On("InsertContractEvent", event)}
 }
 
-func (_c *MockDB_GetSignedRefunds_Call) Run(run func()) *MockDB_GetSignedRefunds_Call {
+func (_c *MockDB_InsertContractEvent_Call) Run(run func(event models.ContractEvent)) *MockDB_InsertContractEvent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(models.ContractEvent))
 	})
 	return _c
 }
 
-func (_c *MockDB_GetSignedRefunds_Call) Return(_a0 []models.Refund, _a1 error) *MockDB_GetSignedRefunds_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDB_InsertContractEvent_Call) Return(_a0 error) *MockDB_InsertContractEvent_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDB_GetSignedRefunds_Call) RunAndReturn(run func() ([]models.Refund, error)) *MockDB_GetSignedRefunds_Call {
+func (_c *MockDB_InsertContractEvent_Call) RunAndReturn(run func(models.ContractEvent) error) *MockDB_InsertContractEvent_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -841,6 +1636,64 @@ func (_c *MockDB_InsertRefund_Call) RunAndReturn(run func(models.Refund) (primit
 	return _c
 }
 
+// InsertSupplySnapshot provides a mock function with given fields: snapshot
+func (_m *MockDB) InsertSupplySnapshot(snapshot models.SupplySnapshot) (primitive.ObjectID, error) {
+	ret := _m.Called(snapshot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InsertSupplySnapshot")
+	}
+
+	var r0 primitive.ObjectID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.SupplySnapshot) (primitive.ObjectID, error)); ok {
+		return rf(snapshot)
+	}
+	if rf, ok := ret.Get(0).(func(models.SupplySnapshot) primitive.ObjectID); ok {
+		r0 = rf(snapshot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(primitive.ObjectID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.SupplySnapshot) error); ok {
+		r1 = rf(snapshot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDB_InsertSupplySnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InsertSupplySnapshot'
+type MockDB_InsertSupplySnapshot_Call struct {
+	*mock.Call
+}
+
+// InsertSupplySnapshot is a helper method to define mock.On call
+//   - snapshot models.SupplySnapshot
+func (_e *MockDB_Expecter) InsertSupplySnapshot(snapshot interface{}) *MockDB_InsertSupplySnapshot_Call {
+	return &MockDB_InsertSupplySnapshot_Call{Call: _e.mock.On("InsertSupplySnapshot", snapshot)}
+}
+
+func (_c *MockDB_InsertSupplySnapshot_Call) Run(run func(snapshot models.SupplySnapshot)) *MockDB_InsertSupplySnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.SupplySnapshot))
+	})
+	return _c
+}
+
+func (_c *MockDB_InsertSupplySnapshot_Call) Return(_a0 primitive.ObjectID, _a1 error) *MockDB_InsertSupplySnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDB_InsertSupplySnapshot_Call) RunAndReturn(run func(models.SupplySnapshot) (primitive.ObjectID, error)) *MockDB_InsertSupplySnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InsertTransaction provides a mock function with given fields: tx
 func (_m *MockDB) InsertTransaction(tx models.Transaction) (primitive.ObjectID, error) {
 	ret := _m.Called(tx)
@@ -1533,6 +2386,103 @@ func (_c *MockDB_NewRefund_Call) RunAndReturn(run func(*types.TxResponse, *model
 	return _c
 }
 
+// SeedCheckpoint provides a mock function with given fields: chain, runner, blockHeight
+func (_m *MockDB) SeedCheckpoint(chain models.Chain, runner string, blockHeight uint64) error {
+	ret := _m.Called(chain, runner, blockHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SeedCheckpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Chain, string, uint64) error); ok {
+		r0 = rf(chain, runner, blockHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_SeedCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SeedCheckpoint'
+type MockDB_SeedCheckpoint_Call struct {
+	*mock.Call
+}
+
+// SeedCheckpoint is a helper method to define mock.On call
+//   - chain models.Chain
+//   - runner string
+//   - blockHeight uint64
+func (_e *MockDB_Expecter) SeedCheckpoint(chain interface{}, runner interface{}, blockHeight interface{}) *MockDB_SeedCheckpoint_Call {
+	return &MockDB_SeedCheckpoint_Call{Call: _e.mock.On("SeedCheckpoint", chain, runner, blockHeight)}
+}
+
+func (_c *MockDB_SeedCheckpoint_Call) Run(run func(chain models.Chain, runner string, blockHeight uint64)) *MockDB_SeedCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDB_SeedCheckpoint_Call) Return(_a0 error) *MockDB_SeedCheckpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_SeedCheckpoint_Call) RunAndReturn(run func(models.Chain, string, uint64) error) *MockDB_SeedCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFulfillmentFeePaid provides a mock function with given fields: messageID, index, txHash, feePaid
+func (_m *MockDB) SetFulfillmentFeePaid(messageID *primitive.ObjectID, index int, txHash string, feePaid string) error {
+	ret := _m.Called(messageID, index, txHash, feePaid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFulfillmentFeePaid")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, int, string, string) error); ok {
+		r0 = rf(messageID, index, txHash, feePaid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_SetFulfillmentFeePaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFulfillmentFeePaid'
+type MockDB_SetFulfillmentFeePaid_Call struct {
+	*mock.Call
+}
+
+// SetFulfillmentFeePaid is a helper method to define mock.On call
+//   - messageID *primitive.ObjectID
+//   - index int
+//   - txHash string
+//   - feePaid string
+func (_e *MockDB_Expecter) SetFulfillmentFeePaid(messageID interface{}, index interface{}, txHash interface{}, feePaid interface{}) *MockDB_SetFulfillmentFeePaid_Call {
+	return &MockDB_SetFulfillmentFeePaid_Call{Call: _e.mock.On("SetFulfillmentFeePaid", messageID, index, txHash, feePaid)}
+}
+
+func (_c *MockDB_SetFulfillmentFeePaid_Call) Run(run func(messageID *primitive.ObjectID, index int, txHash string, feePaid string)) *MockDB_SetFulfillmentFeePaid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*primitive.ObjectID), args[1].(int), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockDB_SetFulfillmentFeePaid_Call) Return(_a0 error) *MockDB_SetFulfillmentFeePaid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_SetFulfillmentFeePaid_Call) RunAndReturn(run func(*primitive.ObjectID, int, string, string) error) *MockDB_SetFulfillmentFeePaid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Unlock provides a mock function with given fields: lockID
 func (_m *MockDB) Unlock(lockID string) error {
 	ret := _m.Called(lockID)
@@ -1579,17 +2529,17 @@ func (_c *MockDB_Unlock_Call) RunAndReturn(run func(string) error) *MockDB_Unloc
 	return _c
 }
 
-// UpdateMessage provides a mock function with given fields: messageID, update
-func (_m *MockDB) UpdateMessage(messageID *primitive.ObjectID, update primitive.M) error {
-	ret := _m.Called(messageID, update)
+// UpdateMessage provides a mock function with given fields: messageID, currentStatus, update
+func (_m *MockDB) UpdateMessage(messageID *primitive.ObjectID, currentStatus models.MessageStatus, update primitive.M) error {
+	ret := _m.Called(messageID, currentStatus, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateMessage")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, primitive.M) error); ok {
-		r0 = rf(messageID, update)
+	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, models.MessageStatus, primitive.M) error); ok {
+		r0 = rf(messageID, currentStatus, update)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1604,14 +2554,15 @@ type MockDB_UpdateMessage_Call struct {
 
 // UpdateMessage is a helper method to define mock.On call
 //   - messageID *primitive.ObjectID
+//   - currentStatus models.MessageStatus
 //   - update primitive.M
-func (_e *MockDB_Expecter) UpdateMessage(messageID interface{}, update interface{}) *MockDB_UpdateMessage_Call {
-	return &MockDB_UpdateMessage_Call{Call: _e.mock.On("UpdateMessage", messageID, update)}
+func (_e *MockDB_Expecter) UpdateMessage(messageID interface{}, currentStatus interface{}, update interface{}) *MockDB_UpdateMessage_Call {
+	return &MockDB_UpdateMessage_Call{Call: _e.mock.On("UpdateMessage", messageID, currentStatus, update)}
 }
 
-func (_c *MockDB_UpdateMessage_Call) Run(run func(messageID *primitive.ObjectID, update primitive.M)) *MockDB_UpdateMessage_Call {
+func (_c *MockDB_UpdateMessage_Call) Run(run func(messageID *primitive.ObjectID, currentStatus models.MessageStatus, update primitive.M)) *MockDB_UpdateMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*primitive.ObjectID), args[1].(primitive.M))
+		run(args[0].(*primitive.ObjectID), args[1].(models.MessageStatus), args[2].(primitive.M))
 	})
 	return _c
 }
@@ -1621,14 +2572,14 @@ func (_c *MockDB_UpdateMessage_Call) Return(_a0 error) *MockDB_UpdateMessage_Cal
 	return _c
 }
 
-func (_c *MockDB_UpdateMessage_Call) RunAndReturn(run func(*primitive.ObjectID, primitive.M) error) *MockDB_UpdateMessage_Call {
+func (_c *MockDB_UpdateMessage_Call) RunAndReturn(run func(*primitive.ObjectID, models.MessageStatus, primitive.M) error) *MockDB_UpdateMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateMessageByMessageID provides a mock function with given fields: messageID, update
-func (_m *MockDB) UpdateMessageByMessageID(messageID [32]byte, update primitive.M) (primitive.ObjectID, error) {
-	ret := _m.Called(messageID, update)
+// UpdateMessageByMessageID provides a mock function with given fields: messageID, currentStatus, update
+func (_m *MockDB) UpdateMessageByMessageID(messageID [32]byte, currentStatus models.MessageStatus, update primitive.M) (primitive.ObjectID, error) {
+	ret := _m.Called(messageID, currentStatus, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateMessageByMessageID")
@@ -1636,19 +2587,19 @@ func (_m *MockDB) UpdateMessageByMessageID(messageID [32]byte, update primitive.
 
 	var r0 primitive.ObjectID
 	var r1 error
-	if rf, ok := ret.Get(0).(func([32]byte, primitive.M) (primitive.ObjectID, error)); ok {
-		return rf(messageID, update)
+	if rf, ok := ret.Get(0).(func([32]byte, models.MessageStatus, primitive.M) (primitive.ObjectID, error)); ok {
+		return rf(messageID, currentStatus, update)
 	}
-	if rf, ok := ret.Get(0).(func([32]byte, primitive.M) primitive.ObjectID); ok {
-		r0 = rf(messageID, update)
+	if rf, ok := ret.Get(0).(func([32]byte, models.MessageStatus, primitive.M) primitive.ObjectID); ok {
+		r0 = rf(messageID, currentStatus, update)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(primitive.ObjectID)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func([32]byte, primitive.M) error); ok {
-		r1 = rf(messageID, update)
+	if rf, ok := ret.Get(1).(func([32]byte, models.MessageStatus, primitive.M) error); ok {
+		r1 = rf(messageID, currentStatus, update)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1663,14 +2614,15 @@ type MockDB_UpdateMessageByMessageID_Call struct {
 
 // UpdateMessageByMessageID is a helper method to define mock.On call
 //   - messageID [32]byte
+//   - currentStatus models.MessageStatus
 //   - update primitive.M
-func (_e *MockDB_Expecter) UpdateMessageByMessageID(messageID interface{}, update interface{}) *MockDB_UpdateMessageByMessageID_Call {
-	return &MockDB_UpdateMessageByMessageID_Call{Call: _e.mock.On("UpdateMessageByMessageID", messageID, update)}
+func (_e *MockDB_Expecter) UpdateMessageByMessageID(messageID interface{}, currentStatus interface{}, update interface{}) *MockDB_UpdateMessageByMessageID_Call {
+	return &MockDB_UpdateMessageByMessageID_Call{Call: _e.mock.On("UpdateMessageByMessageID", messageID, currentStatus, update)}
 }
 
-func (_c *MockDB_UpdateMessageByMessageID_Call) Run(run func(messageID [32]byte, update primitive.M)) *MockDB_UpdateMessageByMessageID_Call {
+func (_c *MockDB_UpdateMessageByMessageID_Call) Run(run func(messageID [32]byte, currentStatus models.MessageStatus, update primitive.M)) *MockDB_UpdateMessageByMessageID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([32]byte), args[1].(primitive.M))
+		run(args[0].([32]byte), args[1].(models.MessageStatus), args[2].(primitive.M))
 	})
 	return _c
 }
@@ -1680,22 +2632,69 @@ func (_c *MockDB_UpdateMessageByMessageID_Call) Return(_a0 primitive.ObjectID, _
 	return _c
 }
 
-func (_c *MockDB_UpdateMessageByMessageID_Call) RunAndReturn(run func([32]byte, primitive.M) (primitive.ObjectID, error)) *MockDB_UpdateMessageByMessageID_Call {
+func (_c *MockDB_UpdateMessageByMessageID_Call) RunAndReturn(run func([32]byte, models.MessageStatus, primitive.M) (primitive.ObjectID, error)) *MockDB_UpdateMessageByMessageID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateMessages provides a mock function with given fields: filter, update
+func (_m *MockDB) UpdateMessages(filter primitive.M, update primitive.M) error {
+	ret := _m.Called(filter, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.M, primitive.M) error); ok {
+		r0 = rf(filter, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMessages'
+type MockDB_UpdateMessages_Call struct {
+	*mock.Call
+}
+
+// UpdateMessages is a helper method to define mock.On call
+//   - filter primitive.M
+//   - update primitive.M
+func (_e *MockDB_Expecter) UpdateMessages(filter interface{}, update interface{}) *MockDB_UpdateMessages_Call {
+	return &MockDB_UpdateMessages_Call{Call: _e.mock.On("UpdateMessages", filter, update)}
+}
+
+func (_c *MockDB_UpdateMessages_Call) Run(run func(filter primitive.M, update primitive.M)) *MockDB_UpdateMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(primitive.M), args[1].(primitive.M))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateMessages_Call) Return(_a0 error) *MockDB_UpdateMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpdateMessages_Call) RunAndReturn(run func(primitive.M, primitive.M) error) *MockDB_UpdateMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRefund provides a mock function with given fields: refundID, update
-func (_m *MockDB) UpdateRefund(refundID *primitive.ObjectID, update primitive.M) error {
-	ret := _m.Called(refundID, update)
+// UpdateRefund provides a mock function with given fields: refundID, currentStatus, update
+func (_m *MockDB) UpdateRefund(refundID *primitive.ObjectID, currentStatus models.RefundStatus, update primitive.M) error {
+	ret := _m.Called(refundID, currentStatus, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateRefund")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, primitive.M) error); ok {
-		r0 = rf(refundID, update)
+	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, models.RefundStatus, primitive.M) error); ok {
+		r0 = rf(refundID, currentStatus, update)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1710,14 +2709,15 @@ type MockDB_UpdateRefund_Call struct {
 
 // UpdateRefund is a helper method to define mock.On call
 //   - refundID *primitive.ObjectID
+//   - currentStatus models.RefundStatus
 //   - update primitive.M
-func (_e *MockDB_Expecter) UpdateRefund(refundID interface{}, update interface{}) *MockDB_UpdateRefund_Call {
-	return &MockDB_UpdateRefund_Call{Call: _e.mock.On("UpdateRefund", refundID, update)}
+func (_e *MockDB_Expecter) UpdateRefund(refundID interface{}, currentStatus interface{}, update interface{}) *MockDB_UpdateRefund_Call {
+	return &MockDB_UpdateRefund_Call{Call: _e.mock.On("UpdateRefund", refundID, currentStatus, update)}
 }
 
-func (_c *MockDB_UpdateRefund_Call) Run(run func(refundID *primitive.ObjectID, update primitive.M)) *MockDB_UpdateRefund_Call {
+func (_c *MockDB_UpdateRefund_Call) Run(run func(refundID *primitive.ObjectID, currentStatus models.RefundStatus, update primitive.M)) *MockDB_UpdateRefund_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*primitive.ObjectID), args[1].(primitive.M))
+		run(args[0].(*primitive.ObjectID), args[1].(models.RefundStatus), args[2].(primitive.M))
 	})
 	return _c
 }
@@ -1727,22 +2727,69 @@ func (_c *MockDB_UpdateRefund_Call) Return(_a0 error) *MockDB_UpdateRefund_Call
 	return _c
 }
 
-func (_c *MockDB_UpdateRefund_Call) RunAndReturn(run func(*primitive.ObjectID, primitive.M) error) *MockDB_UpdateRefund_Call {
+func (_c *MockDB_UpdateRefund_Call) RunAndReturn(run func(*primitive.ObjectID, models.RefundStatus, primitive.M) error) *MockDB_UpdateRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReorgedMessages provides a mock function with given fields: filter, update
+func (_m *MockDB) UpdateReorgedMessages(filter primitive.M, update primitive.M) error {
+	ret := _m.Called(filter, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReorgedMessages")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(primitive.M, primitive.M) error); ok {
+		r0 = rf(filter, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpdateReorgedMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReorgedMessages'
+type MockDB_UpdateReorgedMessages_Call struct {
+	*mock.Call
+}
+
+// UpdateReorgedMessages is a helper method to define mock.On call
+//   - filter primitive.M
+//   - update primitive.M
+func (_e *MockDB_Expecter) UpdateReorgedMessages(filter interface{}, update interface{}) *MockDB_UpdateReorgedMessages_Call {
+	return &MockDB_UpdateReorgedMessages_Call{Call: _e.mock.On("UpdateReorgedMessages", filter, update)}
+}
+
+func (_c *MockDB_UpdateReorgedMessages_Call) Run(run func(filter primitive.M, update primitive.M)) *MockDB_UpdateReorgedMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(primitive.M), args[1].(primitive.M))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpdateReorgedMessages_Call) Return(_a0 error) *MockDB_UpdateReorgedMessages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpdateReorgedMessages_Call) RunAndReturn(run func(primitive.M, primitive.M) error) *MockDB_UpdateReorgedMessages_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateTransaction provides a mock function with given fields: txID, update
-func (_m *MockDB) UpdateTransaction(txID *primitive.ObjectID, update primitive.M) error {
-	ret := _m.Called(txID, update)
+// UpdateTransaction provides a mock function with given fields: txID, currentStatus, update
+func (_m *MockDB) UpdateTransaction(txID *primitive.ObjectID, currentStatus models.TransactionStatus, update primitive.M) error {
+	ret := _m.Called(txID, currentStatus, update)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateTransaction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, primitive.M) error); ok {
-		r0 = rf(txID, update)
+	if rf, ok := ret.Get(0).(func(*primitive.ObjectID, models.TransactionStatus, primitive.M) error); ok {
+		r0 = rf(txID, currentStatus, update)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1757,14 +2804,15 @@ type MockDB_UpdateTransaction_Call struct {
 
 // UpdateTransaction is a helper method to define mock.On call
 //   - txID *primitive.ObjectID
+//   - currentStatus models.TransactionStatus
 //   - update primitive.M
-func (_e *MockDB_Expecter) UpdateTransaction(txID interface{}, update interface{}) *MockDB_UpdateTransaction_Call {
-	return &MockDB_UpdateTransaction_Call{Call: _e.mock.On("UpdateTransaction", txID, update)}
+func (_e *MockDB_Expecter) UpdateTransaction(txID interface{}, currentStatus interface{}, update interface{}) *MockDB_UpdateTransaction_Call {
+	return &MockDB_UpdateTransaction_Call{Call: _e.mock.On("UpdateTransaction", txID, currentStatus, update)}
 }
 
-func (_c *MockDB_UpdateTransaction_Call) Run(run func(txID *primitive.ObjectID, update primitive.M)) *MockDB_UpdateTransaction_Call {
+func (_c *MockDB_UpdateTransaction_Call) Run(run func(txID *primitive.ObjectID, currentStatus models.TransactionStatus, update primitive.M)) *MockDB_UpdateTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*primitive.ObjectID), args[1].(primitive.M))
+		run(args[0].(*primitive.ObjectID), args[1].(models.TransactionStatus), args[2].(primitive.M))
 	})
 	return _c
 }
@@ -1774,7 +2822,55 @@ func (_c *MockDB_UpdateTransaction_Call) Return(_a0 error) *MockDB_UpdateTransac
 	return _c
 }
 
-func (_c *MockDB_UpdateTransaction_Call) RunAndReturn(run func(*primitive.ObjectID, primitive.M) error) *MockDB_UpdateTransaction_Call {
+func (_c *MockDB_UpdateTransaction_Call) RunAndReturn(run func(*primitive.ObjectID, models.TransactionStatus, primitive.M) error) *MockDB_UpdateTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertCheckpoint provides a mock function with given fields: chain, runner, blockHeight
+func (_m *MockDB) UpsertCheckpoint(chain models.Chain, runner string, blockHeight uint64) error {
+	ret := _m.Called(chain, runner, blockHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCheckpoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Chain, string, uint64) error); ok {
+		r0 = rf(chain, runner, blockHeight)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockDB_UpsertCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertCheckpoint'
+type MockDB_UpsertCheckpoint_Call struct {
+	*mock.Call
+}
+
+// UpsertCheckpoint is a helper method to define mock.On call
+//   - chain models.Chain
+//   - runner string
+//   - blockHeight uint64
+func (_e *MockDB_Expecter) UpsertCheckpoint(chain interface{}, runner interface{}, blockHeight interface{}) *MockDB_UpsertCheckpoint_Call {
+	return &MockDB_UpsertCheckpoint_Call{Call: _e.mock.On("UpsertCheckpoint", chain, runner, blockHeight)}
+}
+
+func (_c *MockDB_UpsertCheckpoint_Call) Run(run func(chain models.Chain, runner string, blockHeight uint64)) *MockDB_UpsertCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(models.Chain), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockDB_UpsertCheckpoint_Call) Return(_a0 error) *MockDB_UpsertCheckpoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockDB_UpsertCheckpoint_Call) RunAndReturn(run func(models.Chain, string, uint64) error) *MockDB_UpsertCheckpoint_Call {
 	_c.Call.Return(run)
 	return _c
 }
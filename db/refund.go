@@ -22,12 +22,14 @@ type RefundDB interface {
 		amountCoin sdk.Coin,
 	) (models.Refund, error)
 
+	FindRefund(filter bson.M) (models.Refund, error)
 	InsertRefund(tx models.Refund) (primitive.ObjectID, error)
-	UpdateRefund(refundID *primitive.ObjectID, update bson.M) error
+	UpdateRefund(refundID *primitive.ObjectID, currentStatus models.RefundStatus, update bson.M) error
 
 	GetPendingRefunds(signerToExclude string) ([]models.Refund, error)
 	GetSignedRefunds() ([]models.Refund, error)
 	GetBroadcastedRefunds() ([]models.Refund, error)
+	GetInFlightRefunds() ([]models.Refund, error)
 }
 
 func newRefund(
@@ -68,6 +70,12 @@ func newRefund(
 	}, nil
 }
 
+func findRefund(filter bson.M) (models.Refund, error) {
+	var refund models.Refund
+	err := mongoDB.FindOne(common.CollectionRefunds, filter, &refund)
+	return refund, err
+}
+
 func insertRefund(tx models.Refund) (primitive.ObjectID, error) {
 	insertedID, err := mongoDB.InsertOne(common.CollectionRefunds, tx)
 	if err != nil {
@@ -84,15 +92,24 @@ func insertRefund(tx models.Refund) (primitive.ObjectID, error) {
 	return insertedID, nil
 }
 
-func updateRefund(refundID *primitive.ObjectID, update bson.M) error {
+// updateRefund updates the refund only if it still has the current status read by the caller
+func updateRefund(runner string, refundID *primitive.ObjectID, currentStatus models.RefundStatus, update bson.M) error {
 	if refundID == nil {
 		return fmt.Errorf("refundID is nil")
 	}
-	_, err := mongoDB.UpdateOne(
+	if err := checkTransition(refundTransitions, currentStatus, update); err != nil {
+		return err
+	}
+	_, err := updateWithStatusEvent(
 		common.CollectionRefunds,
-		bson.M{"_id": refundID},
-		bson.M{"$set": update},
+		models.StatusEventDocumentRefund,
+		runner,
+		bson.M{"_id": refundID, "status": currentStatus},
+		update,
 	)
+	if err == mongo.ErrNoDocuments {
+		return statusError(common.CollectionRefunds, bson.M{"_id": refundID}, string(currentStatus), update)
+	}
 	return err
 }
 
@@ -136,7 +153,24 @@ func getBroadcastedRefunds() ([]models.Refund, error) {
 	return refunds, err
 }
 
-type refundDB struct{}
+// getInFlightRefunds returns the refunds the multisig has not paid out yet
+func getInFlightRefunds() ([]models.Refund, error) {
+	refunds := []models.Refund{}
+	filter := bson.M{
+		"status": bson.M{"$nin": []models.RefundStatus{
+			models.RefundStatusSuccess,
+			models.RefundStatusInvalid,
+		}},
+	}
+
+	err := mongoDB.FindMany(common.CollectionRefunds, filter, &refunds)
+
+	return refunds, err
+}
+
+type refundDB struct {
+	runner string
+}
 
 func (db *refundDB) NewRefund(
 	txRes *sdk.TxResponse,
@@ -147,12 +181,16 @@ func (db *refundDB) NewRefund(
 	return newRefund(txRes, txDoc, recipientAddress, amountCoin)
 }
 
+func (db *refundDB) FindRefund(filter bson.M) (models.Refund, error) {
+	return findRefund(filter)
+}
+
 func (db *refundDB) InsertRefund(tx models.Refund) (primitive.ObjectID, error) {
 	return insertRefund(tx)
 }
 
-func (db *refundDB) UpdateRefund(refundID *primitive.ObjectID, update bson.M) error {
-	return updateRefund(refundID, update)
+func (db *refundDB) UpdateRefund(refundID *primitive.ObjectID, currentStatus models.RefundStatus, update bson.M) error {
+	return updateRefund(db.runner, refundID, currentStatus, update)
 }
 
 func (db *refundDB) GetPendingRefunds(signerToExclude string) ([]models.Refund, error) {
@@ -166,3 +204,7 @@ func (db *refundDB) GetSignedRefunds() ([]models.Refund, error) {
 func (db *refundDB) GetBroadcastedRefunds() ([]models.Refund, error) {
 	return getBroadcastedRefunds()
 }
+
+func (db *refundDB) GetInFlightRefunds() ([]models.Refund, error) {
+	return getInFlightRefunds()
+}
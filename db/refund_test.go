@@ -123,6 +123,18 @@ func (suite *RefundTestSuite) TestNewRefund_InvalidRecipient() {
 	assert.Equal(suite.T(), expectedError, err)
 }
 
+func (suite *RefundTestSuite) TestFindRefund() {
+	filter := bson.M{"sequence": uint64(1)}
+	expectedRefund := models.Refund{}
+
+	suite.mockDB.EXPECT().FindOne(common.CollectionRefunds, filter, &expectedRefund).Return(nil).Once()
+
+	gotRefund, err := suite.db.FindRefund(filter)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedRefund, gotRefund)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func (suite *RefundTestSuite) TestInsertRefund() {
 	refund := models.Refund{
 		ID: &primitive.ObjectID{},
@@ -197,16 +209,23 @@ func (suite *RefundTestSuite) TestUpdateRefund() {
 	refundID := primitive.NewObjectID()
 	update := bson.M{"status": models.RefundStatusSigned}
 
-	suite.mockDB.EXPECT().UpdateOne(common.CollectionRefunds, bson.M{"_id": &refundID}, bson.M{"$set": update}).Return(primitive.ObjectID{}, nil).Once()
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionRefunds, bson.M{"_id": &refundID, "status": models.RefundStatusPending}, bson.M{"$set": update}, mock.Anything).
+		Run(func(_ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: refundID, Status: string(models.RefundStatusPending)}
+		}).Return(nil).Once()
+	suite.mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.MatchedBy(func(events []interface{}) bool {
+		event := events[0].(models.StatusEvent)
+		return len(events) == 1 && event.Document == models.StatusEventDocumentRefund && event.DocumentID == refundID
+	})).Return(nil).Once()
 
-	err := suite.db.UpdateRefund(&refundID, update)
+	err := suite.db.UpdateRefund(&refundID, models.RefundStatusPending, update)
 	assert.NoError(suite.T(), err)
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
 func (suite *RefundTestSuite) TestUpdateRefund_NilRefundID() {
 	update := bson.M{"status": models.RefundStatusSigned}
-	err := suite.db.UpdateRefund(nil, update)
+	err := suite.db.UpdateRefund(nil, models.RefundStatusPending, update)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), fmt.Errorf("refundID is nil"), err)
 }
@@ -285,6 +304,31 @@ func (suite *RefundTestSuite) TestGetBroadcastedRefunds() {
 	suite.mockDB.AssertExpectations(suite.T())
 }
 
+func (suite *RefundTestSuite) TestGetInFlightRefunds() {
+	refunds := []models.Refund{
+		{
+			ID:     &primitive.ObjectID{},
+			Status: models.RefundStatusSigned,
+		},
+	}
+	filter := bson.M{
+		"status": bson.M{"$nin": []models.RefundStatus{
+			models.RefundStatusSuccess,
+			models.RefundStatusInvalid,
+		}},
+	}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionRefunds, filter, &[]models.Refund{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(2).(*[]models.Refund)
+		*arg = refunds
+	})
+
+	gotRefunds, err := suite.db.GetInFlightRefunds()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), refunds, gotRefunds)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
 func TestRefundTestSuite(t *testing.T) {
 	suite.Run(t, new(RefundTestSuite))
 }
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+var oracleID string
+
+// SetOracleID sets the id of this oracle, stored with the statuses it sets
+func SetOracleID(id string) {
+	oracleID = id
+}
+
+// OracleID returns the id of this oracle
+func OracleID() string {
+	return oracleID
+}
+
+// StatusUpdate sets the status of a document along with its cause and this oracle's id
+func StatusUpdate[S models.TransactionStatus | models.MessageStatus | models.RefundStatus](
+	status S,
+	code models.StatusCode,
+	reason string,
+) bson.M {
+	return bson.M{
+		"status":           status,
+		"status_code":      code,
+		"status_reason":    reason,
+		"status_oracle_id": oracleID,
+	}
+}
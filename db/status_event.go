@@ -0,0 +1,254 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+// statusSnapshot holds the fields of a document, read as it was before an update, that go into its status event
+type statusSnapshot struct {
+	ID                    primitive.ObjectID `bson:"_id"`
+	Status                string             `bson:"status"`
+	Hash                  string             `bson:"hash"`
+	TransactionHash       string             `bson:"transaction_hash"`
+	OriginTransactionHash string             `bson:"origin_transaction_hash"`
+}
+
+func newStatusEvent(
+	document models.StatusEventDocument,
+	runner string,
+	before statusSnapshot,
+	update bson.M,
+) models.StatusEvent {
+	code, _ := update["status_code"].(models.StatusCode)
+	reason, _ := update["status_reason"].(string)
+
+	// transactions are the chain tx, messages and refunds relate to the tx they were broadcast in, or else their origin tx
+	txHash := before.Hash
+	if document != models.StatusEventDocumentTransaction {
+		if hash, ok := update["transaction_hash"].(string); ok && hash != "" {
+			txHash = hash
+		} else if before.TransactionHash != "" {
+			txHash = before.TransactionHash
+		} else {
+			txHash = before.OriginTransactionHash
+		}
+	}
+
+	// the id is set here so an event inserted again after a failed insert is rejected as a duplicate
+	id := primitive.NewObjectID()
+	return models.StatusEvent{
+		ID:              &id,
+		Document:        document,
+		DocumentID:      before.ID,
+		OldStatus:       before.Status,
+		NewStatus:       fmt.Sprint(update["status"]),
+		StatusCode:      code,
+		StatusReason:    reason,
+		OracleID:        oracleID,
+		Runner:          runner,
+		TransactionHash: txHash,
+		CreatedAt:       time.Now(),
+	}
+}
+
+// maxPendingStatusEvents bounds the status events kept for a later insert while they cannot be written
+const maxPendingStatusEvents = 1000
+
+// pendingStatusEvents holds the status events that failed to be inserted, retried with the next ones
+var pendingStatusEvents struct {
+	sync.Mutex
+	events []models.StatusEvent
+}
+
+// isDuplicateKeyOnly reports whether every write of a bulk insert failed for a duplicate key,
+// which is the case for events inserted by an earlier attempt
+func isDuplicateKeyOnly(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordStatusEvents inserts the events along with the ones that failed to be inserted before.
+// The status is the source of truth, so a failed insert does not fail the update. The events
+// are kept instead and inserted with the next status change, up to maxPendingStatusEvents
+func recordStatusEvents(events []models.StatusEvent) {
+	pendingStatusEvents.Lock()
+	defer pendingStatusEvents.Unlock()
+
+	events = append(pendingStatusEvents.events, events...)
+	if len(events) == 0 {
+		return
+	}
+
+	documents := make([]interface{}, len(events))
+	for i := range events {
+		documents[i] = events[i]
+	}
+
+	err := mongoDB.InsertMany(common.CollectionStatusEvents, documents)
+	if err == nil || isDuplicateKeyOnly(err) {
+		pendingStatusEvents.events = nil
+		return
+	}
+
+	if dropped := len(events) - maxPendingStatusEvents; dropped > 0 {
+		log.WithField("dropped", dropped).Error("Too many status events pending, dropping the oldest")
+		events = events[dropped:]
+	}
+	pendingStatusEvents.events = events
+	log.WithError(err).
+		WithField("pending", len(events)).
+		Error("Error inserting status events, retrying with the next status change")
+}
+
+// updateWithStatusEvent updates the document matching the filter and records the change if its status changed
+func updateWithStatusEvent(
+	collection string,
+	document models.StatusEventDocument,
+	runner string,
+	filter bson.M,
+	update bson.M,
+) (primitive.ObjectID, error) {
+	if _, ok := update["status"]; !ok {
+		return mongoDB.UpdateOne(collection, filter, bson.M{"$set": update})
+	}
+
+	var before statusSnapshot
+	if err := mongoDB.FindOneAndUpdate(collection, filter, bson.M{"$set": update}, &before); err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	event := newStatusEvent(document, runner, before, update)
+	if event.OldStatus == event.NewStatus {
+		return before.ID, nil
+	}
+
+	recordStatusEvents([]models.StatusEvent{event})
+
+	return before.ID, nil
+}
+
+// updateManyWithStatusEvents updates the documents matching the filter with one request per status they have,
+// so the status each one changed from is known and recorded
+func updateManyWithStatusEvents(
+	collection string,
+	document models.StatusEventDocument,
+	runner string,
+	filter bson.M,
+	update bson.M,
+) error {
+	if _, ok := update["status"]; !ok {
+		_, err := mongoDB.UpdateMany(collection, filter, bson.M{"$set": update})
+		return err
+	}
+
+	var documents []statusSnapshot
+	if err := mongoDB.FindMany(collection, filter, &documents); err != nil {
+		return err
+	}
+
+	byStatus := make(map[string][]statusSnapshot)
+	statuses := []string{}
+	for _, doc := range documents {
+		if _, ok := byStatus[doc.Status]; !ok {
+			statuses = append(statuses, doc.Status)
+		}
+		byStatus[doc.Status] = append(byStatus[doc.Status], doc)
+	}
+
+	newStatus := fmt.Sprint(update["status"])
+	events := []models.StatusEvent{}
+	for _, status := range statuses {
+		if status == newStatus {
+			_, err := mongoDB.UpdateMany(collection, statusGroupFilter(filter, byStatus[status]), bson.M{"$set": update})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		updated, err := updateStatusGroup(collection, filter, update, byStatus[status])
+		if err != nil {
+			return err
+		}
+		for _, doc := range updated {
+			events = append(events, newStatusEvent(document, runner, doc, update))
+		}
+	}
+
+	recordStatusEvents(events)
+
+	return nil
+}
+
+// statusGroupFilter matches the documents of the group that still match the filter and have the status they were read with
+func statusGroupFilter(filter bson.M, group []statusSnapshot) bson.M {
+	return bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$in": groupIDs(group)}}, {"status": group[0].Status}}}
+}
+
+func groupIDs(group []statusSnapshot) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, len(group))
+	for i, doc := range group {
+		ids[i] = doc.ID
+	}
+	return ids
+}
+
+// updateStatusGroup updates the documents read with the same status and returns the ones that were updated.
+// The update marks them with its own updated_at, so the documents it changed can be told apart from
+// the ones another writer moved to the same status
+func updateStatusGroup(collection string, filter bson.M, update bson.M, group []statusSnapshot) ([]statusSnapshot, error) {
+	// mongo stores times in milliseconds
+	updatedAt := time.Now().UTC().Truncate(time.Millisecond)
+	groupUpdate := bson.M{}
+	for key, value := range update {
+		groupUpdate[key] = value
+	}
+	groupUpdate["updated_at"] = updatedAt
+
+	modified, err := mongoDB.UpdateMany(collection, statusGroupFilter(filter, group), bson.M{"$set": groupUpdate})
+	if err != nil {
+		return nil, err
+	}
+	if modified == int64(len(group)) {
+		return group, nil
+	}
+
+	// some documents changed status since they were read, so read which ones this update changed
+	var current []statusSnapshot
+	currentFilter := bson.M{
+		"_id":        bson.M{"$in": groupIDs(group)},
+		"status":     groupUpdate["status"],
+		"updated_at": updatedAt,
+	}
+	if err := mongoDB.FindMany(collection, currentFilter, &current); err != nil {
+		return nil, err
+	}
+	updated := []statusSnapshot{}
+	for _, doc := range group {
+		for _, now := range current {
+			if now.ID == doc.ID {
+				updated = append(updated, doc)
+			}
+		}
+	}
+	return updated, nil
+}
@@ -0,0 +1,327 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	mocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func setupStatusEventTest(t *testing.T) *mocks.MockDatabase {
+	mockDB := mocks.NewMockDatabase(t)
+	oldMongoDB := mongoDB
+	mongoDB = mockDB
+	pendingStatusEvents.events = nil
+	t.Cleanup(func() {
+		mongoDB = oldMongoDB
+		pendingStatusEvents.events = nil
+	})
+	return mockDB
+}
+
+// setWithUpdatedAt matches a $set of update marked with an updated_at, which is passed to the callback
+func setWithUpdatedAt(update bson.M, updatedAt *time.Time) interface{} {
+	return mock.MatchedBy(func(set bson.M) bool {
+		fields, ok := set["$set"].(bson.M)
+		if !ok || len(fields) != len(update)+1 {
+			return false
+		}
+		for key, value := range update {
+			if fields[key] != value {
+				return false
+			}
+		}
+		at, ok := fields["updated_at"].(time.Time)
+		if ok && updatedAt != nil {
+			*updatedAt = at
+		}
+		return ok
+	})
+}
+
+func TestNewDB(t *testing.T) {
+	database := NewDB("signer").(*db)
+
+	assert.Equal(t, "signer", database.transactionDB.runner)
+	assert.Equal(t, "signer", database.messageDB.runner)
+	assert.Equal(t, "signer", database.refundDB.runner)
+}
+
+func TestNewStatusEvent(t *testing.T) {
+	defer SetOracleID("")
+	SetOracleID("oracle-01")
+
+	before := statusSnapshot{
+		ID:                    primitive.NewObjectID(),
+		Status:                string(models.MessageStatusPending),
+		OriginTransactionHash: "0xorigin",
+	}
+	update := StatusUpdate(models.MessageStatusInvalid, models.StatusCodeAmountMismatch, "amount mismatch")
+
+	event := newStatusEvent(models.StatusEventDocumentMessage, "signer", before, update)
+
+	assert.Equal(t, models.StatusEventDocumentMessage, event.Document)
+	assert.Equal(t, before.ID, event.DocumentID)
+	assert.Equal(t, string(models.MessageStatusPending), event.OldStatus)
+	assert.Equal(t, string(models.MessageStatusInvalid), event.NewStatus)
+	assert.Equal(t, models.StatusCodeAmountMismatch, event.StatusCode)
+	assert.Equal(t, "amount mismatch", event.StatusReason)
+	assert.Equal(t, "oracle-01", event.OracleID)
+	assert.Equal(t, "signer", event.Runner)
+	assert.Equal(t, "0xorigin", event.TransactionHash)
+	assert.False(t, event.CreatedAt.IsZero())
+}
+
+func TestNewStatusEvent_TransactionHash(t *testing.T) {
+	before := statusSnapshot{
+		Hash:                  "0xhash",
+		TransactionHash:       "0xbroadcast",
+		OriginTransactionHash: "0xorigin",
+	}
+
+	event := newStatusEvent(models.StatusEventDocumentTransaction, "monitor", before, bson.M{"status": models.TransactionStatusConfirmed})
+	assert.Equal(t, "0xhash", event.TransactionHash)
+
+	event = newStatusEvent(models.StatusEventDocumentRefund, "relayer", before, bson.M{"status": models.RefundStatusSuccess})
+	assert.Equal(t, "0xbroadcast", event.TransactionHash)
+
+	event = newStatusEvent(models.StatusEventDocumentMessage, "signer", before, bson.M{
+		"status":           models.MessageStatusBroadcasted,
+		"transaction_hash": "0xnew",
+	})
+	assert.Equal(t, "0xnew", event.TransactionHash)
+}
+
+func TestUpdateWithStatusEvent_NoStatus(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	docID := primitive.NewObjectID()
+	filter := bson.M{"_id": docID}
+	update := bson.M{"confirmations": uint64(2)}
+
+	mockDB.EXPECT().UpdateOne(common.CollectionTransactions, filter, bson.M{"$set": update}).Return(docID, nil).Once()
+
+	gotID, err := updateWithStatusEvent(common.CollectionTransactions, models.StatusEventDocumentTransaction, "monitor", filter, update)
+
+	assert.NoError(t, err)
+	assert.Equal(t, docID, gotID)
+}
+
+func TestUpdateWithStatusEvent_StatusUnchanged(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	docID := primitive.NewObjectID()
+	filter := bson.M{"_id": docID}
+	update := bson.M{"status": models.MessageStatusPending, "signatures": []models.Signature{}}
+
+	mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, filter, bson.M{"$set": update}, mock.Anything).
+		Run(func(_ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: docID, Status: string(models.MessageStatusPending)}
+		}).Return(nil).Once()
+
+	gotID, err := updateWithStatusEvent(common.CollectionMessages, models.StatusEventDocumentMessage, "signer", filter, update)
+
+	assert.NoError(t, err)
+	assert.Equal(t, docID, gotID)
+}
+
+func TestUpdateWithStatusEvent_UpdateError(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	filter := bson.M{"_id": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusSigned}
+
+	mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, filter, bson.M{"$set": update}, mock.Anything).Return(assert.AnError).Once()
+
+	_, err := updateWithStatusEvent(common.CollectionMessages, models.StatusEventDocumentMessage, "signer", filter, update)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUpdateWithStatusEvent_InsertError(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	docID := primitive.NewObjectID()
+	filter := bson.M{"_id": docID}
+	update := bson.M{"status": models.MessageStatusSigned}
+
+	mockDB.EXPECT().FindOneAndUpdate(common.CollectionMessages, filter, bson.M{"$set": update}, mock.Anything).
+		Run(func(_ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: docID, Status: string(models.MessageStatusPending)}
+		}).Return(nil).Twice()
+	mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.Anything).Return(assert.AnError).Once()
+
+	gotID, err := updateWithStatusEvent(common.CollectionMessages, models.StatusEventDocumentMessage, "signer", filter, update)
+
+	assert.NoError(t, err)
+	assert.Equal(t, docID, gotID)
+	assert.Len(t, pendingStatusEvents.events, 1)
+	pending := *pendingStatusEvents.events[0].ID
+
+	// the event that failed is inserted with the next one
+	mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.MatchedBy(func(events []interface{}) bool {
+		return len(events) == 2 && *events[0].(models.StatusEvent).ID == pending
+	})).Return(nil).Once()
+
+	_, err = updateWithStatusEvent(common.CollectionMessages, models.StatusEventDocumentMessage, "signer", filter, update)
+
+	assert.NoError(t, err)
+	assert.Empty(t, pendingStatusEvents.events)
+}
+
+func TestRecordStatusEvents_Duplicate(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	pendingStatusEvents.events = []models.StatusEvent{newStatusEvent(models.StatusEventDocumentMessage, "signer", statusSnapshot{}, bson.M{"status": models.MessageStatusSigned})}
+
+	// the pending event was written by the insert that returned an error
+	mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.Anything).Return(mongo.BulkWriteException{
+		WriteErrors: []mongo.BulkWriteError{{WriteError: mongo.WriteError{Index: 0, Code: 11000}}},
+	}).Once()
+
+	recordStatusEvents([]models.StatusEvent{newStatusEvent(models.StatusEventDocumentMessage, "signer", statusSnapshot{}, bson.M{"status": models.MessageStatusSigned})})
+
+	assert.Empty(t, pendingStatusEvents.events)
+}
+
+func TestRecordStatusEvents_TooManyPending(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	for i := 0; i < maxPendingStatusEvents; i++ {
+		pendingStatusEvents.events = append(pendingStatusEvents.events, newStatusEvent(models.StatusEventDocumentMessage, "signer", statusSnapshot{}, bson.M{"status": models.MessageStatusSigned}))
+	}
+	second := *pendingStatusEvents.events[1].ID
+	event := newStatusEvent(models.StatusEventDocumentMessage, "signer", statusSnapshot{}, bson.M{"status": models.MessageStatusSigned})
+
+	mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.Anything).Return(mongo.BulkWriteException{
+		WriteErrors: []mongo.BulkWriteError{{WriteError: mongo.WriteError{Index: 0, Code: 11000}}, {WriteError: mongo.WriteError{Index: 1, Code: 2}}},
+	}).Once()
+
+	recordStatusEvents([]models.StatusEvent{event})
+
+	// the oldest event is dropped
+	assert.Len(t, pendingStatusEvents.events, maxPendingStatusEvents)
+	assert.Equal(t, second, *pendingStatusEvents.events[0].ID)
+	assert.Equal(t, *event.ID, *pendingStatusEvents.events[maxPendingStatusEvents-1].ID)
+}
+
+func TestUpdateManyWithStatusEvents_NoStatus(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"transaction": nil}
+
+	mockDB.EXPECT().UpdateMany(common.CollectionMessages, filter, bson.M{"$set": update}).Return(int64(2), nil).Once()
+
+	err := updateManyWithStatusEvents(common.CollectionMessages, models.StatusEventDocumentMessage, "relayer", filter, update)
+
+	assert.NoError(t, err)
+}
+
+func TestUpdateManyWithStatusEvents(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusReorged}
+	pending := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+	signed := primitive.NewObjectID()
+	reorged := primitive.NewObjectID()
+
+	mockDB.EXPECT().FindMany(common.CollectionMessages, filter, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]statusSnapshot) = []statusSnapshot{
+				{ID: pending[0], Status: string(models.MessageStatusPending)},
+				{ID: signed, Status: string(models.MessageStatusSigned)},
+				{ID: reorged, Status: string(models.MessageStatusReorged)},
+				{ID: pending[1], Status: string(models.MessageStatusPending)},
+			}
+		}).Return(nil).Once()
+
+	// one update per status the documents were read with
+	mockDB.EXPECT().UpdateMany(common.CollectionMessages, bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$in": pending}}, {"status": string(models.MessageStatusPending)}}}, setWithUpdatedAt(update, nil)).
+		Return(int64(2), nil).Once()
+	mockDB.EXPECT().UpdateMany(common.CollectionMessages, bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$in": []primitive.ObjectID{signed}}}, {"status": string(models.MessageStatusSigned)}}}, setWithUpdatedAt(update, nil)).
+		Return(int64(1), nil).Once()
+	mockDB.EXPECT().UpdateMany(common.CollectionMessages, bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$in": []primitive.ObjectID{reorged}}}, {"status": string(models.MessageStatusReorged)}}}, bson.M{"$set": update}).
+		Return(int64(0), nil).Once()
+
+	// the events are inserted together, without one for the document that kept its status
+	mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.MatchedBy(func(events []interface{}) bool {
+		return len(events) == 3 &&
+			events[0].(models.StatusEvent).DocumentID == pending[0] &&
+			events[1].(models.StatusEvent).DocumentID == pending[1] &&
+			events[2].(models.StatusEvent).DocumentID == signed
+	})).Return(nil).Once()
+
+	err := updateManyWithStatusEvents(common.CollectionMessages, models.StatusEventDocumentMessage, "monitor", filter, update)
+
+	assert.NoError(t, err)
+}
+
+func TestUpdateManyWithStatusEvents_NoLongerMatching(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusReorged}
+	first := primitive.NewObjectID()
+	second := primitive.NewObjectID()
+	ids := []primitive.ObjectID{first, second}
+	var updatedAt time.Time
+
+	mockDB.EXPECT().FindMany(common.CollectionMessages, filter, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]statusSnapshot) = []statusSnapshot{
+				{ID: first, Status: string(models.MessageStatusSigned)},
+				{ID: second, Status: string(models.MessageStatusSigned)},
+			}
+		}).Return(nil).Once()
+	mockDB.EXPECT().UpdateMany(common.CollectionMessages, bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$in": ids}}, {"status": string(models.MessageStatusSigned)}}}, setWithUpdatedAt(update, &updatedAt)).
+		Return(int64(1), nil).Once()
+
+	// the first document changed status since it was read, so it was not updated
+	mockDB.EXPECT().FindMany(common.CollectionMessages, mock.Anything, mock.Anything).
+		Run(func(_ string, currentFilter interface{}, result interface{}) {
+			assert.Equal(t, bson.M{"_id": bson.M{"$in": ids}, "status": models.MessageStatusReorged, "updated_at": updatedAt}, currentFilter)
+			*result.(*[]statusSnapshot) = []statusSnapshot{
+				{ID: second, Status: string(models.MessageStatusReorged)},
+			}
+		}).Return(nil).Once()
+	mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.MatchedBy(func(events []interface{}) bool {
+		event := events[0].(models.StatusEvent)
+		return len(events) == 1 &&
+			event.DocumentID == second &&
+			event.OldStatus == string(models.MessageStatusSigned) &&
+			event.NewStatus == string(models.MessageStatusReorged)
+	})).Return(nil).Once()
+
+	err := updateManyWithStatusEvents(common.CollectionMessages, models.StatusEventDocumentMessage, "monitor", filter, update)
+
+	assert.NoError(t, err)
+}
+
+func TestUpdateManyWithStatusEvents_UpdateError(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusReorged}
+
+	mockDB.EXPECT().FindMany(common.CollectionMessages, filter, mock.Anything).
+		Run(func(_ string, _ interface{}, result interface{}) {
+			*result.(*[]statusSnapshot) = []statusSnapshot{{ID: primitive.NewObjectID(), Status: string(models.MessageStatusSigned)}}
+		}).Return(nil).Once()
+	mockDB.EXPECT().UpdateMany(common.CollectionMessages, mock.Anything, setWithUpdatedAt(update, nil)).Return(int64(0), assert.AnError).Once()
+
+	err := updateManyWithStatusEvents(common.CollectionMessages, models.StatusEventDocumentMessage, "monitor", filter, update)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestUpdateManyWithStatusEvents_FindError(t *testing.T) {
+	mockDB := setupStatusEventTest(t)
+	filter := bson.M{"origin_transaction": primitive.NewObjectID()}
+	update := bson.M{"status": models.MessageStatusReorged}
+
+	mockDB.EXPECT().FindMany(common.CollectionMessages, filter, mock.Anything).Return(assert.AnError).Once()
+
+	err := updateManyWithStatusEvents(common.CollectionMessages, models.StatusEventDocumentMessage, "monitor", filter, update)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
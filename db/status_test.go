@@ -0,0 +1,24 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func TestStatusUpdate(t *testing.T) {
+	defer SetOracleID("")
+	SetOracleID("oracle-01")
+
+	update := StatusUpdate(models.MessageStatusInvalid, models.StatusCodeAmountMismatch, "amount mismatch")
+
+	assert.Equal(t, bson.M{
+		"status":           models.MessageStatusInvalid,
+		"status_code":      models.StatusCodeAmountMismatch,
+		"status_reason":    "amount mismatch",
+		"status_oracle_id": "oracle-01",
+	}, update)
+}
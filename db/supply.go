@@ -0,0 +1,22 @@
+package db
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+type SupplyDB interface {
+	InsertSupplySnapshot(snapshot models.SupplySnapshot) (primitive.ObjectID, error)
+}
+
+func insertSupplySnapshot(snapshot models.SupplySnapshot) (primitive.ObjectID, error) {
+	return mongoDB.InsertOne(common.CollectionSupplySnapshots, snapshot)
+}
+
+type supplyDB struct{}
+
+func (db *supplyDB) InsertSupplySnapshot(snapshot models.SupplySnapshot) (primitive.ObjectID, error) {
+	return insertSupplySnapshot(snapshot)
+}
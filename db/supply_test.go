@@ -0,0 +1,61 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	mocks "github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SupplyTestSuite struct {
+	suite.Suite
+	mockDB     *mocks.MockDatabase
+	oldMongoDB Database
+	db         SupplyDB
+}
+
+func (suite *SupplyTestSuite) SetupTest() {
+	suite.mockDB = mocks.NewMockDatabase(suite.T())
+	suite.oldMongoDB = mongoDB
+	mongoDB = suite.mockDB
+	suite.db = &supplyDB{}
+}
+
+func (suite *SupplyTestSuite) TearDownTest() {
+	mongoDB = suite.oldMongoDB
+}
+
+func (suite *SupplyTestSuite) TestInsertSupplySnapshot() {
+	snapshot := models.SupplySnapshot{
+		MultisigBalance: "100",
+		TotalSupply:     "90",
+		Discrepancy:     "10",
+	}
+	insertedID := primitive.NewObjectID()
+
+	suite.mockDB.EXPECT().InsertOne(common.CollectionSupplySnapshots, snapshot).Return(insertedID, nil).Once()
+
+	gotID, err := suite.db.InsertSupplySnapshot(snapshot)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), insertedID, gotID)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *SupplyTestSuite) TestInsertSupplySnapshot_SomeError() {
+	expectedError := errors.New("some error")
+
+	suite.mockDB.EXPECT().InsertOne(common.CollectionSupplySnapshots, models.SupplySnapshot{}).Return(primitive.ObjectID{}, expectedError).Once()
+
+	_, err := suite.db.InsertSupplySnapshot(models.SupplySnapshot{})
+	assert.Equal(suite.T(), expectedError, err)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func TestSupplyTestSuite(t *testing.T) {
+	suite.Run(t, new(SupplyTestSuite))
+}
@@ -35,13 +35,17 @@ type TransactionDB interface {
 
 	InsertTransaction(tx models.Transaction) (primitive.ObjectID, error)
 
-	UpdateTransaction(txID *primitive.ObjectID, update bson.M) error
+	UpdateTransaction(txID *primitive.ObjectID, currentStatus models.TransactionStatus, update bson.M) error
 
 	GetPendingTransactionsTo(chain models.Chain, toAddress []byte) ([]models.Transaction, error)
 
 	GetConfirmedTransactionsTo(chain models.Chain, toAddress []byte) ([]models.Transaction, error)
 
 	GetPendingTransactionsFrom(chain models.Chain, fromAddress []byte) ([]models.Transaction, error)
+
+	GetRecentTransactionsTo(chain models.Chain, toAddress []byte, fromBlockHeight uint64) ([]models.Transaction, error)
+
+	GetTransactionsToInRange(chain models.Chain, toAddress []byte, fromBlockHeight uint64, toBlockHeight uint64) ([]models.Transaction, error)
 }
 
 func newEthereumTransaction(
@@ -71,6 +75,7 @@ func newEthereumTransaction(
 		FromAddress: txFrom,
 		ToAddress:   txTo,
 		BlockHeight: receipt.BlockNumber.Uint64(),
+		BlockHash:   receipt.BlockHash.Hex(),
 		Chain:       chain,
 		Status:      txStatus,
 		CreatedAt:   time.Now(),
@@ -131,15 +136,24 @@ func insertTransaction(tx models.Transaction) (primitive.ObjectID, error) {
 	return insertedID, nil
 }
 
-func updateTransaction(txID *primitive.ObjectID, update bson.M) error {
+// updateTransaction updates the transaction only if it still has the current status read by the caller
+func updateTransaction(runner string, txID *primitive.ObjectID, currentStatus models.TransactionStatus, update bson.M) error {
 	if txID == nil {
 		return fmt.Errorf("txID is nil")
 	}
-	_, err := mongoDB.UpdateOne(
+	if err := checkTransition(transactionTransitions, currentStatus, update); err != nil {
+		return err
+	}
+	_, err := updateWithStatusEvent(
 		common.CollectionTransactions,
-		bson.M{"_id": *txID},
-		bson.M{"$set": update},
+		models.StatusEventDocumentTransaction,
+		runner,
+		bson.M{"_id": *txID, "status": currentStatus},
+		update,
 	)
+	if err == mongo.ErrNoDocuments {
+		return statusError(common.CollectionTransactions, bson.M{"_id": *txID}, string(currentStatus), update)
+	}
 	return err
 }
 
@@ -223,7 +237,54 @@ func getPendingTransactionsFrom(chain models.Chain, fromAddress []byte) ([]model
 	return txs, err
 }
 
-type transactionDB struct{}
+func getRecentTransactionsTo(chain models.Chain, toAddress []byte, fromBlockHeight uint64) ([]models.Transaction, error) {
+	txs := []models.Transaction{}
+
+	txTo, err := common.AddressHexFromBytes(toAddress)
+	if err != nil {
+		return txs, fmt.Errorf("invalid to address: %w", err)
+	}
+
+	filter := bson.M{
+		"status": bson.M{"$in": []models.TransactionStatus{
+			models.TransactionStatusPending,
+			models.TransactionStatusConfirmed,
+			models.TransactionStatusReorged, // checked again in case it is included in a different block
+		}},
+		"chain":        chain,
+		"to_address":   txTo,
+		"block_height": bson.M{"$gte": fromBlockHeight},
+	}
+
+	err = mongoDB.FindMany(common.CollectionTransactions, filter, &txs)
+
+	return txs, err
+}
+
+// getTransactionsToInRange returns the transactions of any status sent to toAddress
+// within the given block range, inclusive
+func getTransactionsToInRange(chain models.Chain, toAddress []byte, fromBlockHeight uint64, toBlockHeight uint64) ([]models.Transaction, error) {
+	txs := []models.Transaction{}
+
+	txTo, err := common.AddressHexFromBytes(toAddress)
+	if err != nil {
+		return txs, fmt.Errorf("invalid to address: %w", err)
+	}
+
+	filter := bson.M{
+		"chain":        chain,
+		"to_address":   txTo,
+		"block_height": bson.M{"$gte": fromBlockHeight, "$lte": toBlockHeight},
+	}
+
+	err = mongoDB.FindMany(common.CollectionTransactions, filter, &txs)
+
+	return txs, err
+}
+
+type transactionDB struct {
+	runner string
+}
 
 func (db *transactionDB) NewEthereumTransaction(
 	tx *types.Transaction,
@@ -249,8 +310,8 @@ func (db *transactionDB) InsertTransaction(tx models.Transaction) (primitive.Obj
 	return insertTransaction(tx)
 }
 
-func (db *transactionDB) UpdateTransaction(txID *primitive.ObjectID, update bson.M) error {
-	return updateTransaction(txID, update)
+func (db *transactionDB) UpdateTransaction(txID *primitive.ObjectID, currentStatus models.TransactionStatus, update bson.M) error {
+	return updateTransaction(db.runner, txID, currentStatus, update)
 }
 
 func (db *transactionDB) GetPendingTransactionsTo(chain models.Chain, toAddress []byte) ([]models.Transaction, error) {
@@ -264,3 +325,11 @@ func (db *transactionDB) GetConfirmedTransactionsTo(chain models.Chain, toAddres
 func (db *transactionDB) GetPendingTransactionsFrom(chain models.Chain, fromAddress []byte) ([]models.Transaction, error) {
 	return getPendingTransactionsFrom(chain, fromAddress)
 }
+
+func (db *transactionDB) GetRecentTransactionsTo(chain models.Chain, toAddress []byte, fromBlockHeight uint64) ([]models.Transaction, error) {
+	return getRecentTransactionsTo(chain, toAddress, fromBlockHeight)
+}
+
+func (db *transactionDB) GetTransactionsToInRange(chain models.Chain, toAddress []byte, fromBlockHeight uint64, toBlockHeight uint64) ([]models.Transaction, error) {
+	return getTransactionsToInRange(chain, toAddress, fromBlockHeight, toBlockHeight)
+}
@@ -59,6 +59,7 @@ func (suite *TransactionTestSuite) TestNewEthereumTransaction() {
 	receipt := &types.Receipt{
 		TxHash:      ethcommon.HexToHash("0x01"),
 		BlockNumber: big.NewInt(1),
+		BlockHash:   ethcommon.HexToHash("0x02"),
 	}
 	chain := models.Chain{ChainID: "eth"}
 	txStatus := models.TransactionStatusPending
@@ -66,6 +67,7 @@ func (suite *TransactionTestSuite) TestNewEthereumTransaction() {
 	ethTx, err := suite.db.NewEthereumTransaction(tx, toAddress.Bytes(), receipt, chain, txStatus)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), common.Ensure0xPrefix(receipt.TxHash.String()), ethTx.Hash)
+	assert.Equal(suite.T(), receipt.BlockHash.Hex(), ethTx.BlockHash)
 	assert.Equal(suite.T(), toAddress.Hex(), ethTx.ToAddress)
 	assert.Equal(suite.T(), chain, ethTx.Chain)
 	assert.Equal(suite.T(), txStatus, ethTx.Status)
@@ -251,9 +253,16 @@ func (suite *TransactionTestSuite) TestUpdateTransaction() {
 	txID := primitive.NewObjectID()
 	update := bson.M{"status": models.TransactionStatusConfirmed}
 
-	suite.mockDB.EXPECT().UpdateOne(common.CollectionTransactions, bson.M{"_id": txID}, bson.M{"$set": update}).Return(primitive.ObjectID{}, nil).Once()
+	suite.mockDB.EXPECT().FindOneAndUpdate(common.CollectionTransactions, bson.M{"_id": txID, "status": models.TransactionStatusPending}, bson.M{"$set": update}, mock.Anything).
+		Run(func(_ string, _ interface{}, _ interface{}, result interface{}) {
+			*result.(*statusSnapshot) = statusSnapshot{ID: txID, Status: string(models.TransactionStatusPending), Hash: "0x010203"}
+		}).Return(nil).Once()
+	suite.mockDB.EXPECT().InsertMany(common.CollectionStatusEvents, mock.MatchedBy(func(events []interface{}) bool {
+		event := events[0].(models.StatusEvent)
+		return len(events) == 1 && event.Document == models.StatusEventDocumentTransaction && event.TransactionHash == "0x010203"
+	})).Return(nil).Once()
 
-	err := suite.db.UpdateTransaction(&txID, update)
+	err := suite.db.UpdateTransaction(&txID, models.TransactionStatusPending, update)
 	assert.NoError(suite.T(), err)
 	suite.mockDB.AssertExpectations(suite.T())
 }
@@ -261,7 +270,7 @@ func (suite *TransactionTestSuite) TestUpdateTransaction() {
 func (suite *TransactionTestSuite) TestUpdateTransaction_NilTxDoc() {
 	update := bson.M{"status": models.TransactionStatusConfirmed}
 
-	err := suite.db.UpdateTransaction(nil, update)
+	err := suite.db.UpdateTransaction(nil, models.TransactionStatusPending, update)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), "txID is nil", err.Error())
 }
@@ -381,6 +390,79 @@ func (suite *TransactionTestSuite) TestGetPendingTransactionsFrom_InvalidFromAdd
 	assert.Equal(suite.T(), expectedError, err)
 }
 
+func (suite *TransactionTestSuite) TestGetRecentTransactionsTo() {
+	chain := models.Chain{ChainID: "eth"}
+	toAddress := ethcommon.HexToAddress("0x010203")
+	expectedTxs := []models.Transaction{
+		{ID: &primitive.ObjectID{}, Status: models.TransactionStatusConfirmed},
+	}
+
+	filter := bson.M{
+		"status": bson.M{"$in": []models.TransactionStatus{
+			models.TransactionStatusPending,
+			models.TransactionStatusConfirmed,
+			models.TransactionStatusReorged,
+		}},
+		"chain":        chain,
+		"to_address":   strings.ToLower(toAddress.Hex()),
+		"block_height": bson.M{"$gte": uint64(100)},
+	}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionTransactions, filter, &[]models.Transaction{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		txs := args.Get(2).(*[]models.Transaction)
+		*txs = expectedTxs
+	})
+
+	gotTxs, err := suite.db.GetRecentTransactionsTo(chain, toAddress[:], 100)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedTxs, gotTxs)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionTestSuite) TestGetRecentTransactionsTo_InvalidToAddress() {
+	chain := models.Chain{ChainID: "eth"}
+	toAddress := []byte{0x01}
+
+	expectedError := fmt.Errorf("invalid to address: %w", common.ErrInvalidAddressLength)
+	_, err := suite.db.GetRecentTransactionsTo(chain, toAddress[:], 100)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
+func (suite *TransactionTestSuite) TestGetTransactionsToInRange() {
+	chain := models.Chain{ChainID: "eth"}
+	toAddress := ethcommon.HexToAddress("0x010203")
+	expectedTxs := []models.Transaction{
+		{ID: &primitive.ObjectID{}, Status: models.TransactionStatusInvalid},
+	}
+
+	filter := bson.M{
+		"chain":        chain,
+		"to_address":   strings.ToLower(toAddress.Hex()),
+		"block_height": bson.M{"$gte": uint64(100), "$lte": uint64(200)},
+	}
+
+	suite.mockDB.EXPECT().FindMany(common.CollectionTransactions, filter, &[]models.Transaction{}).Return(nil).Once().Run(func(args mock.Arguments) {
+		txs := args.Get(2).(*[]models.Transaction)
+		*txs = expectedTxs
+	})
+
+	gotTxs, err := suite.db.GetTransactionsToInRange(chain, toAddress[:], 100, 200)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedTxs, gotTxs)
+	suite.mockDB.AssertExpectations(suite.T())
+}
+
+func (suite *TransactionTestSuite) TestGetTransactionsToInRange_InvalidToAddress() {
+	chain := models.Chain{ChainID: "eth"}
+	toAddress := []byte{0x01}
+
+	expectedError := fmt.Errorf("invalid to address: %w", common.ErrInvalidAddressLength)
+	_, err := suite.db.GetTransactionsToInRange(chain, toAddress[:], 100, 200)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), expectedError, err)
+}
+
 func TestTransactionTestSuite(t *testing.T) {
 	suite.Run(t, new(TransactionTestSuite))
 }
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+// ErrStatusTransition is returned for an update to a status that cannot follow the current one
+var ErrStatusTransition = errors.New("illegal status transition")
+
+// ErrStatusConflict is returned when a document is no longer in the status the update expects,
+// since another runner or oracle changed it after it was read
+var ErrStatusConflict = errors.New("status changed concurrently")
+
+// messageTransitions lists the statuses each message status can move to.
+// Keeping the status, for example to add signatures, is always allowed.
+// Invalid messages are final, and reorged messages only leave through reorgMessageTransitions
+var messageTransitions = map[models.MessageStatus][]models.MessageStatus{
+	models.MessageStatusPending: {
+		models.MessageStatusSigned,
+		models.MessageStatusInvalid,
+		models.MessageStatusOverLimit,
+		models.MessageStatusDestinationPaused,
+		models.MessageStatusSuccess, // fulfilled with the signatures of other oracles
+	},
+	models.MessageStatusSigned: {
+		models.MessageStatusPending, // signatures removed or found invalid
+		models.MessageStatusBroadcasted,
+		models.MessageStatusSuccess,
+		models.MessageStatusInvalid,
+		models.MessageStatusDestinationPaused,
+	},
+	models.MessageStatusBroadcasted: {
+		models.MessageStatusPending, // the cosmos tx failed
+		models.MessageStatusSigned,  // the fulfillment reverted
+		models.MessageStatusSuccess,
+	},
+	models.MessageStatusOverLimit: {
+		models.MessageStatusPending,
+		models.MessageStatusSigned,
+		models.MessageStatusInvalid,
+		models.MessageStatusDestinationPaused,
+		models.MessageStatusSuccess,
+	},
+	models.MessageStatusDestinationPaused: {
+		models.MessageStatusPending,
+		models.MessageStatusSigned,
+		models.MessageStatusInvalid,
+		models.MessageStatusOverLimit,
+		models.MessageStatusSuccess,
+	},
+}
+
+// reorgMessageTransitions lists the statuses a message can move to when a reorg
+// changes the inclusion of its origin or fulfillment transaction.
+// Only the reorg checks of the ethereum monitor and relayer use them
+var reorgMessageTransitions = map[models.MessageStatus][]models.MessageStatus{
+	models.MessageStatusPending: {
+		models.MessageStatusReorged,
+	},
+	models.MessageStatusSigned: {
+		models.MessageStatusReorged,
+	},
+	models.MessageStatusReorged: {
+		models.MessageStatusPending, // the origin transaction was included in a different block
+	},
+	models.MessageStatusSuccess: {
+		models.MessageStatusSigned, // the fulfillment was reorged
+	},
+}
+
+// refundTransitions lists the statuses each refund status can move to.
+// Successful and invalid refunds are final
+var refundTransitions = map[models.RefundStatus][]models.RefundStatus{
+	models.RefundStatusPending: {
+		models.RefundStatusSigned,
+		models.RefundStatusInvalid,
+	},
+	models.RefundStatusSigned: {
+		models.RefundStatusPending, // signatures found invalid
+		models.RefundStatusBroadcasted,
+		models.RefundStatusInvalid,
+	},
+	models.RefundStatusBroadcasted: {
+		models.RefundStatusPending, // the tx failed
+		models.RefundStatusSuccess,
+	},
+}
+
+// transactionTransitions lists the statuses each transaction status can move to.
+// Failed and invalid transactions are final
+var transactionTransitions = map[models.TransactionStatus][]models.TransactionStatus{
+	models.TransactionStatusPending: {
+		models.TransactionStatusConfirmed,
+		models.TransactionStatusFailed,
+		models.TransactionStatusInvalid,
+		models.TransactionStatusReorged,
+	},
+	models.TransactionStatusConfirmed: {
+		models.TransactionStatusPending, // included in a different block
+		models.TransactionStatusFailed,
+		models.TransactionStatusInvalid,
+		models.TransactionStatusReorged,
+	},
+	models.TransactionStatusReorged: {
+		models.TransactionStatusPending, // included in a different block
+	},
+}
+
+func canTransition[S ~string](transitions map[S][]S, from S, to S) bool {
+	return from == to || slices.Contains(transitions[from], to)
+}
+
+// previousStatuses returns the statuses that can move to the status, sorted
+func previousStatuses[S ~string](transitions map[S][]S, to S) []S {
+	statuses := []S{to}
+	for from, next := range transitions {
+		if from != to && slices.Contains(next, to) {
+			statuses = append(statuses, from)
+		}
+	}
+	slices.Sort(statuses)
+	return statuses
+}
+
+// newStatus returns the status set by the update, if any
+func newStatus[S ~string](update bson.M) (S, bool, error) {
+	value, ok := update["status"]
+	if !ok {
+		return "", false, nil
+	}
+	status, ok := value.(S)
+	if !ok {
+		return "", false, fmt.Errorf("invalid status %v of type %T", value, value)
+	}
+	return status, true, nil
+}
+
+// checkTransition returns an error if the update sets a status that cannot follow the current status
+func checkTransition[S ~string](transitions map[S][]S, currentStatus S, update bson.M) error {
+	to, ok, err := newStatus[S](update)
+	if err != nil || !ok {
+		return err
+	}
+	if !canTransition(transitions, currentStatus, to) {
+		return fmt.Errorf("%w: %s to %s", ErrStatusTransition, currentStatus, to)
+	}
+	return nil
+}
+
+// transitionFilter restricts the filter to the documents whose status can move to the status set by the update
+func transitionFilter[S ~string](transitions map[S][]S, filter bson.M, update bson.M) (bson.M, error) {
+	to, ok, err := newStatus[S](update)
+	if err != nil || !ok {
+		return filter, err
+	}
+	return bson.M{"$and": []bson.M{filter, {"status": bson.M{"$in": previousStatuses(transitions, to)}}}}, nil
+}
+
+// statusError explains why an update matched no document, given the filter without its status condition
+func statusError(collection string, filter bson.M, expected string, update bson.M) error {
+	var current statusSnapshot
+	if err := mongoDB.FindOne(collection, filter, &current); err != nil {
+		return err
+	}
+	if expected != "" && current.Status != expected {
+		return fmt.Errorf("%w: expected %s, found %s", ErrStatusConflict, expected, current.Status)
+	}
+	return fmt.Errorf("%w: %s to %v", ErrStatusTransition, current.Status, update["status"])
+}
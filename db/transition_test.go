@@ -0,0 +1,108 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func TestCanTransition_Message(t *testing.T) {
+	tests := []struct {
+		from     models.MessageStatus
+		to       models.MessageStatus
+		expected bool
+	}{
+		{models.MessageStatusPending, models.MessageStatusPending, true},
+		{models.MessageStatusPending, models.MessageStatusSigned, true},
+		{models.MessageStatusSigned, models.MessageStatusBroadcasted, true},
+		{models.MessageStatusBroadcasted, models.MessageStatusSuccess, true},
+		{models.MessageStatusBroadcasted, models.MessageStatusPending, true},
+		{models.MessageStatusOverLimit, models.MessageStatusSigned, true},
+		{models.MessageStatusSuccess, models.MessageStatusPending, false},
+		{models.MessageStatusSuccess, models.MessageStatusBroadcasted, false},
+		{models.MessageStatusInvalid, models.MessageStatusPending, false},
+		{models.MessageStatusInvalid, models.MessageStatusSuccess, false},
+		{models.MessageStatusReorged, models.MessageStatusSigned, false},
+		{models.MessageStatusReorged, models.MessageStatusPending, false},
+		{models.MessageStatusSuccess, models.MessageStatusSigned, false},
+		{models.MessageStatusPending, models.MessageStatusReorged, false},
+		{models.MessageStatusPending, models.MessageStatusBroadcasted, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, canTransition(messageTransitions, test.from, test.to), "%s to %s", test.from, test.to)
+	}
+}
+
+func TestCanTransition_ReorgMessage(t *testing.T) {
+	assert.True(t, canTransition(reorgMessageTransitions, models.MessageStatusPending, models.MessageStatusReorged))
+	assert.True(t, canTransition(reorgMessageTransitions, models.MessageStatusSigned, models.MessageStatusReorged))
+	assert.True(t, canTransition(reorgMessageTransitions, models.MessageStatusReorged, models.MessageStatusPending))
+	assert.True(t, canTransition(reorgMessageTransitions, models.MessageStatusSuccess, models.MessageStatusSigned))
+	assert.False(t, canTransition(reorgMessageTransitions, models.MessageStatusBroadcasted, models.MessageStatusSigned))
+	assert.False(t, canTransition(reorgMessageTransitions, models.MessageStatusReorged, models.MessageStatusSigned))
+}
+
+func TestCanTransition_Refund(t *testing.T) {
+	assert.True(t, canTransition(refundTransitions, models.RefundStatusBroadcasted, models.RefundStatusPending))
+	assert.True(t, canTransition(refundTransitions, models.RefundStatusBroadcasted, models.RefundStatusSuccess))
+	assert.False(t, canTransition(refundTransitions, models.RefundStatusSuccess, models.RefundStatusPending))
+	assert.False(t, canTransition(refundTransitions, models.RefundStatusInvalid, models.RefundStatusSigned))
+}
+
+func TestCanTransition_Transaction(t *testing.T) {
+	assert.True(t, canTransition(transactionTransitions, models.TransactionStatusConfirmed, models.TransactionStatusReorged))
+	assert.True(t, canTransition(transactionTransitions, models.TransactionStatusConfirmed, models.TransactionStatusPending))
+	assert.False(t, canTransition(transactionTransitions, models.TransactionStatusFailed, models.TransactionStatusConfirmed))
+	assert.True(t, canTransition(transactionTransitions, models.TransactionStatusReorged, models.TransactionStatusPending))
+	assert.False(t, canTransition(transactionTransitions, models.TransactionStatusReorged, models.TransactionStatusConfirmed))
+}
+
+func TestPreviousStatuses(t *testing.T) {
+	assert.Equal(t, []models.RefundStatus{
+		models.RefundStatusBroadcasted,
+		models.RefundStatusSuccess,
+	}, previousStatuses(refundTransitions, models.RefundStatusSuccess))
+
+	assert.Equal(t, []models.TransactionStatus{
+		models.TransactionStatusConfirmed,
+		models.TransactionStatusPending,
+		models.TransactionStatusReorged,
+	}, previousStatuses(transactionTransitions, models.TransactionStatusReorged))
+}
+
+func TestCheckTransition(t *testing.T) {
+	assert.NoError(t, checkTransition(messageTransitions, models.MessageStatusPending, bson.M{"signatures": []models.Signature{}}))
+	assert.NoError(t, checkTransition(messageTransitions, models.MessageStatusPending, bson.M{"status": models.MessageStatusSigned}))
+
+	err := checkTransition(messageTransitions, models.MessageStatusSuccess, bson.M{"status": models.MessageStatusPending})
+	assert.ErrorIs(t, err, ErrStatusTransition)
+	assert.Contains(t, err.Error(), "success to pending")
+}
+
+func TestCheckTransition_InvalidStatusType(t *testing.T) {
+	err := checkTransition(messageTransitions, models.MessageStatusPending, bson.M{"status": models.RefundStatusSigned})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrStatusTransition)
+
+	err = checkTransition(messageTransitions, models.MessageStatusPending, bson.M{"status": "signed"})
+	assert.Error(t, err)
+}
+
+func TestTransitionFilter(t *testing.T) {
+	filter := bson.M{"_id": "id"}
+
+	got, err := transitionFilter(refundTransitions, filter, bson.M{"transaction": nil})
+	assert.NoError(t, err)
+	assert.Equal(t, filter, got)
+
+	got, err = transitionFilter(refundTransitions, filter, bson.M{"status": models.RefundStatusSuccess})
+	assert.NoError(t, err)
+	assert.Equal(t, bson.M{"$and": []bson.M{filter, {"status": bson.M{"$in": []models.RefundStatus{
+		models.RefundStatusBroadcasted,
+		models.RefundStatusSuccess,
+	}}}}}, got)
+}
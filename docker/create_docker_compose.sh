@@ -13,8 +13,12 @@ env_vars=""
 for i in $(seq 0 $(($NUM_ETHEREUM_NETWORKS - 1))); do
   env_vars+="      ETHEREUM_NETWORKS_${i}_START_BLOCK_HEIGHT: \${ETHEREUM_NETWORKS_${i}_START_BLOCK_HEIGHT}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_CONFIRMATIONS: \${ETHEREUM_NETWORKS_${i}_CONFIRMATIONS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_FINALITY_TAG: \${ETHEREUM_NETWORKS_${i}_FINALITY_TAG}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_RPC_URL: \${ETHEREUM_NETWORKS_${i}_RPC_URL}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_RPC_URLS: \${ETHEREUM_NETWORKS_${i}_RPC_URLS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_RPC_QUORUM: \${ETHEREUM_NETWORKS_${i}_RPC_QUORUM}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_TIMEOUT_MS: \${ETHEREUM_NETWORKS_${i}_TIMEOUT_MS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MAX_QUERY_BLOCKS: \${ETHEREUM_NETWORKS_${i}_MAX_QUERY_BLOCKS}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_CHAIN_ID: \${ETHEREUM_NETWORKS_${i}_CHAIN_ID}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_CHAIN_NAME: \${ETHEREUM_NETWORKS_${i}_CHAIN_NAME}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_MAILBOX_ADDRESS: \${ETHEREUM_NETWORKS_${i}_MAILBOX_ADDRESS}\n"
@@ -28,6 +32,18 @@ for i in $(seq 0 $(($NUM_ETHEREUM_NETWORKS - 1))); do
   env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_SIGNER_INTERVAL_MS: \${ETHEREUM_NETWORKS_${i}_MESSAGE_SIGNER_INTERVAL_MS}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_ENABLED: \${ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_ENABLED}\n"
   env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_INTERVAL_MS: \${ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_INTERVAL_MS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_ENABLED: \${ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_ENABLED}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_INTERVAL_MS: \${ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_INTERVAL_MS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_ENABLED: \${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_ENABLED}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_INTERVAL_MS: \${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_INTERVAL_MS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_PRIVATE_KEY: \${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_PRIVATE_KEY}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_DAILY_SPENDING_CAP_WEI: \${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_DAILY_SPENDING_CAP_WEI}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_STUCK_TIMEOUT_MS: \${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_STUCK_TIMEOUT_MS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_GAS_BUMP_PERCENT: \${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_GAS_BUMP_PERCENT}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_ENABLED: \${ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_ENABLED}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_INTERVAL_MS: \${ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_INTERVAL_MS}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_ENABLED: \${ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_ENABLED}\n"
+  env_vars+="      ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_INTERVAL_MS: \${ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_INTERVAL_MS}\n"
 done
 
 # Substitute the placeholder with actual environment variable lines
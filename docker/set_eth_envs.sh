@@ -8,8 +8,12 @@ for i in $(seq 0 $(($NUM_ETHEREUM_NETWORKS - 1)))
 do
   eval "export ETHEREUM_NETWORKS_${i}_START_BLOCK_HEIGHT=\${ETHEREUM_NETWORKS_${i}_START_BLOCK_HEIGHT}"
   eval "export ETHEREUM_NETWORKS_${i}_CONFIRMATIONS=\${ETHEREUM_NETWORKS_${i}_CONFIRMATIONS}"
+  eval "export ETHEREUM_NETWORKS_${i}_FINALITY_TAG=\${ETHEREUM_NETWORKS_${i}_FINALITY_TAG}"
   eval "export ETHEREUM_NETWORKS_${i}_RPC_URL=\${ETHEREUM_NETWORKS_${i}_RPC_URL}"
+  eval "export ETHEREUM_NETWORKS_${i}_RPC_URLS=\${ETHEREUM_NETWORKS_${i}_RPC_URLS}"
+  eval "export ETHEREUM_NETWORKS_${i}_RPC_QUORUM=\${ETHEREUM_NETWORKS_${i}_RPC_QUORUM}"
   eval "export ETHEREUM_NETWORKS_${i}_TIMEOUT_MS=\${ETHEREUM_NETWORKS_${i}_TIMEOUT_MS}"
+  eval "export ETHEREUM_NETWORKS_${i}_MAX_QUERY_BLOCKS=\${ETHEREUM_NETWORKS_${i}_MAX_QUERY_BLOCKS}"
   eval "export ETHEREUM_NETWORKS_${i}_CHAIN_ID=\${ETHEREUM_NETWORKS_${i}_CHAIN_ID}"
   eval "export ETHEREUM_NETWORKS_${i}_CHAIN_NAME=\${ETHEREUM_NETWORKS_${i}_CHAIN_NAME}"
   eval "export ETHEREUM_NETWORKS_${i}_MAILBOX_ADDRESS=\${ETHEREUM_NETWORKS_${i}_MAILBOX_ADDRESS}"
@@ -23,4 +27,16 @@ do
   eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_SIGNER_INTERVAL_MS=\${ETHEREUM_NETWORKS_${i}_MESSAGE_SIGNER_INTERVAL_MS}"
   eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_ENABLED=\${ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_ENABLED}"
   eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_INTERVAL_MS=\${ETHEREUM_NETWORKS_${i}_MESSAGE_RELAYER_INTERVAL_MS}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_ENABLED=\${ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_ENABLED}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_INTERVAL_MS=\${ETHEREUM_NETWORKS_${i}_MESSAGE_AUDITOR_INTERVAL_MS}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_ENABLED=\${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_ENABLED}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_INTERVAL_MS=\${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_INTERVAL_MS}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_PRIVATE_KEY=\${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_PRIVATE_KEY}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_DAILY_SPENDING_CAP_WEI=\${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_DAILY_SPENDING_CAP_WEI}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_STUCK_TIMEOUT_MS=\${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_STUCK_TIMEOUT_MS}"
+  eval "export ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_GAS_BUMP_PERCENT=\${ETHEREUM_NETWORKS_${i}_MESSAGE_FULFILLER_GAS_BUMP_PERCENT}"
+  eval "export ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_ENABLED=\${ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_ENABLED}"
+  eval "export ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_INTERVAL_MS=\${ETHEREUM_NETWORKS_${i}_MINT_AUDITOR_INTERVAL_MS}"
+  eval "export ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_ENABLED=\${ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_ENABLED}"
+  eval "export ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_INTERVAL_MS=\${ETHEREUM_NETWORKS_${i}_SECURITY_WATCH_INTERVAL_MS}"
 done
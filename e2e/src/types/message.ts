@@ -19,7 +19,9 @@ export type MessageStatus =
   | "signed"
   | "broadcasted"
   | "success"
-  | "invalid";
+  | "invalid"
+  | "over_limit"
+  | "destination_paused";
 
 // Define the Message type
 export type Message = {
@@ -34,6 +36,9 @@ export type Message = {
   readonly sequence?: Long | null;
   readonly transaction_hash: Hex;
   readonly status: MessageStatus;
+  readonly fulfillable_at?: Date | null;
+  readonly simulation_error?: string;
+  readonly fulfillments?: Fulfillment[];
   readonly created_at: Date;
   readonly updated_at: Date;
 };
@@ -51,4 +56,17 @@ export type Signature = {
   readonly signature: Hex; // Assuming signature is a string representation
 };
 
+// Define the Fulfillment type
+export type Fulfillment = {
+  readonly submitter: Hex;
+  readonly nonce: number;
+  readonly tx_hashes: Hex[];
+  readonly gas_limit: number;
+  readonly gas_fee_cap: string;
+  readonly gas_tip_cap: string;
+  readonly fee_paid: string;
+  readonly submitted_at: Date;
+  readonly sent_at: Date;
+};
+
 export const CollectionMessages = "messages";
@@ -28,6 +28,12 @@ export type ChainServiceHealth = {
   readonly message_monitor?: RunnerServiceStatus | null;
   readonly message_signer?: RunnerServiceStatus | null;
   readonly message_relayer?: RunnerServiceStatus | null;
+  readonly message_auditor?: RunnerServiceStatus | null;
+  readonly message_fulfiller?: RunnerServiceStatus | null;
+  readonly mint_auditor?: RunnerServiceStatus | null;
+  readonly supply_reconciler?: RunnerServiceStatus | null;
+  readonly spend_auditor?: RunnerServiceStatus | null;
+  readonly security_watch?: RunnerServiceStatus | null;
 };
 
 // Define the Node type
@@ -39,6 +45,8 @@ export type Node = {
   readonly oracle_id: string;
   readonly supported_chains: Chain[];
   readonly health: ChainServiceHealth[];
+  readonly healthy: boolean;
+  readonly anomalies?: number;
   readonly created_at: Date;
   readonly updated_at: Date;
 };
@@ -23,7 +23,6 @@ export type Config = {
 
 export type HealthCheckConfig = {
   interval_ms: number;
-  read_last_health: boolean;
 };
 
 export type LoggerConfig = {
@@ -40,8 +39,12 @@ export type MongoConfig = {
 export type EthereumNetworkConfig = {
   start_block_height: number;
   confirmations: number;
+  finality_tag: string;
   rpc_url: string;
+  rpc_urls: string[];
+  rpc_quorum: number;
   timeout_ms: number;
+  max_query_blocks: number;
   chain_id: number;
   chain_name: string;
   mailbox_address: string;
@@ -52,6 +55,10 @@ export type EthereumNetworkConfig = {
   message_monitor: ServiceConfig;
   message_signer: ServiceConfig;
   message_relayer: ServiceConfig;
+  message_auditor: ServiceConfig;
+  message_fulfiller: FulfillerConfig;
+  mint_auditor: ServiceConfig;
+  security_watch: ServiceConfig;
 };
 
 export type CosmosNetworkConfig = {
@@ -61,6 +68,9 @@ export type CosmosNetworkConfig = {
   grpc_enabled: boolean;
   grpc_host: string;
   grpc_port: number;
+  rpc_urls: string[];
+  grpc_urls: string[];
+  verify_responses: boolean;
   timeout_ms: number;
   chain_id: string;
   chain_name: string;
@@ -73,6 +83,8 @@ export type CosmosNetworkConfig = {
   message_monitor: ServiceConfig;
   message_signer: ServiceConfig;
   message_relayer: ServiceConfig;
+  supply_reconciler: ReconcilerConfig;
+  spend_auditor: ServiceConfig;
 };
 
 export type ServiceConfig = {
@@ -80,4 +92,15 @@ export type ServiceConfig = {
   interval_ms: number;
 };
 
+export type FulfillerConfig = ServiceConfig & {
+  private_key: string;
+  daily_spending_cap_wei: string;
+  stuck_timeout_ms: number;
+  gas_bump_percent: number;
+};
+
+export type ReconcilerConfig = ServiceConfig & {
+  tolerance: string;
+};
+
 export const config = yaml.load(fs.readFileSync(CONFIG_PATH, "utf8")) as Config;
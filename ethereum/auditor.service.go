@@ -0,0 +1,420 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// EthMessageAuditorRunnable walks the nonces dispatched by the mailbox and checks
+// that every dispatch from the mint controller made it into the messages collection
+type EthMessageAuditorRunnable struct {
+	currentBlockHeight uint64
+
+	// auditedNonce is the lowest nonce that is not yet accounted for
+	auditedNonce uint32
+	initialized  bool
+	// foreignNonces holds the nonces above auditedNonce that were dispatched by other senders
+	foreignNonces map[uint32]bool
+	// tailBlockHeight is the last block scanned for dispatches after the latest known message
+	tailBlockHeight uint64
+
+	mintControllerMap map[uint32][]byte
+
+	mailbox eth.MailboxContract
+	client  eth.EthereumClient
+
+	chain models.Chain
+
+	// monitor syncs the blocks that contain a missed dispatch
+	monitor *EthMessageMonitorRunnable
+
+	logger *log.Entry
+
+	db db.DB
+}
+
+func (x *EthMessageAuditorRunnable) Run() {
+	x.UpdateCurrentBlockHeight()
+	x.AuditNonces()
+}
+
+func (x *EthMessageAuditorRunnable) Height() uint64 {
+	return uint64(x.currentBlockHeight)
+}
+
+// UpdateCurrentBlockHeight reads the block heights for the run and hands them to the monitor
+// so that the transactions it syncs count their confirmations from them
+func (x *EthMessageAuditorRunnable) UpdateCurrentBlockHeight() {
+	heights, err := eth.GetBlockHeights(x.client)
+	if err != nil {
+		x.logger.
+			WithError(err).
+			Error("could not get current block height")
+		return
+	}
+	x.currentBlockHeight = heights.Current
+	if x.monitor != nil {
+		x.monitor.currentBlockHeight = heights.Current
+		x.monitor.finalizedBlockHeight = heights.Finalized
+	}
+	x.logger.
+		WithField("current_block_height", x.currentBlockHeight).
+		WithField("finalized_block_height", heights.Finalized).
+		Info("updated current block height")
+}
+
+// ScanDispatches calls handle for every dispatch event of the mailbox in the block range, from any sender
+func (x *EthMessageAuditorRunnable) ScanDispatches(startBlockHeight uint64, endBlockHeight uint64, handle func(event *autogen.MailboxDispatch) bool) bool {
+	filter, err := x.mailbox.FilterDispatch(&bind.FilterOpts{
+		Start:   startBlockHeight,
+		End:     &endBlockHeight,
+		Context: context.Background(),
+	}, nil, nil, nil)
+
+	if filter != nil {
+		defer filter.Close()
+	}
+
+	if err != nil {
+		if eth.IsRateLimitError(err) {
+			x.logger.WithError(err).Warn("Rate limited, retrying the block range on the next run")
+			return false
+		}
+		if eth.IsQueryRangeError(err) && endBlockHeight > startBlockHeight {
+			midBlockHeight := startBlockHeight + (endBlockHeight-startBlockHeight)/2
+			x.logger.WithError(err).Warnf("Block range %d to %d rejected, splitting at %d", startBlockHeight, endBlockHeight, midBlockHeight)
+			x.client.ReduceMaxQueryBlocks(midBlockHeight - startBlockHeight)
+			return x.ScanDispatches(startBlockHeight, midBlockHeight, handle) &&
+				x.ScanDispatches(midBlockHeight+1, endBlockHeight, handle)
+		}
+		x.logger.WithError(err).Error("Error creating filter for dispatch events")
+		return false
+	}
+
+	success := true
+	for filter.Next() {
+		event := filter.Event()
+		if event == nil || event.Raw.Removed {
+			continue
+		}
+		success = handle(event) && success
+	}
+
+	if err := filter.Error(); err != nil {
+		x.logger.WithError(err).Error("Error processing dispatch events")
+		return false
+	}
+
+	return success
+}
+
+// RescanBlocks syncs the block range again with the monitor to insert the dispatches that were missed
+func (x *EthMessageAuditorRunnable) RescanBlocks(startBlockHeight uint64, endBlockHeight uint64) bool {
+	result, err := service.Rescan(x.chain, models.CheckpointRunnerMonitor, startBlockHeight, endBlockHeight,
+		func() ([]models.Transaction, error) {
+			return x.db.GetTransactionsToInRange(x.chain, x.mailbox.Address().Bytes(), startBlockHeight, endBlockHeight)
+		},
+		func() bool {
+			return x.monitor.SyncBlocks(startBlockHeight, endBlockHeight)
+		},
+	)
+	if err != nil {
+		x.logger.WithError(err).Error("Error rescanning blocks")
+		return false
+	}
+
+	x.logger.
+		WithField("start_block_height", startBlockHeight).
+		WithField("end_block_height", endBlockHeight).
+		WithField("discovered", result.Discovered).
+		Info("Rescanned blocks for missed dispatches")
+
+	return result.Success
+}
+
+// IsKnownTransaction reports whether the dispatch transaction is already in the database,
+// in which case its message is still waiting to be created
+func (x *EthMessageAuditorRunnable) IsKnownTransaction(event *autogen.MailboxDispatch) (bool, error) {
+	blockHeight := event.Raw.BlockNumber
+	txs, err := x.db.GetTransactionsToInRange(x.chain, x.mailbox.Address().Bytes(), blockHeight, blockHeight)
+	if err != nil {
+		return false, err
+	}
+	for _, tx := range txs {
+		if strings.EqualFold(tx.Hash, event.Raw.TxHash.Hex()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// FindMissingNonces looks for the dispatches of the missing nonces in the block range.
+// Nonces dispatched by other senders are remembered, and the blocks holding a dispatch from the
+// mint controller that is not in the database are rescanned. When the range is known to contain
+// every missing nonce, the ones that were not found are reported.
+func (x *EthMessageAuditorRunnable) FindMissingNonces(
+	missing map[uint32]bool,
+	startBlockHeight uint64,
+	endBlockHeight uint64,
+	reportNotFound bool,
+) bool {
+	mintController, ok := x.mintControllerMap[x.chain.ChainDomain]
+	if !ok {
+		x.logger.Errorf("Mint controller not found for chain domain: %d", x.chain.ChainDomain)
+		return false
+	}
+
+	success := SyncBlocksInChunks(x.client, startBlockHeight, endBlockHeight, func(start uint64, end uint64) bool {
+		return x.ScanDispatches(start, end, func(event *autogen.MailboxDispatch) bool {
+			nonce, ok := dispatchNonce(event)
+			if !ok || !missing[nonce] {
+				return true
+			}
+			delete(missing, nonce)
+
+			if !bytes.Equal(event.Sender.Bytes(), mintController) {
+				x.foreignNonces[nonce] = true
+				return true
+			}
+
+			logger := x.logger.
+				WithField("nonce", nonce).
+				WithField("tx_hash", event.Raw.TxHash.Hex()).
+				WithField("block_height", event.Raw.BlockNumber)
+
+			known, err := x.IsKnownTransaction(event)
+			if err != nil {
+				logger.WithError(err).Error("Error finding dispatch transaction")
+				return false
+			}
+			if known {
+				logger.Debug("Dispatch is waiting for its message to be created")
+				return true
+			}
+
+			logger.Warn("Found a dispatch missing from the database, rescanning its block")
+			return x.RescanBlocks(event.Raw.BlockNumber, event.Raw.BlockNumber)
+		})
+	})
+
+	if reportNotFound && len(missing) > 0 {
+		x.logger.
+			WithField("nonces", sortedNonces(missing)).
+			WithField("start_block_height", startBlockHeight).
+			WithField("end_block_height", endBlockHeight).
+			Error("Dispatched nonces not found in the block range between known messages")
+		return false
+	}
+
+	return success
+}
+
+// AuditNonces compares the nonces dispatched by the mailbox with the messages in the database,
+// up to the last block synced by the monitor
+func (x *EthMessageAuditorRunnable) AuditNonces() bool {
+	logger := x.logger.WithField("section", "AuditNonces")
+
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerMonitor)
+	if err != nil {
+		logger.WithError(err).Error("Error finding monitor checkpoint")
+		return false
+	}
+	if checkpoint == nil {
+		logger.Info("Monitor has not synced any blocks yet")
+		return true
+	}
+	auditEndBlockHeight := checkpoint.BlockHeight
+
+	nonces, err := x.db.GetDispatchedNonces(x.chain, x.auditedNonce)
+	if err != nil {
+		logger.WithError(err).Error("Error getting dispatched nonces")
+		return false
+	}
+	if len(nonces) == 0 {
+		logger.Info("No dispatched messages to audit")
+		return true
+	}
+
+	if !x.initialized {
+		// nonces before the first known message cannot be bounded to a block range
+		x.auditedNonce = nonces[0].Nonce
+		x.initialized = true
+	}
+
+	success := true
+	known := map[uint32]bool{nonces[0].Nonce: true}
+
+	for i := 1; i < len(nonces); i++ {
+		prev, next := nonces[i-1], nonces[i]
+		known[next.Nonce] = true
+
+		missing := make(map[uint32]bool)
+		for nonce := prev.Nonce + 1; nonce < next.Nonce; nonce++ {
+			if !x.foreignNonces[nonce] {
+				missing[nonce] = true
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		logger.
+			WithField("nonces", sortedNonces(missing)).
+			Warnf("Gap in dispatched nonces between %d and %d", prev.Nonce, next.Nonce)
+		success = x.FindMissingNonces(missing, prev.BlockHeight, next.BlockHeight, true) && success
+	}
+
+	success = x.AuditLatestNonces(nonces[len(nonces)-1], auditEndBlockHeight) && success
+
+	for known[x.auditedNonce] || x.foreignNonces[x.auditedNonce] {
+		delete(x.foreignNonces, x.auditedNonce)
+		x.auditedNonce++
+	}
+	logger.WithField("audited_nonce", x.auditedNonce).Info("Audited dispatched nonces")
+
+	return success
+}
+
+// AuditLatestNonces checks the nonces dispatched after the latest known message against
+// LatestDispatchedId and the nonce of the mailbox
+func (x *EthMessageAuditorRunnable) AuditLatestNonces(last models.DispatchedNonce, auditEndBlockHeight uint64) bool {
+	logger := x.logger.WithField("section", "AuditLatestNonces")
+
+	latestDispatchedID, err := x.mailbox.LatestDispatchedId(&bind.CallOpts{Context: context.Background()})
+	if err != nil {
+		logger.WithError(err).Error("Error getting latest dispatched id")
+		return false
+	}
+	if common.Hash(latestDispatchedID) == common.HexToHash(last.MessageID) {
+		logger.Debug("Latest dispatch is known")
+		return true
+	}
+
+	nextNonce, err := x.mailbox.Nonce(&bind.CallOpts{Context: context.Background()})
+	if err != nil {
+		logger.WithError(err).Error("Error getting mailbox nonce")
+		return false
+	}
+
+	missing := make(map[uint32]bool)
+	for nonce := last.Nonce + 1; nonce < nextNonce; nonce++ {
+		if !x.foreignNonces[nonce] {
+			missing[nonce] = true
+		}
+	}
+	if len(missing) == 0 {
+		return true
+	}
+
+	startBlockHeight := max(last.BlockHeight, x.tailBlockHeight+1)
+	if startBlockHeight > auditEndBlockHeight {
+		return true
+	}
+
+	// later nonces may be dispatched after the blocks synced by the monitor, so they are not reported
+	if !x.FindMissingNonces(missing, startBlockHeight, auditEndBlockHeight, false) {
+		return false
+	}
+	x.tailBlockHeight = auditEndBlockHeight
+
+	return true
+}
+
+// dispatchNonce reads the nonce from the header of a dispatched message,
+// which is shared by the messages of every sender
+func dispatchNonce(event *autogen.MailboxDispatch) (uint32, bool) {
+	if len(event.Message) < 5 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(event.Message[1:5]), true
+}
+
+func sortedNonces(nonces map[uint32]bool) []uint32 {
+	sorted := make([]uint32, 0, len(nonces))
+	for nonce := range nonces {
+		sorted = append(sorted, nonce)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func NewMessageAuditor(
+	config models.EthereumNetworkConfig,
+	mintControllerMap map[uint32][]byte,
+) service.Runnable {
+	logger := log.
+		WithField("module", "ethereum").
+		WithField("service", "auditor").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", config.ChainID)
+
+	if !config.MessageAuditor.Enabled {
+		logger.Fatalf("Message auditor is not enabled")
+	}
+
+	logger.Debugf("Initializing")
+
+	client, err := ethNewClient(config)
+	if err != nil {
+		logger.Fatalf("Error creating ethereum client: %s", err)
+	}
+
+	logger.Debug("Connecting to mailbox contract at: ", config.MailboxAddress)
+	mailbox, err := ethNewMailboxContract(common.HexToAddress(config.MailboxAddress), client.GetClient())
+	if err != nil {
+		logger.Fatal("Error connecting to mailbox contract: ", err)
+	}
+	logger.Debug("Connected to mailbox contract")
+
+	chain := utilParseChain(config)
+	database := dbNewDB("auditor")
+
+	x := &EthMessageAuditorRunnable{
+		foreignNonces: make(map[uint32]bool),
+
+		mintControllerMap: mintControllerMap,
+
+		mailbox: mailbox,
+		client:  client,
+
+		chain: chain,
+
+		monitor: &EthMessageMonitorRunnable{
+			mintControllerMap: mintControllerMap,
+
+			mailbox:       mailbox,
+			confirmations: config.Confirmations,
+
+			client: client,
+
+			chain: chain,
+
+			logger: logger.WithField("runner", models.CheckpointRunnerMonitor),
+
+			db: database,
+		},
+
+		logger: logger,
+
+		db: database,
+	}
+
+	x.UpdateCurrentBlockHeight()
+
+	logger.Infof("Initialized")
+
+	return x
+}
@@ -0,0 +1,372 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	clientMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+var auditorMintController = ethcommon.HexToAddress("0x0301")
+
+func newTestAuditor(t *testing.T) (*EthMessageAuditorRunnable, *mocks.MockDB, *clientMocks.MockEthereumClient, *clientMocks.MockMailboxContract) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	logger := log.New().WithField("test", "auditor")
+	chain := models.Chain{ChainDomain: 1}
+	mintControllerMap := map[uint32][]byte{1: auditorMintController.Bytes()}
+
+	auditor := &EthMessageAuditorRunnable{
+		foreignNonces:     make(map[uint32]bool),
+		mintControllerMap: mintControllerMap,
+		mailbox:           mailbox,
+		client:            mockClient,
+		chain:             chain,
+		monitor: &EthMessageMonitorRunnable{
+			mintControllerMap: mintControllerMap,
+			mailbox:           mailbox,
+			client:            mockClient,
+			chain:             chain,
+			logger:            logger,
+			db:                mockDB,
+		},
+		logger: logger,
+		db:     mockDB,
+	}
+
+	return auditor, mockDB, mockClient, mailbox
+}
+
+func dispatchEvent(nonce uint32, sender ethcommon.Address, blockHeight uint64) *autogen.MailboxDispatch {
+	message := make([]byte, 173)
+	binary.BigEndian.PutUint32(message[1:5], nonce)
+	return &autogen.MailboxDispatch{
+		Sender:  sender,
+		Message: message,
+		Raw: types.Log{
+			BlockNumber: blockHeight,
+			TxHash:      ethcommon.BytesToHash(message[1:5]),
+		},
+	}
+}
+
+// expectDispatches returns the events from a filter over all senders of the block range
+func expectDispatches(t *testing.T, mailbox *clientMocks.MockMailboxContract, start uint64, end uint64, events ...*autogen.MailboxDispatch) {
+	iterator := clientMocks.NewMockMailboxDispatchIterator(t)
+	mailbox.EXPECT().FilterDispatch(&bind.FilterOpts{
+		Start:   start,
+		End:     &end,
+		Context: context.Background(),
+	}, []ethcommon.Address(nil), []uint32(nil), [][32]byte(nil)).Return(iterator, nil).Once()
+
+	for _, event := range events {
+		iterator.EXPECT().Next().Return(true).Once()
+		iterator.EXPECT().Event().Return(event).Once()
+	}
+	iterator.EXPECT().Next().Return(false).Once()
+	iterator.EXPECT().Error().Return(nil).Once()
+	iterator.EXPECT().Close().Return(nil).Once()
+}
+
+func TestAuditorHeight(t *testing.T) {
+	auditor, _, _, _ := newTestAuditor(t)
+	auditor.currentBlockHeight = 100
+
+	assert.Equal(t, uint64(100), auditor.Height())
+}
+
+func TestAuditorUpdateCurrentBlockHeight(t *testing.T) {
+	auditor, _, mockClient, _ := newTestAuditor(t)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	mockClient.EXPECT().FinalityTag().Return(models.FinalityTagFinalized)
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(90), nil).Once()
+	auditor.UpdateCurrentBlockHeight()
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+	// the monitor syncs missed blocks with the heights of the run
+	assert.Equal(t, uint64(100), auditor.monitor.currentBlockHeight)
+	assert.Equal(t, uint64(90), auditor.monitor.finalizedBlockHeight)
+
+	mockClient.EXPECT().GetBlockHeight().Return(0, fmt.Errorf("error")).Once()
+	auditor.UpdateCurrentBlockHeight()
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+	assert.Equal(t, uint64(100), auditor.monitor.currentBlockHeight)
+}
+
+func TestAuditorAuditNonces_NoCheckpoint(t *testing.T) {
+	auditor, mockDB, _, _ := newTestAuditor(t)
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(nil, nil).Once()
+
+	assert.True(t, auditor.AuditNonces())
+	assert.False(t, auditor.initialized)
+}
+
+func TestAuditorAuditNonces_NoMessages(t *testing.T) {
+	auditor, mockDB, _, _ := newTestAuditor(t)
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return([]models.DispatchedNonce{}, nil).Once()
+
+	assert.True(t, auditor.AuditNonces())
+}
+
+func TestAuditorAuditNonces_Error(t *testing.T) {
+	auditor, mockDB, _, _ := newTestAuditor(t)
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(nil, fmt.Errorf("error")).Once()
+	assert.False(t, auditor.AuditNonces())
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return(nil, fmt.Errorf("error")).Once()
+	assert.False(t, auditor.AuditNonces())
+}
+
+func TestAuditorAuditNonces_Contiguous(t *testing.T) {
+	auditor, mockDB, _, mailbox := newTestAuditor(t)
+
+	nonces := []models.DispatchedNonce{
+		{Nonce: 5, MessageID: "0x05", BlockHeight: 100},
+		{Nonce: 6, MessageID: "0x06", BlockHeight: 110},
+	}
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return(nonces, nil).Once()
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x06"), nil).Once()
+
+	assert.True(t, auditor.AuditNonces())
+	assert.Equal(t, uint32(7), auditor.auditedNonce)
+	assert.True(t, auditor.initialized)
+}
+
+func TestAuditorAuditNonces_GapFromOtherSender(t *testing.T) {
+	auditor, mockDB, mockClient, mailbox := newTestAuditor(t)
+
+	nonces := []models.DispatchedNonce{
+		{Nonce: 5, MessageID: "0x05", BlockHeight: 100},
+		{Nonce: 7, MessageID: "0x07", BlockHeight: 110},
+	}
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return(nonces, nil).Once()
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(1000))
+	expectDispatches(t, mailbox, 100, 110, dispatchEvent(6, ethcommon.HexToAddress("0x0999"), 105))
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x07"), nil).Once()
+
+	assert.True(t, auditor.AuditNonces())
+	assert.Equal(t, uint32(8), auditor.auditedNonce)
+	assert.Empty(t, auditor.foreignNonces)
+}
+
+func TestAuditorAuditNonces_MissedDispatch(t *testing.T) {
+	auditor, mockDB, mockClient, mailbox := newTestAuditor(t)
+
+	nonces := []models.DispatchedNonce{
+		{Nonce: 5, MessageID: "0x05", BlockHeight: 100},
+		{Nonce: 7, MessageID: "0x07", BlockHeight: 110},
+	}
+	event := dispatchEvent(6, auditorMintController, 105)
+	mailboxAddress := ethcommon.HexToAddress("0x0404")
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return(nonces, nil).Once()
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(1000))
+	expectDispatches(t, mailbox, 100, 110, event)
+	mailbox.EXPECT().Address().Return(mailboxAddress)
+	mockDB.EXPECT().GetTransactionsToInRange(auditor.chain, mailboxAddress.Bytes(), uint64(105), uint64(105)).Return([]models.Transaction{}, nil).Times(3)
+
+	// the targeted rescan syncs the block of the missed dispatch with the monitor
+	end := uint64(105)
+	iterator := clientMocks.NewMockMailboxDispatchIterator(t)
+	mailbox.EXPECT().FilterDispatch(&bind.FilterOpts{
+		Start:   105,
+		End:     &end,
+		Context: context.Background(),
+	}, []ethcommon.Address{auditorMintController}, []uint32{}, [][32]byte{}).Return(iterator, nil).Once()
+	iterator.EXPECT().Next().Return(false).Once()
+	iterator.EXPECT().Error().Return(nil)
+	iterator.EXPECT().Close().Return(nil).Once()
+
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x07"), nil).Once()
+
+	assert.True(t, auditor.AuditNonces())
+	// the nonce stays unaccounted for until its message is created
+	assert.Equal(t, uint32(6), auditor.auditedNonce)
+}
+
+func TestAuditorAuditNonces_DispatchAwaitingMessage(t *testing.T) {
+	auditor, mockDB, mockClient, mailbox := newTestAuditor(t)
+
+	nonces := []models.DispatchedNonce{
+		{Nonce: 5, MessageID: "0x05", BlockHeight: 100},
+		{Nonce: 7, MessageID: "0x07", BlockHeight: 110},
+	}
+	event := dispatchEvent(6, auditorMintController, 105)
+	mailboxAddress := ethcommon.HexToAddress("0x0404")
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return(nonces, nil).Once()
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(1000))
+	expectDispatches(t, mailbox, 100, 110, event)
+	mailbox.EXPECT().Address().Return(mailboxAddress)
+	mockDB.EXPECT().GetTransactionsToInRange(auditor.chain, mailboxAddress.Bytes(), uint64(105), uint64(105)).
+		Return([]models.Transaction{{Hash: event.Raw.TxHash.Hex()}}, nil).Once()
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x07"), nil).Once()
+
+	assert.True(t, auditor.AuditNonces())
+	assert.Equal(t, uint32(6), auditor.auditedNonce)
+}
+
+func TestAuditorAuditNonces_NonceNotFound(t *testing.T) {
+	auditor, mockDB, mockClient, mailbox := newTestAuditor(t)
+
+	nonces := []models.DispatchedNonce{
+		{Nonce: 5, MessageID: "0x05", BlockHeight: 100},
+		{Nonce: 7, MessageID: "0x07", BlockHeight: 110},
+	}
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 200}, nil).Once()
+	mockDB.EXPECT().GetDispatchedNonces(auditor.chain, uint32(0)).Return(nonces, nil).Once()
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(1000))
+	expectDispatches(t, mailbox, 100, 110)
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x07"), nil).Once()
+
+	assert.False(t, auditor.AuditNonces())
+	assert.Equal(t, uint32(6), auditor.auditedNonce)
+}
+
+func TestAuditorAuditLatestNonces(t *testing.T) {
+	auditor, _, mockClient, mailbox := newTestAuditor(t)
+
+	last := models.DispatchedNonce{Nonce: 5, MessageID: "0x05", BlockHeight: 100}
+
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x08"), nil).Once()
+	mailbox.EXPECT().Nonce(mock.Anything).Return(uint32(8), nil).Once()
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(1000))
+	// nonce 7 is dispatched after the blocks synced by the monitor and is not reported
+	expectDispatches(t, mailbox, 100, 200, dispatchEvent(6, ethcommon.HexToAddress("0x0999"), 150))
+
+	assert.True(t, auditor.AuditLatestNonces(last, 200))
+	assert.True(t, auditor.foreignNonces[6])
+	assert.Equal(t, uint64(200), auditor.tailBlockHeight)
+
+	// blocks that were scanned already are skipped
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x08"), nil).Once()
+	mailbox.EXPECT().Nonce(mock.Anything).Return(uint32(8), nil).Once()
+
+	assert.True(t, auditor.AuditLatestNonces(last, 200))
+}
+
+func TestAuditorAuditLatestNonces_Errors(t *testing.T) {
+	auditor, _, _, mailbox := newTestAuditor(t)
+
+	last := models.DispatchedNonce{Nonce: 5, MessageID: "0x05", BlockHeight: 100}
+
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return([32]byte{}, fmt.Errorf("error")).Once()
+	assert.False(t, auditor.AuditLatestNonces(last, 200))
+
+	mailbox.EXPECT().LatestDispatchedId(mock.Anything).Return(ethcommon.HexToHash("0x08"), nil).Once()
+	mailbox.EXPECT().Nonce(mock.Anything).Return(uint32(0), fmt.Errorf("error")).Once()
+	assert.False(t, auditor.AuditLatestNonces(last, 200))
+}
+
+func TestAuditorScanDispatches_QueryRangeError(t *testing.T) {
+	auditor, _, mockClient, mailbox := newTestAuditor(t)
+
+	end := uint64(200)
+	mailbox.EXPECT().FilterDispatch(&bind.FilterOpts{
+		Start:   100,
+		End:     &end,
+		Context: context.Background(),
+	}, []ethcommon.Address(nil), []uint32(nil), [][32]byte(nil)).Return(nil, fmt.Errorf("query returned more than 10000 results")).Once()
+	mockClient.EXPECT().ReduceMaxQueryBlocks(uint64(50)).Once()
+	expectDispatches(t, mailbox, 100, 150)
+	expectDispatches(t, mailbox, 151, 200)
+
+	handled := 0
+	assert.True(t, auditor.ScanDispatches(100, 200, func(*autogen.MailboxDispatch) bool {
+		handled++
+		return true
+	}))
+	assert.Equal(t, 0, handled)
+}
+
+func TestDispatchNonce(t *testing.T) {
+	nonce, ok := dispatchNonce(dispatchEvent(42, auditorMintController, 1))
+	assert.True(t, ok)
+	assert.Equal(t, uint32(42), nonce)
+
+	_, ok = dispatchNonce(&autogen.MailboxDispatch{Message: []byte{1, 2}})
+	assert.False(t, ok)
+}
+
+func TestNewMessageAuditor(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockMailbox := clientMocks.NewMockMailboxContract(t)
+
+	mintControllerMap := map[uint32][]byte{
+		1: ethcommon.FromHex("0x01"),
+	}
+
+	config := models.EthereumNetworkConfig{
+		ChainID:        1,
+		ChainName:      "test",
+		MailboxAddress: ethcommon.BytesToAddress([]byte("mailbox")).Hex(),
+		MessageAuditor: models.ServiceConfig{
+			Enabled: true,
+		},
+	}
+
+	ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+		return mockClient, nil
+	}
+
+	ethNewMailboxContract = func(ethcommon.Address, bind.ContractBackend) (eth.MailboxContract, error) {
+		return mockMailbox, nil
+	}
+
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+
+	defer func() {
+		ethNewClient = eth.NewClient
+		ethNewMailboxContract = eth.NewMailboxContract
+		dbNewDB = db.NewDB
+	}()
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
+	mockClient.EXPECT().FinalityTag().Return("")
+	mockClient.EXPECT().GetClient().Return(nil)
+
+	runnable := NewMessageAuditor(config, mintControllerMap)
+
+	auditor, ok := runnable.(*EthMessageAuditorRunnable)
+	assert.True(t, ok)
+	assert.Equal(t, mockDB, auditor.db)
+	assert.Equal(t, mockMailbox, auditor.mailbox)
+	assert.Equal(t, mockMailbox, auditor.monitor.mailbox)
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+	config.MessageAuditor.Enabled = false
+	assert.Panics(t, func() { NewMessageAuditor(config, mintControllerMap) })
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// methodNotFoundErrorCode is the json-rpc error code for a method that does not exist or is not available
+const methodNotFoundErrorCode = -32601
+
+// batchCall sends elems as a single json-rpc batch request
+func (c *ethereumClient) batchCall(elems []rpc.BatchElem) error {
+	if len(elems) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	return c.client.BatchCallContext(ctx, elems)
+}
+
+// GetTransactionReceipts fetches transaction receipts in a single batch request.
+// Receipts that are not found or could not be fetched are left out of the result.
+func (c *ethereumClient) GetTransactionReceipts(txHashes []string) (map[common.Hash]*types.Receipt, error) {
+	receipts := make(map[common.Hash]*types.Receipt)
+
+	if c.quorum > 1 {
+		// a batch is answered by a single endpoint, so receipts are still voted on one by one
+		for _, txHash := range txHashes {
+			receipt, err := c.getTransactionReceiptWithQuorum(common.HexToHash(txHash))
+			if err != nil {
+				continue
+			}
+			receipts[common.HexToHash(txHash)] = receipt
+		}
+		return receipts, nil
+	}
+
+	results := make([]*types.Receipt, len(txHashes))
+	elems := make([]rpc.BatchElem, len(txHashes))
+	for i, txHash := range txHashes {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{common.HexToHash(txHash)},
+			Result: &results[i],
+		}
+	}
+
+	if err := c.batchCall(elems); err != nil {
+		return nil, err
+	}
+
+	for i, elem := range elems {
+		if elem.Error != nil {
+			c.logger.WithError(elem.Error).WithField("tx_hash", txHashes[i]).Debug("failed to get transaction receipt")
+			continue
+		}
+		if results[i] == nil {
+			continue
+		}
+		receipts[common.HexToHash(txHashes[i])] = results[i]
+	}
+
+	return receipts, nil
+}
+
+// GetBlockReceipts fetches all receipts of the canonical blocks at the given heights
+// with eth_getBlockReceipts in a single batch request.
+// Blocks that are not found are left out of the result, and nothing is returned
+// once the endpoint has shown that it does not support eth_getBlockReceipts.
+func (c *ethereumClient) GetBlockReceipts(blockHeights []uint64) (map[uint64][]*types.Receipt, error) {
+	blockReceipts := make(map[uint64][]*types.Receipt)
+
+	// receipts from a single endpoint cannot satisfy the quorum
+	if c.blockReceiptsUnsupported.Load() || c.quorum > 1 {
+		return blockReceipts, nil
+	}
+
+	results := make([][]*types.Receipt, len(blockHeights))
+	elems := make([]rpc.BatchElem, len(blockHeights))
+	for i, blockHeight := range blockHeights {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getBlockReceipts",
+			Args:   []interface{}{hexutil.EncodeUint64(blockHeight)},
+			Result: &results[i],
+		}
+	}
+
+	if err := c.batchCall(elems); err != nil {
+		return nil, err
+	}
+
+	for i, elem := range elems {
+		if elem.Error != nil {
+			if isMethodNotFoundError(elem.Error) {
+				c.logger.WithError(elem.Error).Info("eth_getBlockReceipts is not supported, falling back to transaction receipts")
+				c.blockReceiptsUnsupported.Store(true)
+				return make(map[uint64][]*types.Receipt), nil
+			}
+			c.logger.WithError(elem.Error).WithField("block_height", blockHeights[i]).Debug("failed to get block receipts")
+			continue
+		}
+		if results[i] == nil {
+			continue
+		}
+		blockReceipts[blockHeights[i]] = results[i]
+	}
+
+	return blockReceipts, nil
+}
+
+// isMethodNotFoundError reports whether err is the json-rpc error for a method the endpoint does not implement
+func isMethodNotFoundError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == methodNotFoundErrorCode
+}
@@ -0,0 +1,226 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/dan13ram/wpokt-oracle/ethereum/client/client_mocks"
+	log "github.com/sirupsen/logrus"
+)
+
+// batchResponse fills the result of each batch element with the matching raw json response,
+// or sets the matching error
+func batchResponse(t *testing.T, responses []string, errs []error) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		assert.Equal(t, len(responses), len(elems))
+		for i := range elems {
+			if errs != nil && errs[i] != nil {
+				elems[i].Error = errs[i]
+				continue
+			}
+			assert.NoError(t, json.Unmarshal([]byte(responses[i]), elems[i].Result))
+		}
+	}
+}
+
+func marshalJSON(t *testing.T, v interface{}) string {
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestEthereumClient_GetTransactionReceipts(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}
+
+	receipt := &types.Receipt{
+		Type:        types.LegacyTxType,
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      common.HexToHash("0x01"),
+		BlockHash:   common.HexToHash("0x0a"),
+		BlockNumber: big.NewInt(10),
+		Logs:        []*types.Log{},
+	}
+
+	mockClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(elems []rpc.BatchElem) bool {
+		return len(elems) == 3 &&
+			elems[0].Method == "eth_getTransactionReceipt" &&
+			elems[0].Args[0] == common.HexToHash("0x01")
+	})).Run(batchResponse(t,
+		[]string{marshalJSON(t, receipt), "null", ""},
+		[]error{nil, nil, fmt.Errorf("error")},
+	)).Return(nil).Once()
+
+	receipts, err := ethClient.GetTransactionReceipts([]string{"0x01", "0x02", "0x03"})
+	assert.NoError(t, err)
+	assert.Len(t, receipts, 1)
+	assert.Equal(t, receipt.TxHash, receipts[common.HexToHash("0x01")].TxHash)
+	assert.Equal(t, receipt.BlockNumber, receipts[common.HexToHash("0x01")].BlockNumber)
+}
+
+func TestEthereumClient_GetTransactionReceipts_Error(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}
+
+	mockClient.On("BatchCallContext", mock.Anything, mock.Anything).Return(fmt.Errorf("error")).Once()
+
+	receipts, err := ethClient.GetTransactionReceipts([]string{"0x01"})
+	assert.Error(t, err)
+	assert.Nil(t, receipts)
+}
+
+func TestEthereumClient_GetTransactionReceipts_Empty(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}
+
+	receipts, err := ethClient.GetTransactionReceipts([]string{})
+	assert.NoError(t, err)
+	assert.Empty(t, receipts)
+	mockClient.AssertNotCalled(t, "BatchCallContext", mock.Anything, mock.Anything)
+}
+
+func TestEthereumClient_GetTransactionReceipts_Quorum(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		endpoints: []EthHTTPClient{mockClientOne, mockClientTwo},
+		quorum:    2,
+		timeout:   5 * time.Second,
+		logger:    log.NewEntry(log.New()),
+	}
+
+	receipt := &types.Receipt{TxHash: common.HexToHash("0x01"), BlockNumber: big.NewInt(10), Logs: []*types.Log{}}
+
+	mockClientOne.On("TransactionReceipt", mock.Anything, common.HexToHash("0x01")).Return(receipt, nil).Once()
+	mockClientTwo.On("TransactionReceipt", mock.Anything, common.HexToHash("0x01")).Return(receipt, nil).Once()
+	mockClientOne.On("TransactionReceipt", mock.Anything, common.HexToHash("0x02")).Return(nil, ethereum.NotFound).Once()
+	mockClientTwo.On("TransactionReceipt", mock.Anything, common.HexToHash("0x02")).Return(nil, ethereum.NotFound).Once()
+
+	receipts, err := ethClient.GetTransactionReceipts([]string{"0x01", "0x02"})
+	assert.NoError(t, err)
+	assert.Len(t, receipts, 1)
+	assert.Equal(t, receipt, receipts[common.HexToHash("0x01")])
+}
+
+func TestEthereumClient_GetBlockReceipts(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}
+
+	receipt := &types.Receipt{
+		Type:        types.LegacyTxType,
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      common.HexToHash("0x01"),
+		BlockNumber: big.NewInt(10),
+		Logs:        []*types.Log{},
+	}
+
+	mockClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(elems []rpc.BatchElem) bool {
+		return len(elems) == 2 &&
+			elems[0].Method == "eth_getBlockReceipts" &&
+			elems[0].Args[0] == "0xa"
+	})).Run(batchResponse(t,
+		[]string{marshalJSON(t, []*types.Receipt{receipt}), "null"},
+		nil,
+	)).Return(nil).Once()
+
+	blockReceipts, err := ethClient.GetBlockReceipts([]uint64{10, 11})
+	assert.NoError(t, err)
+	assert.Len(t, blockReceipts, 1)
+	assert.Len(t, blockReceipts[10], 1)
+	assert.Equal(t, receipt.TxHash, blockReceipts[10][0].TxHash)
+}
+
+func TestEthereumClient_GetBlockReceipts_Unsupported(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}
+
+	mockClient.On("BatchCallContext", mock.Anything, mock.Anything).Run(batchResponse(t,
+		[]string{""},
+		[]error{rpcError{code: -32601, message: "the method eth_getBlockReceipts does not exist/is not available"}},
+	)).Return(nil).Once()
+
+	blockReceipts, err := ethClient.GetBlockReceipts([]uint64{10})
+	assert.NoError(t, err)
+	assert.Empty(t, blockReceipts)
+	assert.True(t, ethClient.blockReceiptsUnsupported.Load())
+
+	// no further requests are made once the method is known to be unsupported
+	blockReceipts, err = ethClient.GetBlockReceipts([]uint64{10})
+	assert.NoError(t, err)
+	assert.Empty(t, blockReceipts)
+}
+
+func TestEthereumClient_GetBlockReceipts_Error(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}
+
+	mockClient.On("BatchCallContext", mock.Anything, mock.Anything).Return(fmt.Errorf("error")).Once()
+
+	blockReceipts, err := ethClient.GetBlockReceipts([]uint64{10})
+	assert.Error(t, err)
+	assert.Nil(t, blockReceipts)
+}
+
+func TestEthereumClient_GetBlockReceipts_Quorum(t *testing.T) {
+	ethClient := &ethereumClient{
+		quorum: 2,
+		logger: log.NewEntry(log.New()),
+	}
+
+	blockReceipts, err := ethClient.GetBlockReceipts([]uint64{10})
+	assert.NoError(t, err)
+	assert.Empty(t, blockReceipts)
+}
+
+// rpcError is a json-rpc error as returned by the rpc client
+type rpcError struct {
+	code    int
+	message string
+}
+
+func (e rpcError) Error() string  { return e.message }
+func (e rpcError) ErrorCode() int { return e.code }
+
+func TestIsMethodNotFoundError(t *testing.T) {
+	assert.True(t, isMethodNotFoundError(rpcError{code: -32601, message: "Method not found"}))
+	assert.True(t, isMethodNotFoundError(fmt.Errorf("wrapped: %w", rpcError{code: -32601, message: "Method not found"})))
+	// only the error code tells that the method is missing
+	assert.False(t, isMethodNotFoundError(fmt.Errorf("the method eth_getBlockReceipts does not exist/is not available")))
+	assert.False(t, isMethodNotFoundError(rpcError{code: -32000, message: "block range not supported"}))
+	assert.False(t, isMethodNotFoundError(fmt.Errorf("header not found")))
+}
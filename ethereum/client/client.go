@@ -2,16 +2,21 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/dan13ram/wpokt-oracle/ethereum/util"
 	"github.com/dan13ram/wpokt-oracle/models"
@@ -21,17 +26,26 @@ import (
 
 const (
 	MaxQueryBlocks uint64 = 100000
+	MaxReorgDepth  uint64 = 256
+	MaxBatchSize   int    = 100
 )
 
 type EthereumClient interface {
 	Chain() models.Chain
 	Confirmations() uint64
+	FinalityTag() string
 	ValidateNetwork() error
 	GetBlockHeight() (uint64, error)
+	GetFinalizedBlockHeight() (uint64, error)
+	GetBlockHash(blockHeight uint64) (common.Hash, error)
 	GetChainID() (*big.Int, error)
 	GetClient() EthHTTPClient
 	GetTransactionByHash(txHash string) (*types.Transaction, bool, error)
 	GetTransactionReceipt(txHash string) (*types.Receipt, error)
+	GetTransactionReceipts(txHashes []string) (map[common.Hash]*types.Receipt, error)
+	GetBlockReceipts(blockHeights []uint64) (map[uint64][]*types.Receipt, error)
+	MaxQueryBlocks() uint64
+	ReduceMaxQueryBlocks(queryBlocks uint64)
 }
 
 type EthHTTPClient interface {
@@ -40,17 +54,27 @@ type EthHTTPClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 }
 
 type ethereumClient struct {
 	chain         models.Chain
 	confirmations uint64
+	finalityTag   string
+
+	// failover keeps the block range of log queries of each endpoint
+	failover *failoverClient
+
+	// blockReceiptsUnsupported is set once the endpoint has rejected eth_getBlockReceipts
+	blockReceiptsUnsupported atomic.Bool
 
 	timeout   time.Duration
 	chainID   uint64
 	chainName string
 
-	client EthHTTPClient
+	client    EthHTTPClient
+	endpoints []EthHTTPClient
+	quorum    int
 
 	logger *log.Entry
 }
@@ -63,11 +87,31 @@ func (c *ethereumClient) Confirmations() uint64 {
 	return c.confirmations
 }
 
+func (c *ethereumClient) FinalityTag() string {
+	return c.finalityTag
+}
+
+// MaxQueryBlocks returns the largest block range known to work for log queries on the active endpoint
+func (c *ethereumClient) MaxQueryBlocks() uint64 {
+	return c.failover.MaxQueryBlocks()
+}
+
+// ReduceMaxQueryBlocks lowers the block range used for log queries after an endpoint rejected a larger one
+func (c *ethereumClient) ReduceMaxQueryBlocks(queryBlocks uint64) {
+	c.failover.ReduceMaxQueryBlocks(queryBlocks)
+}
+
 func (c *ethereumClient) GetClient() EthHTTPClient {
 	return c.client
 }
 
 func (c *ethereumClient) GetBlockHeight() (uint64, error) {
+	if c.quorum > 1 {
+		return c.getHeightWithQuorum(func(ctx context.Context, client EthHTTPClient) (uint64, error) {
+			return client.BlockNumber(ctx)
+		})
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
@@ -79,6 +123,51 @@ func (c *ethereumClient) GetBlockHeight() (uint64, error) {
 	return blockNumber, nil
 }
 
+// GetFinalizedBlockHeight returns the height of the block at the configured finality tag
+func (c *ethereumClient) GetFinalizedBlockHeight() (uint64, error) {
+	var tag rpc.BlockNumber
+	switch c.finalityTag {
+	case models.FinalityTagSafe:
+		tag = rpc.SafeBlockNumber
+	case models.FinalityTagFinalized:
+		tag = rpc.FinalizedBlockNumber
+	default:
+		return 0, fmt.Errorf("invalid finality tag: %q", c.finalityTag)
+	}
+
+	if c.quorum > 1 {
+		return c.getHeightWithQuorum(func(ctx context.Context, client EthHTTPClient) (uint64, error) {
+			header, err := client.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+			if err != nil {
+				return 0, err
+			}
+			return header.Number.Uint64(), nil
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	header, err := c.client.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+	if err != nil {
+		return 0, err
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+func (c *ethereumClient) GetBlockHash(blockHeight uint64) (common.Hash, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	header, err := c.client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockHeight))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return header.Hash(), nil
+}
+
 func (c *ethereumClient) GetChainID() (*big.Int, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
@@ -91,15 +180,33 @@ func (c *ethereumClient) GetChainID() (*big.Int, error) {
 	return chainID, nil
 }
 
+// ValidateNetwork checks the chain id of every endpoint, failing if any endpoint is on another chain
+// or if fewer endpoints than the quorum respond
 func (c *ethereumClient) ValidateNetwork() error {
 	c.logger.Debugf("Validating network")
 
-	chainID, err := c.GetChainID()
-	if err != nil {
-		return err
+	var lastErr error
+	validEndpoints := 0
+	for i, endpoint := range c.endpoints {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		chainID, err := endpoint.ChainID(ctx)
+		cancel()
+		if err != nil {
+			c.logger.WithError(err).WithField("endpoint", i).Warn("failed to get chain id")
+			lastErr = err
+			continue
+		}
+		if chainID.Cmp(big.NewInt(int64(c.chainID))) != 0 {
+			return fmt.Errorf("expected chain id %d, got %s from endpoint %d", c.chainID, chainID, i)
+		}
+		validEndpoints++
 	}
-	if chainID.Cmp(big.NewInt(int64(c.chainID))) != 0 {
-		return fmt.Errorf("expected chain id %d, got %s", c.chainID, chainID)
+
+	if validEndpoints == 0 || validEndpoints < c.quorum {
+		if lastErr != nil {
+			return fmt.Errorf("%d of %d endpoints responded: %w", validEndpoints, len(c.endpoints), lastErr)
+		}
+		return fmt.Errorf("%d of %d endpoints responded", validEndpoints, len(c.endpoints))
 	}
 
 	c.logger.Debugf("Validated network")
@@ -115,6 +222,10 @@ func (c *ethereumClient) GetTransactionByHash(txHash string) (*types.Transaction
 }
 
 func (c *ethereumClient) GetTransactionReceipt(txHash string) (*types.Receipt, error) {
+	if c.quorum > 1 {
+		return c.getTransactionReceiptWithQuorum(common.HexToHash(txHash))
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
 	defer cancel()
 
@@ -122,10 +233,140 @@ func (c *ethereumClient) GetTransactionReceipt(txHash string) (*types.Receipt, e
 	return receipt, err
 }
 
+// getHeightWithQuorum queries every endpoint and returns the highest block height
+// that at least quorum endpoints have reached
+func (c *ethereumClient) getHeightWithQuorum(getHeight func(ctx context.Context, client EthHTTPClient) (uint64, error)) (uint64, error) {
+	heights := []uint64{}
+	for i, endpoint := range c.endpoints {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		height, err := getHeight(ctx, endpoint)
+		cancel()
+		if err != nil {
+			c.logger.WithError(err).WithField("endpoint", i).Warn("failed to get block height")
+			continue
+		}
+		heights = append(heights, height)
+	}
+
+	if len(heights) < c.quorum {
+		return 0, fmt.Errorf("block height quorum not reached: %d of %d endpoints responded, %d required", len(heights), len(c.endpoints), c.quorum)
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	return heights[c.quorum-1], nil
+}
+
+// getTransactionReceiptWithQuorum returns the receipt once quorum endpoints have returned the same one,
+// or ethereum.NotFound once quorum endpoints agree that the transaction is not included
+func (c *ethereumClient) getTransactionReceiptWithQuorum(txHash common.Hash) (*types.Receipt, error) {
+	const notFound = "not_found"
+
+	votes := make(map[string]int)
+	for i, endpoint := range c.endpoints {
+		ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+		receipt, err := endpoint.TransactionReceipt(ctx, txHash)
+		cancel()
+
+		var key string
+		switch {
+		case errors.Is(err, ethereum.NotFound):
+			key = notFound
+		case err != nil:
+			c.logger.WithError(err).WithField("endpoint", i).Warn("failed to get transaction receipt")
+			continue
+		default:
+			key, err = receiptKey(receipt)
+			if err != nil {
+				c.logger.WithError(err).WithField("endpoint", i).Warn("failed to encode transaction receipt")
+				continue
+			}
+		}
+
+		votes[key]++
+		if votes[key] < c.quorum {
+			continue
+		}
+		if key == notFound {
+			return nil, ethereum.NotFound
+		}
+		return receipt, nil
+	}
+
+	return nil, fmt.Errorf("receipt quorum not reached for %s: %d endpoints must agree", txHash.Hex(), c.quorum)
+}
+
+// receiptKey identifies a receipt by its consensus encoding and the block it was included in
+func receiptKey(receipt *types.Receipt) (string, error) {
+	data, err := receipt.MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s:%s:%x", receipt.TxHash.Hex(), receipt.BlockHash.Hex(), receipt.BlockNumber, data), nil
+}
+
+// Confirmations returns the number of blocks mined on top of the block,
+// or zero if the current block height has not caught up with it
+func Confirmations(blockHeight uint64, currentBlockHeight uint64) uint64 {
+	if currentBlockHeight < blockHeight {
+		return 0
+	}
+	return currentBlockHeight - blockHeight
+}
+
+// BlockHeights are the heights of the latest block and, if the client has a finality tag,
+// of the block at that tag, read together once per run
+type BlockHeights struct {
+	Current   uint64
+	Finalized uint64
+}
+
+func GetBlockHeights(client EthereumClient) (BlockHeights, error) {
+	currentBlockHeight, err := client.GetBlockHeight()
+	if err != nil {
+		return BlockHeights{}, fmt.Errorf("error getting current block height: %w", err)
+	}
+	if client.FinalityTag() == "" {
+		return BlockHeights{Current: currentBlockHeight}, nil
+	}
+	finalizedBlockHeight, err := client.GetFinalizedBlockHeight()
+	if err != nil {
+		return BlockHeights{}, fmt.Errorf("error getting %s block height: %w", client.FinalityTag(), err)
+	}
+	return BlockHeights{Current: currentBlockHeight, Finalized: finalizedBlockHeight}, nil
+}
+
+// IsBlockConfirmed checks a block against the finalized block height if the client has a finality tag,
+// and against the required number of confirmations otherwise
+func IsBlockConfirmed(
+	client EthereumClient,
+	blockHeight uint64,
+	currentBlockHeight uint64,
+	finalizedBlockHeight uint64,
+	confirmations uint64,
+) bool {
+	if client.FinalityTag() == "" {
+		return Confirmations(blockHeight, currentBlockHeight) >= confirmations
+	}
+	return blockHeight <= finalizedBlockHeight
+}
+
+// httpClient adds json-rpc batch requests to the ethclient of an endpoint
+type httpClient struct {
+	*ethclient.Client
+}
+
+func (c httpClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.Client.Client().BatchCallContext(ctx, b)
+}
+
 type EthclientDial func(url string) (EthHTTPClient, error)
 
 var ethclientDial EthclientDial = func(url string) (EthHTTPClient, error) {
-	return ethclient.Dial(url)
+	client, err := ethclient.Dial(url)
+	if err != nil {
+		return nil, err
+	}
+	return httpClient{client}, nil
 }
 
 func NewClient(config models.EthereumNetworkConfig) (EthereumClient, error) {
@@ -134,12 +375,28 @@ func NewClient(config models.EthereumNetworkConfig) (EthereumClient, error) {
 		WithField("package", "client").
 		WithField("chain_name", strings.ToLower(config.ChainName)).
 		WithField("chain_id", config.ChainID)
-	client, err := ethclientDial(config.RPCURL)
-	if err != nil {
-		logger.WithError(err).Error("failed to connect to rpc")
-		return nil, fmt.Errorf("failed to connect to rpc")
+
+	urls := config.RPCEndpoints()
+	if len(urls) == 0 {
+		logger.Error("no rpc urls configured")
+		return nil, fmt.Errorf("no rpc urls configured")
 	}
 
+	endpoints := make([]EthHTTPClient, 0, len(urls))
+	for i, url := range urls {
+		endpoint, err := ethclientDial(url)
+		if err != nil {
+			logger.WithError(err).WithField("endpoint", i).Error("failed to connect to rpc")
+			return nil, fmt.Errorf("failed to connect to rpc")
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	failover := newFailoverClient(endpoints, logger)
+	// the log queries of the contract bindings go through the failover client
+	failover.setQueryRanges(config.MaxQueryBlocks)
+	failover.attemptTimeout = time.Duration(config.TimeoutMS) * time.Millisecond
+
 	ethclient := &ethereumClient{
 		chain: util.ParseChain(config),
 
@@ -147,13 +404,18 @@ func NewClient(config models.EthereumNetworkConfig) (EthereumClient, error) {
 		chainID:       config.ChainID,
 		chainName:     config.ChainName,
 		confirmations: config.Confirmations,
+		finalityTag:   config.FinalityTag,
+
+		failover: failover,
 
-		client: client,
+		client:    failover,
+		endpoints: endpoints,
+		quorum:    int(config.RPCQuorum),
 
 		logger: logger,
 	}
 
-	err = ethclient.ValidateNetwork()
+	err := ethclient.ValidateNetwork()
 	if err != nil {
 		logger.WithError(err).Error("failed to validate network")
 		return nil, fmt.Errorf("failed to validate network")
@@ -13,6 +13,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	rpc "github.com/ethereum/go-ethereum/rpc"
+
 	types "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -29,6 +31,53 @@ func (_m *MockEthHTTPClient) EXPECT() *MockEthHTTPClient_Expecter {
 	return &MockEthHTTPClient_Expecter{mock: &_m.Mock}
 }
 
+// BatchCallContext provides a mock function with given fields: ctx, b
+func (_m *MockEthHTTPClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchCallContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []rpc.BatchElem) error); ok {
+		r0 = rf(ctx, b)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockEthHTTPClient_BatchCallContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchCallContext'
+type MockEthHTTPClient_BatchCallContext_Call struct {
+	*mock.Call
+}
+
+// BatchCallContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - b []rpc.BatchElem
+func (_e *MockEthHTTPClient_Expecter) BatchCallContext(ctx interface{}, b interface{}) *MockEthHTTPClient_BatchCallContext_Call {
+	return &MockEthHTTPClient_BatchCallContext_Call{Call: _e.mock.On("BatchCallContext", ctx, b)}
+}
+
+func (_c *MockEthHTTPClient_BatchCallContext_Call) Run(run func(ctx context.Context, b []rpc.BatchElem)) *MockEthHTTPClient_BatchCallContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]rpc.BatchElem))
+	})
+	return _c
+}
+
+func (_c *MockEthHTTPClient_BatchCallContext_Call) Return(_a0 error) *MockEthHTTPClient_BatchCallContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEthHTTPClient_BatchCallContext_Call) RunAndReturn(run func(context.Context, []rpc.BatchElem) error) *MockEthHTTPClient_BatchCallContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BlockNumber provides a mock function with given fields: ctx
 func (_m *MockEthHTTPClient) BlockNumber(ctx context.Context) (uint64, error) {
 	ret := _m.Called(ctx)
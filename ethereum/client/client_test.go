@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -87,10 +89,11 @@ func TestEthereumClient_GetChainID(t *testing.T) {
 func TestEthereumClient_ValidateNetwork(t *testing.T) {
 	mockClient := mocks.NewMockEthHTTPClient(t)
 	ethClient := &ethereumClient{
-		client:  mockClient,
-		timeout: 5 * time.Second,
-		chainID: 1,
-		logger:  log.NewEntry(log.New()),
+		client:    mockClient,
+		endpoints: []EthHTTPClient{mockClient},
+		timeout:   5 * time.Second,
+		chainID:   1,
+		logger:    log.NewEntry(log.New()),
 	}
 
 	expectedChainID := big.NewInt(1)
@@ -105,10 +108,11 @@ func TestEthereumClient_ValidateNetwork(t *testing.T) {
 func TestEthereumClient_ValidateNetwork_ErrorChainID(t *testing.T) {
 	mockClient := mocks.NewMockEthHTTPClient(t)
 	ethClient := &ethereumClient{
-		client:  mockClient,
-		timeout: 5 * time.Second,
-		chainID: 1,
-		logger:  log.NewEntry(log.New()),
+		client:    mockClient,
+		endpoints: []EthHTTPClient{mockClient},
+		timeout:   5 * time.Second,
+		chainID:   1,
+		logger:    log.NewEntry(log.New()),
 	}
 
 	expectedError := fmt.Errorf("failed to get chain id")
@@ -124,10 +128,11 @@ func TestEthereumClient_ValidateNetwork_ErrorChainID(t *testing.T) {
 func TestEthereumClient_ValidateNetwork_InvalidChainID(t *testing.T) {
 	mockClient := mocks.NewMockEthHTTPClient(t)
 	ethClient := &ethereumClient{
-		client:  mockClient,
-		timeout: 5 * time.Second,
-		chainID: 1,
-		logger:  log.NewEntry(log.New()),
+		client:    mockClient,
+		endpoints: []EthHTTPClient{mockClient},
+		timeout:   5 * time.Second,
+		chainID:   1,
+		logger:    log.NewEntry(log.New()),
 	}
 
 	wrongChainID := big.NewInt(2)
@@ -135,7 +140,7 @@ func TestEthereumClient_ValidateNetwork_InvalidChainID(t *testing.T) {
 
 	err := ethClient.ValidateNetwork()
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "expected chain id 1, got 2")
+	assert.Contains(t, err.Error(), "expected chain id 1, got 2 from endpoint 0")
 
 	mockClient.AssertExpectations(t)
 }
@@ -177,6 +182,318 @@ func TestEthereumClient_GetTransactionReceipt(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestEthereumClient_GetBlockHash(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+	}
+
+	header := &types.Header{Number: big.NewInt(100)}
+	mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(100)).Return(header, nil)
+
+	hash, err := ethClient.GetBlockHash(100)
+	assert.NoError(t, err)
+	assert.Equal(t, header.Hash(), hash)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestEthereumClient_GetBlockHash_Error(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+	}
+
+	mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(100)).Return(nil, fmt.Errorf("error"))
+
+	_, err := ethClient.GetBlockHash(100)
+	assert.Error(t, err)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestEthereumClient_GetFinalizedBlockHeight(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:      mockClient,
+		timeout:     5 * time.Second,
+		finalityTag: models.FinalityTagFinalized,
+	}
+
+	header := &types.Header{Number: big.NewInt(90)}
+	mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(rpc.FinalizedBlockNumber.Int64())).Return(header, nil)
+
+	height, err := ethClient.GetFinalizedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(90), height)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestEthereumClient_GetFinalizedBlockHeight_Safe(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:      mockClient,
+		timeout:     5 * time.Second,
+		finalityTag: models.FinalityTagSafe,
+	}
+
+	header := &types.Header{Number: big.NewInt(95)}
+	mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(rpc.SafeBlockNumber.Int64())).Return(header, nil)
+
+	height, err := ethClient.GetFinalizedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(95), height)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestEthereumClient_GetFinalizedBlockHeight_InvalidTag(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:      mockClient,
+		timeout:     5 * time.Second,
+		finalityTag: "latest",
+	}
+
+	_, err := ethClient.GetFinalizedBlockHeight()
+	assert.Error(t, err)
+}
+
+func TestEthereumClient_GetFinalizedBlockHeight_Error(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		client:      mockClient,
+		timeout:     5 * time.Second,
+		finalityTag: models.FinalityTagFinalized,
+	}
+
+	mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(rpc.FinalizedBlockNumber.Int64())).Return(nil, fmt.Errorf("error"))
+
+	_, err := ethClient.GetFinalizedBlockHeight()
+	assert.Error(t, err)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestConfirmations(t *testing.T) {
+	assert.Equal(t, uint64(10), Confirmations(90, 100))
+	assert.Equal(t, uint64(0), Confirmations(100, 100))
+	assert.Equal(t, uint64(0), Confirmations(110, 100))
+}
+
+func TestGetBlockHeights(t *testing.T) {
+	t.Run("no finality tag", func(t *testing.T) {
+		mockClient := mocks.NewMockEthHTTPClient(t)
+		ethClient := &ethereumClient{client: mockClient, timeout: 5 * time.Second}
+		mockClient.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+
+		heights, err := GetBlockHeights(ethClient)
+		assert.NoError(t, err)
+		assert.Equal(t, BlockHeights{Current: 100}, heights)
+	})
+
+	t.Run("finality tag", func(t *testing.T) {
+		mockClient := mocks.NewMockEthHTTPClient(t)
+		ethClient := &ethereumClient{
+			client:      mockClient,
+			timeout:     5 * time.Second,
+			finalityTag: models.FinalityTagFinalized,
+		}
+		header := &types.Header{Number: big.NewInt(95)}
+		mockClient.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+		mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(rpc.FinalizedBlockNumber.Int64())).Return(header, nil)
+
+		heights, err := GetBlockHeights(ethClient)
+		assert.NoError(t, err)
+		assert.Equal(t, BlockHeights{Current: 100, Finalized: 95}, heights)
+	})
+
+	t.Run("finality tag error", func(t *testing.T) {
+		mockClient := mocks.NewMockEthHTTPClient(t)
+		ethClient := &ethereumClient{
+			client:      mockClient,
+			timeout:     5 * time.Second,
+			finalityTag: models.FinalityTagSafe,
+		}
+		mockClient.On("BlockNumber", mock.Anything).Return(uint64(100), nil)
+		mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(rpc.SafeBlockNumber.Int64())).Return(nil, fmt.Errorf("error"))
+
+		_, err := GetBlockHeights(ethClient)
+		assert.Error(t, err)
+	})
+
+	t.Run("block number error", func(t *testing.T) {
+		mockClient := mocks.NewMockEthHTTPClient(t)
+		ethClient := &ethereumClient{client: mockClient, timeout: 5 * time.Second}
+		mockClient.On("BlockNumber", mock.Anything).Return(uint64(0), fmt.Errorf("error"))
+
+		_, err := GetBlockHeights(ethClient)
+		assert.Error(t, err)
+	})
+}
+
+func TestIsBlockConfirmed(t *testing.T) {
+	t.Run("confirmations", func(t *testing.T) {
+		ethClient := &ethereumClient{}
+		assert.True(t, IsBlockConfirmed(ethClient, 90, 100, 0, 10))
+		assert.False(t, IsBlockConfirmed(ethClient, 91, 100, 0, 10))
+	})
+
+	t.Run("finality tag", func(t *testing.T) {
+		ethClient := &ethereumClient{finalityTag: models.FinalityTagFinalized}
+		assert.True(t, IsBlockConfirmed(ethClient, 95, 100, 95, 100))
+		assert.False(t, IsBlockConfirmed(ethClient, 96, 100, 95, 0))
+	})
+}
+
+func TestEthereumClient_ReduceMaxQueryBlocks(t *testing.T) {
+	failover := newFailoverClient([]EthHTTPClient{mocks.NewMockEthHTTPClient(t)}, log.NewEntry(log.New()))
+	failover.setQueryRanges(1000)
+	ethClient := &ethereumClient{
+		failover: failover,
+	}
+
+	ethClient.ReduceMaxQueryBlocks(2000)
+	assert.Equal(t, uint64(1000), ethClient.MaxQueryBlocks())
+
+	ethClient.ReduceMaxQueryBlocks(0)
+	assert.Equal(t, uint64(1000), ethClient.MaxQueryBlocks())
+
+	ethClient.ReduceMaxQueryBlocks(500)
+	assert.Equal(t, uint64(500), ethClient.MaxQueryBlocks())
+}
+
+func TestEthereumClient_ValidateNetwork_MultipleEndpoints(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		endpoints: []EthHTTPClient{mockClientOne, mockClientTwo},
+		timeout:   5 * time.Second,
+		chainID:   1,
+		logger:    log.NewEntry(log.New()),
+	}
+
+	mockClientOne.On("ChainID", mock.Anything).Return(nil, fmt.Errorf("error"))
+	mockClientTwo.On("ChainID", mock.Anything).Return(big.NewInt(1), nil)
+
+	err := ethClient.ValidateNetwork()
+	assert.NoError(t, err)
+
+	ethClient.quorum = 2
+	err = ethClient.ValidateNetwork()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "1 of 2 endpoints responded")
+}
+
+func TestEthereumClient_GetBlockHeight_Quorum(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	mockClientThree := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		endpoints: []EthHTTPClient{mockClientOne, mockClientTwo, mockClientThree},
+		quorum:    2,
+		timeout:   5 * time.Second,
+		logger:    log.NewEntry(log.New()),
+	}
+
+	mockClientOne.On("BlockNumber", mock.Anything).Return(uint64(105), nil).Once()
+	mockClientTwo.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Once()
+	mockClientThree.On("BlockNumber", mock.Anything).Return(uint64(1000), nil).Once()
+
+	height, err := ethClient.GetBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(105), height)
+
+	mockClientOne.On("BlockNumber", mock.Anything).Return(uint64(0), fmt.Errorf("error")).Once()
+	mockClientTwo.On("BlockNumber", mock.Anything).Return(uint64(0), fmt.Errorf("error")).Once()
+	mockClientThree.On("BlockNumber", mock.Anything).Return(uint64(1000), nil).Once()
+
+	_, err = ethClient.GetBlockHeight()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "quorum not reached")
+}
+
+func TestEthereumClient_GetFinalizedBlockHeight_Quorum(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		endpoints:   []EthHTTPClient{mockClientOne, mockClientTwo},
+		quorum:      2,
+		timeout:     5 * time.Second,
+		finalityTag: models.FinalityTagFinalized,
+		logger:      log.NewEntry(log.New()),
+	}
+
+	tag := big.NewInt(rpc.FinalizedBlockNumber.Int64())
+	mockClientOne.On("HeaderByNumber", mock.Anything, tag).Return(&types.Header{Number: big.NewInt(90)}, nil).Once()
+	mockClientTwo.On("HeaderByNumber", mock.Anything, tag).Return(&types.Header{Number: big.NewInt(95)}, nil).Once()
+
+	height, err := ethClient.GetFinalizedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(90), height)
+}
+
+func TestEthereumClient_GetTransactionReceipt_Quorum(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	mockClientThree := mocks.NewMockEthHTTPClient(t)
+	ethClient := &ethereumClient{
+		endpoints: []EthHTTPClient{mockClientOne, mockClientTwo, mockClientThree},
+		quorum:    2,
+		timeout:   5 * time.Second,
+		logger:    log.NewEntry(log.New()),
+	}
+
+	txHash := common.HexToHash("0x01")
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockHash:   common.HexToHash("0x0a"),
+		BlockNumber: big.NewInt(100),
+		Logs:        []*types.Log{},
+	}
+	forgedReceipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockHash:   common.HexToHash("0x0a"),
+		BlockNumber: big.NewInt(100),
+		Logs:        []*types.Log{{Address: common.HexToAddress("0x02"), Topics: []common.Hash{}}},
+	}
+
+	t.Run("endpoints agree", func(t *testing.T) {
+		mockClientOne.On("TransactionReceipt", mock.Anything, txHash).Return(forgedReceipt, nil).Once()
+		mockClientTwo.On("TransactionReceipt", mock.Anything, txHash).Return(receipt, nil).Once()
+		mockClientThree.On("TransactionReceipt", mock.Anything, txHash).Return(receipt, nil).Once()
+
+		result, err := ethClient.GetTransactionReceipt("0x01")
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, result)
+	})
+
+	t.Run("endpoints disagree", func(t *testing.T) {
+		mockClientOne.On("TransactionReceipt", mock.Anything, txHash).Return(forgedReceipt, nil).Once()
+		mockClientTwo.On("TransactionReceipt", mock.Anything, txHash).Return(receipt, nil).Once()
+		mockClientThree.On("TransactionReceipt", mock.Anything, txHash).Return(nil, fmt.Errorf("error")).Once()
+
+		result, err := ethClient.GetTransactionReceipt("0x01")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "quorum not reached")
+		assert.Nil(t, result)
+	})
+
+	t.Run("endpoints agree receipt is not found", func(t *testing.T) {
+		mockClientOne.On("TransactionReceipt", mock.Anything, txHash).Return(nil, ethereum.NotFound).Once()
+		mockClientTwo.On("TransactionReceipt", mock.Anything, txHash).Return(nil, ethereum.NotFound).Once()
+
+		result, err := ethClient.GetTransactionReceipt("0x01")
+		assert.ErrorIs(t, err, ethereum.NotFound)
+		assert.Nil(t, result)
+	})
+}
+
 func TestNewClient(t *testing.T) {
 	config := models.EthereumNetworkConfig{
 		RPCURL:        "http://localhost:8545",
@@ -200,6 +517,12 @@ func TestNewClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, client)
 	assert.Equal(t, uint64(12), client.Confirmations())
+	assert.Equal(t, MaxQueryBlocks, client.MaxQueryBlocks())
+
+	config.MaxQueryBlocks = 2000
+	client, err = NewClient(config)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2000), client.MaxQueryBlocks())
 }
 
 func TestNewClient_InvalidRPC(t *testing.T) {
@@ -216,6 +539,45 @@ func TestNewClient_InvalidRPC(t *testing.T) {
 	assert.Nil(t, client)
 }
 
+func TestNewClient_MultipleEndpoints(t *testing.T) {
+	config := models.EthereumNetworkConfig{
+		RPCURL:        "http://localhost:8545",
+		RPCURLs:       []string{"http://localhost:8546", "http://localhost:8545"},
+		RPCQuorum:     2,
+		TimeoutMS:     5000,
+		ChainID:       1,
+		ChainName:     "Ethereum",
+		Confirmations: 12,
+	}
+
+	dialed := []string{}
+	oldEthclientDial := ethclientDial
+	ethclientDial = func(url string) (EthHTTPClient, error) {
+		dialed = append(dialed, url)
+		mockClient := mocks.NewMockEthHTTPClient(t)
+		mockClient.On("ChainID", mock.Anything).Return(big.NewInt(1), nil)
+		return mockClient, nil
+	}
+	defer func() { ethclientDial = oldEthclientDial }()
+
+	client, err := NewClient(config)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+	assert.Equal(t, []string{"http://localhost:8545", "http://localhost:8546"}, dialed)
+}
+
+func TestNewClient_NoRPCURL(t *testing.T) {
+	config := models.EthereumNetworkConfig{
+		TimeoutMS: 5000,
+		ChainID:   1,
+		ChainName: "Ethereum",
+	}
+
+	client, err := NewClient(config)
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
 func TestNewClient_ConnectionFailed(t *testing.T) {
 	config := models.EthereumNetworkConfig{
 		RPCURL:        "valid-url",
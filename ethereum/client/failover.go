@@ -0,0 +1,295 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// failoverClient spreads calls over several rpc endpoints of the same network,
+// moving on to the next endpoint whenever the active one cannot be reached
+type failoverClient struct {
+	mu      sync.Mutex
+	clients []EthHTTPClient
+	active  int
+
+	// attemptTimeout bounds each call to an endpoint, so one that hangs leaves the next one a full timeout
+	attemptTimeout time.Duration
+
+	// queryRanges holds the block range of log queries of each endpoint, since providers limit them differently.
+	// rejected is the endpoint that last rejected the range of a log query
+	queryRanges []*queryRange
+	rejected    int
+
+	logger *log.Entry
+}
+
+func newFailoverClient(clients []EthHTTPClient, logger *log.Entry) *failoverClient {
+	return &failoverClient{
+		clients: clients,
+		logger:  logger,
+	}
+}
+
+func (f *failoverClient) activeIndex() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active
+}
+
+// setQueryRanges starts every endpoint with maxBlocks as the block range of its log queries
+func (f *failoverClient) setQueryRanges(maxBlocks uint64) {
+	f.queryRanges = make([]*queryRange, len(f.clients))
+	for i := range f.clients {
+		f.queryRanges[i] = newQueryRange(maxBlocks, f.logger.WithField("endpoint", i))
+	}
+}
+
+func (f *failoverClient) queryRange(index int) *queryRange {
+	if index < len(f.queryRanges) {
+		return f.queryRanges[index]
+	}
+	return nil
+}
+
+// MaxQueryBlocks returns the block range of log queries of the active endpoint, which the next query goes to
+func (f *failoverClient) MaxQueryBlocks() uint64 {
+	if f == nil {
+		return MaxQueryBlocks
+	}
+	return f.queryRange(f.activeIndex()).Blocks()
+}
+
+// ReduceMaxQueryBlocks lowers the block range of log queries of the endpoint that last rejected one
+func (f *failoverClient) ReduceMaxQueryBlocks(blocks uint64) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	rejected := f.rejected
+	f.mu.Unlock()
+	f.queryRange(rejected).Reduce(blocks)
+}
+
+func (f *failoverClient) markFailed(index int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.active == index {
+		f.active = (index + 1) % len(f.clients)
+	}
+}
+
+// isTransportError reports whether err means the endpoint could not be reached or did not answer in time.
+// Errors returned by the node, such as a reverted call or a rejected log query, are valid answers
+func isTransportError(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// attemptContext returns the context of a call to one endpoint. It has its own timeout
+// instead of what is left of the deadline of ctx, but is still cancelled along with ctx
+func (f *failoverClient) attemptContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if f.attemptTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	attemptCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), f.attemptTimeout)
+	stop := context.AfterFunc(ctx, func() {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			cancel()
+		}
+	})
+	return attemptCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// do runs call against each endpoint in turn, starting with the active one,
+// until one of them answers or ctx is cancelled.
+// Only transport errors move on to the next endpoint, any other error is returned as it is
+func (f *failoverClient) do(ctx context.Context, call func(ctx context.Context, client EthHTTPClient) error) error {
+	_, err := f.doOn(ctx, call)
+	return err
+}
+
+// doOn is do that also returns the index of the endpoint that answered
+func (f *failoverClient) doOn(ctx context.Context, call func(ctx context.Context, client EthHTTPClient) error) (int, error) {
+	if len(f.clients) == 0 {
+		return 0, fmt.Errorf("no rpc endpoints configured")
+	}
+
+	start := f.activeIndex()
+	var err error
+	for i := 0; i < len(f.clients); i++ {
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return 0, ctx.Err()
+		}
+		index := (start + i) % len(f.clients)
+		attemptCtx, cancel := f.attemptContext(ctx)
+		err = call(attemptCtx, f.clients[index])
+		cancel()
+		if !isTransportError(err) {
+			return index, err
+		}
+		f.logger.WithError(err).WithField("endpoint", index).Debug("rpc call failed")
+		f.markFailed(index)
+	}
+	return start, err
+}
+
+func (f *failoverClient) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) (code []byte, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		code, err = client.CodeAt(ctx, contract, blockNumber)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		result, err = client.CallContract(ctx, call, blockNumber)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		header, err = client.HeaderByNumber(ctx, number)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		code, err = client.PendingCodeAt(ctx, account)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		nonce, err = client.PendingNonceAt(ctx, account)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		price, err = client.SuggestGasPrice(ctx)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) SuggestGasTipCap(ctx context.Context) (tip *big.Int, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		tip, err = client.SuggestGasTipCap(ctx)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		gas, err = client.EstimateGas(ctx, call)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return f.do(ctx, func(ctx context.Context, client EthHTTPClient) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+func (f *failoverClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) (logs []types.Log, err error) {
+	index, err := f.doOn(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		logs, err = client.FilterLogs(ctx, query)
+		return
+	})
+	if err == nil {
+		f.queryRange(index).Succeeded(queryBlocks(query))
+	}
+	if IsQueryRangeError(err) {
+		f.mu.Lock()
+		f.rejected = index
+		f.mu.Unlock()
+	}
+	return
+}
+
+func (f *failoverClient) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
+	// the subscription outlives the call, so it keeps the context of the caller
+	err = f.do(ctx, func(_ context.Context, client EthHTTPClient) (err error) {
+		sub, err = client.SubscribeFilterLogs(ctx, query, ch)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) BlockNumber(ctx context.Context) (blockNumber uint64, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		blockNumber, err = client.BlockNumber(ctx)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) ChainID(ctx context.Context) (chainID *big.Int, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		chainID, err = client.ChainID(ctx)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) TransactionByHash(ctx context.Context, hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		tx, isPending, err = client.TransactionByHash(ctx, hash)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = f.do(ctx, func(ctx context.Context, client EthHTTPClient) (err error) {
+		receipt, err = client.TransactionReceipt(ctx, txHash)
+		return
+	})
+	return
+}
+
+func (f *failoverClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return f.do(ctx, func(ctx context.Context, client EthHTTPClient) error {
+		return client.BatchCallContext(ctx, b)
+	})
+}
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/dan13ram/wpokt-oracle/ethereum/client/client_mocks"
+	log "github.com/sirupsen/logrus"
+)
+
+func TestFailoverClient_NoEndpoints(t *testing.T) {
+	client := newFailoverClient([]EthHTTPClient{}, log.NewEntry(log.New()))
+
+	_, err := client.BlockNumber(context.Background())
+	assert.Error(t, err)
+}
+
+func TestFailoverClient_FailsOver(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+
+	mockClientOne.On("BlockNumber", mock.Anything).Return(uint64(0), rpc.HTTPError{StatusCode: 502, Status: "502 Bad Gateway"}).Once()
+	mockClientTwo.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Once()
+
+	blockNumber, err := client.BlockNumber(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), blockNumber)
+	assert.Equal(t, 1, client.activeIndex())
+
+	// the endpoint that answered stays active
+	mockClientTwo.On("BlockNumber", mock.Anything).Return(uint64(101), nil).Once()
+
+	blockNumber, err = client.BlockNumber(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(101), blockNumber)
+	assert.Equal(t, 1, client.activeIndex())
+}
+
+func TestFailoverClient_AllFail(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+
+	mockClientOne.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, &net.OpError{Op: "dial", Err: fmt.Errorf("connection refused")}).Once()
+	mockClientTwo.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, io.EOF).Once()
+
+	receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash("0x01"))
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Nil(t, receipt)
+	assert.Equal(t, 0, client.activeIndex())
+}
+
+func TestFailoverClient_NotFound(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+
+	mockClientOne.On("TransactionReceipt", mock.Anything, mock.Anything).Return(nil, ethereum.NotFound).Once()
+
+	receipt, err := client.TransactionReceipt(context.Background(), common.HexToHash("0x01"))
+	assert.ErrorIs(t, err, ethereum.NotFound)
+	assert.Nil(t, receipt)
+	assert.Equal(t, 0, client.activeIndex())
+}
+
+func TestFailoverClient_ContextDone(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// no endpoint is called or blamed for the cancelled call
+	_, err := client.ChainID(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 0, client.activeIndex())
+}
+
+func TestFailoverClient_AttemptTimeout(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+	client.attemptTimeout = 20 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// the first endpoint hangs until the attempt times out
+	mockClientOne.On("BlockNumber", mock.Anything).Return(func(ctx context.Context) (uint64, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}).Once()
+	mockClientTwo.On("BlockNumber", mock.Anything).Return(func(ctx context.Context) (uint64, error) {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		return 100, nil
+	}).Once()
+
+	blockNumber, err := client.BlockNumber(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), blockNumber)
+	assert.Equal(t, 1, client.activeIndex())
+}
+
+type testDataError struct{}
+
+func (testDataError) Error() string          { return "execution reverted" }
+func (testDataError) ErrorCode() int         { return 3 }
+func (testDataError) ErrorData() interface{} { return "0x08c379a0" }
+
+func TestFailoverClient_NodeErrors(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+
+	// answers of the node are returned without trying the other endpoint or demoting this one
+	mockClientOne.On("CallContract", mock.Anything, ethereum.CallMsg{}, (*big.Int)(nil)).Return(nil, testDataError{}).Once()
+	mockClientOne.On("FilterLogs", mock.Anything, ethereum.FilterQuery{}).Return(nil, fmt.Errorf("query returned more than 10000 results")).Once()
+	mockClientOne.On("BlockNumber", mock.Anything).Return(uint64(0), rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"}).Once()
+
+	_, err := client.CallContract(context.Background(), ethereum.CallMsg{}, nil)
+	var dataErr rpc.DataError
+	assert.ErrorAs(t, err, &dataErr)
+
+	_, err = client.FilterLogs(context.Background(), ethereum.FilterQuery{})
+	assert.True(t, IsQueryRangeError(err))
+
+	_, err = client.BlockNumber(context.Background())
+	assert.True(t, IsRateLimitError(err))
+
+	assert.Equal(t, 0, client.activeIndex())
+}
+
+func TestFailoverClient_QueryRangePerEndpoint(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+	client.setQueryRanges(1000)
+
+	// the first endpoint rejects the range, so only its range is reduced
+	mockClientOne.On("FilterLogs", mock.Anything, ethereum.FilterQuery{}).Return(nil, fmt.Errorf("block range is too large")).Once()
+
+	_, err := client.FilterLogs(context.Background(), ethereum.FilterQuery{})
+	assert.True(t, IsQueryRangeError(err))
+	client.ReduceMaxQueryBlocks(100)
+	assert.Equal(t, uint64(100), client.MaxQueryBlocks())
+
+	// once the second endpoint is active its own range is used
+	mockClientOne.On("BlockNumber", mock.Anything).Return(uint64(0), io.EOF).Once()
+	mockClientTwo.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Once()
+
+	_, err = client.BlockNumber(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.activeIndex())
+	assert.Equal(t, uint64(1000), client.MaxQueryBlocks())
+	assert.Equal(t, uint64(100), client.queryRange(0).Blocks())
+}
+
+func TestIsTransportError(t *testing.T) {
+	assert.False(t, isTransportError(nil))
+	assert.False(t, isTransportError(ethereum.NotFound))
+	assert.False(t, isTransportError(testDataError{}))
+	assert.False(t, isTransportError(rpc.HTTPError{StatusCode: 429}))
+	assert.False(t, isTransportError(fmt.Errorf("range is too large")))
+
+	assert.True(t, isTransportError(rpc.HTTPError{StatusCode: 503}))
+	assert.True(t, isTransportError(&net.OpError{Op: "dial", Err: fmt.Errorf("connection refused")}))
+	assert.True(t, isTransportError(fmt.Errorf("post: %w", context.DeadlineExceeded)))
+	assert.True(t, isTransportError(io.ErrUnexpectedEOF))
+}
+
+func TestFailoverClient_ContractBackend(t *testing.T) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClient}, log.NewEntry(log.New()))
+	ctx := context.Background()
+	address := common.HexToAddress("0x01")
+
+	mockClient.On("CodeAt", mock.Anything, address, (*big.Int)(nil)).Return([]byte{1}, nil).Once()
+	mockClient.On("CallContract", mock.Anything, ethereum.CallMsg{}, (*big.Int)(nil)).Return([]byte{2}, nil).Once()
+	mockClient.On("HeaderByNumber", mock.Anything, (*big.Int)(nil)).Return(&types.Header{Number: big.NewInt(1)}, nil).Once()
+	mockClient.On("PendingCodeAt", mock.Anything, address).Return([]byte{3}, nil).Once()
+	mockClient.On("PendingNonceAt", mock.Anything, address).Return(uint64(4), nil).Once()
+	mockClient.On("SuggestGasPrice", mock.Anything).Return(big.NewInt(5), nil).Once()
+	mockClient.On("SuggestGasTipCap", mock.Anything).Return(big.NewInt(6), nil).Once()
+	mockClient.On("EstimateGas", mock.Anything, ethereum.CallMsg{}).Return(uint64(7), nil).Once()
+	mockClient.On("SendTransaction", mock.Anything, (*types.Transaction)(nil)).Return(nil).Once()
+	mockClient.On("FilterLogs", mock.Anything, ethereum.FilterQuery{}).Return([]types.Log{{Index: 8}}, nil).Once()
+	mockClient.On("SubscribeFilterLogs", mock.Anything, ethereum.FilterQuery{}, (chan<- types.Log)(nil)).Return(nil, nil).Once()
+	mockClient.On("TransactionByHash", mock.Anything, common.Hash{}).Return(&types.Transaction{}, true, nil).Once()
+
+	code, err := client.CodeAt(ctx, address, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, code)
+
+	result, err := client.CallContract(ctx, ethereum.CallMsg{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2}, result)
+
+	header, err := client.HeaderByNumber(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), header.Number)
+
+	code, err = client.PendingCodeAt(ctx, address)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{3}, code)
+
+	nonce, err := client.PendingNonceAt(ctx, address)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), nonce)
+
+	price, err := client.SuggestGasPrice(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(5), price)
+
+	tip, err := client.SuggestGasTipCap(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(6), tip)
+
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), gas)
+
+	err = client.SendTransaction(ctx, nil)
+	assert.NoError(t, err)
+
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint(8), logs[0].Index)
+
+	_, err = client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{}, nil)
+	assert.NoError(t, err)
+
+	_, isPending, err := client.TransactionByHash(ctx, common.Hash{})
+	assert.NoError(t, err)
+	assert.True(t, isPending)
+}
+
+func TestFailoverClient_BatchCallContext(t *testing.T) {
+	mockClientOne := mocks.NewMockEthHTTPClient(t)
+	mockClientTwo := mocks.NewMockEthHTTPClient(t)
+	client := newFailoverClient([]EthHTTPClient{mockClientOne, mockClientTwo}, log.NewEntry(log.New()))
+	ctx := context.Background()
+	elems := []rpc.BatchElem{{Method: "eth_blockNumber"}}
+
+	mockClientOne.On("BatchCallContext", mock.Anything, elems).Return(io.EOF).Once()
+	mockClientTwo.On("BatchCallContext", mock.Anything, elems).Return(nil).Once()
+
+	err := client.BatchCallContext(ctx, elems)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.activeIndex())
+}
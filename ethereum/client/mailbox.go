@@ -13,6 +13,8 @@ type MailboxContract interface {
 	FilterDispatch(opts *bind.FilterOpts, sender []common.Address, destination []uint32, recipient [][32]byte) (MailboxDispatchIterator, error)
 	ParseDispatch(log types.Log) (*autogen.MailboxDispatch, error)
 	ParseDispatchId(log types.Log) (*autogen.MailboxDispatchId, error)
+	Nonce(opts *bind.CallOpts) (uint32, error)
+	LatestDispatchedId(opts *bind.CallOpts) ([32]byte, error)
 }
 
 type MailboxDispatchIterator interface {
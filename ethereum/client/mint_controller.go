@@ -13,6 +13,9 @@ import (
 type MintControllerContract interface {
 	Address() common.Address
 	MaxMintLimit(opts *bind.CallOpts) (*big.Int, error)
+	CurrentMintLimit(opts *bind.CallOpts) (*big.Int, error)
+	MintPerSecond(opts *bind.CallOpts) (*big.Int, error)
+	FulfillOrder(opts *bind.TransactOpts, metadata []byte, message []byte) (*types.Transaction, error)
 	FilterFulfillment(opts *bind.FilterOpts, orderID [][32]byte) (MintControllerFulfillmentIterator, error)
 	ParseFulfillment(log types.Log) (*autogen.MintControllerFulfillment, error)
 }
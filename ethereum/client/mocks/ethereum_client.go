@@ -6,6 +6,8 @@ import (
 	big "math/big"
 
 	client "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	common "github.com/ethereum/go-ethereum/common"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/dan13ram/wpokt-oracle/models"
@@ -116,6 +118,109 @@ func (_c *MockEthereumClient_Confirmations_Call) RunAndReturn(run func() uint64)
 	return _c
 }
 
+// FinalityTag provides a mock function with given fields:
+func (_m *MockEthereumClient) FinalityTag() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalityTag")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockEthereumClient_FinalityTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinalityTag'
+type MockEthereumClient_FinalityTag_Call struct {
+	*mock.Call
+}
+
+// FinalityTag is a helper method to define mock.On call
+func (_e *MockEthereumClient_Expecter) FinalityTag() *MockEthereumClient_FinalityTag_Call {
+	return &MockEthereumClient_FinalityTag_Call{Call: _e.mock.On("FinalityTag")}
+}
+
+func (_c *MockEthereumClient_FinalityTag_Call) Run(run func()) *MockEthereumClient_FinalityTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_FinalityTag_Call) Return(_a0 string) *MockEthereumClient_FinalityTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEthereumClient_FinalityTag_Call) RunAndReturn(run func() string) *MockEthereumClient_FinalityTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockHash provides a mock function with given fields: blockHeight
+func (_m *MockEthereumClient) GetBlockHash(blockHeight uint64) (common.Hash, error) {
+	ret := _m.Called(blockHeight)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockHash")
+	}
+
+	var r0 common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (common.Hash, error)); ok {
+		return rf(blockHeight)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) common.Hash); ok {
+		r0 = rf(blockHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(blockHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEthereumClient_GetBlockHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockHash'
+type MockEthereumClient_GetBlockHash_Call struct {
+	*mock.Call
+}
+
+// GetBlockHash is a helper method to define mock.On call
+//   - blockHeight uint64
+func (_e *MockEthereumClient_Expecter) GetBlockHash(blockHeight interface{}) *MockEthereumClient_GetBlockHash_Call {
+	return &MockEthereumClient_GetBlockHash_Call{Call: _e.mock.On("GetBlockHash", blockHeight)}
+}
+
+func (_c *MockEthereumClient_GetBlockHash_Call) Run(run func(blockHeight uint64)) *MockEthereumClient_GetBlockHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_GetBlockHash_Call) Return(_a0 common.Hash, _a1 error) *MockEthereumClient_GetBlockHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEthereumClient_GetBlockHash_Call) RunAndReturn(run func(uint64) (common.Hash, error)) *MockEthereumClient_GetBlockHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBlockHeight provides a mock function with given fields:
 func (_m *MockEthereumClient) GetBlockHeight() (uint64, error) {
 	ret := _m.Called()
@@ -171,6 +276,64 @@ func (_c *MockEthereumClient_GetBlockHeight_Call) RunAndReturn(run func() (uint6
 	return _c
 }
 
+// GetBlockReceipts provides a mock function with given fields: blockHeights
+func (_m *MockEthereumClient) GetBlockReceipts(blockHeights []uint64) (map[uint64][]*types.Receipt, error) {
+	ret := _m.Called(blockHeights)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockReceipts")
+	}
+
+	var r0 map[uint64][]*types.Receipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint64) (map[uint64][]*types.Receipt, error)); ok {
+		return rf(blockHeights)
+	}
+	if rf, ok := ret.Get(0).(func([]uint64) map[uint64][]*types.Receipt); ok {
+		r0 = rf(blockHeights)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint64][]*types.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint64) error); ok {
+		r1 = rf(blockHeights)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEthereumClient_GetBlockReceipts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockReceipts'
+type MockEthereumClient_GetBlockReceipts_Call struct {
+	*mock.Call
+}
+
+// GetBlockReceipts is a helper method to define mock.On call
+//   - blockHeights []uint64
+func (_e *MockEthereumClient_Expecter) GetBlockReceipts(blockHeights interface{}) *MockEthereumClient_GetBlockReceipts_Call {
+	return &MockEthereumClient_GetBlockReceipts_Call{Call: _e.mock.On("GetBlockReceipts", blockHeights)}
+}
+
+func (_c *MockEthereumClient_GetBlockReceipts_Call) Run(run func(blockHeights []uint64)) *MockEthereumClient_GetBlockReceipts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]uint64))
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_GetBlockReceipts_Call) Return(_a0 map[uint64][]*types.Receipt, _a1 error) *MockEthereumClient_GetBlockReceipts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEthereumClient_GetBlockReceipts_Call) RunAndReturn(run func([]uint64) (map[uint64][]*types.Receipt, error)) *MockEthereumClient_GetBlockReceipts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChainID provides a mock function with given fields:
 func (_m *MockEthereumClient) GetChainID() (*big.Int, error) {
 	ret := _m.Called()
@@ -275,6 +438,61 @@ func (_c *MockEthereumClient_GetClient_Call) RunAndReturn(run func() client.EthH
 	return _c
 }
 
+// GetFinalizedBlockHeight provides a mock function with given fields:
+func (_m *MockEthereumClient) GetFinalizedBlockHeight() (uint64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFinalizedBlockHeight")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (uint64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEthereumClient_GetFinalizedBlockHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFinalizedBlockHeight'
+type MockEthereumClient_GetFinalizedBlockHeight_Call struct {
+	*mock.Call
+}
+
+// GetFinalizedBlockHeight is a helper method to define mock.On call
+func (_e *MockEthereumClient_Expecter) GetFinalizedBlockHeight() *MockEthereumClient_GetFinalizedBlockHeight_Call {
+	return &MockEthereumClient_GetFinalizedBlockHeight_Call{Call: _e.mock.On("GetFinalizedBlockHeight")}
+}
+
+func (_c *MockEthereumClient_GetFinalizedBlockHeight_Call) Run(run func()) *MockEthereumClient_GetFinalizedBlockHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_GetFinalizedBlockHeight_Call) Return(_a0 uint64, _a1 error) *MockEthereumClient_GetFinalizedBlockHeight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEthereumClient_GetFinalizedBlockHeight_Call) RunAndReturn(run func() (uint64, error)) *MockEthereumClient_GetFinalizedBlockHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTransactionByHash provides a mock function with given fields: txHash
 func (_m *MockEthereumClient) GetTransactionByHash(txHash string) (*types.Transaction, bool, error) {
 	ret := _m.Called(txHash)
@@ -398,6 +616,142 @@ func (_c *MockEthereumClient_GetTransactionReceipt_Call) RunAndReturn(run func(s
 	return _c
 }
 
+// GetTransactionReceipts provides a mock function with given fields: txHashes
+func (_m *MockEthereumClient) GetTransactionReceipts(txHashes []string) (map[common.Hash]*types.Receipt, error) {
+	ret := _m.Called(txHashes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransactionReceipts")
+	}
+
+	var r0 map[common.Hash]*types.Receipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[common.Hash]*types.Receipt, error)); ok {
+		return rf(txHashes)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[common.Hash]*types.Receipt); ok {
+		r0 = rf(txHashes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[common.Hash]*types.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(txHashes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEthereumClient_GetTransactionReceipts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransactionReceipts'
+type MockEthereumClient_GetTransactionReceipts_Call struct {
+	*mock.Call
+}
+
+// GetTransactionReceipts is a helper method to define mock.On call
+//   - txHashes []string
+func (_e *MockEthereumClient_Expecter) GetTransactionReceipts(txHashes interface{}) *MockEthereumClient_GetTransactionReceipts_Call {
+	return &MockEthereumClient_GetTransactionReceipts_Call{Call: _e.mock.On("GetTransactionReceipts", txHashes)}
+}
+
+func (_c *MockEthereumClient_GetTransactionReceipts_Call) Run(run func(txHashes []string)) *MockEthereumClient_GetTransactionReceipts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_GetTransactionReceipts_Call) Return(_a0 map[common.Hash]*types.Receipt, _a1 error) *MockEthereumClient_GetTransactionReceipts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEthereumClient_GetTransactionReceipts_Call) RunAndReturn(run func([]string) (map[common.Hash]*types.Receipt, error)) *MockEthereumClient_GetTransactionReceipts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxQueryBlocks provides a mock function with given fields:
+func (_m *MockEthereumClient) MaxQueryBlocks() uint64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxQueryBlocks")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// MockEthereumClient_MaxQueryBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxQueryBlocks'
+type MockEthereumClient_MaxQueryBlocks_Call struct {
+	*mock.Call
+}
+
+// MaxQueryBlocks is a helper method to define mock.On call
+func (_e *MockEthereumClient_Expecter) MaxQueryBlocks() *MockEthereumClient_MaxQueryBlocks_Call {
+	return &MockEthereumClient_MaxQueryBlocks_Call{Call: _e.mock.On("MaxQueryBlocks")}
+}
+
+func (_c *MockEthereumClient_MaxQueryBlocks_Call) Run(run func()) *MockEthereumClient_MaxQueryBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_MaxQueryBlocks_Call) Return(_a0 uint64) *MockEthereumClient_MaxQueryBlocks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockEthereumClient_MaxQueryBlocks_Call) RunAndReturn(run func() uint64) *MockEthereumClient_MaxQueryBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReduceMaxQueryBlocks provides a mock function with given fields: queryBlocks
+func (_m *MockEthereumClient) ReduceMaxQueryBlocks(queryBlocks uint64) {
+	_m.Called(queryBlocks)
+}
+
+// MockEthereumClient_ReduceMaxQueryBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReduceMaxQueryBlocks'
+type MockEthereumClient_ReduceMaxQueryBlocks_Call struct {
+	*mock.Call
+}
+
+// ReduceMaxQueryBlocks is a helper method to define mock.On call
+//   - queryBlocks uint64
+func (_e *MockEthereumClient_Expecter) ReduceMaxQueryBlocks(queryBlocks interface{}) *MockEthereumClient_ReduceMaxQueryBlocks_Call {
+	return &MockEthereumClient_ReduceMaxQueryBlocks_Call{Call: _e.mock.On("ReduceMaxQueryBlocks", queryBlocks)}
+}
+
+func (_c *MockEthereumClient_ReduceMaxQueryBlocks_Call) Run(run func(queryBlocks uint64)) *MockEthereumClient_ReduceMaxQueryBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *MockEthereumClient_ReduceMaxQueryBlocks_Call) Return() *MockEthereumClient_ReduceMaxQueryBlocks_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEthereumClient_ReduceMaxQueryBlocks_Call) RunAndReturn(run func(uint64)) *MockEthereumClient_ReduceMaxQueryBlocks_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ValidateNetwork provides a mock function with given fields:
 func (_m *MockEthereumClient) ValidateNetwork() error {
 	ret := _m.Called()
@@ -136,6 +136,120 @@ func (_c *MockMailboxContract_FilterDispatch_Call) RunAndReturn(run func(*bind.F
 	return _c
 }
 
+// LatestDispatchedId provides a mock function with given fields: opts
+func (_m *MockMailboxContract) LatestDispatchedId(opts *bind.CallOpts) ([32]byte, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestDispatchedId")
+	}
+
+	var r0 [32]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) ([32]byte, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) [32]byte); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([32]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMailboxContract_LatestDispatchedId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestDispatchedId'
+type MockMailboxContract_LatestDispatchedId_Call struct {
+	*mock.Call
+}
+
+// LatestDispatchedId is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockMailboxContract_Expecter) LatestDispatchedId(opts interface{}) *MockMailboxContract_LatestDispatchedId_Call {
+	return &MockMailboxContract_LatestDispatchedId_Call{Call: _e.mock.On("LatestDispatchedId", opts)}
+}
+
+func (_c *MockMailboxContract_LatestDispatchedId_Call) Run(run func(opts *bind.CallOpts)) *MockMailboxContract_LatestDispatchedId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockMailboxContract_LatestDispatchedId_Call) Return(_a0 [32]byte, _a1 error) *MockMailboxContract_LatestDispatchedId_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMailboxContract_LatestDispatchedId_Call) RunAndReturn(run func(*bind.CallOpts) ([32]byte, error)) *MockMailboxContract_LatestDispatchedId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Nonce provides a mock function with given fields: opts
+func (_m *MockMailboxContract) Nonce(opts *bind.CallOpts) (uint32, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nonce")
+	}
+
+	var r0 uint32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (uint32, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) uint32); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMailboxContract_Nonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nonce'
+type MockMailboxContract_Nonce_Call struct {
+	*mock.Call
+}
+
+// Nonce is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockMailboxContract_Expecter) Nonce(opts interface{}) *MockMailboxContract_Nonce_Call {
+	return &MockMailboxContract_Nonce_Call{Call: _e.mock.On("Nonce", opts)}
+}
+
+func (_c *MockMailboxContract_Nonce_Call) Run(run func(opts *bind.CallOpts)) *MockMailboxContract_Nonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockMailboxContract_Nonce_Call) Return(_a0 uint32, _a1 error) *MockMailboxContract_Nonce_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMailboxContract_Nonce_Call) RunAndReturn(run func(*bind.CallOpts) (uint32, error)) *MockMailboxContract_Nonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ParseDispatch provides a mock function with given fields: log
 func (_m *MockMailboxContract) ParseDispatch(log types.Log) (*autogen.MailboxDispatch, error) {
 	ret := _m.Called(log)
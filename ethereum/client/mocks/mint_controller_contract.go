@@ -78,6 +78,64 @@ func (_c *MockMintControllerContract_Address_Call) RunAndReturn(run func() commo
 	return _c
 }
 
+// CurrentMintLimit provides a mock function with given fields: opts
+func (_m *MockMintControllerContract) CurrentMintLimit(opts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CurrentMintLimit")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_CurrentMintLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CurrentMintLimit'
+type MockMintControllerContract_CurrentMintLimit_Call struct {
+	*mock.Call
+}
+
+// CurrentMintLimit is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockMintControllerContract_Expecter) CurrentMintLimit(opts interface{}) *MockMintControllerContract_CurrentMintLimit_Call {
+	return &MockMintControllerContract_CurrentMintLimit_Call{Call: _e.mock.On("CurrentMintLimit", opts)}
+}
+
+func (_c *MockMintControllerContract_CurrentMintLimit_Call) Run(run func(opts *bind.CallOpts)) *MockMintControllerContract_CurrentMintLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_CurrentMintLimit_Call) Return(_a0 *big.Int, _a1 error) *MockMintControllerContract_CurrentMintLimit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_CurrentMintLimit_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockMintControllerContract_CurrentMintLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FilterFulfillment provides a mock function with given fields: opts, orderID
 func (_m *MockMintControllerContract) FilterFulfillment(opts *bind.FilterOpts, orderID [][32]byte) (client.MintControllerFulfillmentIterator, error) {
 	ret := _m.Called(opts, orderID)
@@ -137,6 +195,66 @@ func (_c *MockMintControllerContract_FilterFulfillment_Call) RunAndReturn(run fu
 	return _c
 }
 
+// FulfillOrder provides a mock function with given fields: opts, metadata, message
+func (_m *MockMintControllerContract) FulfillOrder(opts *bind.TransactOpts, metadata []byte, message []byte) (*types.Transaction, error) {
+	ret := _m.Called(opts, metadata, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FulfillOrder")
+	}
+
+	var r0 *types.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.TransactOpts, []byte, []byte) (*types.Transaction, error)); ok {
+		return rf(opts, metadata, message)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.TransactOpts, []byte, []byte) *types.Transaction); ok {
+		r0 = rf(opts, metadata, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.TransactOpts, []byte, []byte) error); ok {
+		r1 = rf(opts, metadata, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_FulfillOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FulfillOrder'
+type MockMintControllerContract_FulfillOrder_Call struct {
+	*mock.Call
+}
+
+// FulfillOrder is a helper method to define mock.On call
+//   - opts *bind.TransactOpts
+//   - metadata []byte
+//   - message []byte
+func (_e *MockMintControllerContract_Expecter) FulfillOrder(opts interface{}, metadata interface{}, message interface{}) *MockMintControllerContract_FulfillOrder_Call {
+	return &MockMintControllerContract_FulfillOrder_Call{Call: _e.mock.On("FulfillOrder", opts, metadata, message)}
+}
+
+func (_c *MockMintControllerContract_FulfillOrder_Call) Run(run func(opts *bind.TransactOpts, metadata []byte, message []byte)) *MockMintControllerContract_FulfillOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.TransactOpts), args[1].([]byte), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_FulfillOrder_Call) Return(_a0 *types.Transaction, _a1 error) *MockMintControllerContract_FulfillOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_FulfillOrder_Call) RunAndReturn(run func(*bind.TransactOpts, []byte, []byte) (*types.Transaction, error)) *MockMintControllerContract_FulfillOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MaxMintLimit provides a mock function with given fields: opts
 func (_m *MockMintControllerContract) MaxMintLimit(opts *bind.CallOpts) (*big.Int, error) {
 	ret := _m.Called(opts)
@@ -195,6 +313,64 @@ func (_c *MockMintControllerContract_MaxMintLimit_Call) RunAndReturn(run func(*b
 	return _c
 }
 
+// MintPerSecond provides a mock function with given fields: opts
+func (_m *MockMintControllerContract) MintPerSecond(opts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MintPerSecond")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMintControllerContract_MintPerSecond_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MintPerSecond'
+type MockMintControllerContract_MintPerSecond_Call struct {
+	*mock.Call
+}
+
+// MintPerSecond is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockMintControllerContract_Expecter) MintPerSecond(opts interface{}) *MockMintControllerContract_MintPerSecond_Call {
+	return &MockMintControllerContract_MintPerSecond_Call{Call: _e.mock.On("MintPerSecond", opts)}
+}
+
+func (_c *MockMintControllerContract_MintPerSecond_Call) Run(run func(opts *bind.CallOpts)) *MockMintControllerContract_MintPerSecond_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockMintControllerContract_MintPerSecond_Call) Return(_a0 *big.Int, _a1 error) *MockMintControllerContract_MintPerSecond_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMintControllerContract_MintPerSecond_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockMintControllerContract_MintPerSecond_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ParseFulfillment provides a mock function with given fields: log
 func (_m *MockMintControllerContract) ParseFulfillment(log types.Log) (*autogen.MintControllerFulfillment, error) {
 	ret := _m.Called(log)
@@ -0,0 +1,262 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	big "math/big"
+
+	client "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOmniTokenContract is an autogenerated mock type for the OmniTokenContract type
+type MockOmniTokenContract struct {
+	mock.Mock
+}
+
+type MockOmniTokenContract_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOmniTokenContract) EXPECT() *MockOmniTokenContract_Expecter {
+	return &MockOmniTokenContract_Expecter{mock: &_m.Mock}
+}
+
+// Address provides a mock function with given fields:
+func (_m *MockOmniTokenContract) Address() common.Address {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// MockOmniTokenContract_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type MockOmniTokenContract_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *MockOmniTokenContract_Expecter) Address() *MockOmniTokenContract_Address_Call {
+	return &MockOmniTokenContract_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *MockOmniTokenContract_Address_Call) Run(run func()) *MockOmniTokenContract_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenContract_Address_Call) Return(_a0 common.Address) *MockOmniTokenContract_Address_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOmniTokenContract_Address_Call) RunAndReturn(run func() common.Address) *MockOmniTokenContract_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterTransfer provides a mock function with given fields: opts, from, to
+func (_m *MockOmniTokenContract) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (client.OmniTokenTransferIterator, error) {
+	ret := _m.Called(opts, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterTransfer")
+	}
+
+	var r0 client.OmniTokenTransferIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address, []common.Address) (client.OmniTokenTransferIterator, error)); ok {
+		return rf(opts, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address, []common.Address) client.OmniTokenTransferIterator); ok {
+		r0 = rf(opts, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.OmniTokenTransferIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []common.Address, []common.Address) error); ok {
+		r1 = rf(opts, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOmniTokenContract_FilterTransfer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterTransfer'
+type MockOmniTokenContract_FilterTransfer_Call struct {
+	*mock.Call
+}
+
+// FilterTransfer is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - from []common.Address
+//   - to []common.Address
+func (_e *MockOmniTokenContract_Expecter) FilterTransfer(opts interface{}, from interface{}, to interface{}) *MockOmniTokenContract_FilterTransfer_Call {
+	return &MockOmniTokenContract_FilterTransfer_Call{Call: _e.mock.On("FilterTransfer", opts, from, to)}
+}
+
+func (_c *MockOmniTokenContract_FilterTransfer_Call) Run(run func(opts *bind.FilterOpts, from []common.Address, to []common.Address)) *MockOmniTokenContract_FilterTransfer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]common.Address), args[2].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenContract_FilterTransfer_Call) Return(_a0 client.OmniTokenTransferIterator, _a1 error) *MockOmniTokenContract_FilterTransfer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOmniTokenContract_FilterTransfer_Call) RunAndReturn(run func(*bind.FilterOpts, []common.Address, []common.Address) (client.OmniTokenTransferIterator, error)) *MockOmniTokenContract_FilterTransfer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Paused provides a mock function with given fields: opts
+func (_m *MockOmniTokenContract) Paused(opts *bind.CallOpts) (bool, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Paused")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (bool, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) bool); ok {
+		r0 = rf(opts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOmniTokenContract_Paused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Paused'
+type MockOmniTokenContract_Paused_Call struct {
+	*mock.Call
+}
+
+// Paused is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockOmniTokenContract_Expecter) Paused(opts interface{}) *MockOmniTokenContract_Paused_Call {
+	return &MockOmniTokenContract_Paused_Call{Call: _e.mock.On("Paused", opts)}
+}
+
+func (_c *MockOmniTokenContract_Paused_Call) Run(run func(opts *bind.CallOpts)) *MockOmniTokenContract_Paused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenContract_Paused_Call) Return(_a0 bool, _a1 error) *MockOmniTokenContract_Paused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOmniTokenContract_Paused_Call) RunAndReturn(run func(*bind.CallOpts) (bool, error)) *MockOmniTokenContract_Paused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TotalSupply provides a mock function with given fields: opts
+func (_m *MockOmniTokenContract) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TotalSupply")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) (*big.Int, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts) *big.Int); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockOmniTokenContract_TotalSupply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TotalSupply'
+type MockOmniTokenContract_TotalSupply_Call struct {
+	*mock.Call
+}
+
+// TotalSupply is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+func (_e *MockOmniTokenContract_Expecter) TotalSupply(opts interface{}) *MockOmniTokenContract_TotalSupply_Call {
+	return &MockOmniTokenContract_TotalSupply_Call{Call: _e.mock.On("TotalSupply", opts)}
+}
+
+func (_c *MockOmniTokenContract_TotalSupply_Call) Run(run func(opts *bind.CallOpts)) *MockOmniTokenContract_TotalSupply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts))
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenContract_TotalSupply_Call) Return(_a0 *big.Int, _a1 error) *MockOmniTokenContract_TotalSupply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockOmniTokenContract_TotalSupply_Call) RunAndReturn(run func(*bind.CallOpts) (*big.Int, error)) *MockOmniTokenContract_TotalSupply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOmniTokenContract creates a new instance of MockOmniTokenContract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOmniTokenContract(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOmniTokenContract {
+	mock := &MockOmniTokenContract{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	autogen "github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockOmniTokenTransferIterator is an autogenerated mock type for the OmniTokenTransferIterator type
+type MockOmniTokenTransferIterator struct {
+	mock.Mock
+}
+
+type MockOmniTokenTransferIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOmniTokenTransferIterator) EXPECT() *MockOmniTokenTransferIterator_Expecter {
+	return &MockOmniTokenTransferIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockOmniTokenTransferIterator) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOmniTokenTransferIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockOmniTokenTransferIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockOmniTokenTransferIterator_Expecter) Close() *MockOmniTokenTransferIterator_Close_Call {
+	return &MockOmniTokenTransferIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockOmniTokenTransferIterator_Close_Call) Run(run func()) *MockOmniTokenTransferIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Close_Call) Return(_a0 error) *MockOmniTokenTransferIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Close_Call) RunAndReturn(run func() error) *MockOmniTokenTransferIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockOmniTokenTransferIterator) Error() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockOmniTokenTransferIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockOmniTokenTransferIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockOmniTokenTransferIterator_Expecter) Error() *MockOmniTokenTransferIterator_Error_Call {
+	return &MockOmniTokenTransferIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockOmniTokenTransferIterator_Error_Call) Run(run func()) *MockOmniTokenTransferIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Error_Call) Return(_a0 error) *MockOmniTokenTransferIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Error_Call) RunAndReturn(run func() error) *MockOmniTokenTransferIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockOmniTokenTransferIterator) Event() *autogen.OmniTokenTransfer {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Event")
+	}
+
+	var r0 *autogen.OmniTokenTransfer
+	if rf, ok := ret.Get(0).(func() *autogen.OmniTokenTransfer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.OmniTokenTransfer)
+		}
+	}
+
+	return r0
+}
+
+// MockOmniTokenTransferIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockOmniTokenTransferIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockOmniTokenTransferIterator_Expecter) Event() *MockOmniTokenTransferIterator_Event_Call {
+	return &MockOmniTokenTransferIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockOmniTokenTransferIterator_Event_Call) Run(run func()) *MockOmniTokenTransferIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Event_Call) Return(_a0 *autogen.OmniTokenTransfer) *MockOmniTokenTransferIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Event_Call) RunAndReturn(run func() *autogen.OmniTokenTransfer) *MockOmniTokenTransferIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockOmniTokenTransferIterator) Next() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockOmniTokenTransferIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockOmniTokenTransferIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockOmniTokenTransferIterator_Expecter) Next() *MockOmniTokenTransferIterator_Next_Call {
+	return &MockOmniTokenTransferIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockOmniTokenTransferIterator_Next_Call) Run(run func()) *MockOmniTokenTransferIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Next_Call) Return(_a0 bool) *MockOmniTokenTransferIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockOmniTokenTransferIterator_Next_Call) RunAndReturn(run func() bool) *MockOmniTokenTransferIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockOmniTokenTransferIterator creates a new instance of MockOmniTokenTransferIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOmniTokenTransferIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOmniTokenTransferIterator {
+	mock := &MockOmniTokenTransferIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,8 +5,11 @@ package mocks
 import (
 	big "math/big"
 
+	client "github.com/dan13ram/wpokt-oracle/ethereum/client"
 	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	mock "github.com/stretchr/testify/mock"
 
 	util "github.com/dan13ram/wpokt-oracle/ethereum/util"
@@ -25,6 +28,53 @@ func (_m *MockWarpISMContract) EXPECT() *MockWarpISMContract_Expecter {
 	return &MockWarpISMContract_Expecter{mock: &_m.Mock}
 }
 
+// Address provides a mock function with given fields:
+func (_m *MockWarpISMContract) Address() common.Address {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func() common.Address); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	return r0
+}
+
+// MockWarpISMContract_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type MockWarpISMContract_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *MockWarpISMContract_Expecter) Address() *MockWarpISMContract_Address_Call {
+	return &MockWarpISMContract_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *MockWarpISMContract_Address_Call) Run(run func()) *MockWarpISMContract_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMContract_Address_Call) Return(_a0 common.Address) *MockWarpISMContract_Address_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMContract_Address_Call) RunAndReturn(run func() common.Address) *MockWarpISMContract_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Eip712Domain provides a mock function with given fields: opts
 func (_m *MockWarpISMContract) Eip712Domain(opts *bind.CallOpts) (util.DomainData, error) {
 	ret := _m.Called(opts)
@@ -81,6 +131,183 @@ func (_c *MockWarpISMContract_Eip712Domain_Call) RunAndReturn(run func(*bind.Cal
 	return _c
 }
 
+// FilterNewValidator provides a mock function with given fields: opts, validator
+func (_m *MockWarpISMContract) FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (client.WarpISMNewValidatorIterator, error) {
+	ret := _m.Called(opts, validator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterNewValidator")
+	}
+
+	var r0 client.WarpISMNewValidatorIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) (client.WarpISMNewValidatorIterator, error)); ok {
+		return rf(opts, validator)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) client.WarpISMNewValidatorIterator); ok {
+		r0 = rf(opts, validator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.WarpISMNewValidatorIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []common.Address) error); ok {
+		r1 = rf(opts, validator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWarpISMContract_FilterNewValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterNewValidator'
+type MockWarpISMContract_FilterNewValidator_Call struct {
+	*mock.Call
+}
+
+// FilterNewValidator is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - validator []common.Address
+func (_e *MockWarpISMContract_Expecter) FilterNewValidator(opts interface{}, validator interface{}) *MockWarpISMContract_FilterNewValidator_Call {
+	return &MockWarpISMContract_FilterNewValidator_Call{Call: _e.mock.On("FilterNewValidator", opts, validator)}
+}
+
+func (_c *MockWarpISMContract_FilterNewValidator_Call) Run(run func(opts *bind.FilterOpts, validator []common.Address)) *MockWarpISMContract_FilterNewValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *MockWarpISMContract_FilterNewValidator_Call) Return(_a0 client.WarpISMNewValidatorIterator, _a1 error) *MockWarpISMContract_FilterNewValidator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWarpISMContract_FilterNewValidator_Call) RunAndReturn(run func(*bind.FilterOpts, []common.Address) (client.WarpISMNewValidatorIterator, error)) *MockWarpISMContract_FilterNewValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterRemovedValidator provides a mock function with given fields: opts, validator
+func (_m *MockWarpISMContract) FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (client.WarpISMRemovedValidatorIterator, error) {
+	ret := _m.Called(opts, validator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterRemovedValidator")
+	}
+
+	var r0 client.WarpISMRemovedValidatorIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) (client.WarpISMRemovedValidatorIterator, error)); ok {
+		return rf(opts, validator)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []common.Address) client.WarpISMRemovedValidatorIterator); ok {
+		r0 = rf(opts, validator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.WarpISMRemovedValidatorIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []common.Address) error); ok {
+		r1 = rf(opts, validator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWarpISMContract_FilterRemovedValidator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterRemovedValidator'
+type MockWarpISMContract_FilterRemovedValidator_Call struct {
+	*mock.Call
+}
+
+// FilterRemovedValidator is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - validator []common.Address
+func (_e *MockWarpISMContract_Expecter) FilterRemovedValidator(opts interface{}, validator interface{}) *MockWarpISMContract_FilterRemovedValidator_Call {
+	return &MockWarpISMContract_FilterRemovedValidator_Call{Call: _e.mock.On("FilterRemovedValidator", opts, validator)}
+}
+
+func (_c *MockWarpISMContract_FilterRemovedValidator_Call) Run(run func(opts *bind.FilterOpts, validator []common.Address)) *MockWarpISMContract_FilterRemovedValidator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]common.Address))
+	})
+	return _c
+}
+
+func (_c *MockWarpISMContract_FilterRemovedValidator_Call) Return(_a0 client.WarpISMRemovedValidatorIterator, _a1 error) *MockWarpISMContract_FilterRemovedValidator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWarpISMContract_FilterRemovedValidator_Call) RunAndReturn(run func(*bind.FilterOpts, []common.Address) (client.WarpISMRemovedValidatorIterator, error)) *MockWarpISMContract_FilterRemovedValidator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FilterSignerThresholdSet provides a mock function with given fields: opts, ratio
+func (_m *MockWarpISMContract) FilterSignerThresholdSet(opts *bind.FilterOpts, ratio []*big.Int) (client.WarpISMSignerThresholdSetIterator, error) {
+	ret := _m.Called(opts, ratio)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FilterSignerThresholdSet")
+	}
+
+	var r0 client.WarpISMSignerThresholdSetIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []*big.Int) (client.WarpISMSignerThresholdSetIterator, error)); ok {
+		return rf(opts, ratio)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.FilterOpts, []*big.Int) client.WarpISMSignerThresholdSetIterator); ok {
+		r0 = rf(opts, ratio)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(client.WarpISMSignerThresholdSetIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.FilterOpts, []*big.Int) error); ok {
+		r1 = rf(opts, ratio)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWarpISMContract_FilterSignerThresholdSet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FilterSignerThresholdSet'
+type MockWarpISMContract_FilterSignerThresholdSet_Call struct {
+	*mock.Call
+}
+
+// FilterSignerThresholdSet is a helper method to define mock.On call
+//   - opts *bind.FilterOpts
+//   - ratio []*big.Int
+func (_e *MockWarpISMContract_Expecter) FilterSignerThresholdSet(opts interface{}, ratio interface{}) *MockWarpISMContract_FilterSignerThresholdSet_Call {
+	return &MockWarpISMContract_FilterSignerThresholdSet_Call{Call: _e.mock.On("FilterSignerThresholdSet", opts, ratio)}
+}
+
+func (_c *MockWarpISMContract_FilterSignerThresholdSet_Call) Run(run func(opts *bind.FilterOpts, ratio []*big.Int)) *MockWarpISMContract_FilterSignerThresholdSet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.FilterOpts), args[1].([]*big.Int))
+	})
+	return _c
+}
+
+func (_c *MockWarpISMContract_FilterSignerThresholdSet_Call) Return(_a0 client.WarpISMSignerThresholdSetIterator, _a1 error) *MockWarpISMContract_FilterSignerThresholdSet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWarpISMContract_FilterSignerThresholdSet_Call) RunAndReturn(run func(*bind.FilterOpts, []*big.Int) (client.WarpISMSignerThresholdSetIterator, error)) *MockWarpISMContract_FilterSignerThresholdSet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SignerThreshold provides a mock function with given fields: opts
 func (_m *MockWarpISMContract) SignerThreshold(opts *bind.CallOpts) (*big.Int, error) {
 	ret := _m.Called(opts)
@@ -197,6 +424,121 @@ func (_c *MockWarpISMContract_ValidatorCount_Call) RunAndReturn(run func(*bind.C
 	return _c
 }
 
+// Validators provides a mock function with given fields: opts, validator
+func (_m *MockWarpISMContract) Validators(opts *bind.CallOpts, validator common.Address) (bool, error) {
+	ret := _m.Called(opts, validator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validators")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, common.Address) (bool, error)); ok {
+		return rf(opts, validator)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, common.Address) bool); ok {
+		r0 = rf(opts, validator)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts, common.Address) error); ok {
+		r1 = rf(opts, validator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWarpISMContract_Validators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validators'
+type MockWarpISMContract_Validators_Call struct {
+	*mock.Call
+}
+
+// Validators is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+//   - validator common.Address
+func (_e *MockWarpISMContract_Expecter) Validators(opts interface{}, validator interface{}) *MockWarpISMContract_Validators_Call {
+	return &MockWarpISMContract_Validators_Call{Call: _e.mock.On("Validators", opts, validator)}
+}
+
+func (_c *MockWarpISMContract_Validators_Call) Run(run func(opts *bind.CallOpts, validator common.Address)) *MockWarpISMContract_Validators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts), args[1].(common.Address))
+	})
+	return _c
+}
+
+func (_c *MockWarpISMContract_Validators_Call) Return(_a0 bool, _a1 error) *MockWarpISMContract_Validators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWarpISMContract_Validators_Call) RunAndReturn(run func(*bind.CallOpts, common.Address) (bool, error)) *MockWarpISMContract_Validators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: opts, metadata, message
+func (_m *MockWarpISMContract) Verify(opts *bind.CallOpts, metadata []byte, message []byte) (bool, error) {
+	ret := _m.Called(opts, metadata, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, []byte, []byte) (bool, error)); ok {
+		return rf(opts, metadata, message)
+	}
+	if rf, ok := ret.Get(0).(func(*bind.CallOpts, []byte, []byte) bool); ok {
+		r0 = rf(opts, metadata, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*bind.CallOpts, []byte, []byte) error); ok {
+		r1 = rf(opts, metadata, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWarpISMContract_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type MockWarpISMContract_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - opts *bind.CallOpts
+//   - metadata []byte
+//   - message []byte
+func (_e *MockWarpISMContract_Expecter) Verify(opts interface{}, metadata interface{}, message interface{}) *MockWarpISMContract_Verify_Call {
+	return &MockWarpISMContract_Verify_Call{Call: _e.mock.On("Verify", opts, metadata, message)}
+}
+
+func (_c *MockWarpISMContract_Verify_Call) Run(run func(opts *bind.CallOpts, metadata []byte, message []byte)) *MockWarpISMContract_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*bind.CallOpts), args[1].([]byte), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockWarpISMContract_Verify_Call) Return(_a0 bool, _a1 error) *MockWarpISMContract_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWarpISMContract_Verify_Call) RunAndReturn(run func(*bind.CallOpts, []byte, []byte) (bool, error)) *MockWarpISMContract_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWarpISMContract creates a new instance of MockWarpISMContract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWarpISMContract(t interface {
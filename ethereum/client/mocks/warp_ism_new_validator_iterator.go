@@ -0,0 +1,218 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	autogen "github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWarpISMNewValidatorIterator is an autogenerated mock type for the WarpISMNewValidatorIterator type
+type MockWarpISMNewValidatorIterator struct {
+	mock.Mock
+}
+
+type MockWarpISMNewValidatorIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWarpISMNewValidatorIterator) EXPECT() *MockWarpISMNewValidatorIterator_Expecter {
+	return &MockWarpISMNewValidatorIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockWarpISMNewValidatorIterator) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWarpISMNewValidatorIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockWarpISMNewValidatorIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockWarpISMNewValidatorIterator_Expecter) Close() *MockWarpISMNewValidatorIterator_Close_Call {
+	return &MockWarpISMNewValidatorIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Close_Call) Run(run func()) *MockWarpISMNewValidatorIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Close_Call) Return(_a0 error) *MockWarpISMNewValidatorIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Close_Call) RunAndReturn(run func() error) *MockWarpISMNewValidatorIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockWarpISMNewValidatorIterator) Error() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWarpISMNewValidatorIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockWarpISMNewValidatorIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockWarpISMNewValidatorIterator_Expecter) Error() *MockWarpISMNewValidatorIterator_Error_Call {
+	return &MockWarpISMNewValidatorIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Error_Call) Run(run func()) *MockWarpISMNewValidatorIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Error_Call) Return(_a0 error) *MockWarpISMNewValidatorIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Error_Call) RunAndReturn(run func() error) *MockWarpISMNewValidatorIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockWarpISMNewValidatorIterator) Event() *autogen.WarpISMNewValidator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Event")
+	}
+
+	var r0 *autogen.WarpISMNewValidator
+	if rf, ok := ret.Get(0).(func() *autogen.WarpISMNewValidator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.WarpISMNewValidator)
+		}
+	}
+
+	return r0
+}
+
+// MockWarpISMNewValidatorIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockWarpISMNewValidatorIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockWarpISMNewValidatorIterator_Expecter) Event() *MockWarpISMNewValidatorIterator_Event_Call {
+	return &MockWarpISMNewValidatorIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Event_Call) Run(run func()) *MockWarpISMNewValidatorIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Event_Call) Return(_a0 *autogen.WarpISMNewValidator) *MockWarpISMNewValidatorIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Event_Call) RunAndReturn(run func() *autogen.WarpISMNewValidator) *MockWarpISMNewValidatorIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockWarpISMNewValidatorIterator) Next() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockWarpISMNewValidatorIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockWarpISMNewValidatorIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockWarpISMNewValidatorIterator_Expecter) Next() *MockWarpISMNewValidatorIterator_Next_Call {
+	return &MockWarpISMNewValidatorIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Next_Call) Run(run func()) *MockWarpISMNewValidatorIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Next_Call) Return(_a0 bool) *MockWarpISMNewValidatorIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMNewValidatorIterator_Next_Call) RunAndReturn(run func() bool) *MockWarpISMNewValidatorIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWarpISMNewValidatorIterator creates a new instance of MockWarpISMNewValidatorIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWarpISMNewValidatorIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWarpISMNewValidatorIterator {
+	mock := &MockWarpISMNewValidatorIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
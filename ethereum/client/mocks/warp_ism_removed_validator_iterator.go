@@ -0,0 +1,218 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	autogen "github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWarpISMRemovedValidatorIterator is an autogenerated mock type for the WarpISMRemovedValidatorIterator type
+type MockWarpISMRemovedValidatorIterator struct {
+	mock.Mock
+}
+
+type MockWarpISMRemovedValidatorIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWarpISMRemovedValidatorIterator) EXPECT() *MockWarpISMRemovedValidatorIterator_Expecter {
+	return &MockWarpISMRemovedValidatorIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockWarpISMRemovedValidatorIterator) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWarpISMRemovedValidatorIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockWarpISMRemovedValidatorIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockWarpISMRemovedValidatorIterator_Expecter) Close() *MockWarpISMRemovedValidatorIterator_Close_Call {
+	return &MockWarpISMRemovedValidatorIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Close_Call) Run(run func()) *MockWarpISMRemovedValidatorIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Close_Call) Return(_a0 error) *MockWarpISMRemovedValidatorIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Close_Call) RunAndReturn(run func() error) *MockWarpISMRemovedValidatorIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockWarpISMRemovedValidatorIterator) Error() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWarpISMRemovedValidatorIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockWarpISMRemovedValidatorIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockWarpISMRemovedValidatorIterator_Expecter) Error() *MockWarpISMRemovedValidatorIterator_Error_Call {
+	return &MockWarpISMRemovedValidatorIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Error_Call) Run(run func()) *MockWarpISMRemovedValidatorIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Error_Call) Return(_a0 error) *MockWarpISMRemovedValidatorIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Error_Call) RunAndReturn(run func() error) *MockWarpISMRemovedValidatorIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockWarpISMRemovedValidatorIterator) Event() *autogen.WarpISMRemovedValidator {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Event")
+	}
+
+	var r0 *autogen.WarpISMRemovedValidator
+	if rf, ok := ret.Get(0).(func() *autogen.WarpISMRemovedValidator); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.WarpISMRemovedValidator)
+		}
+	}
+
+	return r0
+}
+
+// MockWarpISMRemovedValidatorIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockWarpISMRemovedValidatorIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockWarpISMRemovedValidatorIterator_Expecter) Event() *MockWarpISMRemovedValidatorIterator_Event_Call {
+	return &MockWarpISMRemovedValidatorIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Event_Call) Run(run func()) *MockWarpISMRemovedValidatorIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Event_Call) Return(_a0 *autogen.WarpISMRemovedValidator) *MockWarpISMRemovedValidatorIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Event_Call) RunAndReturn(run func() *autogen.WarpISMRemovedValidator) *MockWarpISMRemovedValidatorIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockWarpISMRemovedValidatorIterator) Next() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockWarpISMRemovedValidatorIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockWarpISMRemovedValidatorIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockWarpISMRemovedValidatorIterator_Expecter) Next() *MockWarpISMRemovedValidatorIterator_Next_Call {
+	return &MockWarpISMRemovedValidatorIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Next_Call) Run(run func()) *MockWarpISMRemovedValidatorIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Next_Call) Return(_a0 bool) *MockWarpISMRemovedValidatorIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMRemovedValidatorIterator_Next_Call) RunAndReturn(run func() bool) *MockWarpISMRemovedValidatorIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWarpISMRemovedValidatorIterator creates a new instance of MockWarpISMRemovedValidatorIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWarpISMRemovedValidatorIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWarpISMRemovedValidatorIterator {
+	mock := &MockWarpISMRemovedValidatorIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,218 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	autogen "github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockWarpISMSignerThresholdSetIterator is an autogenerated mock type for the WarpISMSignerThresholdSetIterator type
+type MockWarpISMSignerThresholdSetIterator struct {
+	mock.Mock
+}
+
+type MockWarpISMSignerThresholdSetIterator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWarpISMSignerThresholdSetIterator) EXPECT() *MockWarpISMSignerThresholdSetIterator_Expecter {
+	return &MockWarpISMSignerThresholdSetIterator_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with given fields:
+func (_m *MockWarpISMSignerThresholdSetIterator) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWarpISMSignerThresholdSetIterator_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockWarpISMSignerThresholdSetIterator_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockWarpISMSignerThresholdSetIterator_Expecter) Close() *MockWarpISMSignerThresholdSetIterator_Close_Call {
+	return &MockWarpISMSignerThresholdSetIterator_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Close_Call) Run(run func()) *MockWarpISMSignerThresholdSetIterator_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Close_Call) Return(_a0 error) *MockWarpISMSignerThresholdSetIterator_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Close_Call) RunAndReturn(run func() error) *MockWarpISMSignerThresholdSetIterator_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Error provides a mock function with given fields:
+func (_m *MockWarpISMSignerThresholdSetIterator) Error() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Error")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockWarpISMSignerThresholdSetIterator_Error_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Error'
+type MockWarpISMSignerThresholdSetIterator_Error_Call struct {
+	*mock.Call
+}
+
+// Error is a helper method to define mock.On call
+func (_e *MockWarpISMSignerThresholdSetIterator_Expecter) Error() *MockWarpISMSignerThresholdSetIterator_Error_Call {
+	return &MockWarpISMSignerThresholdSetIterator_Error_Call{Call: _e.mock.On("Error")}
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Error_Call) Run(run func()) *MockWarpISMSignerThresholdSetIterator_Error_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Error_Call) Return(_a0 error) *MockWarpISMSignerThresholdSetIterator_Error_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Error_Call) RunAndReturn(run func() error) *MockWarpISMSignerThresholdSetIterator_Error_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields:
+func (_m *MockWarpISMSignerThresholdSetIterator) Event() *autogen.WarpISMSignerThresholdSet {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Event")
+	}
+
+	var r0 *autogen.WarpISMSignerThresholdSet
+	if rf, ok := ret.Get(0).(func() *autogen.WarpISMSignerThresholdSet); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*autogen.WarpISMSignerThresholdSet)
+		}
+	}
+
+	return r0
+}
+
+// MockWarpISMSignerThresholdSetIterator_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockWarpISMSignerThresholdSetIterator_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+func (_e *MockWarpISMSignerThresholdSetIterator_Expecter) Event() *MockWarpISMSignerThresholdSetIterator_Event_Call {
+	return &MockWarpISMSignerThresholdSetIterator_Event_Call{Call: _e.mock.On("Event")}
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Event_Call) Run(run func()) *MockWarpISMSignerThresholdSetIterator_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Event_Call) Return(_a0 *autogen.WarpISMSignerThresholdSet) *MockWarpISMSignerThresholdSetIterator_Event_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Event_Call) RunAndReturn(run func() *autogen.WarpISMSignerThresholdSet) *MockWarpISMSignerThresholdSetIterator_Event_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Next provides a mock function with given fields:
+func (_m *MockWarpISMSignerThresholdSetIterator) Next() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Next")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockWarpISMSignerThresholdSetIterator_Next_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Next'
+type MockWarpISMSignerThresholdSetIterator_Next_Call struct {
+	*mock.Call
+}
+
+// Next is a helper method to define mock.On call
+func (_e *MockWarpISMSignerThresholdSetIterator_Expecter) Next() *MockWarpISMSignerThresholdSetIterator_Next_Call {
+	return &MockWarpISMSignerThresholdSetIterator_Next_Call{Call: _e.mock.On("Next")}
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Next_Call) Run(run func()) *MockWarpISMSignerThresholdSetIterator_Next_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Next_Call) Return(_a0 bool) *MockWarpISMSignerThresholdSetIterator_Next_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockWarpISMSignerThresholdSetIterator_Next_Call) RunAndReturn(run func() bool) *MockWarpISMSignerThresholdSetIterator_Next_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWarpISMSignerThresholdSetIterator creates a new instance of MockWarpISMSignerThresholdSetIterator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWarpISMSignerThresholdSetIterator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWarpISMSignerThresholdSetIterator {
+	mock := &MockWarpISMSignerThresholdSetIterator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
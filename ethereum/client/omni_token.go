@@ -0,0 +1,58 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+)
+
+type OmniTokenContract interface {
+	Address() common.Address
+	Paused(opts *bind.CallOpts) (bool, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (OmniTokenTransferIterator, error)
+}
+
+type OmniTokenTransferIterator interface {
+	Next() bool
+	Event() *autogen.OmniTokenTransfer
+	Close() error
+	Error() error
+}
+
+type omniTokenTransferIterator struct {
+	*autogen.OmniTokenTransferIterator
+}
+
+func (x *omniTokenTransferIterator) Event() *autogen.OmniTokenTransfer {
+	return x.OmniTokenTransferIterator.Event
+}
+
+type omniTokenContract struct {
+	*autogen.OmniToken
+	address common.Address
+}
+
+func (x *omniTokenContract) Address() common.Address {
+	return x.address
+}
+
+func (x *omniTokenContract) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (OmniTokenTransferIterator, error) {
+	iterator, err := x.OmniToken.FilterTransfer(opts, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &omniTokenTransferIterator{iterator}, nil
+}
+
+func NewOmniTokenContract(address common.Address, client bind.ContractBackend) (OmniTokenContract, error) {
+	contract, err := autogen.NewOmniToken(address, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &omniTokenContract{OmniToken: contract, address: address}, nil
+}
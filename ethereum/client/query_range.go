@@ -0,0 +1,136 @@
+package client
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// QueryRangeRecoverySuccesses is the number of successful log queries over the reduced
+// block range after which the range is doubled again, up to the configured range
+const QueryRangeRecoverySuccesses = 20
+
+// queryRangeErrors are fragments of the errors returned by rpc providers
+// when a log query covers too many blocks or matches too many results
+var queryRangeErrors = []string{
+	"query returned more than",   // geth, infura
+	"log response size exceeded", // alchemy
+	"range too large",
+	"range is too large",
+	"block range is too wide", // ankr
+	"exceed maximum block range",
+	"eth_getlogs is limited to", // quicknode
+	"too many blocks requested",
+}
+
+// rateLimitErrors are fragments of the errors returned by rpc providers when too many requests were made
+var rateLimitErrors = []string{
+	"429",
+	"too many requests",
+	"rate limit",
+	"request limit",
+	"exceeded the quota",
+}
+
+func containsAny(err error, fragments []string) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range fragments {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsQueryRangeError reports whether err was caused by the range of a log query being too large
+func IsQueryRangeError(err error) bool {
+	return containsAny(err, queryRangeErrors)
+}
+
+// IsRateLimitError reports whether err was caused by the endpoint rate limiting requests,
+// in which case the query is retried on the next run instead of being split
+func IsRateLimitError(err error) bool {
+	return containsAny(err, rateLimitErrors)
+}
+
+// queryRange tracks the largest block range of log queries accepted by an endpoint.
+// It shrinks when a range is rejected and grows back towards the configured range
+// after enough queries over the reduced range succeed
+type queryRange struct {
+	mu        sync.Mutex
+	max       uint64
+	blocks    uint64
+	successes int
+
+	logger *log.Entry
+}
+
+func newQueryRange(maxBlocks uint64, logger *log.Entry) *queryRange {
+	if maxBlocks == 0 {
+		maxBlocks = MaxQueryBlocks
+	}
+	return &queryRange{
+		max:    maxBlocks,
+		blocks: maxBlocks,
+		logger: logger,
+	}
+}
+
+func (q *queryRange) Blocks() uint64 {
+	if q == nil {
+		return MaxQueryBlocks
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.blocks
+}
+
+func (q *queryRange) Reduce(blocks uint64) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if blocks == 0 || blocks >= q.blocks {
+		return
+	}
+	q.logger.Infof("Reducing max query blocks from %d to %d", q.blocks, blocks)
+	q.blocks = blocks
+	q.successes = 0
+}
+
+// Succeeded records a successful log query over blocks blocks.
+// Only queries over the full reduced range count towards growing it again
+func (q *queryRange) Succeeded(blocks uint64) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.blocks >= q.max || blocks < q.blocks {
+		return
+	}
+	q.successes++
+	if q.successes < QueryRangeRecoverySuccesses {
+		return
+	}
+	restored := min(q.blocks*2, q.max)
+	q.logger.Infof("Increasing max query blocks from %d to %d", q.blocks, restored)
+	q.blocks = restored
+	q.successes = 0
+}
+
+// queryBlocks returns the number of blocks covered by a log query, or zero if its range is open
+func queryBlocks(query ethereum.FilterQuery) uint64 {
+	if query.FromBlock == nil || query.ToBlock == nil || query.ToBlock.Sign() < 0 || query.ToBlock.Cmp(query.FromBlock) < 0 {
+		return 0
+	}
+	return new(big.Int).Sub(query.ToBlock, query.FromBlock).Uint64() + 1
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsQueryRangeError(t *testing.T) {
+	assert.False(t, IsQueryRangeError(nil))
+	assert.False(t, IsQueryRangeError(fmt.Errorf("connection refused")))
+	assert.True(t, IsQueryRangeError(fmt.Errorf("query returned more than 10000 results")))
+	assert.True(t, IsQueryRangeError(fmt.Errorf("eth_getLogs block range is too large, max is 2000")))
+	assert.True(t, IsQueryRangeError(fmt.Errorf("Log response size exceeded")))
+
+	// rate limits and timeouts are not fixed by querying fewer blocks
+	assert.False(t, IsQueryRangeError(fmt.Errorf("daily request limit exceeded")))
+	assert.False(t, IsQueryRangeError(fmt.Errorf("query timeout exceeded")))
+	assert.False(t, IsQueryRangeError(fmt.Errorf("429 Too Many Requests: compute units per second limit exceeded for block range")))
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	assert.False(t, IsRateLimitError(nil))
+	assert.False(t, IsRateLimitError(fmt.Errorf("query returned more than 10000 results")))
+	assert.True(t, IsRateLimitError(fmt.Errorf("429 Too Many Requests")))
+	assert.True(t, IsRateLimitError(fmt.Errorf("daily request limit exceeded")))
+	assert.True(t, IsRateLimitError(fmt.Errorf("project ID exceeded the quota")))
+}
+
+func TestQueryRange_Reduce(t *testing.T) {
+	q := newQueryRange(0, log.NewEntry(log.New()))
+	assert.Equal(t, MaxQueryBlocks, q.Blocks())
+
+	q.Reduce(0)
+	assert.Equal(t, MaxQueryBlocks, q.Blocks())
+
+	q.Reduce(MaxQueryBlocks + 1)
+	assert.Equal(t, MaxQueryBlocks, q.Blocks())
+
+	q.Reduce(1000)
+	assert.Equal(t, uint64(1000), q.Blocks())
+}
+
+func TestQueryRange_Succeeded(t *testing.T) {
+	q := newQueryRange(3000, log.NewEntry(log.New()))
+	q.Reduce(1000)
+
+	// queries over fewer blocks than the range do not show that it can grow
+	for i := 0; i < QueryRangeRecoverySuccesses; i++ {
+		q.Succeeded(10)
+	}
+	assert.Equal(t, uint64(1000), q.Blocks())
+
+	for i := 0; i < QueryRangeRecoverySuccesses; i++ {
+		q.Succeeded(1000)
+	}
+	assert.Equal(t, uint64(2000), q.Blocks())
+
+	for i := 0; i < QueryRangeRecoverySuccesses; i++ {
+		q.Succeeded(2000)
+	}
+	assert.Equal(t, uint64(3000), q.Blocks())
+
+	q.Succeeded(3000)
+	assert.Equal(t, uint64(3000), q.Blocks())
+}
+
+func TestQueryRange_Reduce_ResetsSuccesses(t *testing.T) {
+	q := newQueryRange(2000, log.NewEntry(log.New()))
+	q.Reduce(1000)
+	for i := 0; i < QueryRangeRecoverySuccesses-1; i++ {
+		q.Succeeded(1000)
+	}
+	q.Reduce(500)
+	q.Succeeded(500)
+	assert.Equal(t, uint64(500), q.Blocks())
+}
+
+func TestQueryRange_Concurrent(t *testing.T) {
+	q := newQueryRange(1000, log.NewEntry(log.New()))
+	var wg sync.WaitGroup
+	for i := 1; i <= 100; i++ {
+		wg.Add(1)
+		go func(blocks uint64) {
+			defer wg.Done()
+			q.Reduce(blocks)
+			q.Succeeded(blocks)
+		}(uint64(i * 5))
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, q.Blocks(), uint64(1000))
+}
+
+func TestQueryRange_Nil(t *testing.T) {
+	var q *queryRange
+	assert.Equal(t, MaxQueryBlocks, q.Blocks())
+	q.Reduce(10)
+	q.Succeeded(10)
+}
+
+func TestQueryBlocks(t *testing.T) {
+	assert.Equal(t, uint64(0), queryBlocks(ethereum.FilterQuery{}))
+	assert.Equal(t, uint64(0), queryBlocks(ethereum.FilterQuery{FromBlock: big.NewInt(10)}))
+	assert.Equal(t, uint64(0), queryBlocks(ethereum.FilterQuery{FromBlock: big.NewInt(10), ToBlock: big.NewInt(5)}))
+	assert.Equal(t, uint64(1), queryBlocks(ethereum.FilterQuery{FromBlock: big.NewInt(10), ToBlock: big.NewInt(10)}))
+	assert.Equal(t, uint64(100), queryBlocks(ethereum.FilterQuery{FromBlock: big.NewInt(1), ToBlock: big.NewInt(100)}))
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ReceiptCache holds, per chain domain, the receipts prefetched with batch requests
+// and the block heights read for the transactions processed in a run.
+// A nil cache reads everything from the client.
+type ReceiptCache struct {
+	receipts     map[uint32]map[common.Hash]*types.Receipt
+	blockHeights map[uint32]BlockHeights
+}
+
+func NewReceiptCache() *ReceiptCache {
+	return &ReceiptCache{
+		receipts:     make(map[uint32]map[common.Hash]*types.Receipt),
+		blockHeights: make(map[uint32]BlockHeights),
+	}
+}
+
+// SetReceipts replaces the receipts cached for the chain
+func (c *ReceiptCache) SetReceipts(chainDomain uint32, receipts map[common.Hash]*types.Receipt) {
+	if c == nil {
+		return
+	}
+	c.receipts[chainDomain] = receipts
+}
+
+// GetTransactionReceipt returns the cached receipt, falling back to fetching it from the client of the chain
+func (c *ReceiptCache) GetTransactionReceipt(chainDomain uint32, client EthereumClient, txHash string) (*types.Receipt, error) {
+	if c != nil {
+		if receipt, ok := c.receipts[chainDomain][common.HexToHash(txHash)]; ok {
+			return receipt, nil
+		}
+	}
+	return client.GetTransactionReceipt(txHash)
+}
+
+// GetBlockHeights returns the cached block heights of the chain, reading them from its client the first time
+func (c *ReceiptCache) GetBlockHeights(chainDomain uint32, client EthereumClient) (BlockHeights, error) {
+	if c != nil {
+		if heights, ok := c.blockHeights[chainDomain]; ok {
+			return heights, nil
+		}
+	}
+	heights, err := GetBlockHeights(client)
+	if err != nil {
+		return heights, err
+	}
+	if c != nil {
+		c.blockHeights[chainDomain] = heights
+	}
+	return heights, nil
+}
+
+// PrefetchMessageReceipts fetches the receipts of the ethereum origin transactions of messages
+// with a batch request per origin chain. Chains without a client are skipped.
+func (c *ReceiptCache) PrefetchMessageReceipts(clients map[uint32]EthereumClient, messages []models.Message, logger *log.Entry) {
+	if c == nil {
+		return
+	}
+
+	txHashes := make(map[uint32][]string)
+	seen := make(map[uint32]map[string]bool)
+	for _, messageDoc := range messages {
+		chainDomain := messageDoc.Content.OriginDomain
+		if _, ok := clients[chainDomain]; !ok {
+			continue
+		}
+		if seen[chainDomain] == nil {
+			seen[chainDomain] = make(map[string]bool)
+		}
+		if seen[chainDomain][messageDoc.OriginTransactionHash] {
+			continue
+		}
+		seen[chainDomain][messageDoc.OriginTransactionHash] = true
+		txHashes[chainDomain] = append(txHashes[chainDomain], messageDoc.OriginTransactionHash)
+	}
+
+	c.receipts = make(map[uint32]map[common.Hash]*types.Receipt)
+	for chainDomain, hashes := range txHashes {
+		receipts, err := clients[chainDomain].GetTransactionReceipts(hashes)
+		if err != nil {
+			logger.WithError(err).WithField("chain_domain", chainDomain).Warn("Error prefetching transaction receipts")
+			continue
+		}
+		c.receipts[chainDomain] = receipts
+	}
+}
+
+// ProcessInBatches calls prefetch for each batch of up to MaxBatchSize items
+// and then process for each item of the batch
+func ProcessInBatches[T any](items []T, prefetch func(batch []T), process func(item *T) bool) bool {
+	success := true
+	for start := 0; start < len(items); start += MaxBatchSize {
+		batch := items[start:min(start+MaxBatchSize, len(items))]
+		prefetch(batch)
+		for i := range batch {
+			success = process(&batch[i]) && success
+		}
+	}
+	return success
+}
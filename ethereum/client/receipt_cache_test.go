@@ -0,0 +1,169 @@
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/dan13ram/wpokt-oracle/ethereum/client/client_mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	log "github.com/sirupsen/logrus"
+)
+
+func newTestEthereumClient(t *testing.T) (*ethereumClient, *mocks.MockEthHTTPClient) {
+	mockClient := mocks.NewMockEthHTTPClient(t)
+	return &ethereumClient{
+		client:  mockClient,
+		timeout: 5 * time.Second,
+		logger:  log.NewEntry(log.New()),
+	}, mockClient
+}
+
+func TestReceiptCache_GetBlockHeights(t *testing.T) {
+	ethClient, mockClient := newTestEthereumClient(t)
+	ethClient.finalityTag = models.FinalityTagFinalized
+	cache := NewReceiptCache()
+
+	// the heights are read once per chain for the run
+	mockClient.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Once()
+	mockClient.On("HeaderByNumber", mock.Anything, big.NewInt(rpc.FinalizedBlockNumber.Int64())).Return(&types.Header{Number: big.NewInt(90)}, nil).Once()
+
+	heights, err := cache.GetBlockHeights(1, ethClient)
+	assert.NoError(t, err)
+	assert.Equal(t, BlockHeights{Current: 100, Finalized: 90}, heights)
+
+	heights, err = cache.GetBlockHeights(1, ethClient)
+	assert.NoError(t, err)
+	assert.Equal(t, BlockHeights{Current: 100, Finalized: 90}, heights)
+}
+
+func TestReceiptCache_GetBlockHeights_Error(t *testing.T) {
+	ethClient, mockClient := newTestEthereumClient(t)
+	cache := NewReceiptCache()
+
+	mockClient.On("BlockNumber", mock.Anything).Return(uint64(0), fmt.Errorf("error")).Once()
+
+	_, err := cache.GetBlockHeights(1, ethClient)
+	assert.Error(t, err)
+
+	// a failed read is not cached
+	mockClient.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Once()
+
+	heights, err := cache.GetBlockHeights(1, ethClient)
+	assert.NoError(t, err)
+	assert.Equal(t, BlockHeights{Current: 100}, heights)
+}
+
+func TestReceiptCache_Nil(t *testing.T) {
+	ethClient, mockClient := newTestEthereumClient(t)
+	var cache *ReceiptCache
+
+	receipt := &types.Receipt{TxHash: common.HexToHash("0x01")}
+	mockClient.On("TransactionReceipt", mock.Anything, receipt.TxHash).Return(receipt, nil).Twice()
+	mockClient.On("BlockNumber", mock.Anything).Return(uint64(100), nil).Twice()
+
+	cache.SetReceipts(1, map[common.Hash]*types.Receipt{receipt.TxHash: receipt})
+	cache.PrefetchMessageReceipts(map[uint32]EthereumClient{1: ethClient}, []models.Message{{OriginTransactionHash: "0x01"}}, log.NewEntry(log.New()))
+
+	// every call reads from the client
+	for i := 0; i < 2; i++ {
+		gotReceipt, err := cache.GetTransactionReceipt(1, ethClient, "0x01")
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, gotReceipt)
+
+		_, err = cache.GetBlockHeights(1, ethClient)
+		assert.NoError(t, err)
+	}
+}
+
+func TestReceiptCache_SetReceipts(t *testing.T) {
+	ethClient, mockClient := newTestEthereumClient(t)
+	cache := NewReceiptCache()
+
+	receipt := &types.Receipt{TxHash: common.HexToHash("0x01")}
+	cache.SetReceipts(1, map[common.Hash]*types.Receipt{receipt.TxHash: receipt})
+
+	gotReceipt, err := cache.GetTransactionReceipt(1, ethClient, "0x01")
+	assert.NoError(t, err)
+	assert.Equal(t, receipt, gotReceipt)
+
+	// receipts are kept per chain
+	mockClient.On("TransactionReceipt", mock.Anything, receipt.TxHash).Return(nil, fmt.Errorf("error")).Once()
+
+	gotReceipt, err = cache.GetTransactionReceipt(2, ethClient, "0x01")
+	assert.Error(t, err)
+	assert.Nil(t, gotReceipt)
+}
+
+func TestReceiptCache_PrefetchMessageReceipts(t *testing.T) {
+	ethClientOne, mockClientOne := newTestEthereumClient(t)
+	ethClientTwo, mockClientTwo := newTestEthereumClient(t)
+	cache := NewReceiptCache()
+
+	messages := []models.Message{
+		{OriginTransactionHash: "0x01", Content: models.MessageContent{OriginDomain: 1}},
+		{OriginTransactionHash: "0x01", Content: models.MessageContent{OriginDomain: 1}},
+		{OriginTransactionHash: "0x02", Content: models.MessageContent{OriginDomain: 2}},
+		{OriginTransactionHash: "0x03", Content: models.MessageContent{OriginDomain: 500}},
+	}
+
+	receipt := &types.Receipt{
+		Type:        types.LegacyTxType,
+		Status:      types.ReceiptStatusSuccessful,
+		TxHash:      common.HexToHash("0x01"),
+		BlockNumber: big.NewInt(10),
+		Logs:        []*types.Log{},
+	}
+
+	// duplicate hashes are fetched once
+	mockClientOne.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(elems []rpc.BatchElem) bool {
+		return len(elems) == 1 && elems[0].Args[0] == common.HexToHash("0x01")
+	})).Run(batchResponse(t, []string{marshalJSON(t, receipt)}, nil)).Return(nil).Once()
+	mockClientTwo.On("BatchCallContext", mock.Anything, mock.Anything).Return(fmt.Errorf("error")).Once()
+
+	cache.PrefetchMessageReceipts(map[uint32]EthereumClient{1: ethClientOne, 2: ethClientTwo}, messages, log.NewEntry(log.New()))
+
+	gotReceipt, err := cache.GetTransactionReceipt(1, ethClientOne, "0x01")
+	assert.NoError(t, err)
+	assert.Equal(t, receipt.TxHash, gotReceipt.TxHash)
+
+	// the receipt falls back to a single lookup since prefetching it failed
+	mockClientTwo.On("TransactionReceipt", mock.Anything, common.HexToHash("0x02")).Return(nil, fmt.Errorf("error")).Once()
+
+	gotReceipt, err = cache.GetTransactionReceipt(2, ethClientTwo, "0x02")
+	assert.Error(t, err)
+	assert.Nil(t, gotReceipt)
+}
+
+func TestProcessInBatches(t *testing.T) {
+	items := make([]string, MaxBatchSize+1)
+	for i := range items {
+		items[i] = fmt.Sprintf("0x%x", i+1)
+	}
+
+	batchSizes := []int{}
+	processed := 0
+	result := ProcessInBatches(items, func(batch []string) {
+		batchSizes = append(batchSizes, len(batch))
+	}, func(item *string) bool {
+		processed++
+		return *item != items[1]
+	})
+
+	assert.False(t, result)
+	assert.Equal(t, len(items), processed)
+	assert.Equal(t, []int{MaxBatchSize, 1}, batchSizes)
+
+	assert.True(t, ProcessInBatches([]string{}, func(batch []string) {
+		t.Fatal("no batch to prefetch")
+	}, func(item *string) bool {
+		return false
+	}))
+}
@@ -11,9 +11,60 @@ import (
 )
 
 type WarpISMContract interface {
+	Address() common.Address
 	ValidatorCount(opts *bind.CallOpts) (*big.Int, error)
 	SignerThreshold(opts *bind.CallOpts) (*big.Int, error)
+	Validators(opts *bind.CallOpts, validator common.Address) (bool, error)
 	Eip712Domain(opts *bind.CallOpts) (util.DomainData, error)
+	Verify(opts *bind.CallOpts, metadata []byte, message []byte) (bool, error)
+	FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (WarpISMNewValidatorIterator, error)
+	FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (WarpISMRemovedValidatorIterator, error)
+	FilterSignerThresholdSet(opts *bind.FilterOpts, ratio []*big.Int) (WarpISMSignerThresholdSetIterator, error)
+}
+
+type WarpISMNewValidatorIterator interface {
+	Next() bool
+	Event() *autogen.WarpISMNewValidator
+	Close() error
+	Error() error
+}
+
+type warpISMNewValidatorIterator struct {
+	*autogen.WarpISMNewValidatorIterator
+}
+
+func (x *warpISMNewValidatorIterator) Event() *autogen.WarpISMNewValidator {
+	return x.WarpISMNewValidatorIterator.Event
+}
+
+type WarpISMRemovedValidatorIterator interface {
+	Next() bool
+	Event() *autogen.WarpISMRemovedValidator
+	Close() error
+	Error() error
+}
+
+type warpISMRemovedValidatorIterator struct {
+	*autogen.WarpISMRemovedValidatorIterator
+}
+
+func (x *warpISMRemovedValidatorIterator) Event() *autogen.WarpISMRemovedValidator {
+	return x.WarpISMRemovedValidatorIterator.Event
+}
+
+type WarpISMSignerThresholdSetIterator interface {
+	Next() bool
+	Event() *autogen.WarpISMSignerThresholdSet
+	Close() error
+	Error() error
+}
+
+type warpISMSignerThresholdSetIterator struct {
+	*autogen.WarpISMSignerThresholdSetIterator
+}
+
+func (x *warpISMSignerThresholdSetIterator) Event() *autogen.WarpISMSignerThresholdSet {
+	return x.WarpISMSignerThresholdSetIterator.Event
 }
 
 type warpISMContract struct {
@@ -29,6 +80,30 @@ func (x *warpISMContract) Eip712Domain(opts *bind.CallOpts) (util.DomainData, er
 	return x.WarpISM.Eip712Domain(opts)
 }
 
+func (x *warpISMContract) FilterNewValidator(opts *bind.FilterOpts, validator []common.Address) (WarpISMNewValidatorIterator, error) {
+	iterator, err := x.WarpISM.FilterNewValidator(opts, validator)
+	if err != nil {
+		return nil, err
+	}
+	return &warpISMNewValidatorIterator{iterator}, nil
+}
+
+func (x *warpISMContract) FilterRemovedValidator(opts *bind.FilterOpts, validator []common.Address) (WarpISMRemovedValidatorIterator, error) {
+	iterator, err := x.WarpISM.FilterRemovedValidator(opts, validator)
+	if err != nil {
+		return nil, err
+	}
+	return &warpISMRemovedValidatorIterator{iterator}, nil
+}
+
+func (x *warpISMContract) FilterSignerThresholdSet(opts *bind.FilterOpts, ratio []*big.Int) (WarpISMSignerThresholdSetIterator, error) {
+	iterator, err := x.WarpISM.FilterSignerThresholdSet(opts, ratio)
+	if err != nil {
+		return nil, err
+	}
+	return &warpISMSignerThresholdSetIterator{iterator}, nil
+}
+
 func NewWarpISMContract(address common.Address, client bind.ContractBackend) (WarpISMContract, error) {
 	contract, err := autogen.NewWarpISM(address, client)
 	if err != nil {
@@ -0,0 +1,724 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/ethereum/util"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// FulfillmentSpendingWindow is the period over which the gas fees of the fulfiller are capped
+const FulfillmentSpendingWindow = 24 * time.Hour
+
+// FulfillmentTakeOverTimeouts is the number of stuck timeouts after which a fulfillment sent by another oracle
+// that was neither mined nor replaced is taken over, since the fulfiller of that oracle may be down
+const FulfillmentTakeOverTimeouts = 3
+
+// EthMessageFulfillerRunnable submits fulfillOrder for the signed messages to the chain
+// from a funded account, replacing the transactions that are not mined in time
+type EthMessageFulfillerRunnable struct {
+	timeout time.Duration
+
+	currentBlockHeight uint64
+
+	mintController    eth.MintControllerContract
+	mintControllerABI *abi.ABI
+	omniToken         eth.OmniTokenContract
+	client            eth.EthereumClient
+
+	chain models.Chain
+
+	// from is the fulfiller account and address its lowercase hex, as stored on the fulfillments
+	from    ethcommon.Address
+	address string
+	signer  bind.SignerFn
+
+	nonces *NonceManager
+
+	// spendingCap limits the gas fees of the fulfillments submitted within FulfillmentSpendingWindow
+	spendingCap *big.Int
+	spent       *big.Int
+
+	stuckTimeout   time.Duration
+	gasBumpPercent uint64
+
+	logger *log.Entry
+
+	db db.DB
+}
+
+func (x *EthMessageFulfillerRunnable) Run() {
+	x.UpdateCurrentBlockHeight()
+	if !x.UpdateSpending() {
+		return
+	}
+	// new transactions are only sent once the nonces of the ones in flight are known
+	if !x.TrackFulfillments() {
+		return
+	}
+	// fulfillments revert while the omni token is paused, the signers hold the messages until it is unpaused
+	if paused, ok := x.DestinationPaused(); !ok || paused {
+		return
+	}
+	x.FulfillMessages()
+}
+
+func (x *EthMessageFulfillerRunnable) Height() uint64 {
+	return uint64(x.currentBlockHeight)
+}
+
+// DestinationPaused returns whether the omni token is paused, and false for ok if it could not be checked
+func (x *EthMessageFulfillerRunnable) DestinationPaused() (paused bool, ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx, Pending: false}
+	paused, err := x.omniToken.Paused(opts)
+	if err != nil {
+		x.logger.WithError(err).Error("Error fetching omni token paused state")
+		return false, false
+	}
+	if paused {
+		x.logger.Warn("Omni token is paused, not fulfilling messages")
+	}
+	return paused, true
+}
+
+func (x *EthMessageFulfillerRunnable) UpdateCurrentBlockHeight() {
+	res, err := x.client.GetBlockHeight()
+	if err != nil {
+		x.logger.
+			WithError(err).
+			Error("could not get current block height")
+		return
+	}
+	x.currentBlockHeight = res
+	x.logger.
+		WithField("current_block_height", x.currentBlockHeight).
+		Info("updated current block height")
+}
+
+// FulfillmentCost returns the fee paid for a mined fulfillment,
+// or the most its latest transaction can cost otherwise
+func FulfillmentCost(fulfillment models.Fulfillment) *big.Int {
+	if feePaid, ok := new(big.Int).SetString(fulfillment.FeePaid, 10); ok {
+		return feePaid
+	}
+	gasFeeCap, ok := new(big.Int).SetString(fulfillment.GasFeeCap, 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return gasFeeCap.Mul(gasFeeCap, new(big.Int).SetUint64(fulfillment.GasLimit))
+}
+
+// UpdateSpending adds up the cost of the fulfillments this fulfiller submitted within the spending window
+func (x *EthMessageFulfillerRunnable) UpdateSpending() bool {
+	since := time.Now().Add(-FulfillmentSpendingWindow)
+	messages, err := x.db.GetFulfillments(x.chain, x.address, since)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting fulfillments")
+		return false
+	}
+
+	spent := big.NewInt(0)
+	for i := range messages {
+		for j, fulfillment := range messages[i].Fulfillments {
+			if fulfillment.Submitter != x.address || fulfillment.SubmittedAt.Before(since) {
+				continue
+			}
+			if fulfillment.FeePaid == "" && messages[i].Status == models.MessageStatusSuccess {
+				// the relayer confirmed the fulfillment before this fulfiller saw its receipt
+				if feePaid := x.RecordSettledFee(&messages[i], j); feePaid != nil {
+					fulfillment.FeePaid = feePaid.String()
+				}
+			}
+			spent.Add(spent, FulfillmentCost(fulfillment))
+		}
+	}
+	x.spent = spent
+
+	x.logger.
+		WithField("spent", x.spent.String()).
+		WithField("spending_cap", x.spendingCap.String()).
+		Debug("Updated fulfillment spending")
+	return true
+}
+
+// CanSpend returns whether amount more in gas fees stays within the spending cap
+func (x *EthMessageFulfillerRunnable) CanSpend(amount *big.Int) bool {
+	return new(big.Int).Add(x.spent, amount).Cmp(x.spendingCap) <= 0
+}
+
+// EstimateFees returns the tip and fee caps for a new transaction,
+// leaving room for the base fee to double before it is mined
+func (x *EthMessageFulfillerRunnable) EstimateFees() (gasTipCap *big.Int, gasFeeCap *big.Int, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+
+	gasTipCap, err = x.client.GetClient().SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error suggesting gas tip cap: %w", err)
+	}
+
+	header, err := x.client.GetClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting latest header: %w", err)
+	}
+	if header.BaseFee == nil {
+		return nil, nil, fmt.Errorf("network does not support dynamic fee transactions")
+	}
+
+	gasFeeCap = new(big.Int).Add(gasTipCap, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+	return gasTipCap, gasFeeCap, nil
+}
+
+// BumpFee raises fee by the gas bump percentage, rounding up
+func (x *EthMessageFulfillerRunnable) BumpFee(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+x.gasBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// BuildFulfillment signs a fulfillOrder transaction for the message without sending it,
+// estimating the gas limit when it is 0, which fails if the call would revert
+func (x *EthMessageFulfillerRunnable) BuildFulfillment(
+	messageDoc *models.Message,
+	nonce uint64,
+	gasLimit uint64,
+	gasTipCap *big.Int,
+	gasFeeCap *big.Int,
+) (*types.Transaction, error) {
+	metadata, err := utilEncodeMetadata(messageDoc.Signatures)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding metadata: %w", err)
+	}
+	message, err := messageDoc.Content.EncodeToBytes()
+	if err != nil {
+		return nil, fmt.Errorf("error encoding message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+
+	opts := &bind.TransactOpts{
+		From:      x.from,
+		Signer:    x.signer,
+		Nonce:     new(big.Int).SetUint64(nonce),
+		GasLimit:  gasLimit,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Context:   ctx,
+		NoSend:    true,
+	}
+
+	tx, err := x.mintController.FulfillOrder(opts, metadata, message)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", util.RevertReason(err, x.mintControllerABI), err)
+	}
+	return tx, nil
+}
+
+func (x *EthMessageFulfillerRunnable) SendTransaction(tx *types.Transaction) error {
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	return x.client.GetClient().SendTransaction(ctx, tx)
+}
+
+// FulfillMessage submits fulfillOrder for a signed message. The message is locked and read again
+// so that only one oracle submits each order
+func (x *EthMessageFulfillerRunnable) FulfillMessage(messageDoc *models.Message) bool {
+	logger := x.logger.WithField("message_id", messageDoc.MessageID).WithField("section", "FulfillMessage")
+
+	if lockID, err := x.db.LockWriteMessage(messageDoc); err != nil {
+		logger.WithError(err).Error("Error locking message")
+		return false
+	} else {
+		//nolint:errcheck
+		defer x.db.Unlock(lockID)
+	}
+
+	current, err := x.db.FindMessage(bson.M{"_id": messageDoc.ID})
+	if err != nil {
+		logger.WithError(err).Error("Error finding message")
+		return false
+	}
+	if current.Status != models.MessageStatusSigned {
+		logger.WithField("status", current.Status).Debug("Message is no longer signed, not fulfilling")
+		return true
+	}
+
+	gasTipCap, gasFeeCap, err := x.EstimateFees()
+	if err != nil {
+		logger.WithError(err).Error("Error estimating fees")
+		return false
+	}
+
+	nonce := x.nonces.Next()
+	tx, err := x.BuildFulfillment(&current, nonce, 0, gasTipCap, gasFeeCap)
+	if err != nil {
+		x.nonces.Release(nonce)
+		logger.WithError(err).Error("Error building fulfillment transaction")
+		return false
+	}
+
+	cost := new(big.Int).Mul(gasFeeCap, new(big.Int).SetUint64(tx.Gas()))
+	if !x.CanSpend(cost) {
+		x.nonces.Release(nonce)
+		logger.
+			WithField("cost", cost.String()).
+			WithField("spent", x.spent.String()).
+			Warn("Fulfillment would exceed the spending cap, not fulfilling")
+		return false
+	}
+
+	now := time.Now()
+	txHash := strings.ToLower(tx.Hash().Hex())
+	fulfillment := models.Fulfillment{
+		Submitter:   x.address,
+		Nonce:       nonce,
+		TxHashes:    []string{txHash},
+		GasLimit:    tx.Gas(),
+		GasFeeCap:   gasFeeCap.String(),
+		GasTipCap:   gasTipCap.String(),
+		SubmittedAt: now,
+		SentAt:      now,
+	}
+
+	// the fulfillment is stored before it is sent, so a sent transaction is always tracked
+	// and its nonce and fees are accounted for after a restart
+	if !x.UpdateMessage(&current, bson.M{
+		"status":           models.MessageStatusBroadcasted,
+		"transaction_hash": txHash,
+		"fulfillments":     append(current.Fulfillments, fulfillment),
+	}) {
+		x.nonces.Release(nonce)
+		return false
+	}
+	x.spent.Add(x.spent, cost)
+
+	if err := x.SendTransaction(tx); err != nil {
+		// the nonce stays reserved, the stored fulfillment is replaced at the same nonce once it is stuck
+		logger.WithError(err).WithField("nonce", nonce).Error("Error sending fulfillment transaction")
+		return false
+	}
+
+	logger.WithField("fulfillment_tx_hash", txHash).WithField("nonce", nonce).Info("Sent fulfillment transaction")
+	return true
+}
+
+func (x *EthMessageFulfillerRunnable) UpdateMessage(messageDoc *models.Message, update bson.M) bool {
+	err := x.db.UpdateMessage(messageDoc.ID, messageDoc.Status, update)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating message")
+		return false
+	}
+	return true
+}
+
+// UpdateBroadcastedMessage updates the message unless it is no longer broadcasted,
+// such as once the relayer has seen its fulfillment
+func (x *EthMessageFulfillerRunnable) UpdateBroadcastedMessage(messageDoc *models.Message, update bson.M) bool {
+	filter := bson.M{
+		"_id":    messageDoc.ID,
+		"status": models.MessageStatusBroadcasted,
+	}
+	err := x.db.UpdateMessages(filter, update)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating message")
+		return false
+	}
+	return true
+}
+
+// FulfillMessages submits the signed messages that the mint limit allows fulfilling,
+// in the order the signers scheduled them
+func (x *EthMessageFulfillerRunnable) FulfillMessages() bool {
+	x.logger.Infof("Fulfilling messages")
+	messages, err := x.db.GetSignedMessages(x.chain)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting signed messages")
+		return false
+	}
+	x.logger.Infof("Found %d signed messages", len(messages))
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		if fulfillableBefore(messages[i].FulfillableAt, messages[j].FulfillableAt) {
+			return true
+		}
+		if fulfillableBefore(messages[j].FulfillableAt, messages[i].FulfillableAt) {
+			return false
+		}
+		return queuedBefore(&messages[i], &messages[j])
+	})
+
+	now := time.Now()
+	success := true
+	for i := range messages {
+		if messages[i].FulfillableAt != nil && messages[i].FulfillableAt.After(now) {
+			continue
+		}
+		success = x.FulfillMessage(&messages[i]) && success
+	}
+	return success
+}
+
+// LatestFulfillment returns the index of the latest fulfillment of the message if this fulfiller submitted it
+func (x *EthMessageFulfillerRunnable) LatestFulfillment(messageDoc *models.Message) (int, bool) {
+	index := len(messageDoc.Fulfillments) - 1
+	if index < 0 || messageDoc.Fulfillments[index].Submitter != x.address {
+		return 0, false
+	}
+	return index, true
+}
+
+// TrackFulfillments follows the transactions this fulfiller sent for the broadcasted messages
+// and syncs the nonces with the ones still in flight
+func (x *EthMessageFulfillerRunnable) TrackFulfillments() bool {
+	messages, err := x.db.GetBroadcastedMessages(x.chain)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting broadcasted messages")
+		return false
+	}
+
+	inFlight := []uint64{}
+	for i := range messages {
+		index, ok := x.LatestFulfillment(&messages[i])
+		if !ok {
+			x.TakeOverFulfillment(&messages[i])
+			continue
+		}
+		if x.TrackFulfillment(&messages[i], index) {
+			inFlight = append(inFlight, messages[i].Fulfillments[index].Nonce)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	pendingNonce, err := x.client.GetClient().PendingNonceAt(ctx, x.from)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting pending nonce")
+		return false
+	}
+	x.nonces.Sync(pendingNonce, inFlight)
+	return true
+}
+
+// TrackFulfillment looks for a receipt of the transactions of a fulfillment, replacing the latest one
+// if none was mined within the stuck timeout, and returns whether the fulfillment is still in flight
+func (x *EthMessageFulfillerRunnable) TrackFulfillment(messageDoc *models.Message, index int) bool {
+	logger := x.logger.WithField("message_id", messageDoc.MessageID).WithField("section", "TrackFulfillment")
+	fulfillment := messageDoc.Fulfillments[index]
+
+	if fulfillment.FeePaid != "" {
+		return false
+	}
+
+	receipt, err := x.FindReceipt(fulfillment)
+	if err != nil {
+		logger.WithError(err).Error("Error getting fulfillment transaction receipt")
+		return true
+	}
+	if receipt != nil {
+		return !x.RecordReceipt(messageDoc, index, receipt)
+	}
+
+	if time.Since(fulfillment.SentAt) < x.stuckTimeout {
+		return true
+	}
+
+	x.ReplaceFulfillment(messageDoc, index)
+	return true
+}
+
+// FindReceipt returns the receipt of the transaction of the fulfillment that was mined, or nil if none was
+func (x *EthMessageFulfillerRunnable) FindReceipt(fulfillment models.Fulfillment) (*types.Receipt, error) {
+	for i := len(fulfillment.TxHashes) - 1; i >= 0; i-- {
+		receipt, err := x.client.GetTransactionReceipt(fulfillment.TxHashes[i])
+		if errors.Is(err, ethereum.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+	}
+	return nil, nil
+}
+
+// FeePaid returns the gas fee paid by the mined transaction of a fulfillment
+func FeePaid(fulfillment models.Fulfillment, receipt *types.Receipt) *big.Int {
+	gasPrice := receipt.EffectiveGasPrice
+	if gasPrice == nil {
+		gasPrice, _ = new(big.Int).SetString(fulfillment.GasFeeCap, 10)
+	}
+	if gasPrice == nil {
+		gasPrice = big.NewInt(0)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), gasPrice)
+}
+
+// RecordReceipt stores the fee paid for a mined fulfillment. If its transaction reverted,
+// the message goes back to being signed so that it can be submitted again
+func (x *EthMessageFulfillerRunnable) RecordReceipt(messageDoc *models.Message, index int, receipt *types.Receipt) bool {
+	logger := x.logger.WithField("message_id", messageDoc.MessageID).WithField("section", "RecordReceipt")
+	txHash := strings.ToLower(receipt.TxHash.Hex())
+	feePaid := FeePaid(messageDoc.Fulfillments[index], receipt)
+
+	if receipt.Status == types.ReceiptStatusSuccessful {
+		logger.WithField("fulfillment_tx_hash", txHash).Info("Fulfillment transaction mined")
+		// the relayer marks the message successful, possibly before the fee is stored
+		if err := x.db.SetFulfillmentFeePaid(messageDoc.ID, index, txHash, feePaid.String()); err != nil {
+			logger.WithError(err).Error("Error storing fulfillment fee")
+			return false
+		}
+		return true
+	}
+
+	logger.WithField("fulfillment_tx_hash", txHash).Warn("Fulfillment transaction reverted")
+	return x.UpdateBroadcastedMessage(messageDoc, bson.M{
+		fmt.Sprintf("fulfillments.%d.fee_paid", index): feePaid.String(),
+		"status":           models.MessageStatusSigned,
+		"transaction_hash": "",
+	})
+}
+
+// RecordSettledFee stores the fee paid for a fulfillment of a message that the relayer marked successful
+// before its receipt was recorded, and returns it, or nil if the receipt could not be found
+func (x *EthMessageFulfillerRunnable) RecordSettledFee(messageDoc *models.Message, index int) *big.Int {
+	logger := x.logger.WithField("message_id", messageDoc.MessageID).WithField("section", "RecordSettledFee")
+
+	receipt, err := x.FindReceipt(messageDoc.Fulfillments[index])
+	if err != nil {
+		logger.WithError(err).Error("Error getting fulfillment transaction receipt")
+		return nil
+	}
+	if receipt == nil {
+		return nil
+	}
+
+	feePaid := FeePaid(messageDoc.Fulfillments[index], receipt)
+	if err := x.db.SetFulfillmentFeePaid(messageDoc.ID, index, strings.ToLower(receipt.TxHash.Hex()), feePaid.String()); err != nil {
+		logger.WithError(err).Error("Error storing fulfillment fee")
+	}
+	return feePaid
+}
+
+// TakeOverFulfillment moves a message broadcasted by another oracle back to signed once its latest fulfillment
+// was neither mined nor replaced for FulfillmentTakeOverTimeouts stuck timeouts, so that this fulfiller submits it.
+// If both fulfillments end up mined, the later one reverts since the order is already fulfilled
+func (x *EthMessageFulfillerRunnable) TakeOverFulfillment(messageDoc *models.Message) bool {
+	logger := x.logger.WithField("message_id", messageDoc.MessageID).WithField("section", "TakeOverFulfillment")
+
+	index := len(messageDoc.Fulfillments) - 1
+	if index < 0 {
+		return false
+	}
+	fulfillment := messageDoc.Fulfillments[index]
+	if time.Since(fulfillment.SentAt) < FulfillmentTakeOverTimeouts*x.stuckTimeout {
+		return false
+	}
+
+	receipt, err := x.FindReceipt(fulfillment)
+	if err != nil {
+		logger.WithError(err).Error("Error getting fulfillment transaction receipt")
+		return false
+	}
+
+	update := bson.M{
+		"status":           models.MessageStatusSigned,
+		"transaction_hash": "",
+	}
+	if receipt != nil {
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			// the relayer marks the message successful once the fulfillment is confirmed
+			return false
+		}
+		update[fmt.Sprintf("fulfillments.%d.fee_paid", index)] = FeePaid(fulfillment, receipt).String()
+	}
+
+	logger.
+		WithField("submitter", fulfillment.Submitter).
+		WithField("sent_at", fulfillment.SentAt).
+		Warn("Taking over the fulfillment of another oracle")
+	return x.UpdateBroadcastedMessage(messageDoc, update)
+}
+
+// ReplaceFulfillment sends a transaction at the same nonce with the fees bumped,
+// or raised to the current estimate if that is higher
+func (x *EthMessageFulfillerRunnable) ReplaceFulfillment(messageDoc *models.Message, index int) bool {
+	logger := x.logger.WithField("message_id", messageDoc.MessageID).WithField("section", "ReplaceFulfillment")
+	fulfillment := messageDoc.Fulfillments[index]
+
+	previousTipCap, ok := new(big.Int).SetString(fulfillment.GasTipCap, 10)
+	if !ok {
+		logger.Error("Invalid gas tip cap")
+		return false
+	}
+	previousFeeCap, ok := new(big.Int).SetString(fulfillment.GasFeeCap, 10)
+	if !ok {
+		logger.Error("Invalid gas fee cap")
+		return false
+	}
+
+	gasTipCap, gasFeeCap, err := x.EstimateFees()
+	if err != nil {
+		logger.WithError(err).Error("Error estimating fees")
+		return false
+	}
+	if bumped := x.BumpFee(previousTipCap); bumped.Cmp(gasTipCap) > 0 {
+		gasTipCap = bumped
+	}
+	if bumped := x.BumpFee(previousFeeCap); bumped.Cmp(gasFeeCap) > 0 {
+		gasFeeCap = bumped
+	}
+	if gasTipCap.Cmp(gasFeeCap) > 0 {
+		gasFeeCap = new(big.Int).Set(gasTipCap)
+	}
+
+	// only one of the transactions at the nonce can be mined, so the replacement adds the fee difference
+	extra := new(big.Int).Sub(gasFeeCap, previousFeeCap)
+	extra.Mul(extra, new(big.Int).SetUint64(fulfillment.GasLimit))
+	if !x.CanSpend(extra) {
+		logger.
+			WithField("extra_cost", extra.String()).
+			WithField("spent", x.spent.String()).
+			Warn("Replacement would exceed the spending cap, not replacing")
+		return false
+	}
+
+	tx, err := x.BuildFulfillment(messageDoc, fulfillment.Nonce, fulfillment.GasLimit, gasTipCap, gasFeeCap)
+	if err != nil {
+		logger.WithError(err).Error("Error building replacement transaction")
+		return false
+	}
+
+	if err := x.SendTransaction(tx); err != nil {
+		logger.WithError(err).Error("Error sending replacement transaction")
+		return false
+	}
+	x.spent.Add(x.spent, extra)
+
+	txHash := strings.ToLower(tx.Hash().Hex())
+	logger.
+		WithField("fulfillment_tx_hash", txHash).
+		WithField("nonce", fulfillment.Nonce).
+		WithField("gas_fee_cap", gasFeeCap.String()).
+		Info("Replaced stuck fulfillment transaction")
+
+	prefix := fmt.Sprintf("fulfillments.%d.", index)
+	return x.UpdateBroadcastedMessage(messageDoc, bson.M{
+		"transaction_hash":     txHash,
+		prefix + "tx_hashes":   append(fulfillment.TxHashes, txHash),
+		prefix + "gas_fee_cap": gasFeeCap.String(),
+		prefix + "gas_tip_cap": gasTipCap.String(),
+		prefix + "sent_at":     time.Now(),
+	})
+}
+
+func NewMessageFulfiller(config models.EthereumNetworkConfig) service.Runnable {
+	logger := log.
+		WithField("module", "ethereum").
+		WithField("service", "fulfiller").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", config.ChainID)
+
+	if !config.MessageFulfiller.Enabled {
+		logger.Fatalf("Message fulfiller is not enabled")
+	}
+
+	logger.Debugf("Initializing")
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(config.MessageFulfiller.PrivateKey, "0x"))
+	if err != nil {
+		logger.Fatalf("Error parsing fulfiller private key: %s", err)
+	}
+	from := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	spendingCap, ok := new(big.Int).SetString(config.MessageFulfiller.DailySpendingCapWei, 10)
+	if !ok {
+		logger.Fatalf("Invalid fulfiller daily spending cap: %s", config.MessageFulfiller.DailySpendingCapWei)
+	}
+
+	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetUint64(config.ChainID))
+	if err != nil {
+		logger.Fatalf("Error creating transactor: %s", err)
+	}
+
+	client, err := ethNewClient(config)
+	if err != nil {
+		logger.Fatalf("Error creating ethereum client: %s", err)
+	}
+
+	logger.Debug("Connecting to mint controller contract at: ", config.MintControllerAddress)
+	mintController, err := ethNewMintControllerContract(common.HexToAddress(config.MintControllerAddress), client.GetClient())
+	if err != nil {
+		logger.Fatal("Error connecting to mint controller contract: ", err)
+	}
+	logger.Debug("Connected to mint controller contract")
+
+	mintControllerABI, err := autogen.MintControllerMetaData.GetAbi()
+	if err != nil {
+		logger.Fatal("Error parsing mint controller abi: ", err)
+	}
+
+	logger.Debug("Connecting to omni token contract at: ", config.OmniTokenAddress)
+	omniToken, err := ethNewOmniTokenContract(common.HexToAddress(config.OmniTokenAddress), client.GetClient())
+	if err != nil {
+		logger.Fatal("Error connecting to omni token contract: ", err)
+	}
+	logger.Debug("Connected to omni token contract")
+
+	x := &EthMessageFulfillerRunnable{
+		timeout: time.Duration(config.TimeoutMS) * time.Millisecond,
+
+		mintController:    mintController,
+		mintControllerABI: mintControllerABI,
+		omniToken:         omniToken,
+		client:            client,
+
+		chain: utilParseChain(config),
+
+		from:    from,
+		address: strings.ToLower(from.Hex()),
+		signer:  transactor.Signer,
+
+		nonces: &NonceManager{},
+
+		spendingCap: spendingCap,
+		spent:       big.NewInt(0),
+
+		stuckTimeout:   time.Duration(config.MessageFulfiller.StuckTimeoutMS) * time.Millisecond,
+		gasBumpPercent: config.MessageFulfiller.GasBumpPercent,
+
+		logger: logger.WithField("fulfiller", strings.ToLower(from.Hex())),
+
+		db: dbNewDB("fulfiller"),
+	}
+
+	x.UpdateCurrentBlockHeight()
+
+	logger.Infof("Initialized")
+
+	return x
+}
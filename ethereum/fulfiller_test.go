@@ -0,0 +1,704 @@
+package ethereum
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	ethMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/client_mocks"
+	clientMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+var fulfillerAddress = ethcommon.HexToAddress("0x0f0f")
+
+func newTestFulfiller(t *testing.T) (*EthMessageFulfillerRunnable, *mocks.MockDB, *clientMocks.MockEthereumClient, *ethMocks.MockEthHTTPClient, *clientMocks.MockMintControllerContract) {
+	encodeSignaturesAsMetadata(t)
+
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockHTTPClient := ethMocks.NewMockEthHTTPClient(t)
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+	mockClient.EXPECT().GetClient().Return(mockHTTPClient).Maybe()
+
+	mintControllerABI, err := autogen.MintControllerMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	fulfiller := &EthMessageFulfillerRunnable{
+		timeout:           10 * time.Second,
+		mintController:    mintController,
+		mintControllerABI: mintControllerABI,
+		omniToken:         omniToken,
+		client:            mockClient,
+		chain:             models.Chain{ChainDomain: 1},
+		from:              fulfillerAddress,
+		address:           "0x0000000000000000000000000000000000000f0f",
+		nonces:            &NonceManager{},
+		spendingCap:       big.NewInt(1000000),
+		spent:             big.NewInt(0),
+		stuckTimeout:      time.Minute,
+		gasBumpPercent:    10,
+		logger:            log.New().WithField("test", "fulfiller"),
+		db:                mockDB,
+	}
+
+	return fulfiller, mockDB, mockClient, mockHTTPClient, mintController
+}
+
+func fulfillableMessage(id byte) models.Message {
+	one := "0x0000000000000000000000000000000000000001"
+	return models.Message{
+		ID:        &primitive.ObjectID{id},
+		MessageID: fmt.Sprintf("0x%02x", id),
+		Content: models.MessageContent{
+			Nonce:     uint32(id),
+			Sender:    one,
+			Recipient: one,
+			MessageBody: models.MessageBody{
+				SenderAddress:    one,
+				Amount:           "100",
+				RecipientAddress: one,
+			},
+		},
+		Status:     models.MessageStatusSigned,
+		Signatures: []models.Signature{{Signer: one, Signature: one}},
+	}
+}
+
+func fulfillmentTx(nonce uint64, gasLimit uint64, gasTipCap *big.Int, gasFeeCap *big.Int) *types.Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		Nonce:     nonce,
+		Gas:       gasLimit,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	})
+}
+
+// expectFees makes the fee estimate a tip of 2 and a fee cap of 2 + 2 * 4
+func expectFees(mockHTTPClient *ethMocks.MockEthHTTPClient) {
+	mockHTTPClient.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(2), nil).Once()
+	mockHTTPClient.EXPECT().HeaderByNumber(mock.Anything, (*big.Int)(nil)).Return(&types.Header{BaseFee: big.NewInt(4)}, nil).Once()
+}
+
+func TestFulfillmentCost(t *testing.T) {
+	assert.Equal(t, "50", FulfillmentCost(models.Fulfillment{FeePaid: "50", GasLimit: 10, GasFeeCap: "20"}).String())
+	assert.Equal(t, "200", FulfillmentCost(models.Fulfillment{GasLimit: 10, GasFeeCap: "20"}).String())
+	assert.Equal(t, "0", FulfillmentCost(models.Fulfillment{GasLimit: 10}).String())
+}
+
+func TestFulfillerUpdateSpending(t *testing.T) {
+	fulfiller, mockDB, _, _, _ := newTestFulfiller(t)
+
+	now := time.Now()
+	messages := []models.Message{
+		{Fulfillments: []models.Fulfillment{
+			// submitted before the window
+			{Submitter: fulfiller.address, FeePaid: "1000", SubmittedAt: now.Add(-25 * time.Hour)},
+			// submitted by another oracle
+			{Submitter: "0x0000000000000000000000000000000000000001", FeePaid: "1000", SubmittedAt: now},
+			{Submitter: fulfiller.address, FeePaid: "30", SubmittedAt: now},
+		}},
+		{Fulfillments: []models.Fulfillment{
+			{Submitter: fulfiller.address, GasLimit: 10, GasFeeCap: "5", SubmittedAt: now},
+		}},
+	}
+
+	mockDB.EXPECT().GetFulfillments(fulfiller.chain, fulfiller.address, mock.Anything).Return(messages, nil).Once()
+
+	success := fulfiller.UpdateSpending()
+
+	assert.True(t, success)
+	assert.Equal(t, "80", fulfiller.spent.String())
+	assert.True(t, fulfiller.CanSpend(big.NewInt(999920)))
+	assert.False(t, fulfiller.CanSpend(big.NewInt(999921)))
+}
+
+func TestFulfillerUpdateSpending_SettledFee(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	now := time.Now()
+	// the relayer marked the message successful before the fulfiller recorded the receipt
+	settled := fulfillableMessage(1)
+	settled.Status = models.MessageStatusSuccess
+	settled.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, TxHashes: []string{"0x01"}, GasLimit: 10, GasFeeCap: "5", SubmittedAt: now},
+	}
+	receipt := &types.Receipt{
+		TxHash:            ethcommon.HexToHash("0x01"),
+		Status:            types.ReceiptStatusSuccessful,
+		GasUsed:           8,
+		EffectiveGasPrice: big.NewInt(3),
+	}
+
+	mockDB.EXPECT().GetFulfillments(fulfiller.chain, fulfiller.address, mock.Anything).Return([]models.Message{settled}, nil).Once()
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(receipt, nil).Once()
+	mockDB.EXPECT().SetFulfillmentFeePaid(settled.ID, 0, strings.ToLower(receipt.TxHash.Hex()), "24").Return(nil).Once()
+
+	success := fulfiller.UpdateSpending()
+
+	assert.True(t, success)
+	assert.Equal(t, "24", fulfiller.spent.String())
+}
+
+func TestFulfillerUpdateSpending_Error(t *testing.T) {
+	fulfiller, mockDB, _, _, _ := newTestFulfiller(t)
+
+	mockDB.EXPECT().GetFulfillments(fulfiller.chain, fulfiller.address, mock.Anything).Return(nil, errors.New("error")).Once()
+
+	success := fulfiller.UpdateSpending()
+
+	assert.False(t, success)
+}
+
+func TestFulfillerEstimateFees(t *testing.T) {
+	fulfiller, _, _, mockHTTPClient, _ := newTestFulfiller(t)
+
+	expectFees(mockHTTPClient)
+
+	gasTipCap, gasFeeCap, err := fulfiller.EstimateFees()
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2", gasTipCap.String())
+	assert.Equal(t, "10", gasFeeCap.String())
+}
+
+func TestFulfillerEstimateFees_NoBaseFee(t *testing.T) {
+	fulfiller, _, _, mockHTTPClient, _ := newTestFulfiller(t)
+
+	mockHTTPClient.EXPECT().SuggestGasTipCap(mock.Anything).Return(big.NewInt(2), nil).Once()
+	mockHTTPClient.EXPECT().HeaderByNumber(mock.Anything, (*big.Int)(nil)).Return(&types.Header{}, nil).Once()
+
+	_, _, err := fulfiller.EstimateFees()
+
+	assert.Error(t, err)
+}
+
+func TestFulfillerBumpFee(t *testing.T) {
+	fulfiller, _, _, _, _ := newTestFulfiller(t)
+
+	assert.Equal(t, "110", fulfiller.BumpFee(big.NewInt(100)).String())
+	// rounded up so the replacement is never below the minimum bump
+	assert.Equal(t, "2", fulfiller.BumpFee(big.NewInt(1)).String())
+}
+
+func TestFulfillMessage(t *testing.T) {
+	fulfiller, mockDB, _, mockHTTPClient, mintController := newTestFulfiller(t)
+	fulfiller.nonces.Sync(7, nil)
+
+	message := fulfillableMessage(1)
+	tx := fulfillmentTx(7, 1000, big.NewInt(2), big.NewInt(10))
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(message, nil).Once()
+	expectFees(mockHTTPClient)
+	mintController.EXPECT().FulfillOrder(mock.Anything, []byte(message.Signatures[0].Signature), mock.Anything).
+		RunAndReturn(func(opts *bind.TransactOpts, _ []byte, _ []byte) (*types.Transaction, error) {
+			assert.Equal(t, fulfillerAddress, opts.From)
+			assert.Equal(t, "7", opts.Nonce.String())
+			assert.Equal(t, uint64(0), opts.GasLimit)
+			assert.Equal(t, "2", opts.GasTipCap.String())
+			assert.Equal(t, "10", opts.GasFeeCap.String())
+			assert.True(t, opts.NoSend)
+			return tx, nil
+		}).Once()
+	mockHTTPClient.EXPECT().SendTransaction(mock.Anything, tx).Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).
+		RunAndReturn(func(_ *primitive.ObjectID, _ models.MessageStatus, update bson.M) error {
+			assert.Equal(t, models.MessageStatusBroadcasted, update["status"])
+			assert.Equal(t, tx.Hash().Hex(), ethcommon.HexToHash(update["transaction_hash"].(string)).Hex())
+			fulfillments := update["fulfillments"].([]models.Fulfillment)
+			assert.Len(t, fulfillments, 1)
+			assert.Equal(t, fulfiller.address, fulfillments[0].Submitter)
+			assert.Equal(t, uint64(7), fulfillments[0].Nonce)
+			assert.Equal(t, uint64(1000), fulfillments[0].GasLimit)
+			assert.Equal(t, "10", fulfillments[0].GasFeeCap)
+			assert.Equal(t, []string{update["transaction_hash"].(string)}, fulfillments[0].TxHashes)
+			return nil
+		}).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	assert.True(t, success)
+	assert.Equal(t, "10000", fulfiller.spent.String())
+	assert.Equal(t, uint64(8), fulfiller.nonces.Next())
+}
+
+func TestFulfillMessage_ClaimedByAnotherOracle(t *testing.T) {
+	fulfiller, mockDB, _, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	claimed := fulfillableMessage(1)
+	claimed.Status = models.MessageStatusBroadcasted
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(claimed, nil).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	assert.True(t, success)
+}
+
+func TestFulfillMessage_LockError(t *testing.T) {
+	fulfiller, mockDB, _, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("", errors.New("error")).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	assert.False(t, success)
+}
+
+func TestFulfillMessage_Reverted(t *testing.T) {
+	fulfiller, mockDB, _, mockHTTPClient, mintController := newTestFulfiller(t)
+	fulfiller.nonces.Sync(7, nil)
+
+	message := fulfillableMessage(1)
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(message, nil).Once()
+	expectFees(mockHTTPClient)
+	mintController.EXPECT().FulfillOrder(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("execution reverted")).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	assert.False(t, success)
+	assert.Equal(t, uint64(7), fulfiller.nonces.Next())
+}
+
+func TestFulfillMessage_OverSpendingCap(t *testing.T) {
+	fulfiller, mockDB, _, mockHTTPClient, mintController := newTestFulfiller(t)
+	fulfiller.nonces.Sync(7, nil)
+	fulfiller.spent = big.NewInt(995000)
+
+	message := fulfillableMessage(1)
+	tx := fulfillmentTx(7, 1000, big.NewInt(2), big.NewInt(10))
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(message, nil).Once()
+	expectFees(mockHTTPClient)
+	mintController.EXPECT().FulfillOrder(mock.Anything, mock.Anything, mock.Anything).Return(tx, nil).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	assert.False(t, success)
+	assert.Equal(t, "995000", fulfiller.spent.String())
+	assert.Equal(t, uint64(7), fulfiller.nonces.Next())
+}
+
+func TestFulfillMessage_SendError(t *testing.T) {
+	fulfiller, mockDB, _, mockHTTPClient, mintController := newTestFulfiller(t)
+	fulfiller.nonces.Sync(7, nil)
+
+	message := fulfillableMessage(1)
+	tx := fulfillmentTx(7, 1000, big.NewInt(2), big.NewInt(10))
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(message, nil).Once()
+	expectFees(mockHTTPClient)
+	mintController.EXPECT().FulfillOrder(mock.Anything, mock.Anything, mock.Anything).Return(tx, nil).Once()
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil).Once()
+	mockHTTPClient.EXPECT().SendTransaction(mock.Anything, tx).Return(errors.New("error")).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	// the stored fulfillment keeps its nonce and fees until it is replaced
+	assert.False(t, success)
+	assert.Equal(t, "10000", fulfiller.spent.String())
+	assert.Equal(t, uint64(8), fulfiller.nonces.Next())
+}
+
+func TestFulfillMessage_UpdateError(t *testing.T) {
+	fulfiller, mockDB, _, mockHTTPClient, mintController := newTestFulfiller(t)
+	fulfiller.nonces.Sync(7, nil)
+
+	message := fulfillableMessage(1)
+	tx := fulfillmentTx(7, 1000, big.NewInt(2), big.NewInt(10))
+
+	mockDB.EXPECT().LockWriteMessage(&message).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(message, nil).Once()
+	expectFees(mockHTTPClient)
+	mintController.EXPECT().FulfillOrder(mock.Anything, mock.Anything, mock.Anything).Return(tx, nil).Once()
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(errors.New("error")).Once()
+
+	success := fulfiller.FulfillMessage(&message)
+
+	// nothing is sent unless the fulfillment is stored
+	assert.False(t, success)
+	assert.Equal(t, "0", fulfiller.spent.String())
+	assert.Equal(t, uint64(7), fulfiller.nonces.Next())
+}
+
+func TestFulfillMessages(t *testing.T) {
+	fulfiller, mockDB, _, _, _ := newTestFulfiller(t)
+
+	later := time.Now().Add(time.Hour)
+	earlier := time.Now().Add(-time.Hour)
+
+	scheduled := fulfillableMessage(1)
+	scheduled.FulfillableAt = &later
+	first := fulfillableMessage(3)
+	first.FulfillableAt = &earlier
+	second := fulfillableMessage(2)
+
+	mockDB.EXPECT().GetSignedMessages(fulfiller.chain).Return([]models.Message{scheduled, second, first}, nil).Once()
+
+	// the message scheduled for later is skipped and the others are locked in order
+	order := []*primitive.ObjectID{}
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).
+		RunAndReturn(func(message *models.Message) (string, error) {
+			order = append(order, message.ID)
+			return "", errors.New("error")
+		}).Twice()
+
+	success := fulfiller.FulfillMessages()
+
+	assert.False(t, success)
+	assert.Equal(t, []*primitive.ObjectID{first.ID, second.ID}, order)
+}
+
+func TestFulfillMessages_OrderedAcrossOrigins(t *testing.T) {
+	fulfiller, mockDB, _, _, _ := newTestFulfiller(t)
+
+	now := time.Now()
+
+	// nonces of different origins are not comparable, the message created first is fulfilled first
+	older := fulfillableMessage(9)
+	older.Content.OriginDomain = 1
+	older.CreatedAt = now.Add(-time.Minute)
+	newer := fulfillableMessage(2)
+	newer.Content.OriginDomain = 2
+	newer.CreatedAt = now
+
+	mockDB.EXPECT().GetSignedMessages(fulfiller.chain).Return([]models.Message{newer, older}, nil).Once()
+
+	order := []*primitive.ObjectID{}
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).
+		RunAndReturn(func(message *models.Message) (string, error) {
+			order = append(order, message.ID)
+			return "", errors.New("error")
+		}).Twice()
+
+	fulfiller.FulfillMessages()
+
+	assert.Equal(t, []*primitive.ObjectID{older.ID, newer.ID}, order)
+}
+
+func TestTrackFulfillments(t *testing.T) {
+	fulfiller, mockDB, mockClient, mockHTTPClient, _ := newTestFulfiller(t)
+
+	ours := fulfillableMessage(1)
+	ours.Status = models.MessageStatusBroadcasted
+	ours.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, Nonce: 9, TxHashes: []string{"0x01"}, SentAt: time.Now()},
+	}
+	// the latest fulfillment was submitted by another oracle
+	theirs := fulfillableMessage(2)
+	theirs.Status = models.MessageStatusBroadcasted
+	theirs.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, Nonce: 3, TxHashes: []string{"0x02"}, FeePaid: "10"},
+		{Submitter: "0x0000000000000000000000000000000000000001", Nonce: 1, TxHashes: []string{"0x03"}, SentAt: time.Now()},
+	}
+
+	mockDB.EXPECT().GetBroadcastedMessages(fulfiller.chain).Return([]models.Message{ours, theirs}, nil).Once()
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(nil, ethereum.NotFound).Once()
+	mockHTTPClient.EXPECT().PendingNonceAt(mock.Anything, fulfillerAddress).Return(uint64(5), nil).Once()
+
+	success := fulfiller.TrackFulfillments()
+
+	assert.True(t, success)
+	assert.Equal(t, uint64(10), fulfiller.nonces.Next())
+}
+
+func TestTakeOverFulfillment(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Status = models.MessageStatusBroadcasted
+	message.Fulfillments = []models.Fulfillment{{
+		Submitter: "0x0000000000000000000000000000000000000001",
+		TxHashes:  []string{"0x01"},
+		SentAt:    time.Now().Add(-FulfillmentTakeOverTimeouts * fulfiller.stuckTimeout),
+	}}
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(nil, ethereum.NotFound).Once()
+	mockDB.EXPECT().UpdateMessages(
+		bson.M{"_id": message.ID, "status": models.MessageStatusBroadcasted},
+		bson.M{"status": models.MessageStatusSigned, "transaction_hash": ""},
+	).Return(nil).Once()
+
+	success := fulfiller.TakeOverFulfillment(&message)
+
+	assert.True(t, success)
+}
+
+func TestTakeOverFulfillment_Reverted(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Status = models.MessageStatusBroadcasted
+	message.Fulfillments = []models.Fulfillment{{
+		Submitter: "0x0000000000000000000000000000000000000001",
+		TxHashes:  []string{"0x01"},
+		SentAt:    time.Now().Add(-FulfillmentTakeOverTimeouts * fulfiller.stuckTimeout),
+	}}
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(&types.Receipt{
+		Status:            types.ReceiptStatusFailed,
+		GasUsed:           10,
+		EffectiveGasPrice: big.NewInt(2),
+	}, nil).Once()
+	mockDB.EXPECT().UpdateMessages(
+		bson.M{"_id": message.ID, "status": models.MessageStatusBroadcasted},
+		bson.M{"status": models.MessageStatusSigned, "transaction_hash": "", "fulfillments.0.fee_paid": "20"},
+	).Return(nil).Once()
+
+	success := fulfiller.TakeOverFulfillment(&message)
+
+	assert.True(t, success)
+}
+
+func TestTakeOverFulfillment_NotTaken(t *testing.T) {
+	fulfiller, _, mockClient, _, _ := newTestFulfiller(t)
+
+	other := "0x0000000000000000000000000000000000000001"
+
+	// the other oracle sent or replaced its transaction recently
+	recent := fulfillableMessage(1)
+	recent.Status = models.MessageStatusBroadcasted
+	recent.Fulfillments = []models.Fulfillment{{Submitter: other, TxHashes: []string{"0x01"}, SentAt: time.Now()}}
+	assert.False(t, fulfiller.TakeOverFulfillment(&recent))
+
+	// the transaction was mined and is left for the relayer to confirm
+	mined := fulfillableMessage(2)
+	mined.Status = models.MessageStatusBroadcasted
+	mined.Fulfillments = []models.Fulfillment{{Submitter: other, TxHashes: []string{"0x02"}, SentAt: time.Now().Add(-time.Hour)}}
+	mockClient.EXPECT().GetTransactionReceipt("0x02").Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil).Once()
+	assert.False(t, fulfiller.TakeOverFulfillment(&mined))
+
+	// the receipt could not be read
+	unknown := fulfillableMessage(3)
+	unknown.Status = models.MessageStatusBroadcasted
+	unknown.Fulfillments = []models.Fulfillment{{Submitter: other, TxHashes: []string{"0x03"}, SentAt: time.Now().Add(-time.Hour)}}
+	mockClient.EXPECT().GetTransactionReceipt("0x03").Return(nil, errors.New("error")).Once()
+	assert.False(t, fulfiller.TakeOverFulfillment(&unknown))
+
+	assert.False(t, fulfiller.TakeOverFulfillment(&models.Message{Status: models.MessageStatusBroadcasted}))
+}
+
+func TestTrackFulfillment_Mined(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Status = models.MessageStatusBroadcasted
+	message.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, Nonce: 9, TxHashes: []string{"0x01", "0x02"}, GasFeeCap: "10"},
+	}
+
+	// the replacement was not mined, the original transaction was
+	mockClient.EXPECT().GetTransactionReceipt("0x02").Return(nil, ethereum.NotFound).Once()
+	receipt := &types.Receipt{
+		TxHash:            ethcommon.HexToHash("0x01"),
+		Status:            types.ReceiptStatusSuccessful,
+		GasUsed:           100,
+		EffectiveGasPrice: big.NewInt(7),
+	}
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(receipt, nil).Once()
+	// the fee is stored whatever the status, which the relayer may have changed to success
+	mockDB.EXPECT().SetFulfillmentFeePaid(message.ID, 0, strings.ToLower(receipt.TxHash.Hex()), "700").Return(nil).Once()
+
+	inFlight := fulfiller.TrackFulfillment(&message, 0)
+
+	assert.False(t, inFlight)
+}
+
+func TestTrackFulfillment_MinedFeeError(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Status = models.MessageStatusBroadcasted
+	message.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, Nonce: 9, TxHashes: []string{"0x01"}, GasFeeCap: "10"},
+	}
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(&types.Receipt{Status: types.ReceiptStatusSuccessful}, nil).Once()
+	mockDB.EXPECT().SetFulfillmentFeePaid(message.ID, 0, mock.Anything, "0").Return(errors.New("error")).Once()
+
+	inFlight := fulfiller.TrackFulfillment(&message, 0)
+
+	assert.True(t, inFlight)
+}
+
+func TestTrackFulfillment_MinedReverted(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Status = models.MessageStatusBroadcasted
+	message.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, Nonce: 9, TxHashes: []string{"0x01"}, GasFeeCap: "10"},
+	}
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(&types.Receipt{
+		Status:  types.ReceiptStatusFailed,
+		GasUsed: 100,
+	}, nil).Once()
+	mockDB.EXPECT().UpdateMessages(
+		bson.M{"_id": message.ID, "status": models.MessageStatusBroadcasted},
+		bson.M{
+			"fulfillments.0.fee_paid": "1000",
+			"status":                  models.MessageStatusSigned,
+			"transaction_hash":        "",
+		},
+	).Return(nil).Once()
+
+	inFlight := fulfiller.TrackFulfillment(&message, 0)
+
+	assert.False(t, inFlight)
+}
+
+func TestTrackFulfillment_Pending(t *testing.T) {
+	fulfiller, _, mockClient, _, _ := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Fulfillments = []models.Fulfillment{
+		{Submitter: fulfiller.address, Nonce: 9, TxHashes: []string{"0x01"}, SentAt: time.Now()},
+	}
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(nil, ethereum.NotFound).Once()
+
+	inFlight := fulfiller.TrackFulfillment(&message, 0)
+
+	assert.True(t, inFlight)
+}
+
+func TestTrackFulfillment_Stuck(t *testing.T) {
+	fulfiller, mockDB, mockClient, mockHTTPClient, mintController := newTestFulfiller(t)
+
+	message := fulfillableMessage(1)
+	message.Status = models.MessageStatusBroadcasted
+	message.Fulfillments = []models.Fulfillment{{
+		Submitter: fulfiller.address,
+		Nonce:     9,
+		TxHashes:  []string{"0x01"},
+		GasLimit:  1000,
+		GasTipCap: "20",
+		GasFeeCap: "100",
+		SentAt:    time.Now().Add(-2 * time.Minute),
+	}}
+	replacement := fulfillmentTx(9, 1000, big.NewInt(22), big.NewInt(110))
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(nil, ethereum.NotFound).Once()
+	// the bumped fees are above the estimate
+	expectFees(mockHTTPClient)
+	mintController.EXPECT().FulfillOrder(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(opts *bind.TransactOpts, _ []byte, _ []byte) (*types.Transaction, error) {
+			assert.Equal(t, "9", opts.Nonce.String())
+			assert.Equal(t, uint64(1000), opts.GasLimit)
+			assert.Equal(t, "22", opts.GasTipCap.String())
+			assert.Equal(t, "110", opts.GasFeeCap.String())
+			return replacement, nil
+		}).Once()
+	mockHTTPClient.EXPECT().SendTransaction(mock.Anything, replacement).Return(nil).Once()
+	mockDB.EXPECT().UpdateMessages(bson.M{"_id": message.ID, "status": models.MessageStatusBroadcasted}, mock.Anything).
+		RunAndReturn(func(_ bson.M, update bson.M) error {
+			txHashes := update["fulfillments.0.tx_hashes"].([]string)
+			assert.Len(t, txHashes, 2)
+			assert.Equal(t, txHashes[1], update["transaction_hash"])
+			assert.Equal(t, "110", update["fulfillments.0.gas_fee_cap"])
+			assert.Equal(t, "22", update["fulfillments.0.gas_tip_cap"])
+			return nil
+		}).Once()
+
+	inFlight := fulfiller.TrackFulfillment(&message, 0)
+
+	assert.True(t, inFlight)
+	assert.Equal(t, "10000", fulfiller.spent.String())
+}
+
+func TestReplaceFulfillment_OverSpendingCap(t *testing.T) {
+	fulfiller, _, _, mockHTTPClient, _ := newTestFulfiller(t)
+	fulfiller.spent = big.NewInt(995000)
+
+	message := fulfillableMessage(1)
+	message.Fulfillments = []models.Fulfillment{{
+		Submitter: fulfiller.address,
+		Nonce:     9,
+		TxHashes:  []string{"0x01"},
+		GasLimit:  1000,
+		GasTipCap: "20",
+		GasFeeCap: "100",
+	}}
+
+	expectFees(mockHTTPClient)
+
+	success := fulfiller.ReplaceFulfillment(&message, 0)
+
+	assert.False(t, success)
+}
+
+func TestFulfillerRun(t *testing.T) {
+	fulfiller, mockDB, mockClient, mockHTTPClient, _ := newTestFulfiller(t)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	mockDB.EXPECT().GetFulfillments(fulfiller.chain, fulfiller.address, mock.Anything).Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetBroadcastedMessages(fulfiller.chain).Return([]models.Message{}, nil).Once()
+	mockHTTPClient.EXPECT().PendingNonceAt(mock.Anything, fulfillerAddress).Return(uint64(5), nil).Once()
+	fulfiller.omniToken.(*clientMocks.MockOmniTokenContract).EXPECT().Paused(mock.Anything).Return(false, nil).Once()
+	mockDB.EXPECT().GetSignedMessages(fulfiller.chain).Return([]models.Message{}, nil).Once()
+
+	fulfiller.Run()
+
+	assert.Equal(t, uint64(100), fulfiller.Height())
+	assert.Equal(t, uint64(5), fulfiller.nonces.Next())
+}
+
+func TestFulfillerRun_DestinationPaused(t *testing.T) {
+	fulfiller, mockDB, mockClient, mockHTTPClient, _ := newTestFulfiller(t)
+	omniToken := fulfiller.omniToken.(*clientMocks.MockOmniTokenContract)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Twice()
+	mockDB.EXPECT().GetFulfillments(fulfiller.chain, fulfiller.address, mock.Anything).Return([]models.Message{}, nil).Twice()
+	mockDB.EXPECT().GetBroadcastedMessages(fulfiller.chain).Return([]models.Message{}, nil).Twice()
+	mockHTTPClient.EXPECT().PendingNonceAt(mock.Anything, fulfillerAddress).Return(uint64(5), nil).Twice()
+
+	// no signed messages are read while paused or when the paused state is unknown
+	omniToken.EXPECT().Paused(mock.Anything).Return(true, nil).Once()
+	fulfiller.Run()
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, errors.New("error")).Once()
+	fulfiller.Run()
+}
+
+func TestFulfillerRun_TrackError(t *testing.T) {
+	fulfiller, mockDB, mockClient, _, _ := newTestFulfiller(t)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	mockDB.EXPECT().GetFulfillments(fulfiller.chain, fulfiller.address, mock.Anything).Return([]models.Message{}, nil).Once()
+	mockDB.EXPECT().GetBroadcastedMessages(fulfiller.chain).Return(nil, errors.New("error")).Once()
+
+	// no new transactions are sent without knowing the nonces in flight
+	fulfiller.Run()
+}
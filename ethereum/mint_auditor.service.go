@@ -0,0 +1,369 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// EthMintAuditorRunnable matches every mint of the omni token, a transfer from the zero address,
+// to a fulfillment of a message signed by the oracles in the same transaction
+type EthMintAuditorRunnable struct {
+	startBlockHeight   uint64
+	currentBlockHeight uint64
+
+	omniToken      eth.OmniTokenContract
+	mintController eth.MintControllerContract
+	client         eth.EthereumClient
+
+	confirmations uint64
+	finalityTag   string
+
+	chain models.Chain
+
+	logger *log.Entry
+
+	db db.DB
+}
+
+func (x *EthMintAuditorRunnable) Run() {
+	x.UpdateCurrentBlockHeight()
+	x.AuditNewBlocks()
+}
+
+func (x *EthMintAuditorRunnable) Height() uint64 {
+	return uint64(x.currentBlockHeight)
+}
+
+func (x *EthMintAuditorRunnable) UpdateCurrentBlockHeight() {
+	res, err := x.client.GetBlockHeight()
+	if err != nil {
+		x.logger.
+			WithError(err).
+			Error("could not get current block height")
+		return
+	}
+	x.currentBlockHeight = res
+	x.logger.
+		WithField("current_block_height", x.currentBlockHeight).
+		Info("updated current block height")
+}
+
+// ConfirmedBlockHeight returns the latest block that can no longer be reorged,
+// so mints are only audited once their fulfillments are final
+func (x *EthMintAuditorRunnable) ConfirmedBlockHeight() (uint64, error) {
+	if x.finalityTag != "" {
+		finalizedBlockHeight, err := x.client.GetFinalizedBlockHeight()
+		if err != nil {
+			return 0, fmt.Errorf("error getting %s block height: %w", x.finalityTag, err)
+		}
+		return finalizedBlockHeight, nil
+	}
+	if x.currentBlockHeight < x.confirmations {
+		return 0, nil
+	}
+	return x.currentBlockHeight - x.confirmations, nil
+}
+
+// ScanFulfillments returns the fulfillment events of the mint controller in the block range by transaction hash
+func (x *EthMintAuditorRunnable) ScanFulfillments(startBlockHeight uint64, endBlockHeight uint64) (map[ethcommon.Hash][]*autogen.MintControllerFulfillment, error) {
+	filter, err := x.mintController.FilterFulfillment(&bind.FilterOpts{
+		Start:   startBlockHeight,
+		End:     &endBlockHeight,
+		Context: context.Background(),
+	}, [][32]byte{})
+
+	if filter != nil {
+		defer filter.Close()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	fulfillments := make(map[ethcommon.Hash][]*autogen.MintControllerFulfillment)
+	for filter.Next() {
+		event := filter.Event()
+		if event == nil || event.Raw.Removed {
+			continue
+		}
+		fulfillments[event.Raw.TxHash] = append(fulfillments[event.Raw.TxHash], event)
+	}
+
+	if err := filter.Error(); err != nil {
+		return nil, err
+	}
+
+	return fulfillments, nil
+}
+
+// ScanMints returns the transfers of the omni token from the zero address in the block range
+func (x *EthMintAuditorRunnable) ScanMints(startBlockHeight uint64, endBlockHeight uint64) ([]*autogen.OmniTokenTransfer, error) {
+	filter, err := x.omniToken.FilterTransfer(&bind.FilterOpts{
+		Start:   startBlockHeight,
+		End:     &endBlockHeight,
+		Context: context.Background(),
+	}, []ethcommon.Address{{}}, nil)
+
+	if filter != nil {
+		defer filter.Close()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var mints []*autogen.OmniTokenTransfer
+	for filter.Next() {
+		event := filter.Event()
+		if event == nil || event.Raw.Removed {
+			continue
+		}
+		mints = append(mints, event)
+	}
+
+	if err := filter.Error(); err != nil {
+		return nil, err
+	}
+
+	return mints, nil
+}
+
+// MatchesFulfillment reports whether the fulfillment is for a message signed by the oracles
+// that pays the amount of the mint to its recipient
+func (x *EthMintAuditorRunnable) MatchesFulfillment(mint *autogen.OmniTokenTransfer, fulfillment *autogen.MintControllerFulfillment) (bool, error) {
+	var content models.MessageContent
+	if err := content.DecodeFromBytes(fulfillment.Message); err != nil {
+		return false, nil
+	}
+	if content.DestinationDomain != x.chain.ChainDomain ||
+		!strings.EqualFold(content.MessageBody.RecipientAddress, mint.To.Hex()) ||
+		content.MessageBody.Amount != mint.Value.String() {
+		return false, nil
+	}
+
+	orderID := common.Ensure0xPrefix(common.HexFromBytes(fulfillment.OrderId[:]))
+	messageDoc, err := x.db.FindMessage(bson.M{"message_id": orderID})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return isSignedMessage(&messageDoc), nil
+}
+
+// AuditBlocks checks that every mint in the block range is matched by its own fulfillment
+func (x *EthMintAuditorRunnable) AuditBlocks(startBlockHeight uint64, endBlockHeight uint64) bool {
+	fulfillments, err := x.ScanFulfillments(startBlockHeight, endBlockHeight)
+	if err == nil {
+		var mints []*autogen.OmniTokenTransfer
+		if mints, err = x.ScanMints(startBlockHeight, endBlockHeight); err == nil {
+			return x.AuditMints(mints, fulfillments)
+		}
+	}
+
+	if eth.IsRateLimitError(err) {
+		x.logger.WithError(err).Warn("Rate limited, retrying the block range on the next run")
+		return false
+	}
+	if eth.IsQueryRangeError(err) && endBlockHeight > startBlockHeight {
+		midBlockHeight := startBlockHeight + (endBlockHeight-startBlockHeight)/2
+		x.logger.WithError(err).Warnf("Block range %d to %d rejected, splitting at %d", startBlockHeight, endBlockHeight, midBlockHeight)
+		x.client.ReduceMaxQueryBlocks(midBlockHeight - startBlockHeight)
+		return x.AuditBlocks(startBlockHeight, midBlockHeight) && x.AuditBlocks(midBlockHeight+1, endBlockHeight)
+	}
+	x.logger.WithError(err).Error("Error scanning mints and fulfillments")
+	return false
+}
+
+// AuditMints records every mint that is not matched by a fulfillment in its transaction.
+// A fulfillment accounts for one mint only
+func (x *EthMintAuditorRunnable) AuditMints(mints []*autogen.OmniTokenTransfer, fulfillments map[ethcommon.Hash][]*autogen.MintControllerFulfillment) bool {
+	success := true
+	for _, mint := range mints {
+		candidates := fulfillments[mint.Raw.TxHash]
+		matched := false
+		for i, fulfillment := range candidates {
+			ok, err := x.MatchesFulfillment(mint, fulfillment)
+			if err != nil {
+				x.logger.WithError(err).WithField("tx_hash", mint.Raw.TxHash.Hex()).Error("Error finding message")
+				return false
+			}
+			if ok {
+				fulfillments[mint.Raw.TxHash] = append(candidates[:i:i], candidates[i+1:]...)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			success = x.RecordUnauthorizedMint(mint) && success
+		}
+	}
+	return success
+}
+
+// RecordUnauthorizedMint stores a mint that no signed message accounts for and raises a critical alert
+func (x *EthMintAuditorRunnable) RecordUnauthorizedMint(mint *autogen.OmniTokenTransfer) bool {
+	anomaly := models.Anomaly{
+		Chain:           x.chain,
+		Type:            models.AnomalyTypeUnauthorizedMint,
+		Contract:        strings.ToLower(mint.Raw.Address.Hex()),
+		TransactionHash: strings.ToLower(mint.Raw.TxHash.Hex()),
+		BlockHeight:     mint.Raw.BlockNumber,
+		LogIndex:        uint64(mint.Raw.Index),
+		Recipient:       strings.ToLower(mint.To.Hex()),
+		Amount:          mint.Value.String(),
+		CreatedAt:       time.Now(),
+	}
+
+	logger := x.logger.
+		WithField("alert", "anomaly").
+		WithField("severity", "critical").
+		WithField("anomaly_type", anomaly.Type).
+		WithField("tx_hash", anomaly.TransactionHash).
+		WithField("recipient", anomaly.Recipient).
+		WithField("amount", anomaly.Amount)
+	logger.Error("Mint of the omni token without a fulfillment of a signed message")
+
+	if err := x.db.InsertAnomaly(anomaly); err != nil {
+		logger.WithError(err).Error("Error inserting anomaly")
+		return false
+	}
+	return true
+}
+
+func (x *EthMintAuditorRunnable) UpdateCheckpoint(blockHeight uint64) bool {
+	err := x.db.UpsertCheckpoint(x.chain, models.CheckpointRunnerMintAuditor, blockHeight)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating checkpoint")
+		return false
+	}
+	x.startBlockHeight = blockHeight
+	return true
+}
+
+// AuditNewBlocks audits the confirmed blocks after the checkpoint in chunks
+func (x *EthMintAuditorRunnable) AuditNewBlocks() bool {
+	confirmedBlockHeight, err := x.ConfirmedBlockHeight()
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting confirmed block height")
+		return false
+	}
+
+	if confirmedBlockHeight <= x.startBlockHeight {
+		x.logger.Infof("No new blocks to audit")
+		return true
+	}
+
+	for x.startBlockHeight < confirmedBlockHeight {
+		endBlockHeight := x.startBlockHeight + x.client.MaxQueryBlocks()
+		if endBlockHeight > confirmedBlockHeight {
+			endBlockHeight = confirmedBlockHeight
+		}
+		x.logger.Info("Auditing mints from blockNumber: ", x.startBlockHeight, " to blockNumber: ", endBlockHeight)
+		if !x.AuditBlocks(x.startBlockHeight, endBlockHeight) {
+			return false
+		}
+		// the checkpoint only moves past a chunk once every mint in it has been matched or recorded
+		if !x.UpdateCheckpoint(endBlockHeight) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (x *EthMintAuditorRunnable) InitStartBlockHeight() {
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerMintAuditor)
+	if err != nil {
+		x.logger.WithError(err).Fatalf("Error finding checkpoint")
+		return
+	}
+	if checkpoint == nil {
+		x.logger.Infof("No checkpoint found")
+	} else {
+		x.logger.Debugf("Checkpoint block height: %d", checkpoint.BlockHeight)
+		x.startBlockHeight = checkpoint.BlockHeight
+	}
+	if x.startBlockHeight == 0 || x.startBlockHeight > x.currentBlockHeight {
+		x.logger.Infof("Start block height is greater than current block height")
+		x.startBlockHeight = x.currentBlockHeight
+	}
+	x.logger.Infof("Initialized start block height: %d", x.startBlockHeight)
+}
+
+func NewMintAuditor(config models.EthereumNetworkConfig) service.Runnable {
+	logger := log.
+		WithField("module", "ethereum").
+		WithField("service", "mint_auditor").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", config.ChainID)
+
+	if !config.MintAuditor.Enabled {
+		logger.Fatalf("Mint auditor is not enabled")
+	}
+
+	logger.Debugf("Initializing")
+
+	client, err := ethNewClient(config)
+	if err != nil {
+		logger.Fatalf("Error creating ethereum client: %s", err)
+	}
+
+	logger.Debug("Connecting to omniToken contract at: ", config.OmniTokenAddress)
+	omniToken, err := ethNewOmniTokenContract(common.HexToAddress(config.OmniTokenAddress), client.GetClient())
+	if err != nil {
+		logger.Fatal("Error connecting to omniToken contract: ", err)
+	}
+	logger.Debug("Connected to omniToken contract")
+
+	logger.Debug("Connecting to mintController contract at: ", config.MintControllerAddress)
+	mintController, err := ethNewMintControllerContract(common.HexToAddress(config.MintControllerAddress), client.GetClient())
+	if err != nil {
+		logger.Fatal("Error connecting to mintController contract: ", err)
+	}
+	logger.Debug("Connected to mintController contract")
+
+	x := &EthMintAuditorRunnable{
+		startBlockHeight:   config.StartBlockHeight,
+		currentBlockHeight: 0,
+
+		omniToken:      omniToken,
+		mintController: mintController,
+		confirmations:  config.Confirmations,
+		finalityTag:    config.FinalityTag,
+
+		client: client,
+
+		chain: utilParseChain(config),
+
+		logger: logger,
+
+		db: dbNewDB("mint_auditor"),
+	}
+
+	x.UpdateCurrentBlockHeight()
+
+	x.InitStartBlockHeight()
+
+	logger.Infof("Initialized")
+
+	return x
+}
@@ -0,0 +1,442 @@
+package ethereum
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	clientMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+var mintRecipient = ethcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+func newTestMintAuditor(t *testing.T) (*EthMintAuditorRunnable, *clientMocks.MockEthereumClient, *clientMocks.MockOmniTokenContract, *clientMocks.MockMintControllerContract, *mocks.MockDB) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockOmniToken := clientMocks.NewMockOmniTokenContract(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	mockDB := mocks.NewMockDB(t)
+
+	auditor := &EthMintAuditorRunnable{
+		omniToken:      mockOmniToken,
+		mintController: mockMintController,
+		client:         mockClient,
+		confirmations:  2,
+		chain:          models.Chain{ChainID: "1", ChainDomain: 1},
+		logger:         log.New().WithField("test", "mint_auditor"),
+		db:             mockDB,
+	}
+
+	return auditor, mockClient, mockOmniToken, mockMintController, mockDB
+}
+
+func mintEvent(txHash string, index uint, amount int64) *autogen.OmniTokenTransfer {
+	return &autogen.OmniTokenTransfer{
+		To:    mintRecipient,
+		Value: big.NewInt(amount),
+		Raw: types.Log{
+			Address:     ethcommon.HexToAddress("0x0000000000000000000000000000000000000010"),
+			TxHash:      ethcommon.HexToHash(txHash),
+			BlockNumber: 5,
+			Index:       index,
+		},
+	}
+}
+
+func mintFulfillment(t *testing.T, txHash string, orderID byte, destinationDomain uint32, amount int64) *autogen.MintControllerFulfillment {
+	content := models.MessageContent{
+		Version:           1,
+		Nonce:             uint32(orderID),
+		OriginDomain:      2,
+		Sender:            "0x0000000000000000000000000000000000000001",
+		DestinationDomain: destinationDomain,
+		Recipient:         "0x0000000000000000000000000000000000000002",
+		MessageBody: models.MessageBody{
+			SenderAddress:    "0x0000000000000000000000000000000000000003",
+			Amount:           fmt.Sprintf("%d", amount),
+			RecipientAddress: mintRecipient.Hex(),
+		},
+	}
+	message, err := content.EncodeToBytes()
+	assert.NoError(t, err)
+
+	return &autogen.MintControllerFulfillment{
+		OrderId: [32]byte{orderID},
+		Message: message,
+		Raw:     types.Log{TxHash: ethcommon.HexToHash(txHash)},
+	}
+}
+
+func expectOrder(mockDB *mocks.MockDB, orderID byte, status models.MessageStatus) {
+	id := [32]byte{orderID}
+	mockDB.EXPECT().FindMessage(bson.M{"message_id": "0x" + ethcommon.Bytes2Hex(id[:])}).Return(models.Message{Status: status}, nil).Once()
+}
+
+func TestMintAuditorHeight(t *testing.T) {
+	auditor := &EthMintAuditorRunnable{currentBlockHeight: 100}
+
+	assert.Equal(t, uint64(100), auditor.Height())
+}
+
+func TestMintAuditorUpdateCurrentBlockHeight(t *testing.T) {
+	auditor, mockClient, _, _, _ := newTestMintAuditor(t)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	auditor.UpdateCurrentBlockHeight()
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(0), errors.New("error")).Once()
+	auditor.UpdateCurrentBlockHeight()
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+}
+
+func TestMintAuditorConfirmedBlockHeight(t *testing.T) {
+	auditor, mockClient, _, _, _ := newTestMintAuditor(t)
+
+	auditor.currentBlockHeight = 100
+	height, err := auditor.ConfirmedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(98), height)
+
+	auditor.currentBlockHeight = 1
+	height, err = auditor.ConfirmedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	auditor.finalityTag = models.FinalityTagFinalized
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(90), nil).Once()
+	height, err = auditor.ConfirmedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(90), height)
+
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(0), errors.New("error")).Once()
+	_, err = auditor.ConfirmedBlockHeight()
+	assert.Error(t, err)
+}
+
+func TestMintAuditorMatchesFulfillment(t *testing.T) {
+	auditor, _, _, _, mockDB := newTestMintAuditor(t)
+
+	mint := mintEvent("0x01", 0, 100)
+
+	expectOrder(mockDB, 1, models.MessageStatusSuccess)
+	ok, err := auditor.MatchesFulfillment(mint, mintFulfillment(t, "0x01", 1, 1, 100))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	// the amount, recipient and destination must match the mint
+	ok, err = auditor.MatchesFulfillment(mint, mintFulfillment(t, "0x01", 1, 1, 99))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = auditor.MatchesFulfillment(mint, mintFulfillment(t, "0x01", 1, 2, 100))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = auditor.MatchesFulfillment(mint, &autogen.MintControllerFulfillment{Message: []byte("invalid")})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestMintAuditorMatchesFulfillment_NotSigned(t *testing.T) {
+	auditor, _, _, _, mockDB := newTestMintAuditor(t)
+
+	mint := mintEvent("0x01", 0, 100)
+
+	expectOrder(mockDB, 1, models.MessageStatusPending)
+	ok, err := auditor.MatchesFulfillment(mint, mintFulfillment(t, "0x01", 1, 1, 100))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	ok, err = auditor.MatchesFulfillment(mint, mintFulfillment(t, "0x01", 1, 1, 100))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{}, errors.New("error")).Once()
+	_, err = auditor.MatchesFulfillment(mint, mintFulfillment(t, "0x01", 1, 1, 100))
+	assert.Error(t, err)
+}
+
+func TestMintAuditorAuditMints(t *testing.T) {
+	auditor, _, _, _, mockDB := newTestMintAuditor(t)
+
+	mints := []*autogen.OmniTokenTransfer{
+		mintEvent("0x01", 1, 100),
+		mintEvent("0x01", 3, 100),
+		mintEvent("0x02", 0, 50),
+	}
+	fulfillments := map[ethcommon.Hash][]*autogen.MintControllerFulfillment{
+		ethcommon.HexToHash("0x01"): {mintFulfillment(t, "0x01", 1, 1, 100)},
+	}
+
+	expectOrder(mockDB, 1, models.MessageStatusSuccess)
+
+	// the second mint of the transaction has no fulfillment left, the third has none at all
+	var anomalies []models.Anomaly
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).Run(func(anomaly models.Anomaly) {
+		anomalies = append(anomalies, anomaly)
+	}).Return(nil).Twice()
+
+	success := auditor.AuditMints(mints, fulfillments)
+
+	assert.True(t, success)
+	assert.Len(t, anomalies, 2)
+	assert.Equal(t, models.AnomalyTypeUnauthorizedMint, anomalies[0].Type)
+	assert.Equal(t, ethcommon.HexToHash("0x01").Hex(), anomalies[0].TransactionHash)
+	assert.Equal(t, uint64(3), anomalies[0].LogIndex)
+	assert.Equal(t, "100", anomalies[0].Amount)
+	assert.Equal(t, "0x00000000000000000000000000000000000000aa", anomalies[0].Recipient)
+	assert.Equal(t, ethcommon.HexToHash("0x02").Hex(), anomalies[1].TransactionHash)
+	assert.Equal(t, "50", anomalies[1].Amount)
+}
+
+func TestMintAuditorAuditMints_Errors(t *testing.T) {
+	auditor, _, _, _, mockDB := newTestMintAuditor(t)
+
+	mints := []*autogen.OmniTokenTransfer{mintEvent("0x01", 1, 100)}
+	fulfillments := map[ethcommon.Hash][]*autogen.MintControllerFulfillment{
+		ethcommon.HexToHash("0x01"): {mintFulfillment(t, "0x01", 1, 1, 100)},
+	}
+
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{}, errors.New("error")).Once()
+	assert.False(t, auditor.AuditMints(mints, fulfillments))
+
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).Return(errors.New("error")).Once()
+	assert.False(t, auditor.AuditMints([]*autogen.OmniTokenTransfer{mintEvent("0x02", 0, 50)}, fulfillments))
+}
+
+func TestMintAuditorAuditBlocks(t *testing.T) {
+	auditor, _, mockOmniToken, mockMintController, mockDB := newTestMintAuditor(t)
+
+	startBlockHeight := uint64(1)
+	endBlockHeight := uint64(100)
+
+	fulfillmentIterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+	mockMintController.EXPECT().FilterFulfillment(mock.MatchedBy(func(opts *bind.FilterOpts) bool {
+		return opts.Start == startBlockHeight && *opts.End == endBlockHeight
+	}), mock.Anything).Return(fulfillmentIterator, nil).Once()
+	fulfillmentIterator.EXPECT().Next().Return(true).Twice()
+	fulfillmentIterator.EXPECT().Event().Return(mintFulfillment(t, "0x01", 1, 1, 100)).Once()
+	fulfillmentIterator.EXPECT().Event().Return(&autogen.MintControllerFulfillment{Raw: types.Log{Removed: true}}).Once()
+	fulfillmentIterator.EXPECT().Next().Return(false).Once()
+	fulfillmentIterator.EXPECT().Error().Return(nil).Once()
+	fulfillmentIterator.EXPECT().Close().Return(nil).Once()
+
+	transferIterator := clientMocks.NewMockOmniTokenTransferIterator(t)
+	mockOmniToken.EXPECT().FilterTransfer(mock.MatchedBy(func(opts *bind.FilterOpts) bool {
+		return opts.Start == startBlockHeight && *opts.End == endBlockHeight
+	}), []ethcommon.Address{{}}, []ethcommon.Address(nil)).Return(transferIterator, nil).Once()
+	transferIterator.EXPECT().Next().Return(true).Once()
+	transferIterator.EXPECT().Event().Return(mintEvent("0x01", 1, 100)).Once()
+	transferIterator.EXPECT().Next().Return(false).Once()
+	transferIterator.EXPECT().Error().Return(nil).Once()
+	transferIterator.EXPECT().Close().Return(nil).Once()
+
+	expectOrder(mockDB, 1, models.MessageStatusBroadcasted)
+
+	assert.True(t, auditor.AuditBlocks(startBlockHeight, endBlockHeight))
+}
+
+func TestMintAuditorAuditBlocks_RangeError(t *testing.T) {
+	auditor, mockClient, mockOmniToken, mockMintController, _ := newTestMintAuditor(t)
+
+	mockMintController.EXPECT().FilterFulfillment(mock.MatchedBy(func(opts *bind.FilterOpts) bool {
+		return opts.Start == 1 && *opts.End == 100
+	}), mock.Anything).Return(nil, errors.New("query returned more than 10000 results")).Once()
+	mockClient.EXPECT().ReduceMaxQueryBlocks(uint64(49)).Once()
+
+	emptyFulfillments := func() eth.MintControllerFulfillmentIterator {
+		iterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+		iterator.EXPECT().Next().Return(false).Once()
+		iterator.EXPECT().Error().Return(nil).Once()
+		iterator.EXPECT().Close().Return(nil).Once()
+		return iterator
+	}
+	emptyTransfers := func() eth.OmniTokenTransferIterator {
+		iterator := clientMocks.NewMockOmniTokenTransferIterator(t)
+		iterator.EXPECT().Next().Return(false).Once()
+		iterator.EXPECT().Error().Return(nil).Once()
+		iterator.EXPECT().Close().Return(nil).Once()
+		return iterator
+	}
+
+	for _, r := range [][2]uint64{{1, 50}, {51, 100}} {
+		start, end := r[0], r[1]
+		matchRange := mock.MatchedBy(func(opts *bind.FilterOpts) bool {
+			return opts.Start == start && *opts.End == end
+		})
+		mockMintController.EXPECT().FilterFulfillment(matchRange, mock.Anything).Return(emptyFulfillments(), nil).Once()
+		mockOmniToken.EXPECT().FilterTransfer(matchRange, mock.Anything, mock.Anything).Return(emptyTransfers(), nil).Once()
+	}
+
+	assert.True(t, auditor.AuditBlocks(1, 100))
+}
+
+func TestMintAuditorAuditBlocks_Error(t *testing.T) {
+	auditor, _, mockOmniToken, mockMintController, _ := newTestMintAuditor(t)
+
+	fulfillmentIterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+	fulfillmentIterator.EXPECT().Next().Return(false).Once()
+	fulfillmentIterator.EXPECT().Error().Return(nil).Once()
+	fulfillmentIterator.EXPECT().Close().Return(nil).Once()
+	mockMintController.EXPECT().FilterFulfillment(mock.Anything, mock.Anything).Return(fulfillmentIterator, nil).Once()
+
+	mockOmniToken.EXPECT().FilterTransfer(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+
+	assert.False(t, auditor.AuditBlocks(1, 100))
+}
+
+func TestMintAuditorAuditNewBlocks(t *testing.T) {
+	auditor, mockClient, mockOmniToken, mockMintController, mockDB := newTestMintAuditor(t)
+	auditor.startBlockHeight = 10
+	auditor.currentBlockHeight = 42
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(20))
+
+	for _, r := range [][2]uint64{{10, 30}, {30, 40}} {
+		start, end := r[0], r[1]
+		matchRange := mock.MatchedBy(func(opts *bind.FilterOpts) bool {
+			return opts.Start == start && *opts.End == end
+		})
+
+		fulfillmentIterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+		fulfillmentIterator.EXPECT().Next().Return(false).Once()
+		fulfillmentIterator.EXPECT().Error().Return(nil).Once()
+		fulfillmentIterator.EXPECT().Close().Return(nil).Once()
+		mockMintController.EXPECT().FilterFulfillment(matchRange, mock.Anything).Return(fulfillmentIterator, nil).Once()
+
+		transferIterator := clientMocks.NewMockOmniTokenTransferIterator(t)
+		transferIterator.EXPECT().Next().Return(false).Once()
+		transferIterator.EXPECT().Error().Return(nil).Once()
+		transferIterator.EXPECT().Close().Return(nil).Once()
+		mockOmniToken.EXPECT().FilterTransfer(matchRange, mock.Anything, mock.Anything).Return(transferIterator, nil).Once()
+
+		mockDB.EXPECT().UpsertCheckpoint(auditor.chain, models.CheckpointRunnerMintAuditor, end).Return(nil).Once()
+	}
+
+	assert.True(t, auditor.AuditNewBlocks())
+	assert.Equal(t, uint64(40), auditor.startBlockHeight)
+
+	// nothing new is confirmed
+	assert.True(t, auditor.AuditNewBlocks())
+}
+
+func TestMintAuditorAuditNewBlocks_CheckpointError(t *testing.T) {
+	auditor, mockClient, mockOmniToken, mockMintController, mockDB := newTestMintAuditor(t)
+	auditor.startBlockHeight = 10
+	auditor.currentBlockHeight = 22
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(100))
+
+	fulfillmentIterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+	fulfillmentIterator.EXPECT().Next().Return(false).Once()
+	fulfillmentIterator.EXPECT().Error().Return(nil).Once()
+	fulfillmentIterator.EXPECT().Close().Return(nil).Once()
+	mockMintController.EXPECT().FilterFulfillment(mock.Anything, mock.Anything).Return(fulfillmentIterator, nil).Once()
+
+	transferIterator := clientMocks.NewMockOmniTokenTransferIterator(t)
+	transferIterator.EXPECT().Next().Return(false).Once()
+	transferIterator.EXPECT().Error().Return(nil).Once()
+	transferIterator.EXPECT().Close().Return(nil).Once()
+	mockOmniToken.EXPECT().FilterTransfer(mock.Anything, mock.Anything, mock.Anything).Return(transferIterator, nil).Once()
+
+	mockDB.EXPECT().UpsertCheckpoint(auditor.chain, models.CheckpointRunnerMintAuditor, uint64(20)).Return(errors.New("error")).Once()
+
+	assert.False(t, auditor.AuditNewBlocks())
+	assert.Equal(t, uint64(10), auditor.startBlockHeight)
+}
+
+func TestMintAuditorInitStartBlockHeight(t *testing.T) {
+	auditor, _, _, _, mockDB := newTestMintAuditor(t)
+	auditor.currentBlockHeight = 100
+
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMintAuditor).Return(&models.Checkpoint{BlockHeight: 50}, nil).Once()
+	auditor.InitStartBlockHeight()
+	assert.Equal(t, uint64(50), auditor.startBlockHeight)
+
+	auditor.startBlockHeight = 0
+	mockDB.EXPECT().FindCheckpoint(auditor.chain, models.CheckpointRunnerMintAuditor).Return(nil, nil).Once()
+	auditor.InitStartBlockHeight()
+	assert.Equal(t, uint64(100), auditor.startBlockHeight)
+}
+
+func TestNewMintAuditor(t *testing.T) {
+	config := models.EthereumNetworkConfig{
+		StartBlockHeight:      1,
+		Confirmations:         3,
+		ChainID:               1,
+		ChainName:             "Ethereum",
+		OmniTokenAddress:      "0x0000000000000000000000000000000000000010",
+		MintControllerAddress: "0x0000000000000000000000000000000000000020",
+		MintAuditor:           models.ServiceConfig{Enabled: true, IntervalMS: 1000},
+	}
+
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockOmniToken := clientMocks.NewMockOmniTokenContract(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	mockDB := mocks.NewMockDB(t)
+
+	mockClient.EXPECT().GetClient().Return(nil)
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	mockDB.EXPECT().FindCheckpoint(mock.Anything, models.CheckpointRunnerMintAuditor).Return(nil, nil).Once()
+
+	originalEthNewClient := ethNewClient
+	originalEthNewOmniTokenContract := ethNewOmniTokenContract
+	originalEthNewMintControllerContract := ethNewMintControllerContract
+	originalDBNewDB := dbNewDB
+	defer func() {
+		ethNewClient = originalEthNewClient
+		ethNewOmniTokenContract = originalEthNewOmniTokenContract
+		ethNewMintControllerContract = originalEthNewMintControllerContract
+		dbNewDB = originalDBNewDB
+	}()
+
+	ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+		return mockClient, nil
+	}
+	ethNewOmniTokenContract = func(address ethcommon.Address, _ bind.ContractBackend) (eth.OmniTokenContract, error) {
+		assert.Equal(t, ethcommon.HexToAddress(config.OmniTokenAddress), address)
+		return mockOmniToken, nil
+	}
+	ethNewMintControllerContract = func(address ethcommon.Address, _ bind.ContractBackend) (eth.MintControllerContract, error) {
+		assert.Equal(t, ethcommon.HexToAddress(config.MintControllerAddress), address)
+		return mockMintController, nil
+	}
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+
+	runnable := NewMintAuditor(config)
+
+	auditor, ok := runnable.(*EthMintAuditorRunnable)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(100), auditor.currentBlockHeight)
+	assert.Equal(t, uint64(1), auditor.startBlockHeight)
+	assert.Equal(t, uint64(3), auditor.confirmations)
+	assert.Equal(t, mockOmniToken, auditor.omniToken)
+	assert.Equal(t, mockMintController, auditor.mintController)
+}
+
+func TestNewMintAuditor_Disabled(t *testing.T) {
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+	assert.Panics(t, func() {
+		NewMintAuditor(models.EthereumNetworkConfig{ChainName: "Ethereum"})
+	})
+}
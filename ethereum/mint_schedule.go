@@ -0,0 +1,58 @@
+package ethereum
+
+import (
+	"math"
+	"math/big"
+	"time"
+)
+
+// MintSchedule estimates when queued mints can be fulfilled by the mint controller,
+// whose mint limit regenerates at mintPerSecond up to maxMintLimit
+type MintSchedule struct {
+	// limit is the mint limit available at time once the queued mints are fulfilled
+	limit *big.Int
+	time  time.Time
+
+	maxMintLimit  *big.Int
+	mintPerSecond *big.Int
+}
+
+// Reserve queues a mint of amount and returns the earliest time it can be fulfilled,
+// or false if the mint limit can never reach the amount
+func (s *MintSchedule) Reserve(amount *big.Int) (time.Time, bool) {
+	if s.maxMintLimit != nil && amount.Cmp(s.maxMintLimit) > 0 {
+		return time.Time{}, false
+	}
+
+	if s.limit.Cmp(amount) < 0 {
+		if s.mintPerSecond == nil || s.mintPerSecond.Sign() <= 0 {
+			return time.Time{}, false
+		}
+
+		// wait for the missing amount to regenerate, rounding up to whole seconds
+		missing := new(big.Int).Sub(amount, s.limit)
+		seconds := new(big.Int).Add(missing, new(big.Int).Sub(s.mintPerSecond, big.NewInt(1)))
+		seconds.Div(seconds, s.mintPerSecond)
+		if !seconds.IsInt64() || seconds.Int64() > int64(math.MaxInt64/time.Second) {
+			return time.Time{}, false
+		}
+
+		s.time = s.time.Add(time.Duration(seconds.Int64()) * time.Second)
+		s.limit.Add(s.limit, new(big.Int).Mul(seconds, s.mintPerSecond))
+		if s.maxMintLimit != nil && s.limit.Cmp(s.maxMintLimit) > 0 {
+			s.limit.Set(s.maxMintLimit)
+		}
+	}
+
+	s.limit.Sub(s.limit, amount)
+	return s.time, true
+}
+
+func NewMintSchedule(now time.Time, currentMintLimit *big.Int, maxMintLimit *big.Int, mintPerSecond *big.Int) *MintSchedule {
+	return &MintSchedule{
+		limit:         new(big.Int).Set(currentMintLimit),
+		time:          now,
+		maxMintLimit:  maxMintLimit,
+		mintPerSecond: mintPerSecond,
+	}
+}
@@ -0,0 +1,53 @@
+package ethereum
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMintScheduleReserve(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	schedule := NewMintSchedule(now, big.NewInt(500), big.NewInt(1000), big.NewInt(10))
+
+	fulfillableAt, ok := schedule.Reserve(big.NewInt(300))
+	assert.True(t, ok)
+	assert.Equal(t, now, fulfillableAt)
+
+	// 200 left, 100 more regenerate in 10 seconds
+	fulfillableAt, ok = schedule.Reserve(big.NewInt(300))
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(10*time.Second), fulfillableAt)
+
+	// partial seconds are rounded up
+	fulfillableAt, ok = schedule.Reserve(big.NewInt(5))
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(11*time.Second), fulfillableAt)
+	assert.Equal(t, "5", schedule.limit.String())
+}
+
+func TestMintScheduleReserve_CappedAtMaxMintLimit(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	schedule := NewMintSchedule(now, big.NewInt(0), big.NewInt(100), big.NewInt(30))
+
+	fulfillableAt, ok := schedule.Reserve(big.NewInt(100))
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(4*time.Second), fulfillableAt)
+	assert.Equal(t, "0", schedule.limit.String())
+}
+
+func TestMintScheduleReserve_Never(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	schedule := NewMintSchedule(now, big.NewInt(500), big.NewInt(1000), big.NewInt(10))
+	_, ok := schedule.Reserve(big.NewInt(1001))
+	assert.False(t, ok)
+	assert.Equal(t, "500", schedule.limit.String())
+
+	schedule = NewMintSchedule(now, big.NewInt(500), big.NewInt(1000), big.NewInt(0))
+	_, ok = schedule.Reserve(big.NewInt(600))
+	assert.False(t, ok)
+	assert.Equal(t, "500", schedule.limit.String())
+}
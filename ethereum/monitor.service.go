@@ -23,6 +23,8 @@ import (
 type EthMessageMonitorRunnable struct {
 	startBlockHeight   uint64
 	currentBlockHeight uint64
+	// finalizedBlockHeight is the height of the block at the finality tag of the client, if it has one
+	finalizedBlockHeight uint64
 
 	mintControllerMap map[uint32][]byte
 
@@ -33,6 +35,9 @@ type EthMessageMonitorRunnable struct {
 
 	chain models.Chain
 
+	// receipts holds the receipts prefetched for the batch of transactions being processed
+	receipts *eth.ReceiptCache
+
 	logger *log.Entry
 
 	db db.DB
@@ -41,6 +46,7 @@ type EthMessageMonitorRunnable struct {
 func (x *EthMessageMonitorRunnable) Run() {
 	x.UpdateCurrentBlockHeight()
 	x.SyncNewBlocks()
+	x.CheckForReorgs()
 	x.ConfirmDispatchTxs()
 	x.CreateMessagesForTxs()
 }
@@ -50,16 +56,18 @@ func (x *EthMessageMonitorRunnable) Height() uint64 {
 }
 
 func (x *EthMessageMonitorRunnable) UpdateCurrentBlockHeight() {
-	res, err := x.client.GetBlockHeight()
+	heights, err := eth.GetBlockHeights(x.client)
 	if err != nil {
 		x.logger.
 			WithError(err).
 			Error("could not get current block height")
 		return
 	}
-	x.currentBlockHeight = res
+	x.currentBlockHeight = heights.Current
+	x.finalizedBlockHeight = heights.Finalized
 	x.logger.
 		WithField("current_block_height", x.currentBlockHeight).
+		WithField("finalized_block_height", x.finalizedBlockHeight).
 		Info("updated current block height")
 }
 
@@ -67,7 +75,7 @@ func (x *EthMessageMonitorRunnable) UpdateTransaction(
 	tx *models.Transaction,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateTransaction(tx.ID, update)
+	err := x.db.UpdateTransaction(tx.ID, tx.Status, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating transaction")
 		return false
@@ -180,16 +188,30 @@ type ValidateTransactionAndParseDispatchEventsResult struct {
 	Events        []*autogen.MailboxDispatch
 	Confirmations uint64
 	TxStatus      models.TransactionStatus
+	StatusCode    models.StatusCode // cause of a failed or invalid status
+	StatusReason  string
+}
+
+// ProcessTxsInBatches prefetches the receipts of txs in batches and calls process for each transaction
+func (x *EthMessageMonitorRunnable) ProcessTxsInBatches(txs []models.Transaction, process func(txDoc *models.Transaction) bool) bool {
+	x.receipts = eth.NewReceiptCache()
+	defer func() { x.receipts = nil }()
+
+	return eth.ProcessInBatches(txs, func(batch []models.Transaction) {
+		prefetchTransactionReceipts(x.receipts, x.client, x.chain.ChainDomain, batch, x.logger)
+	}, process)
 }
 
 func (x *EthMessageMonitorRunnable) ValidateTransactionAndParseDispatchEvents(txHash string) (*ValidateTransactionAndParseDispatchEventsResult, error) {
-	receipt, err := x.client.GetTransactionReceipt(txHash)
+	receipt, err := x.receipts.GetTransactionReceipt(x.chain.ChainDomain, x.client, txHash)
 	if err != nil {
 		return nil, fmt.Errorf("error getting transaction receipt: %w", err)
 	}
 	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
 		return &ValidateTransactionAndParseDispatchEventsResult{
-			TxStatus: models.TransactionStatusFailed,
+			TxStatus:     models.TransactionStatusFailed,
+			StatusCode:   models.StatusCodeTxFailed,
+			StatusReason: "tx failed",
 		}, nil
 	}
 	var events []*autogen.MailboxDispatch
@@ -204,14 +226,16 @@ func (x *EthMessageMonitorRunnable) ValidateTransactionAndParseDispatchEvents(tx
 	}
 	result := &ValidateTransactionAndParseDispatchEventsResult{
 		Events:        events,
-		Confirmations: x.currentBlockHeight - receipt.BlockNumber.Uint64(),
+		Confirmations: eth.Confirmations(receipt.BlockNumber.Uint64(), x.currentBlockHeight),
 		TxStatus:      models.TransactionStatusPending,
 	}
-	if result.Confirmations >= x.confirmations {
+	if eth.IsBlockConfirmed(x.client, receipt.BlockNumber.Uint64(), x.currentBlockHeight, x.finalizedBlockHeight, x.confirmations) {
 		result.TxStatus = models.TransactionStatusConfirmed
 	}
 	if len(events) == 0 {
 		result.TxStatus = models.TransactionStatusInvalid
+		result.StatusCode = models.StatusCodeNoDispatchEvent
+		result.StatusReason = "tx has no dispatch events"
 	}
 	return result, nil
 }
@@ -228,10 +252,8 @@ func (x *EthMessageMonitorRunnable) ConfirmTx(txDoc *models.Transaction) bool {
 		return false
 	}
 
-	update := bson.M{
-		"confirmations": result.Confirmations,
-		"status":        result.TxStatus,
-	}
+	update := db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason)
+	update["confirmations"] = result.Confirmations
 	return x.UpdateTransaction(txDoc, update)
 }
 
@@ -252,7 +274,7 @@ func (x *EthMessageMonitorRunnable) CreateMessagesForTx(txDoc *models.Transactio
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
 		logger.Errorf("Transaction not confirmed")
-		x.UpdateTransaction(txDoc, bson.M{"status": result.TxStatus})
+		x.UpdateTransaction(txDoc, db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason))
 		return false
 	}
 
@@ -318,6 +340,13 @@ func (x *EthMessageMonitorRunnable) SyncBlocks(startBlockHeight uint64, endBlock
 	}
 
 	if err != nil {
+		if eth.IsRateLimitError(err) {
+			x.logger.WithError(err).Warn("Rate limited, retrying the block range on the next run")
+			return false
+		}
+		if eth.IsQueryRangeError(err) && endBlockHeight > startBlockHeight {
+			return x.SyncBlocksInHalves(startBlockHeight, endBlockHeight, err)
+		}
 		x.logger.WithError(err).Error("Error creating filter for dispatch events")
 		return false
 	}
@@ -351,33 +380,82 @@ func (x *EthMessageMonitorRunnable) SyncBlocks(startBlockHeight uint64, endBlock
 	return success
 }
 
+func (x *EthMessageMonitorRunnable) UpdateCheckpoint(blockHeight uint64) bool {
+	err := x.db.UpsertCheckpoint(x.chain, models.CheckpointRunnerMonitor, blockHeight)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating checkpoint")
+		return false
+	}
+	x.startBlockHeight = blockHeight
+	return true
+}
+
+// SyncBlocksInHalves splits a block range rejected by the endpoint in two and syncs each half,
+// remembering the smaller range for later queries
+func (x *EthMessageMonitorRunnable) SyncBlocksInHalves(startBlockHeight uint64, endBlockHeight uint64, err error) bool {
+	midBlockHeight := startBlockHeight + (endBlockHeight-startBlockHeight)/2
+	x.logger.WithError(err).Warnf("Block range %d to %d rejected, splitting at %d", startBlockHeight, endBlockHeight, midBlockHeight)
+	x.client.ReduceMaxQueryBlocks(midBlockHeight - startBlockHeight)
+
+	return x.SyncBlocks(startBlockHeight, midBlockHeight) && x.SyncBlocks(midBlockHeight+1, endBlockHeight)
+}
+
 func (x *EthMessageMonitorRunnable) SyncNewBlocks() bool {
 	if x.currentBlockHeight <= x.startBlockHeight {
 		x.logger.Infof("No new blocks to sync")
 		return true
 	}
 
-	success := true
-	if (x.currentBlockHeight - x.startBlockHeight) > eth.MaxQueryBlocks {
-		x.logger.Debug("Syncing blocks in chunks")
-		for i := x.startBlockHeight; i < x.currentBlockHeight; i += eth.MaxQueryBlocks {
-			endBlockHeight := i + eth.MaxQueryBlocks
-			if endBlockHeight > x.currentBlockHeight {
-				endBlockHeight = x.currentBlockHeight
-			}
-			x.logger.Info("Syncing blocks from blockNumber: ", i, " to blockNumber: ", endBlockHeight)
-			success = x.SyncBlocks(uint64(i), uint64(endBlockHeight)) && success
+	for x.startBlockHeight < x.currentBlockHeight {
+		// the chunk size shrinks whenever the endpoint rejects a range
+		endBlockHeight := x.startBlockHeight + x.client.MaxQueryBlocks()
+		if endBlockHeight > x.currentBlockHeight {
+			endBlockHeight = x.currentBlockHeight
+		}
+		x.logger.Info("Syncing blocks from blockNumber: ", x.startBlockHeight, " to blockNumber: ", endBlockHeight)
+		if !x.SyncBlocks(x.startBlockHeight, endBlockHeight) {
+			return false
+		}
+		// the checkpoint only moves past a chunk once it has been fully processed
+		if !x.UpdateCheckpoint(endBlockHeight) {
+			return false
 		}
-	} else {
-		x.logger.Info("Syncing blocks from blockNumber: ", x.startBlockHeight, " to blockNumber: ", x.currentBlockHeight)
-		success = x.SyncBlocks(uint64(x.startBlockHeight), uint64(x.currentBlockHeight)) && success
 	}
 
-	if success {
-		x.startBlockHeight = x.currentBlockHeight
+	return true
+}
+
+func (x *EthMessageMonitorRunnable) reorgChecker() *reorgChecker {
+	return &reorgChecker{client: x.client, db: x.db, logger: x.logger, handler: x}
+}
+
+// ReorgMessages stops the messages dispatched by a reorged transaction from being signed
+func (x *EthMessageMonitorRunnable) ReorgMessages(txDoc *models.Transaction) error {
+	filter := bson.M{
+		"origin_transaction": txDoc.ID,
+		"status": bson.M{"$in": []models.MessageStatus{
+			models.MessageStatusPending,
+			models.MessageStatusSigned,
+		}},
 	}
+	return x.db.UpdateReorgedMessages(filter, bson.M{"status": models.MessageStatusReorged})
+}
 
-	return success
+// RestoreMessages makes the messages of a transaction that was included again pending, to be signed once it is confirmed
+func (x *EthMessageMonitorRunnable) RestoreMessages(txDoc *models.Transaction) error {
+	filter := bson.M{
+		"origin_transaction": txDoc.ID,
+		"status":             models.MessageStatusReorged,
+	}
+	return x.db.UpdateReorgedMessages(filter, bson.M{"status": models.MessageStatusPending})
+}
+
+func (x *EthMessageMonitorRunnable) CheckTxForReorg(txDoc *models.Transaction) bool {
+	return x.reorgChecker().CheckTxForReorg(txDoc, x.currentBlockHeight)
+}
+
+func (x *EthMessageMonitorRunnable) CheckForReorgs() bool {
+	return x.reorgChecker().CheckForReorgs(x.chain, x.mailbox.Address().Bytes(), x.currentBlockHeight)
 }
 
 func (x *EthMessageMonitorRunnable) ConfirmDispatchTxs() bool {
@@ -389,12 +467,7 @@ func (x *EthMessageMonitorRunnable) ConfirmDispatchTxs() bool {
 		return false
 	}
 
-	success := true
-	for _, tx := range txs {
-		success = x.ConfirmTx(&tx) && success
-	}
-
-	return success
+	return x.ProcessTxsInBatches(txs, x.ConfirmTx)
 }
 
 func (x *EthMessageMonitorRunnable) CreateMessagesForTxs() bool {
@@ -406,20 +479,20 @@ func (x *EthMessageMonitorRunnable) CreateMessagesForTxs() bool {
 		return false
 	}
 
-	success := true
-	for _, tx := range txs {
-		success = x.CreateMessagesForTx(&tx) && success
-	}
-
-	return success
+	return x.ProcessTxsInBatches(txs, x.CreateMessagesForTx)
 }
 
-func (x *EthMessageMonitorRunnable) InitStartBlockHeight(lastHealth *models.RunnerServiceStatus) {
-	if lastHealth == nil || lastHealth.BlockHeight == 0 {
-		x.logger.Infof("Invalid last health")
+func (x *EthMessageMonitorRunnable) InitStartBlockHeight() {
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerMonitor)
+	if err != nil {
+		x.logger.WithError(err).Fatalf("Error finding checkpoint")
+		return
+	}
+	if checkpoint == nil {
+		x.logger.Infof("No checkpoint found")
 	} else {
-		x.logger.Debugf("Last block height: %d", lastHealth.BlockHeight)
-		x.startBlockHeight = lastHealth.BlockHeight
+		x.logger.Debugf("Checkpoint block height: %d", checkpoint.BlockHeight)
+		x.startBlockHeight = checkpoint.BlockHeight
 	}
 	if x.startBlockHeight == 0 || x.startBlockHeight > x.currentBlockHeight {
 		x.logger.Infof("Start block height is greater than current block height")
@@ -435,7 +508,6 @@ var dbNewDB = db.NewDB
 func NewMessageMonitor(
 	config models.EthereumNetworkConfig,
 	mintControllerMap map[uint32][]byte,
-	lastHealth *models.RunnerServiceStatus,
 ) service.Runnable {
 	logger := log.
 		WithField("module", "ethereum").
@@ -476,12 +548,12 @@ func NewMessageMonitor(
 
 		logger: logger,
 
-		db: dbNewDB(),
+		db: dbNewDB("monitor"),
 	}
 
 	x.UpdateCurrentBlockHeight()
 
-	x.InitStartBlockHeight(lastHealth)
+	x.InitStartBlockHeight()
 
 	logger.Infof("Initialized")
 
@@ -44,6 +44,7 @@ func TestMonitorHeight(t *testing.T) {
 func TestMonitorUpdateCurrentBlockHeight(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	monitor := &EthMessageMonitorRunnable{
@@ -89,7 +90,7 @@ func TestMonitorUpdateTransaction(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateTransaction(tx.ID, update).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, update).Return(nil)
 
 	result := monitor.UpdateTransaction(tx, update)
 
@@ -108,7 +109,7 @@ func TestMonitorUpdateTransaction_Error(t *testing.T) {
 		logger: logger,
 	}
 
-	mockDB.EXPECT().UpdateTransaction(tx.ID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, update).Return(assert.AnError)
 
 	result := monitor.UpdateTransaction(tx, update)
 
@@ -827,6 +828,7 @@ func TestValidateTransactionAndParseDispatchEvents_Failed(t *testing.T) {
 
 func TestValidateTransactionAndParseDispatchEvents(t *testing.T) {
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -855,6 +857,57 @@ func TestValidateTransactionAndParseDispatchEvents(t *testing.T) {
 	assert.Equal(t, models.TransactionStatusInvalid, result.TxStatus)
 }
 
+func TestValidateTransactionAndParseDispatchEvents_FinalityTag(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	mailbox.EXPECT().ParseDispatch(mock.Anything).Return(&autogen.MailboxDispatch{}, nil)
+	mailbox.EXPECT().Address().Return(ethcommon.Address{})
+	monitor := &EthMessageMonitorRunnable{
+		client:               mockClient,
+		logger:               logger,
+		mailbox:              mailbox,
+		currentBlockHeight:   200,
+		finalizedBlockHeight: 99,
+	}
+
+	receipt := &types.Receipt{
+		BlockNumber: big.NewInt(100),
+		Status:      types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{{
+			Address: ethcommon.Address{},
+		}},
+	}
+
+	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(receipt, nil)
+	mockClient.EXPECT().FinalityTag().Return(models.FinalityTagFinalized)
+
+	result, err := monitor.ValidateTransactionAndParseDispatchEvents("0x01")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, models.TransactionStatusPending, result.TxStatus)
+	assert.Equal(t, uint64(100), result.Confirmations)
+
+	// a block height read behind the receipt does not wrap around
+	monitor.currentBlockHeight = 90
+
+	result, err = monitor.ValidateTransactionAndParseDispatchEvents("0x01")
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), result.Confirmations)
+
+	monitor.currentBlockHeight = 200
+	monitor.finalizedBlockHeight = 100
+
+	result, err = monitor.ValidateTransactionAndParseDispatchEvents("0x01")
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, models.TransactionStatusConfirmed, result.TxStatus)
+}
+
 func TestConfirmTx_Nil(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
@@ -898,6 +951,7 @@ func TestConfirmTx_Failed(t *testing.T) {
 func TestConfirmTx(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -918,7 +972,7 @@ func TestConfirmTx(t *testing.T) {
 
 	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(receipt, nil)
 
-	mockDB.EXPECT().UpdateTransaction(tx.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, mock.Anything).Return(nil)
 
 	result := monitor.ConfirmTx(tx)
 
@@ -946,6 +1000,7 @@ func TestCreateMessagesForTx_Nil(t *testing.T) {
 func TestCreateMessagesForTx_Invalid(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -975,7 +1030,7 @@ func TestCreateMessagesForTx_Invalid(t *testing.T) {
 	}
 
 	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(receipt, nil)
-	mockDB.EXPECT().UpdateTransaction(tx.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, mock.Anything).Return(nil)
 
 	result := monitor.CreateMessagesForTx(tx)
 
@@ -1006,6 +1061,7 @@ func TestCreateMessagesForTx_ErrorValidating(t *testing.T) {
 func TestCreateMessagesForTx_LockError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1046,6 +1102,7 @@ func TestCreateMessagesForTx_LockError(t *testing.T) {
 func TestCreateMessagesForTx_NewError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1089,6 +1146,7 @@ func TestCreateMessagesForTx_NewError(t *testing.T) {
 func TestCreateMessagesForTx_InsertError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1133,6 +1191,7 @@ func TestCreateMessagesForTx_InsertError(t *testing.T) {
 func TestCreateMessagesForTx(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1166,7 +1225,7 @@ func TestCreateMessagesForTx(t *testing.T) {
 	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(tx.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, mock.Anything).Return(nil)
 
 	mockDB.EXPECT().NewMessage(tx, content, models.MessageStatusPending).Return(models.Message{}, nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
@@ -1552,6 +1611,124 @@ func TestMonitorSyncBlocks_Error(t *testing.T) {
 	assert.False(t, result)
 }
 
+func TestMonitorSyncBlocks_QueryRangeError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mintControllerMap := map[uint32][]byte{
+		1: ethcommon.FromHex("0x01"),
+	}
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	monitor := &EthMessageMonitorRunnable{
+		db:                mockDB,
+		client:            mockClient,
+		logger:            logger,
+		mintControllerMap: mintControllerMap,
+		chain: models.Chain{
+			ChainDomain: 1,
+		},
+		mailbox: mailbox,
+	}
+
+	mintControllerAddress := ethcommon.BytesToAddress(mintControllerMap[1])
+
+	startBlock := uint64(1)
+	endBlock := uint64(101)
+	midBlock := uint64(51)
+	secondStartBlock := uint64(52)
+
+	rangeErr := fmt.Errorf("query returned more than 10000 results")
+
+	mailbox.EXPECT().FilterDispatch(&bind.FilterOpts{
+		Start:   startBlock,
+		End:     &endBlock,
+		Context: context.Background(),
+	}, []ethcommon.Address{mintControllerAddress}, []uint32{}, [][32]byte{}).Return(nil, rangeErr).Once()
+
+	iterator := clientMocks.NewMockMailboxDispatchIterator(t)
+
+	mailbox.EXPECT().FilterDispatch(&bind.FilterOpts{
+		Start:   startBlock,
+		End:     &midBlock,
+		Context: context.Background(),
+	}, []ethcommon.Address{mintControllerAddress}, []uint32{}, [][32]byte{}).Return(iterator, nil).Once()
+	mailbox.EXPECT().FilterDispatch(&bind.FilterOpts{
+		Start:   secondStartBlock,
+		End:     &endBlock,
+		Context: context.Background(),
+	}, []ethcommon.Address{mintControllerAddress}, []uint32{}, [][32]byte{}).Return(iterator, nil).Once()
+
+	iterator.EXPECT().Next().Return(false)
+	iterator.EXPECT().Error().Return(nil)
+	iterator.EXPECT().Close().Return(nil)
+
+	mockClient.EXPECT().ReduceMaxQueryBlocks(uint64(50)).Return().Once()
+
+	result := monitor.SyncBlocks(startBlock, endBlock)
+
+	assert.True(t, result)
+}
+
+func TestMonitorSyncBlocks_QueryRangeError_SingleBlock(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mintControllerMap := map[uint32][]byte{
+		1: ethcommon.FromHex("0x01"),
+	}
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	monitor := &EthMessageMonitorRunnable{
+		db:                mockDB,
+		client:            mockClient,
+		logger:            logger,
+		mintControllerMap: mintControllerMap,
+		chain: models.Chain{
+			ChainDomain: 1,
+		},
+		mailbox: mailbox,
+	}
+
+	block := uint64(1)
+
+	mailbox.EXPECT().FilterDispatch(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("query returned more than 10000 results")).Once()
+
+	result := monitor.SyncBlocks(block, block)
+
+	assert.False(t, result)
+}
+
+func TestMonitorSyncBlocks_RateLimitError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mintControllerMap := map[uint32][]byte{
+		1: ethcommon.FromHex("0x01"),
+	}
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	monitor := &EthMessageMonitorRunnable{
+		db:                mockDB,
+		client:            mockClient,
+		logger:            logger,
+		mintControllerMap: mintControllerMap,
+		chain: models.Chain{
+			ChainDomain: 1,
+		},
+		mailbox: mailbox,
+	}
+
+	mailbox.EXPECT().FilterDispatch(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("429 Too Many Requests")).Once()
+
+	result := monitor.SyncBlocks(1, 100)
+
+	assert.False(t, result)
+}
+
 func TestMonitorSyncNewBlocks_NoNewBlocks(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
@@ -1624,9 +1801,65 @@ func TestMonitorSyncNewBlocks(t *testing.T) {
 	iterator.EXPECT().Error().Return(nil)
 	iterator.EXPECT().Close().Return(nil)
 
+	mockDB.EXPECT().UpsertCheckpoint(monitor.chain, models.CheckpointRunnerMonitor, endBlock).Return(nil).Once()
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
 	result := monitor.SyncNewBlocks()
 
 	assert.True(t, result)
+	assert.Equal(t, endBlock, monitor.startBlockHeight)
+}
+
+func TestMonitorSyncNewBlocks_CheckpointError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mintControllerMap := map[uint32][]byte{
+		1: ethcommon.FromHex("0x01"),
+	}
+
+	startBlock := uint64(1)
+	endBlock := uint64(100 + eth.MaxQueryBlocks)
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	monitor := &EthMessageMonitorRunnable{
+		db:                mockDB,
+		client:            mockClient,
+		logger:            logger,
+		mintControllerMap: mintControllerMap,
+		chain: models.Chain{
+			ChainDomain: 1,
+		},
+		mailbox:            mailbox,
+		currentBlockHeight: endBlock,
+		startBlockHeight:   startBlock,
+	}
+
+	iterator := clientMocks.NewMockMailboxDispatchIterator(t)
+
+	mintControllerAddress := ethcommon.BytesToAddress(mintControllerMap[1])
+
+	endBlockOne := startBlock + eth.MaxQueryBlocks
+
+	filterOne := &bind.FilterOpts{
+		Start:   startBlock,
+		End:     &endBlockOne,
+		Context: context.Background(),
+	}
+	mailbox.EXPECT().FilterDispatch(filterOne, []ethcommon.Address{mintControllerAddress}, []uint32{}, [][32]byte{}).Return(iterator, nil).Once()
+
+	iterator.EXPECT().Next().Return(false)
+	iterator.EXPECT().Error().Return(nil)
+	iterator.EXPECT().Close().Return(nil)
+
+	mockDB.EXPECT().UpsertCheckpoint(monitor.chain, models.CheckpointRunnerMonitor, endBlockOne).Return(assert.AnError).Once()
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
+	result := monitor.SyncNewBlocks()
+
+	assert.False(t, result)
+	assert.Equal(t, startBlock, monitor.startBlockHeight)
 }
 
 func TestMonitorSyncNewBlocks_MaxQueryBlocks(t *testing.T) {
@@ -1679,14 +1912,393 @@ func TestMonitorSyncNewBlocks_MaxQueryBlocks(t *testing.T) {
 	iterator.EXPECT().Error().Return(nil)
 	iterator.EXPECT().Close().Return(nil)
 
+	mockDB.EXPECT().UpsertCheckpoint(monitor.chain, models.CheckpointRunnerMonitor, endBlockOne).Return(nil).Once()
+	mockDB.EXPECT().UpsertCheckpoint(monitor.chain, models.CheckpointRunnerMonitor, endBlock).Return(nil).Once()
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
 	result := monitor.SyncNewBlocks()
 
 	assert.True(t, result)
 }
 
+func TestMonitorCheckTxForReorg_Nil(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	result := monitor.CheckTxForReorg(nil)
+
+	assert.False(t, result)
+}
+
+func TestMonitorCheckTxForReorg_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return nil, assert.AnError
+	}
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.False(t, result)
+}
+
+func TestMonitorCheckTxForReorg_NotReorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{}, nil
+	}
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_Moved(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 110,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+	blockHash := ethcommon.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(101), BlockHash: blockHash},
+		}, nil
+	}
+
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"block_height":  uint64(101),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(9),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_MovedAhead(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 100,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+	blockHash := ethcommon.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(101), BlockHash: blockHash},
+		}, nil
+	}
+
+	// the block is ahead of the last read height
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"block_height":  uint64(101),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(0),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_StillReorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01", Status: models.TransactionStatusReorged}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_Reincluded(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 110,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01", Status: models.TransactionStatusReorged}
+	blockHash := ethcommon.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(105), BlockHash: blockHash},
+		}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"block_height":  uint64(105),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(5),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil)
+	mockDB.EXPECT().UpdateReorgedMessages(bson.M{
+		"origin_transaction": tx.ID,
+		"status":             models.MessageStatusReorged,
+	}, bson.M{"status": models.MessageStatusPending}).Return(nil)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_BlockCanonicalAgain(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01", Status: models.TransactionStatusReorged}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: false}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{"status": models.TransactionStatusPending}).Return(nil)
+	mockDB.EXPECT().UpdateReorgedMessages(bson.M{
+		"origin_transaction": tx.ID,
+		"status":             models.MessageStatusReorged,
+	}, bson.M{"status": models.MessageStatusPending}).Return(nil)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_LockError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("", assert.AnError)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.False(t, result)
+}
+
+func TestMonitorCheckTxForReorg_Reorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{"status": models.TransactionStatusReorged}).Return(nil)
+	mockDB.EXPECT().UpdateReorgedMessages(bson.M{
+		"origin_transaction": tx.ID,
+		"status": bson.M{"$in": []models.MessageStatus{
+			models.MessageStatusPending,
+			models.MessageStatusSigned,
+		}},
+	}, bson.M{"status": models.MessageStatusReorged}).Return(nil)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckTxForReorg_UpdateMessagesError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{"status": models.TransactionStatusReorged}).Return(nil)
+	mockDB.EXPECT().UpdateReorgedMessages(mock.Anything, mock.Anything).Return(assert.AnError)
+
+	result := monitor.CheckTxForReorg(tx)
+
+	assert.False(t, result)
+}
+
+func TestMonitorCheckForReorgs(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		mailbox:            mailbox,
+		currentBlockHeight: eth.MaxReorgDepth + 100,
+	}
+
+	tx := models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{}, nil
+	}
+
+	mailbox.EXPECT().Address().Return(ethcommon.Address{})
+	mockDB.EXPECT().GetRecentTransactionsTo(mock.Anything, mock.Anything, uint64(100)).Return([]models.Transaction{tx}, nil)
+
+	result := monitor.CheckForReorgs()
+
+	assert.True(t, result)
+}
+
+func TestMonitorCheckForReorgs_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		mailbox:            mailbox,
+		currentBlockHeight: 100,
+	}
+
+	mailbox.EXPECT().Address().Return(ethcommon.Address{})
+	mockDB.EXPECT().GetRecentTransactionsTo(mock.Anything, mock.Anything, uint64(0)).Return(nil, assert.AnError)
+
+	result := monitor.CheckForReorgs()
+
+	assert.False(t, result)
+}
+
 func TestConfirmDispatchTxs(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1700,10 +2312,12 @@ func TestConfirmDispatchTxs(t *testing.T) {
 	tx := models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
 
 	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{tx}, nil)
-	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(&types.Receipt{
-		BlockNumber: big.NewInt(100),
-		Status:      types.ReceiptStatusSuccessful}, nil)
-	mockDB.EXPECT().UpdateTransaction(tx.ID, mock.Anything).Return(nil)
+	mockClient.EXPECT().GetTransactionReceipts([]string{"0x01"}).Return(map[ethcommon.Hash]*types.Receipt{
+		ethcommon.HexToHash("0x01"): {
+			BlockNumber: big.NewInt(100),
+			Status:      types.ReceiptStatusSuccessful},
+	}, nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, mock.Anything).Return(nil)
 	mailbox.EXPECT().Address().Return(ethcommon.Address{})
 
 	result := monitor.ConfirmDispatchTxs()
@@ -1758,6 +2372,7 @@ func TestCreateMessagesForTxs_Error(t *testing.T) {
 func TestCreateMessagesForTxs(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1788,12 +2403,13 @@ func TestCreateMessagesForTxs(t *testing.T) {
 		},
 	}
 
+	mockClient.EXPECT().GetTransactionReceipts([]string{"0x01"}).Return(map[ethcommon.Hash]*types.Receipt{}, nil)
 	mockClient.EXPECT().GetTransactionReceipt("0x01").Return(receipt, nil)
 
 	mockDB.EXPECT().LockWriteTransaction(&tx).Return("lock-id", nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(tx.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, mock.Anything).Return(nil)
 
 	mockDB.EXPECT().NewMessage(&tx, content, models.MessageStatusPending).Return(models.Message{}, nil)
 	mockDB.EXPECT().InsertMessage(mock.Anything).Return(primitive.ObjectID{}, nil)
@@ -1804,41 +2420,62 @@ func TestCreateMessagesForTxs(t *testing.T) {
 }
 
 func TestMonitorInitStartBlockHeight(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
 	logger := log.New().WithField("test", "monitor")
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
 
 	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
 
-	monitor.InitStartBlockHeight(lastHealth)
-
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 100}, nil).Once()
+	monitor.InitStartBlockHeight()
 	assert.Equal(t, uint64(100), monitor.startBlockHeight)
 
 	monitor = &EthMessageMonitorRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = nil
-	monitor.InitStartBlockHeight(lastHealth)
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(nil, nil).Once()
+	monitor.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), monitor.startBlockHeight)
 
 	monitor = &EthMessageMonitorRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = &models.RunnerServiceStatus{BlockHeight: 300}
-	monitor.InitStartBlockHeight(lastHealth)
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 300}, nil).Once()
+	monitor.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), monitor.startBlockHeight)
 }
 
+func TestMonitorInitStartBlockHeight_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "monitor")
+	logger.Logger.ExitFunc = func(int) {}
+
+	monitor := &EthMessageMonitorRunnable{
+		db:                 mockDB,
+		logger:             logger,
+		startBlockHeight:   10,
+		currentBlockHeight: 200,
+	}
+
+	mockDB.EXPECT().FindCheckpoint(monitor.chain, models.CheckpointRunnerMonitor).Return(nil, assert.AnError).Once()
+	monitor.InitStartBlockHeight()
+	assert.Equal(t, uint64(10), monitor.startBlockHeight)
+}
+
 func TestMonitorRun(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "monitor")
 
 	mailbox := clientMocks.NewMockMailboxContract(t)
@@ -1850,10 +2487,12 @@ func TestMonitorRun(t *testing.T) {
 	}
 
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
+	mockDB.EXPECT().GetRecentTransactionsTo(mock.Anything, mock.Anything, uint64(0)).Return([]models.Transaction{}, nil)
 	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
 	mockDB.EXPECT().GetConfirmedTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
 	mailbox.EXPECT().Address().Return(ethcommon.Address{})
 
+	mockClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
 	monitor.Run()
 
 	mockClient.AssertExpectations(t)
@@ -1863,6 +2502,7 @@ func TestMonitorRun(t *testing.T) {
 func TestNewMessageMonitor(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	mockMailbox := clientMocks.NewMockMailboxContract(t)
 
 	mintControllerMap := map[uint32][]byte{
@@ -1870,8 +2510,6 @@ func TestNewMessageMonitor(t *testing.T) {
 		2: ethcommon.FromHex("0x02"),
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 50}
-
 	config := models.EthereumNetworkConfig{
 		ChainID:          1,
 		ChainName:        "test",
@@ -1891,7 +2529,7 @@ func TestNewMessageMonitor(t *testing.T) {
 		return mockMailbox, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1905,7 +2543,9 @@ func TestNewMessageMonitor(t *testing.T) {
 
 	mockClient.EXPECT().GetClient().Return(nil)
 
-	runnable := NewMessageMonitor(config, mintControllerMap, lastHealth)
+	mockDB.EXPECT().FindCheckpoint(utilParseChain(config), models.CheckpointRunnerMonitor).Return(&models.Checkpoint{BlockHeight: 50}, nil)
+
+	runnable := NewMessageMonitor(config, mintControllerMap)
 
 	assert.NotNil(t, runnable)
 
@@ -1930,8 +2570,6 @@ func TestNewMessageMonitorFailures(t *testing.T) {
 		2: ethcommon.FromHex("0x02"),
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 50}
-
 	config := models.EthereumNetworkConfig{
 		ChainID:          1,
 		ChainName:        "test",
@@ -1951,7 +2589,7 @@ func TestNewMessageMonitorFailures(t *testing.T) {
 		return mockMailbox, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1970,7 +2608,7 @@ func TestNewMessageMonitorFailures(t *testing.T) {
 		config.MessageMonitor.Enabled = false
 
 		assert.Panics(t, func() {
-			NewMessageMonitor(config, mintControllerMap, lastHealth)
+			NewMessageMonitor(config, mintControllerMap)
 		})
 
 		config.MessageMonitor.Enabled = true
@@ -1983,7 +2621,7 @@ func TestNewMessageMonitorFailures(t *testing.T) {
 		}
 
 		assert.Panics(t, func() {
-			NewMessageMonitor(config, mintControllerMap, lastHealth)
+			NewMessageMonitor(config, mintControllerMap)
 		})
 
 		ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
@@ -1999,7 +2637,7 @@ func TestNewMessageMonitorFailures(t *testing.T) {
 		}
 
 		assert.Panics(t, func() {
-			NewMessageMonitor(config, mintControllerMap, lastHealth)
+			NewMessageMonitor(config, mintControllerMap)
 		})
 
 		ethNewMailboxContract = func(ethcommon.Address, bind.ContractBackend) (eth.MailboxContract, error) {
@@ -2009,3 +2647,46 @@ func TestNewMessageMonitorFailures(t *testing.T) {
 	})
 
 }
+
+func TestMonitorProcessTxsInBatches(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "monitor")
+
+	monitor := &EthMessageMonitorRunnable{
+		client: mockClient,
+		logger: logger,
+	}
+
+	txs := make([]models.Transaction, eth.MaxBatchSize+1)
+	for i := range txs {
+		txs[i].Hash = fmt.Sprintf("0x%x", i+1)
+	}
+
+	batchSizes := []int{}
+	ethFetchTransactionReceipts = func(client eth.EthereumClient, txDocs []models.Transaction) (map[ethcommon.Hash]*types.Receipt, error) {
+		batchSizes = append(batchSizes, len(txDocs))
+		if len(batchSizes) == 2 {
+			return nil, assert.AnError
+		}
+		return map[ethcommon.Hash]*types.Receipt{ethcommon.HexToHash(txDocs[0].Hash): {}}, nil
+	}
+	defer func() { ethFetchTransactionReceipts = FetchTransactionReceipts }()
+
+	// the last tx falls back to a single lookup since prefetching its batch failed
+	mockClient.EXPECT().GetTransactionReceipt(txs[eth.MaxBatchSize].Hash).Return(&types.Receipt{}, nil).Once()
+
+	processed := 0
+	result := monitor.ProcessTxsInBatches(txs, func(txDoc *models.Transaction) bool {
+		processed++
+		if txDoc.Hash == txs[0].Hash || txDoc.Hash == txs[eth.MaxBatchSize].Hash {
+			_, err := monitor.receipts.GetTransactionReceipt(monitor.chain.ChainDomain, mockClient, txDoc.Hash)
+			assert.NoError(t, err)
+		}
+		return txDoc.Hash != txs[1].Hash
+	})
+
+	assert.False(t, result)
+	assert.Equal(t, len(txs), processed)
+	assert.Equal(t, []int{eth.MaxBatchSize, 1}, batchSizes)
+	assert.Nil(t, monitor.receipts)
+}
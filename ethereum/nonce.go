@@ -0,0 +1,32 @@
+package ethereum
+
+// NonceManager hands out the nonces of the fulfiller account
+type NonceManager struct {
+	next uint64
+}
+
+// Sync moves the next nonce past the pending nonce of the account and the nonces
+// of its transactions that are not mined yet, so they are never reused for new transactions
+func (m *NonceManager) Sync(pendingNonce uint64, inFlight []uint64) {
+	next := pendingNonce
+	for _, nonce := range inFlight {
+		if nonce >= next {
+			next = nonce + 1
+		}
+	}
+	m.next = next
+}
+
+// Next returns the nonce for a new transaction
+func (m *NonceManager) Next() uint64 {
+	nonce := m.next
+	m.next++
+	return nonce
+}
+
+// Release returns a nonce whose transaction was not sent, if no later nonce has been handed out
+func (m *NonceManager) Release(nonce uint64) {
+	if nonce+1 == m.next {
+		m.next = nonce
+	}
+}
@@ -0,0 +1,33 @@
+package ethereum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonceManager(t *testing.T) {
+	nonces := &NonceManager{}
+
+	nonces.Sync(5, nil)
+	assert.Equal(t, uint64(5), nonces.Next())
+	assert.Equal(t, uint64(6), nonces.Next())
+
+	// only the last nonce handed out can be released
+	nonces.Release(5)
+	assert.Equal(t, uint64(7), nonces.Next())
+	nonces.Release(7)
+	assert.Equal(t, uint64(7), nonces.Next())
+}
+
+func TestNonceManagerSync_InFlight(t *testing.T) {
+	nonces := &NonceManager{}
+
+	// the endpoint may not have seen the in-flight transactions
+	nonces.Sync(5, []uint64{5, 8, 6})
+	assert.Equal(t, uint64(9), nonces.Next())
+
+	// mined transactions are behind the pending nonce
+	nonces.Sync(10, []uint64{3})
+	assert.Equal(t, uint64(10), nonces.Next())
+}
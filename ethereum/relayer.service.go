@@ -2,13 +2,17 @@ package ethereum
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/core/types"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/dan13ram/wpokt-oracle/common"
 	"github.com/dan13ram/wpokt-oracle/db"
@@ -21,6 +25,8 @@ import (
 type EthMessageRelayerRunnable struct {
 	startBlockHeight   uint64
 	currentBlockHeight uint64
+	// finalizedBlockHeight is the height of the block at the finality tag of the client, if it has one
+	finalizedBlockHeight uint64
 
 	mintControllerMap map[uint32][]byte
 
@@ -31,6 +37,9 @@ type EthMessageRelayerRunnable struct {
 
 	chain models.Chain
 
+	// receipts holds the receipts prefetched for the batch of transactions being processed
+	receipts *eth.ReceiptCache
+
 	logger *log.Entry
 
 	db db.DB
@@ -39,6 +48,7 @@ type EthMessageRelayerRunnable struct {
 func (x *EthMessageRelayerRunnable) Run() {
 	x.UpdateCurrentBlockHeight()
 	x.SyncNewBlocks()
+	x.CheckForReorgs()
 	x.ConfirmFulfillmentTxs()
 	x.ConfirmMessages()
 }
@@ -48,16 +58,18 @@ func (x *EthMessageRelayerRunnable) Height() uint64 {
 }
 
 func (x *EthMessageRelayerRunnable) UpdateCurrentBlockHeight() {
-	res, err := x.client.GetBlockHeight()
+	heights, err := eth.GetBlockHeights(x.client)
 	if err != nil {
 		x.logger.
 			WithError(err).
 			Error("could not get current block height")
 		return
 	}
-	x.currentBlockHeight = res
+	x.currentBlockHeight = heights.Current
+	x.finalizedBlockHeight = heights.Finalized
 	x.logger.
 		WithField("current_block_height", x.currentBlockHeight).
+		WithField("finalized_block_height", x.finalizedBlockHeight).
 		Info("updated current block height")
 }
 
@@ -100,16 +112,30 @@ type ValidateTransactionAndParseFulfillmentEventsResult struct {
 	Events        []*autogen.MintControllerFulfillment
 	Confirmations uint64
 	TxStatus      models.TransactionStatus
+	StatusCode    models.StatusCode // cause of a failed or invalid status
+	StatusReason  string
+}
+
+// ProcessTxsInBatches prefetches the receipts of txs in batches and calls process for each transaction
+func (x *EthMessageRelayerRunnable) ProcessTxsInBatches(txs []models.Transaction, process func(txDoc *models.Transaction) bool) bool {
+	x.receipts = eth.NewReceiptCache()
+	defer func() { x.receipts = nil }()
+
+	return eth.ProcessInBatches(txs, func(batch []models.Transaction) {
+		prefetchTransactionReceipts(x.receipts, x.client, x.chain.ChainDomain, batch, x.logger)
+	}, process)
 }
 
 func (x *EthMessageRelayerRunnable) ValidateTransactionAndParseFulfillmentEvents(txHash string) (*ValidateTransactionAndParseFulfillmentEventsResult, error) {
-	receipt, err := x.client.GetTransactionReceipt(txHash)
+	receipt, err := x.receipts.GetTransactionReceipt(x.chain.ChainDomain, x.client, txHash)
 	if err != nil {
 		return nil, fmt.Errorf("error getting transaction receipt: %w", err)
 	}
 	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
 		return &ValidateTransactionAndParseFulfillmentEventsResult{
-			TxStatus: models.TransactionStatusFailed,
+			TxStatus:     models.TransactionStatusFailed,
+			StatusCode:   models.StatusCodeTxFailed,
+			StatusReason: "tx failed",
 		}, nil
 	}
 	var events []*autogen.MintControllerFulfillment
@@ -124,14 +150,16 @@ func (x *EthMessageRelayerRunnable) ValidateTransactionAndParseFulfillmentEvents
 	}
 	result := &ValidateTransactionAndParseFulfillmentEventsResult{
 		Events:        events,
-		Confirmations: x.currentBlockHeight - receipt.BlockNumber.Uint64(),
+		Confirmations: eth.Confirmations(receipt.BlockNumber.Uint64(), x.currentBlockHeight),
 		TxStatus:      models.TransactionStatusPending,
 	}
-	if result.Confirmations >= x.confirmations {
+	if eth.IsBlockConfirmed(x.client, receipt.BlockNumber.Uint64(), x.currentBlockHeight, x.finalizedBlockHeight, x.confirmations) {
 		result.TxStatus = models.TransactionStatusConfirmed
 	}
 	if len(events) == 0 {
 		result.TxStatus = models.TransactionStatusInvalid
+		result.StatusCode = models.StatusCodeNoFulfillmentEvent
+		result.StatusReason = "tx has no fulfillment events"
 	}
 	return result, nil
 }
@@ -140,7 +168,7 @@ func (x *EthMessageRelayerRunnable) UpdateTransaction(
 	tx *models.Transaction,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateTransaction(tx.ID, update)
+	err := x.db.UpdateTransaction(tx.ID, tx.Status, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating transaction")
 		return false
@@ -160,10 +188,8 @@ func (x *EthMessageRelayerRunnable) ConfirmFulfillmentTx(txDoc *models.Transacti
 		return false
 	}
 
-	update := bson.M{
-		"confirmations": result.Confirmations,
-		"status":        result.TxStatus,
-	}
+	update := db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason)
+	update["confirmations"] = result.Confirmations
 	return x.UpdateTransaction(txDoc, update)
 }
 
@@ -183,7 +209,7 @@ func (x *EthMessageRelayerRunnable) ConfirmMessagesForTx(txDoc *models.Transacti
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
 		logger.Errorf("Transaction not confirmed")
-		x.UpdateTransaction(txDoc, bson.M{"status": result.TxStatus})
+		x.UpdateTransaction(txDoc, db.StatusUpdate(result.TxStatus, result.StatusCode, result.StatusReason))
 		return false
 	}
 
@@ -204,7 +230,22 @@ func (x *EthMessageRelayerRunnable) ConfirmMessagesForTx(txDoc *models.Transacti
 	success := true
 
 	for _, event := range result.Events {
-		docID, err := x.db.UpdateMessageByMessageID(event.OrderId, update)
+		messageDoc, ok := x.CheckFulfilledMessage(event)
+		if !ok {
+			success = false
+			continue
+		}
+		if messageDoc == nil {
+			// recorded as an unknown order by CheckFulfilledMessage
+			continue
+		}
+		// the message is only updated if it still has the status it was checked with
+		docID, err := x.db.UpdateMessageByMessageID(event.OrderId, messageDoc.Status, update)
+		if errors.Is(err, db.ErrStatusTransition) {
+			// an invalid or reorged message stays final, the fulfillment is recorded as an unsigned order
+			logger.WithError(err).Warn("Message cannot be marked as fulfilled")
+			continue
+		}
 		if err != nil {
 			logger.WithError(err).Errorf("Error updating message")
 			success = false
@@ -220,6 +261,83 @@ func (x *EthMessageRelayerRunnable) ConfirmMessagesForTx(txDoc *models.Transacti
 	return x.UpdateTransaction(txDoc, bson.M{"messages": common.RemoveDuplicates(txDoc.Messages)})
 }
 
+// CheckFulfilledMessage records an anomaly if the order of a fulfillment event matches no message,
+// or a message that the oracles never signed. It returns the message of the order, nil for an unknown order,
+// and false only if the check could not be made
+func (x *EthMessageRelayerRunnable) CheckFulfilledMessage(event *autogen.MintControllerFulfillment) (*models.Message, bool) {
+	orderID := common.Ensure0xPrefix(common.HexFromBytes(event.OrderId[:]))
+	logger := x.logger.WithField("order_id", orderID).WithField("tx_hash", event.Raw.TxHash.Hex()).WithField("section", "CheckFulfilledMessage")
+
+	messageDoc, err := x.db.FindMessage(bson.M{"message_id": orderID})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, x.RecordAnomaly(event, models.AnomalyTypeUnknownOrder, nil)
+	}
+	if err != nil {
+		logger.WithError(err).Error("Error finding message")
+		return nil, false
+	}
+
+	if isSignedMessage(&messageDoc) {
+		return &messageDoc, true
+	}
+	return &messageDoc, x.RecordAnomaly(event, models.AnomalyTypeUnsignedOrder, &messageDoc)
+}
+
+// isSignedMessage reports whether the oracles may have signed the message with enough signatures to fulfill it.
+// Held messages can carry threshold signatures, and a pending message only has a simulation error
+// once its signatures met the threshold
+func isSignedMessage(messageDoc *models.Message) bool {
+	switch messageDoc.Status {
+	case models.MessageStatusSigned,
+		models.MessageStatusBroadcasted,
+		models.MessageStatusSuccess,
+		models.MessageStatusDestinationPaused,
+		models.MessageStatusOverLimit:
+		return true
+	case models.MessageStatusPending:
+		return messageDoc.SimulationError != ""
+	}
+	return false
+}
+
+// RecordAnomaly stores a fulfillment event that the oracles did not expect and raises an alert
+func (x *EthMessageRelayerRunnable) RecordAnomaly(event *autogen.MintControllerFulfillment, anomalyType models.AnomalyType, messageDoc *models.Message) bool {
+	anomaly := models.Anomaly{
+		Chain:           x.chain,
+		Type:            anomalyType,
+		Contract:        strings.ToLower(event.Raw.Address.Hex()),
+		TransactionHash: strings.ToLower(event.Raw.TxHash.Hex()),
+		BlockHeight:     event.Raw.BlockNumber,
+		LogIndex:        uint64(event.Raw.Index),
+		OrderID:         common.Ensure0xPrefix(common.HexFromBytes(event.OrderId[:])),
+		EventMessage:    common.Ensure0xPrefix(common.HexFromBytes(event.Message)),
+		CreatedAt:       time.Now(),
+	}
+
+	var content models.MessageContent
+	if err := content.DecodeFromBytes(event.Message); err == nil {
+		anomaly.Content = &content
+	}
+	if messageDoc != nil {
+		anomaly.Message = messageDoc.ID
+		anomaly.MessageStatus = messageDoc.Status
+	}
+
+	logger := x.logger.
+		WithField("alert", "anomaly").
+		WithField("anomaly_type", anomaly.Type).
+		WithField("order_id", anomaly.OrderID).
+		WithField("tx_hash", anomaly.TransactionHash).
+		WithField("message_status", anomaly.MessageStatus)
+	logger.Error("Fulfillment of an order that the oracles did not sign")
+
+	if err := x.db.InsertAnomaly(anomaly); err != nil {
+		logger.WithError(err).Error("Error inserting anomaly")
+		return false
+	}
+	return true
+}
+
 func (x *EthMessageRelayerRunnable) SyncBlocks(startBlockHeight uint64, endBlockHeight uint64) bool {
 	filter, err := x.mintController.FilterFulfillment(&bind.FilterOpts{
 		Start:   startBlockHeight,
@@ -232,6 +350,13 @@ func (x *EthMessageRelayerRunnable) SyncBlocks(startBlockHeight uint64, endBlock
 	}
 
 	if err != nil {
+		if eth.IsRateLimitError(err) {
+			x.logger.WithError(err).Warn("Rate limited, retrying the block range on the next run")
+			return false
+		}
+		if eth.IsQueryRangeError(err) && endBlockHeight > startBlockHeight {
+			return x.SyncBlocksInHalves(startBlockHeight, endBlockHeight, err)
+		}
 		x.logger.WithError(err).Error("Error creating filter for fulfillment events")
 		return false
 	}
@@ -265,33 +390,88 @@ func (x *EthMessageRelayerRunnable) SyncBlocks(startBlockHeight uint64, endBlock
 	return success
 }
 
+func (x *EthMessageRelayerRunnable) UpdateCheckpoint(blockHeight uint64) bool {
+	err := x.db.UpsertCheckpoint(x.chain, models.CheckpointRunnerRelayer, blockHeight)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating checkpoint")
+		return false
+	}
+	x.startBlockHeight = blockHeight
+	return true
+}
+
+// SyncBlocksInHalves splits a block range rejected by the endpoint in two and syncs each half,
+// remembering the smaller range for later queries
+func (x *EthMessageRelayerRunnable) SyncBlocksInHalves(startBlockHeight uint64, endBlockHeight uint64, err error) bool {
+	midBlockHeight := startBlockHeight + (endBlockHeight-startBlockHeight)/2
+	x.logger.WithError(err).Warnf("Block range %d to %d rejected, splitting at %d", startBlockHeight, endBlockHeight, midBlockHeight)
+	x.client.ReduceMaxQueryBlocks(midBlockHeight - startBlockHeight)
+
+	return x.SyncBlocks(startBlockHeight, midBlockHeight) && x.SyncBlocks(midBlockHeight+1, endBlockHeight)
+}
+
 func (x *EthMessageRelayerRunnable) SyncNewBlocks() bool {
 	if x.currentBlockHeight <= x.startBlockHeight {
 		x.logger.Infof("No new blocks to sync")
 		return true
 	}
 
-	success := true
-	if (x.currentBlockHeight - x.startBlockHeight) > eth.MaxQueryBlocks {
-		x.logger.Debug("Syncing blocks in chunks")
-		for i := x.startBlockHeight; i < x.currentBlockHeight; i += eth.MaxQueryBlocks {
-			endBlockHeight := i + eth.MaxQueryBlocks
-			if endBlockHeight > x.currentBlockHeight {
-				endBlockHeight = x.currentBlockHeight
-			}
-			x.logger.Info("Syncing blocks from blockNumber: ", i, " to blockNumber: ", endBlockHeight)
-			success = x.SyncBlocks(uint64(i), uint64(endBlockHeight)) && success
+	for x.startBlockHeight < x.currentBlockHeight {
+		// the chunk size shrinks whenever the endpoint rejects a range
+		endBlockHeight := x.startBlockHeight + x.client.MaxQueryBlocks()
+		if endBlockHeight > x.currentBlockHeight {
+			endBlockHeight = x.currentBlockHeight
+		}
+		x.logger.Info("Syncing blocks from blockNumber: ", x.startBlockHeight, " to blockNumber: ", endBlockHeight)
+		if !x.SyncBlocks(x.startBlockHeight, endBlockHeight) {
+			return false
+		}
+		// the checkpoint only moves past a chunk once it has been fully processed
+		if !x.UpdateCheckpoint(endBlockHeight) {
+			return false
 		}
-	} else {
-		x.logger.Info("Syncing blocks from blockNumber: ", x.startBlockHeight, " to blockNumber: ", x.currentBlockHeight)
-		success = x.SyncBlocks(uint64(x.startBlockHeight), uint64(x.currentBlockHeight)) && success
 	}
 
-	if success {
-		x.startBlockHeight = x.currentBlockHeight
+	return true
+}
+
+func (x *EthMessageRelayerRunnable) reorgChecker() *reorgChecker {
+	return &reorgChecker{
+		client:  x.client,
+		db:      x.db,
+		logger:  x.logger,
+		handler: x,
+		// the messages are moved back to signed, so they are confirmed again if the transaction is included again
+		reorgUpdate: bson.M{"messages": []primitive.ObjectID{}},
 	}
+}
 
-	return success
+// ReorgMessages moves the messages fulfilled by a reorged transaction back to signed, since the orders are no longer fulfilled
+func (x *EthMessageRelayerRunnable) ReorgMessages(txDoc *models.Transaction) error {
+	filter := bson.M{
+		"transaction": txDoc.ID,
+		"status":      models.MessageStatusSuccess,
+	}
+	update := bson.M{
+		"status":           models.MessageStatusSigned,
+		"transaction":      nil,
+		"transaction_hash": "",
+	}
+	return x.db.UpdateReorgedMessages(filter, update)
+}
+
+// RestoreMessages leaves the messages of a fulfillment that was included again as they are.
+// The reorg cleared the messages of the transaction, so ConfirmMessages marks them successful again once it is confirmed
+func (x *EthMessageRelayerRunnable) RestoreMessages(txDoc *models.Transaction) error {
+	return nil
+}
+
+func (x *EthMessageRelayerRunnable) CheckTxForReorg(txDoc *models.Transaction) bool {
+	return x.reorgChecker().CheckTxForReorg(txDoc, x.currentBlockHeight)
+}
+
+func (x *EthMessageRelayerRunnable) CheckForReorgs() bool {
+	return x.reorgChecker().CheckForReorgs(x.chain, x.mintController.Address().Bytes(), x.currentBlockHeight)
 }
 
 func (x *EthMessageRelayerRunnable) ConfirmFulfillmentTxs() bool {
@@ -303,12 +483,7 @@ func (x *EthMessageRelayerRunnable) ConfirmFulfillmentTxs() bool {
 		return false
 	}
 
-	success := true
-	for _, tx := range txs {
-		success = x.ConfirmFulfillmentTx(&tx) && success
-	}
-
-	return success
+	return x.ProcessTxsInBatches(txs, x.ConfirmFulfillmentTx)
 }
 
 func (x *EthMessageRelayerRunnable) ConfirmMessages() bool {
@@ -320,20 +495,20 @@ func (x *EthMessageRelayerRunnable) ConfirmMessages() bool {
 		return false
 	}
 
-	success := true
-	for _, tx := range txs {
-		success = x.ConfirmMessagesForTx(&tx) && success
-	}
-
-	return success
+	return x.ProcessTxsInBatches(txs, x.ConfirmMessagesForTx)
 }
 
-func (x *EthMessageRelayerRunnable) InitStartBlockHeight(lastHealth *models.RunnerServiceStatus) {
-	if lastHealth == nil || lastHealth.BlockHeight == 0 {
-		x.logger.Infof("Invalid last health")
+func (x *EthMessageRelayerRunnable) InitStartBlockHeight() {
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerRelayer)
+	if err != nil {
+		x.logger.WithError(err).Fatalf("Error finding checkpoint")
+		return
+	}
+	if checkpoint == nil {
+		x.logger.Infof("No checkpoint found")
 	} else {
-		x.logger.Debugf("Last block height: %d", lastHealth.BlockHeight)
-		x.startBlockHeight = lastHealth.BlockHeight
+		x.logger.Debugf("Checkpoint block height: %d", checkpoint.BlockHeight)
+		x.startBlockHeight = checkpoint.BlockHeight
 	}
 	if x.startBlockHeight == 0 || x.startBlockHeight > x.currentBlockHeight {
 		x.logger.Infof("Start block height is greater than current block height")
@@ -345,7 +520,6 @@ func (x *EthMessageRelayerRunnable) InitStartBlockHeight(lastHealth *models.Runn
 func NewMessageRelayer(
 	config models.EthereumNetworkConfig,
 	mintControllerMap map[uint32][]byte,
-	lastHealth *models.RunnerServiceStatus,
 ) service.Runnable {
 	logger := log.
 		WithField("module", "ethereum").
@@ -386,12 +560,12 @@ func NewMessageRelayer(
 
 		logger: logger,
 
-		db: dbNewDB(),
+		db: dbNewDB("relayer"),
 	}
 
 	x.UpdateCurrentBlockHeight()
 
-	x.InitStartBlockHeight(lastHealth)
+	x.InitStartBlockHeight()
 
 	logger.Infof("Initialized")
 
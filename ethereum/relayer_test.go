@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/dan13ram/wpokt-oracle/db"
 	"github.com/dan13ram/wpokt-oracle/db/mocks"
@@ -63,6 +65,7 @@ func TestRelayerUpdateCurrentBlockHeight_Error(t *testing.T) {
 func TestRelayerUpdateCurrentBlockHeight(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	logger := log.New().WithField("test", "relayer")
 
 	relayer := &EthMessageRelayerRunnable{
@@ -301,6 +304,7 @@ func TestValidateTransactionAndParseFulfillmentEvents_FailedTx(t *testing.T) {
 
 func TestValidateTransactionAndParseFulfillmentEvents_InvalidEvent(t *testing.T) {
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -339,6 +343,7 @@ func TestValidateTransactionAndParseFulfillmentEvents_InvalidEvent(t *testing.T)
 
 func TestValidateTransactionAndParseFulfillmentEvents(t *testing.T) {
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -375,6 +380,47 @@ func TestValidateTransactionAndParseFulfillmentEvents(t *testing.T) {
 	assert.NotEmpty(t, result.Events)
 }
 
+func TestValidateTransactionAndParseFulfillmentEvents_FinalityTag(t *testing.T) {
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		client:               mockEthClient,
+		mintController:       mockMintController,
+		logger:               logger,
+		currentBlockHeight:   100,
+		finalizedBlockHeight: 89,
+	}
+
+	txHash := "0x1"
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(90),
+		Logs: []*types.Log{
+			{
+				Address: common.HexToAddress("0x1"),
+			},
+		},
+	}
+
+	mockEthClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
+	mockMintController.EXPECT().Address().Return(common.HexToAddress("0x1"))
+	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(&autogen.MintControllerFulfillment{}, nil)
+
+	mockEthClient.EXPECT().FinalityTag().Return(models.FinalityTagSafe)
+
+	result, err := relayer.ValidateTransactionAndParseFulfillmentEvents(txHash)
+	assert.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusPending, result.TxStatus)
+
+	relayer.finalizedBlockHeight = 90
+
+	result, err = relayer.ValidateTransactionAndParseFulfillmentEvents(txHash)
+	assert.NoError(t, err)
+	assert.Equal(t, models.TransactionStatusConfirmed, result.TxStatus)
+}
+
 func TestRelayerUpdateTransaction_Error(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
@@ -397,7 +443,7 @@ func TestRelayerUpdateTransaction_Error(t *testing.T) {
 		"status": models.TransactionStatusConfirmed,
 	}
 
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, update).Return(assert.AnError)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, update).Return(assert.AnError)
 
 	success := relayer.UpdateTransaction(txDoc, update)
 	assert.False(t, success)
@@ -425,7 +471,7 @@ func TestRelayerUpdateTransaction(t *testing.T) {
 		"status": models.TransactionStatusConfirmed,
 	}
 
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, update).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, update).Return(nil)
 
 	success := relayer.UpdateTransaction(txDoc, update)
 	assert.True(t, success)
@@ -481,6 +527,7 @@ func TestRelayerConfirmFulfillmentTx_Invalid(t *testing.T) {
 func TestRelayerConfirmFulfillmentTx(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -516,7 +563,7 @@ func TestRelayerConfirmFulfillmentTx(t *testing.T) {
 		Hash: "0x1",
 	}
 
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	success := relayer.ConfirmFulfillmentTx(txDoc)
 	assert.True(t, success)
@@ -601,7 +648,7 @@ func TestRelayerConfirmMessagesForTx_FailedTx(t *testing.T) {
 		Hash: "0x1",
 	}
 
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	success := relayer.ConfirmMessagesForTx(txDoc)
 	assert.False(t, success)
@@ -609,6 +656,7 @@ func TestRelayerConfirmMessagesForTx_FailedTx(t *testing.T) {
 func TestRelayerConfirmMessagesForTx_LockError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -652,6 +700,7 @@ func TestRelayerConfirmMessagesForTx_LockError(t *testing.T) {
 func TestRelayerConfirmMessagesForTx_UpdateError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -689,7 +738,8 @@ func TestRelayerConfirmMessagesForTx_UpdateError(t *testing.T) {
 
 	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, mock.Anything).Return(primitive.ObjectID{}, assert.AnError)
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{Status: models.MessageStatusSigned}, nil)
+	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, models.MessageStatusSigned, mock.Anything).Return(primitive.ObjectID{}, assert.AnError)
 
 	success := relayer.ConfirmMessagesForTx(txDoc)
 	assert.False(t, success)
@@ -697,6 +747,7 @@ func TestRelayerConfirmMessagesForTx_UpdateError(t *testing.T) {
 func TestRelayerConfirmMessagesForTx(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -734,13 +785,247 @@ func TestRelayerConfirmMessagesForTx(t *testing.T) {
 
 	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{Status: models.MessageStatusSigned}, nil)
+	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, models.MessageStatusSigned, mock.Anything).Return(primitive.ObjectID{}, nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
+
+	success := relayer.ConfirmMessagesForTx(txDoc)
+	assert.True(t, success)
+}
+
+func TestRelayerConfirmMessagesForTx_UnknownOrder(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	chain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	relayer := &EthMessageRelayerRunnable{
+		client:             mockEthClient,
+		mintController:     mockMintController,
+		chain:              chain,
+		logger:             logger,
+		currentBlockHeight: 100,
+		confirmations:      10,
+		db:                 mockDB,
+	}
+
+	mintControllerAddress := common.HexToAddress("0x1")
+	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(90),
+		Logs:        []*types.Log{{Address: mintControllerAddress}},
+	}
+	event := &autogen.MintControllerFulfillment{
+		OrderId: [32]byte{1},
+		Message: []byte{2},
+		Raw: types.Log{
+			Address:     mintControllerAddress,
+			TxHash:      common.HexToHash("0x1"),
+			BlockNumber: 90,
+			Index:       3,
+		},
+	}
+	orderID := "0x0100000000000000000000000000000000000000000000000000000000000000"
+
+	mockEthClient.EXPECT().GetTransactionReceipt("0x1").Return(receipt, nil)
+	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(event, nil)
+
+	txDoc := &models.Transaction{
+		ID:   &primitive.ObjectID{},
+		Hash: "0x1",
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(bson.M{"message_id": orderID}).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).RunAndReturn(func(anomaly models.Anomaly) error {
+		assert.Equal(t, chain, anomaly.Chain)
+		assert.Equal(t, models.AnomalyTypeUnknownOrder, anomaly.Type)
+		assert.Equal(t, orderID, anomaly.OrderID)
+		assert.Equal(t, "0x02", anomaly.EventMessage)
+		assert.Equal(t, strings.ToLower(common.HexToHash("0x1").Hex()), anomaly.TransactionHash)
+		assert.Equal(t, uint64(90), anomaly.BlockHeight)
+		assert.Equal(t, uint64(3), anomaly.LogIndex)
+		assert.Nil(t, anomaly.Content)
+		assert.Nil(t, anomaly.Message)
+		return nil
+	}).Once()
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, bson.M{"messages": []primitive.ObjectID{}}).Return(nil)
+
+	success := relayer.ConfirmMessagesForTx(txDoc)
+	assert.True(t, success)
+}
+
+func TestRelayerConfirmMessagesForTx_FinalMessage(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		client:             mockEthClient,
+		mintController:     mockMintController,
+		chain:              models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum},
+		logger:             logger,
+		currentBlockHeight: 100,
+		confirmations:      10,
+		db:                 mockDB,
+	}
+
+	mintControllerAddress := common.HexToAddress("0x1")
+	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(90),
+		Logs:        []*types.Log{{Address: mintControllerAddress}},
+	}
+	event := &autogen.MintControllerFulfillment{
+		OrderId: [32]byte{1},
+		Raw:     types.Log{Address: mintControllerAddress, TxHash: common.HexToHash("0x1")},
+	}
+
+	mockEthClient.EXPECT().GetTransactionReceipt("0x1").Return(receipt, nil)
+	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(event, nil)
+
+	txDoc := &models.Transaction{
+		ID:   &primitive.ObjectID{},
+		Hash: "0x1",
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{ID: &primitive.ObjectID{}, Status: models.MessageStatusInvalid}, nil).Once()
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).RunAndReturn(func(anomaly models.Anomaly) error {
+		assert.Equal(t, models.AnomalyTypeUnsignedOrder, anomaly.Type)
+		return nil
+	}).Once()
+	mockDB.EXPECT().UpdateMessageByMessageID(event.OrderId, models.MessageStatusInvalid, mock.Anything).
+		Return(primitive.NilObjectID, fmt.Errorf("%w: invalid to success", db.ErrStatusTransition)).Once()
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, bson.M{"messages": []primitive.ObjectID{}}).Return(nil)
+
+	success := relayer.ConfirmMessagesForTx(txDoc)
+	assert.True(t, success)
+}
+
+func TestRelayerConfirmMessagesForTx_UnsignedOrder(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		client:             mockEthClient,
+		mintController:     mockMintController,
+		logger:             logger,
+		currentBlockHeight: 100,
+		confirmations:      10,
+		db:                 mockDB,
+	}
+
+	mintControllerAddress := common.HexToAddress("0x1")
+	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(90),
+		Logs:        []*types.Log{{Address: mintControllerAddress}},
+	}
+
+	mockEthClient.EXPECT().GetTransactionReceipt("0x1").Return(receipt, nil)
+	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(&autogen.MintControllerFulfillment{}, nil)
+
+	txDoc := &models.Transaction{
+		ID:   &primitive.ObjectID{},
+		Hash: "0x1",
+	}
+	messageDoc := models.Message{ID: &primitive.ObjectID{5}, Status: models.MessageStatusPending}
+	docID := primitive.ObjectID{5}
+
+	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(messageDoc, nil).Once()
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).RunAndReturn(func(anomaly models.Anomaly) error {
+		assert.Equal(t, models.AnomalyTypeUnsignedOrder, anomaly.Type)
+		assert.Equal(t, messageDoc.ID, anomaly.Message)
+		assert.Equal(t, models.MessageStatusPending, anomaly.MessageStatus)
+		return nil
+	}).Once()
+	// the message is still marked as fulfilled
+	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, models.MessageStatusPending, mock.Anything).Return(docID, nil).Once()
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, bson.M{"messages": []primitive.ObjectID{docID}}).Return(nil)
 
 	success := relayer.ConfirmMessagesForTx(txDoc)
 	assert.True(t, success)
 }
 
+func TestIsSignedMessage(t *testing.T) {
+	for status, signed := range map[models.MessageStatus]bool{
+		models.MessageStatusSigned:            true,
+		models.MessageStatusBroadcasted:       true,
+		models.MessageStatusSuccess:           true,
+		models.MessageStatusDestinationPaused: true,
+		models.MessageStatusOverLimit:         true,
+		models.MessageStatusPending:           false,
+		models.MessageStatusInvalid:           false,
+		models.MessageStatusReorged:           false,
+	} {
+		assert.Equal(t, signed, isSignedMessage(&models.Message{Status: status}), status)
+	}
+
+	// the signatures of a pending message with a simulation error met the threshold
+	assert.True(t, isSignedMessage(&models.Message{Status: models.MessageStatusPending, SimulationError: "CountBelowThreshold"}))
+}
+
+func TestRelayerConfirmMessagesForTx_AnomalyError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		client:             mockEthClient,
+		mintController:     mockMintController,
+		logger:             logger,
+		currentBlockHeight: 100,
+		confirmations:      10,
+		db:                 mockDB,
+	}
+
+	mintControllerAddress := common.HexToAddress("0x1")
+	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(90),
+		Logs:        []*types.Log{{Address: mintControllerAddress}},
+	}
+
+	mockEthClient.EXPECT().GetTransactionReceipt("0x1").Return(receipt, nil)
+	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(&autogen.MintControllerFulfillment{}, nil)
+
+	txDoc := &models.Transaction{
+		ID:   &primitive.ObjectID{},
+		Hash: "0x1",
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{}, mongo.ErrNoDocuments).Once()
+	mockDB.EXPECT().InsertAnomaly(mock.Anything).Return(assert.AnError).Once()
+
+	// the transaction is processed again on the next run
+	success := relayer.ConfirmMessagesForTx(txDoc)
+	assert.False(t, success)
+}
+
 func TestRelayerSyncBlocks(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
@@ -954,6 +1239,72 @@ func TestRelayerSyncBlocks_EventRemoved(t *testing.T) {
 	assert.True(t, success)
 }
 
+func TestRelayerSyncBlocks_QueryRangeError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:             mockDB,
+		client:         mockEthClient,
+		mintController: mockMintController,
+		logger:         logger,
+	}
+
+	startBlock := uint64(1)
+	endBlock := uint64(101)
+	midBlock := uint64(51)
+	secondStartBlock := uint64(52)
+
+	mockMintController.EXPECT().FilterFulfillment(&bind.FilterOpts{
+		Start:   startBlock,
+		End:     &endBlock,
+		Context: context.Background(),
+	}, mock.Anything).Return(nil, fmt.Errorf("block range is too large")).Once()
+
+	iterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+
+	mockMintController.EXPECT().FilterFulfillment(&bind.FilterOpts{
+		Start:   startBlock,
+		End:     &midBlock,
+		Context: context.Background(),
+	}, mock.Anything).Return(iterator, nil).Once()
+	mockMintController.EXPECT().FilterFulfillment(&bind.FilterOpts{
+		Start:   secondStartBlock,
+		End:     &endBlock,
+		Context: context.Background(),
+	}, mock.Anything).Return(iterator, nil).Once()
+
+	iterator.EXPECT().Next().Return(false)
+	iterator.EXPECT().Error().Return(nil)
+	iterator.EXPECT().Close().Return(nil)
+
+	mockEthClient.EXPECT().ReduceMaxQueryBlocks(uint64(50)).Return().Once()
+
+	success := relayer.SyncBlocks(startBlock, endBlock)
+	assert.True(t, success)
+}
+
+func TestRelayerSyncBlocks_RateLimitError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:             mockDB,
+		client:         mockEthClient,
+		mintController: mockMintController,
+		logger:         logger,
+	}
+
+	mockMintController.EXPECT().FilterFulfillment(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("daily request limit exceeded")).Once()
+
+	success := relayer.SyncBlocks(1, 101)
+	assert.False(t, success)
+}
+
 func TestRelayerSyncNewBlock_NoBlocks(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
@@ -1006,6 +1357,7 @@ func TestRelayerSyncNewBlock_Error(t *testing.T) {
 	iterator.EXPECT().Error().Return(assert.AnError)
 	iterator.EXPECT().Close().Return(nil)
 
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
 	success := relayer.SyncNewBlocks()
 	assert.False(t, success)
 }
@@ -1061,7 +1413,9 @@ func TestRelayerSyncNewBlock(t *testing.T) {
 
 	mockDB.EXPECT().NewEthereumTransaction(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(models.Transaction{}, nil)
 	mockDB.EXPECT().InsertTransaction(mock.Anything).Return(primitive.ObjectID{}, nil)
+	mockDB.EXPECT().UpsertCheckpoint(relayer.chain, models.CheckpointRunnerRelayer, endBlockHeight).Return(nil).Once()
 
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
 	success := relayer.SyncNewBlocks()
 	assert.True(t, success)
 }
@@ -1104,37 +1458,83 @@ func TestRelayerSyncNewBlock_EthQueryMax(t *testing.T) {
 	iterator.EXPECT().Error().Return(nil)
 	iterator.EXPECT().Close().Return(nil)
 
+	mockDB.EXPECT().UpsertCheckpoint(relayer.chain, models.CheckpointRunnerRelayer, endBlock).Return(nil).Once()
+	mockDB.EXPECT().UpsertCheckpoint(relayer.chain, models.CheckpointRunnerRelayer, endBlockHeight).Return(nil).Once()
+
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
 	success := relayer.SyncNewBlocks()
 	assert.True(t, success)
+	assert.Equal(t, endBlockHeight, relayer.startBlockHeight)
 }
 
-func TestConfirmFulfillmentTxs_Error(t *testing.T) {
+func TestRelayerSyncNewBlock_CheckpointError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
+	startBlockHeight := uint64(1)
+	endBlockHeight := uint64(100) + eth.MaxQueryBlocks
+
 	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
 		client:             mockEthClient,
 		mintController:     mockMintController,
 		logger:             logger,
-		currentBlockHeight: 100,
-		confirmations:      10,
-		db:                 mockDB,
+		startBlockHeight:   startBlockHeight,
+		currentBlockHeight: endBlockHeight,
 	}
 
-	mintControllerAddress := common.HexToAddress("0x1")
-	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+	iterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
 
-	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mintControllerAddress.Bytes()).Return(nil, assert.AnError)
+	endBlock := startBlockHeight + eth.MaxQueryBlocks
 
-	success := relayer.ConfirmFulfillmentTxs()
-	assert.False(t, success)
-}
+	mockMintController.EXPECT().FilterFulfillment(&bind.FilterOpts{
+		Start:   startBlockHeight,
+		End:     &endBlock,
+		Context: context.Background(),
+	}, mock.Anything).Return(iterator, nil).Once()
 
-func TestConfirmFulfillmentTxs(t *testing.T) {
-	mockDB := mocks.NewMockDB(t)
-	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	iterator.EXPECT().Next().Return(false)
+	iterator.EXPECT().Error().Return(nil)
+	iterator.EXPECT().Close().Return(nil)
+
+	mockDB.EXPECT().UpsertCheckpoint(relayer.chain, models.CheckpointRunnerRelayer, endBlock).Return(assert.AnError).Once()
+
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
+	success := relayer.SyncNewBlocks()
+	assert.False(t, success)
+	assert.Equal(t, startBlockHeight, relayer.startBlockHeight)
+}
+
+func TestConfirmFulfillmentTxs_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		client:             mockEthClient,
+		mintController:     mockMintController,
+		logger:             logger,
+		currentBlockHeight: 100,
+		confirmations:      10,
+		db:                 mockDB,
+	}
+
+	mintControllerAddress := common.HexToAddress("0x1")
+	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+
+	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mintControllerAddress.Bytes()).Return(nil, assert.AnError)
+
+	success := relayer.ConfirmFulfillmentTxs()
+	assert.False(t, success)
+}
+
+func TestConfirmFulfillmentTxs(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -1161,7 +1561,7 @@ func TestConfirmFulfillmentTxs(t *testing.T) {
 		},
 	}
 
-	mockEthClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
+	mockEthClient.EXPECT().GetTransactionReceipts([]string{txHash}).Return(map[common.Hash]*types.Receipt{common.HexToHash(txHash): receipt}, nil)
 	mockMintController.EXPECT().Address().Return(common.HexToAddress("0x1"))
 	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(&autogen.MintControllerFulfillment{}, nil)
 
@@ -1171,7 +1571,7 @@ func TestConfirmFulfillmentTxs(t *testing.T) {
 	}
 
 	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mintControllerAddress.Bytes()).Return([]models.Transaction{*txDoc}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	success := relayer.ConfirmFulfillmentTxs()
 	assert.True(t, success)
@@ -1203,6 +1603,7 @@ func TestRelayerConfirmMessages_Error(t *testing.T) {
 func TestRelayerConfirmMessages(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -1229,6 +1630,7 @@ func TestRelayerConfirmMessages(t *testing.T) {
 		},
 	}
 
+	mockEthClient.EXPECT().GetTransactionReceipts([]string{txHash}).Return(map[common.Hash]*types.Receipt{}, nil)
 	mockEthClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
 	mockMintController.EXPECT().Address().Return(common.HexToAddress("0x1"))
 	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(&autogen.MintControllerFulfillment{}, nil)
@@ -1240,8 +1642,9 @@ func TestRelayerConfirmMessages(t *testing.T) {
 
 	mockDB.EXPECT().LockWriteTransaction(txDoc).Return("lock-id", nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, mock.Anything).Return(primitive.ObjectID{}, nil)
-	mockDB.EXPECT().UpdateTransaction(txDoc.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{Status: models.MessageStatusSigned}, nil)
+	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, models.MessageStatusSigned, mock.Anything).Return(primitive.ObjectID{}, nil)
+	mockDB.EXPECT().UpdateTransaction(txDoc.ID, txDoc.Status, mock.Anything).Return(nil)
 
 	mockDB.EXPECT().GetConfirmedTransactionsTo(mock.Anything, mintControllerAddress.Bytes()).Return([]models.Transaction{*txDoc}, nil)
 
@@ -1250,41 +1653,516 @@ func TestRelayerConfirmMessages(t *testing.T) {
 }
 
 func TestRelayerInitStartBlockHeight(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
 	logger := log.New().WithField("test", "relayer")
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 100}
 
 	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
 
-	relayer.InitStartBlockHeight(lastHealth)
-
+	mockDB.EXPECT().FindCheckpoint(relayer.chain, models.CheckpointRunnerRelayer).Return(&models.Checkpoint{BlockHeight: 100}, nil).Once()
+	relayer.InitStartBlockHeight()
 	assert.Equal(t, uint64(100), relayer.startBlockHeight)
 
 	relayer = &EthMessageRelayerRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = nil
-	relayer.InitStartBlockHeight(lastHealth)
+	mockDB.EXPECT().FindCheckpoint(relayer.chain, models.CheckpointRunnerRelayer).Return(nil, nil).Once()
+	relayer.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), relayer.startBlockHeight)
 
 	relayer = &EthMessageRelayerRunnable{
+		db:                 mockDB,
 		logger:             logger,
 		startBlockHeight:   0,
 		currentBlockHeight: 200,
 	}
-	lastHealth = &models.RunnerServiceStatus{BlockHeight: 300}
-	relayer.InitStartBlockHeight(lastHealth)
+	mockDB.EXPECT().FindCheckpoint(relayer.chain, models.CheckpointRunnerRelayer).Return(&models.Checkpoint{BlockHeight: 300}, nil).Once()
+	relayer.InitStartBlockHeight()
 	assert.Equal(t, uint64(200), relayer.startBlockHeight)
 }
 
+func TestRelayerInitStartBlockHeight_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "relayer")
+	logger.Logger.ExitFunc = func(int) {}
+
+	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
+		logger:             logger,
+		startBlockHeight:   10,
+		currentBlockHeight: 200,
+	}
+
+	mockDB.EXPECT().FindCheckpoint(relayer.chain, models.CheckpointRunnerRelayer).Return(nil, assert.AnError).Once()
+	relayer.InitStartBlockHeight()
+	assert.Equal(t, uint64(10), relayer.startBlockHeight)
+}
+
+func TestRelayerCheckTxForReorg_Nil(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	result := relayer.CheckTxForReorg(nil)
+
+	assert.False(t, result)
+}
+
+func TestRelayerCheckTxForReorg_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return nil, assert.AnError
+	}
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.False(t, result)
+}
+
+func TestRelayerCheckTxForReorg_NotReorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{}, nil
+	}
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_Moved(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 110,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+	blockHash := ethcommon.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(101), BlockHash: blockHash},
+		}, nil
+	}
+
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"block_height":  uint64(101),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(9),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_MovedAhead(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 100,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+	blockHash := ethcommon.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(101), BlockHash: blockHash},
+		}, nil
+	}
+
+	// the block is ahead of the last read height
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"block_height":  uint64(101),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(0),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_StillReorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01", Status: models.TransactionStatusReorged}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_Reincluded(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		currentBlockHeight: 110,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01", Status: models.TransactionStatusReorged}
+	blockHash := ethcommon.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(105), BlockHash: blockHash},
+		}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"block_height":  uint64(105),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(5),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_BlockCanonicalAgain(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01", Status: models.TransactionStatusReorged}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: false}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{"status": models.TransactionStatusPending}).Return(nil)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_LockError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("", assert.AnError)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.False(t, result)
+}
+
+func TestRelayerCheckTxForReorg_Reorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, bson.M{
+		"status":   models.TransactionStatusReorged,
+		"messages": []primitive.ObjectID{},
+	}).Return(nil)
+	mockDB.EXPECT().UpdateReorgedMessages(bson.M{
+		"transaction": tx.ID,
+		"status":      models.MessageStatusSuccess,
+	}, bson.M{
+		"status":           models.MessageStatusSigned,
+		"transaction":      nil,
+		"transaction_hash": "",
+	}).Return(nil)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckTxForReorg_UpdateMessagesError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		db:     mockDB,
+		client: mockClient,
+		logger: logger,
+	}
+
+	tx := &models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateTransaction(tx.ID, tx.Status, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateReorgedMessages(mock.Anything, mock.Anything).Return(assert.AnError)
+
+	result := relayer.CheckTxForReorg(tx)
+
+	assert.False(t, result)
+}
+
+func TestRelayerReorgedFulfillmentConfirmedAgain(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+	logger := log.New().WithField("test", "relayer")
+
+	relayer := &EthMessageRelayerRunnable{
+		client:             mockEthClient,
+		mintController:     mockMintController,
+		logger:             logger,
+		currentBlockHeight: 110,
+		confirmations:      10,
+		db:                 mockDB,
+	}
+
+	messageID := primitive.NewObjectID()
+	tx := &models.Transaction{
+		ID:       &primitive.ObjectID{},
+		Hash:     "0x01",
+		Status:   models.TransactionStatusConfirmed,
+		Messages: []primitive.ObjectID{messageID},
+	}
+	blockHash := common.HexToHash("0x0b")
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+
+	// the fulfillment is reorged, moving its message back to signed and clearing the messages of the transaction
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().UpdateTransaction(tx.ID, models.TransactionStatusConfirmed, bson.M{
+		"status":   models.TransactionStatusReorged,
+		"messages": []primitive.ObjectID{},
+	}).Return(nil).Once()
+	mockDB.EXPECT().UpdateReorgedMessages(mock.Anything, mock.Anything).Return(nil).Once()
+
+	assert.True(t, relayer.CheckTxForReorg(tx))
+
+	// the transaction is included again in a later block
+	tx.Status = models.TransactionStatusReorged
+	tx.Messages = []primitive.ObjectID{}
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{
+			Receipt: &types.Receipt{BlockNumber: big.NewInt(95), BlockHash: blockHash},
+		}, nil
+	}
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().UpdateTransaction(tx.ID, models.TransactionStatusReorged, bson.M{
+		"block_height":  uint64(95),
+		"block_hash":    blockHash.Hex(),
+		"confirmations": uint64(15),
+		"status":        models.TransactionStatusPending,
+	}).Return(nil).Once()
+
+	assert.True(t, relayer.CheckTxForReorg(tx))
+
+	// once confirmed, the transaction has no messages, so the message is marked successful again
+	tx.Status = models.TransactionStatusConfirmed
+	receipt := &types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(95),
+		Logs:        []*types.Log{{Address: common.HexToAddress("0x1")}},
+	}
+	mockEthClient.EXPECT().GetTransactionReceipt(tx.Hash).Return(receipt, nil).Once()
+	mockMintController.EXPECT().Address().Return(common.HexToAddress("0x1"))
+	mockMintController.EXPECT().ParseFulfillment(mock.Anything).Return(&autogen.MintControllerFulfillment{}, nil).Once()
+	mockDB.EXPECT().LockWriteTransaction(tx).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().FindMessage(mock.Anything).Return(models.Message{ID: &messageID, Status: models.MessageStatusSigned}, nil).Once()
+	mockDB.EXPECT().UpdateMessageByMessageID(mock.Anything, models.MessageStatusSigned, bson.M{
+		"status":           models.MessageStatusSuccess,
+		"transaction":      tx.ID,
+		"transaction_hash": tx.Hash,
+	}).Return(messageID, nil).Once()
+	mockDB.EXPECT().UpdateTransaction(tx.ID, models.TransactionStatusConfirmed, bson.M{"messages": []primitive.ObjectID{messageID}}).Return(nil).Once()
+
+	assert.True(t, relayer.ConfirmMessagesForTx(tx))
+}
+
+func TestRelayerCheckForReorgs(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		mintController:     mintController,
+		currentBlockHeight: eth.MaxReorgDepth + 100,
+	}
+
+	tx := models.Transaction{ID: &primitive.ObjectID{}, Hash: "0x01"}
+
+	oldCheckTransactionReorg := ethCheckTransactionReorg
+	defer func() { ethCheckTransactionReorg = oldCheckTransactionReorg }()
+	ethCheckTransactionReorg = func(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+		return &CheckTransactionReorgResult{}, nil
+	}
+
+	mintController.EXPECT().Address().Return(ethcommon.Address{})
+	mockDB.EXPECT().GetRecentTransactionsTo(mock.Anything, mock.Anything, uint64(100)).Return([]models.Transaction{tx}, nil)
+
+	result := relayer.CheckForReorgs()
+
+	assert.True(t, result)
+}
+
+func TestRelayerCheckForReorgs_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "relayer")
+
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	relayer := &EthMessageRelayerRunnable{
+		db:                 mockDB,
+		client:             mockClient,
+		logger:             logger,
+		mintController:     mintController,
+		currentBlockHeight: 100,
+	}
+
+	mintController.EXPECT().Address().Return(ethcommon.Address{})
+	mockDB.EXPECT().GetRecentTransactionsTo(mock.Anything, mock.Anything, uint64(0)).Return(nil, assert.AnError)
+
+	result := relayer.CheckForReorgs()
+
+	assert.False(t, result)
+}
+
 func TestRelayerRun(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockEthClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	logger := log.New().WithField("test", "relayer")
 
@@ -1301,6 +2179,7 @@ func TestRelayerRun(t *testing.T) {
 	mockMintController.EXPECT().Address().Return(mintControllerAddress)
 
 	mockEthClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
+	mockDB.EXPECT().GetRecentTransactionsTo(mock.Anything, mock.Anything, uint64(0)).Return([]models.Transaction{}, nil)
 	mockDB.EXPECT().GetPendingTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
 	mockDB.EXPECT().GetConfirmedTransactionsTo(mock.Anything, mock.Anything).Return([]models.Transaction{}, nil)
 
@@ -1310,6 +2189,7 @@ func TestRelayerRun(t *testing.T) {
 func TestNewMessageRelayer(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 
 	mintControllerMap := map[uint32][]byte{
@@ -1317,8 +2197,6 @@ func TestNewMessageRelayer(t *testing.T) {
 		2: ethcommon.FromHex("0x02"),
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 50}
-
 	config := models.EthereumNetworkConfig{
 		ChainID:               1,
 		ChainName:             "test",
@@ -1338,7 +2216,7 @@ func TestNewMessageRelayer(t *testing.T) {
 		return mockMintController, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1352,7 +2230,9 @@ func TestNewMessageRelayer(t *testing.T) {
 
 	mockClient.EXPECT().GetClient().Return(nil)
 
-	runnable := NewMessageRelayer(config, mintControllerMap, lastHealth)
+	mockDB.EXPECT().FindCheckpoint(utilParseChain(config), models.CheckpointRunnerRelayer).Return(&models.Checkpoint{BlockHeight: 50}, nil)
+
+	runnable := NewMessageRelayer(config, mintControllerMap)
 
 	assert.NotNil(t, runnable)
 
@@ -1377,8 +2257,6 @@ func TestNewMessageRelayerFailures(t *testing.T) {
 		2: ethcommon.FromHex("0x02"),
 	}
 
-	lastHealth := &models.RunnerServiceStatus{BlockHeight: 50}
-
 	config := models.EthereumNetworkConfig{
 		ChainID:               1,
 		ChainName:             "test",
@@ -1398,7 +2276,7 @@ func TestNewMessageRelayerFailures(t *testing.T) {
 		return mockMintController, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -1417,7 +2295,7 @@ func TestNewMessageRelayerFailures(t *testing.T) {
 		config.MessageRelayer.Enabled = false
 
 		assert.Panics(t, func() {
-			NewMessageRelayer(config, mintControllerMap, lastHealth)
+			NewMessageRelayer(config, mintControllerMap)
 		})
 
 		config.MessageRelayer.Enabled = true
@@ -1430,7 +2308,7 @@ func TestNewMessageRelayerFailures(t *testing.T) {
 		}
 
 		assert.Panics(t, func() {
-			NewMessageRelayer(config, mintControllerMap, lastHealth)
+			NewMessageRelayer(config, mintControllerMap)
 		})
 
 		ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
@@ -1446,7 +2324,7 @@ func TestNewMessageRelayerFailures(t *testing.T) {
 		}
 
 		assert.Panics(t, func() {
-			NewMessageRelayer(config, mintControllerMap, lastHealth)
+			NewMessageRelayer(config, mintControllerMap)
 		})
 
 		ethNewMintControllerContract = func(ethcommon.Address, bind.ContractBackend) (eth.MintControllerContract, error) {
@@ -0,0 +1,135 @@
+package ethereum
+
+import (
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/dan13ram/wpokt-oracle/db"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+// reorgHandler updates the messages of a transaction whose inclusion was changed by a reorg
+type reorgHandler interface {
+	// ReorgMessages is called after the transaction is marked as reorged
+	ReorgMessages(txDoc *models.Transaction) error
+	// RestoreMessages is called after a reorged transaction is found in a canonical block again
+	RestoreMessages(txDoc *models.Transaction) error
+}
+
+// reorgChecker checks the recent transactions sent to an address for reorgs
+type reorgChecker struct {
+	client  eth.EthereumClient
+	db      db.DB
+	logger  *log.Entry
+	handler reorgHandler
+	// reorgUpdate holds the fields set on a transaction along with its reorged status
+	reorgUpdate bson.M
+}
+
+func (x *reorgChecker) updateTransaction(logger *log.Entry, txDoc *models.Transaction, update bson.M) bool {
+	if err := x.db.UpdateTransaction(txDoc.ID, txDoc.Status, update); err != nil {
+		logger.WithError(err).Error("Error updating transaction")
+		return false
+	}
+	return true
+}
+
+// updateLockedTransaction updates the transaction under its write lock and then calls updateMessages
+func (x *reorgChecker) updateLockedTransaction(
+	logger *log.Entry,
+	txDoc *models.Transaction,
+	update bson.M,
+	updateMessages func(*models.Transaction) error,
+) bool {
+	if lockID, err := x.db.LockWriteTransaction(txDoc); err != nil {
+		logger.WithError(err).Error("Error locking transaction")
+		return false
+	} else {
+		//nolint:errcheck
+		defer x.db.Unlock(lockID)
+	}
+
+	if !x.updateTransaction(logger, txDoc, update) {
+		return false
+	}
+
+	if err := updateMessages(txDoc); err != nil {
+		logger.WithError(err).Error("Error updating messages")
+		return false
+	}
+
+	return true
+}
+
+func (x *reorgChecker) CheckTxForReorg(txDoc *models.Transaction, currentBlockHeight uint64) bool {
+	if txDoc == nil {
+		x.logger.Error("CheckTxForReorg: txDoc is nil")
+		return false
+	}
+
+	logger := x.logger.WithField("tx_hash", txDoc.Hash).WithField("section", "CheckTxForReorg")
+
+	result, err := ethCheckTransactionReorg(x.client, txDoc)
+	if err != nil {
+		logger.WithError(err).Error("Error checking transaction for reorg")
+		return false
+	}
+
+	wasReorged := txDoc.Status == models.TransactionStatusReorged
+
+	if result.Reorged {
+		if wasReorged {
+			// still not included in any canonical block
+			return true
+		}
+		logger.Warn("Transaction was reorged")
+		update := bson.M{"status": models.TransactionStatusReorged}
+		for key, value := range x.reorgUpdate {
+			update[key] = value
+		}
+		return x.updateLockedTransaction(logger, txDoc, update, x.handler.ReorgMessages)
+	}
+
+	if result.Receipt == nil && !wasReorged {
+		return true
+	}
+
+	update := bson.M{"status": models.TransactionStatusPending}
+	if result.Receipt != nil {
+		logger.Warn("Transaction was included in a different block")
+		update["block_height"] = result.Receipt.BlockNumber.Uint64()
+		update["block_hash"] = result.Receipt.BlockHash.Hex()
+		update["confirmations"] = eth.Confirmations(result.Receipt.BlockNumber.Uint64(), currentBlockHeight)
+	} else {
+		logger.Warn("Block of the reorged transaction is canonical again")
+	}
+
+	if !wasReorged {
+		return x.updateTransaction(logger, txDoc, update)
+	}
+
+	return x.updateLockedTransaction(logger, txDoc, update, x.handler.RestoreMessages)
+}
+
+func (x *reorgChecker) CheckForReorgs(chain models.Chain, toAddress []byte, currentBlockHeight uint64) bool {
+	logger := x.logger.WithField("section", "CheckForReorgs")
+
+	var fromBlockHeight uint64
+	if currentBlockHeight > eth.MaxReorgDepth {
+		fromBlockHeight = currentBlockHeight - eth.MaxReorgDepth
+	}
+
+	txs, err := x.db.GetRecentTransactionsTo(chain, toAddress, fromBlockHeight)
+	if err != nil {
+		logger.WithError(err).Error("Error getting recent transactions")
+		return false
+	}
+
+	success := true
+	for _, tx := range txs {
+		success = x.CheckTxForReorg(&tx, currentBlockHeight) && success
+	}
+
+	return success
+}
@@ -0,0 +1,148 @@
+package ethereum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// SyncBlocksInChunks calls syncBlocks over the block range in chunks of at most MaxQueryBlocks blocks,
+// without moving any checkpoint
+func SyncBlocksInChunks(
+	client eth.EthereumClient,
+	startBlockHeight uint64,
+	endBlockHeight uint64,
+	syncBlocks func(startBlockHeight uint64, endBlockHeight uint64) bool,
+) bool {
+	for startBlockHeight <= endBlockHeight {
+		// the chunk size shrinks whenever the endpoint rejects a range
+		chunkEndBlockHeight := startBlockHeight + client.MaxQueryBlocks() - 1
+		if chunkEndBlockHeight > endBlockHeight {
+			chunkEndBlockHeight = endBlockHeight
+		}
+		if !syncBlocks(startBlockHeight, chunkEndBlockHeight) {
+			return false
+		}
+		startBlockHeight = chunkEndBlockHeight + 1
+	}
+	return true
+}
+
+// Rescan syncs a block range of the network again with the monitor and relayer enabled in config,
+// inserting the transactions that were missed and reporting them apart from the ones already known.
+// An end block height of zero rescans up to the latest block.
+func Rescan(
+	config models.EthereumNetworkConfig,
+	mintControllerMap map[uint32][]byte,
+	startBlockHeight uint64,
+	endBlockHeight uint64,
+) ([]*service.RescanResult, error) {
+	logger := log.
+		WithField("module", "ethereum").
+		WithField("service", "rescan").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", config.ChainID)
+
+	client, err := ethNewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ethereum client: %w", err)
+	}
+
+	// the confirmations of the transactions found are counted from the current block height
+	heights, err := eth.GetBlockHeights(client)
+	if err != nil {
+		return nil, fmt.Errorf("error getting current block height: %w", err)
+	}
+
+	if endBlockHeight == 0 {
+		endBlockHeight = heights.Current
+	}
+
+	if startBlockHeight > endBlockHeight {
+		return nil, fmt.Errorf("start block height %d is after end block height %d", startBlockHeight, endBlockHeight)
+	}
+
+	chain := utilParseChain(config)
+	database := dbNewDB("rescan")
+	results := []*service.RescanResult{}
+
+	if config.MessageMonitor.Enabled {
+		mailbox, err := ethNewMailboxContract(common.HexToAddress(config.MailboxAddress), client.GetClient())
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to mailbox contract: %w", err)
+		}
+
+		monitor := &EthMessageMonitorRunnable{
+			mintControllerMap: mintControllerMap,
+
+			mailbox:       mailbox,
+			confirmations: config.Confirmations,
+
+			client: client,
+
+			chain: chain,
+
+			logger: logger.WithField("runner", models.CheckpointRunnerMonitor),
+
+			db: database,
+		}
+
+		logger.Infof("Rescanning dispatch events from block %d to %d", startBlockHeight, endBlockHeight)
+		result, err := service.Rescan(chain, models.CheckpointRunnerMonitor, startBlockHeight, endBlockHeight,
+			func() ([]models.Transaction, error) {
+				return database.GetTransactionsToInRange(chain, mailbox.Address().Bytes(), startBlockHeight, endBlockHeight)
+			},
+			func() bool {
+				return SyncBlocksInChunks(client, startBlockHeight, endBlockHeight, monitor.SyncBlocks)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if config.MessageRelayer.Enabled {
+		mintController, err := ethNewMintControllerContract(common.HexToAddress(config.MintControllerAddress), client.GetClient())
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to mint controller contract: %w", err)
+		}
+
+		relayer := &EthMessageRelayerRunnable{
+			mintControllerMap: mintControllerMap,
+
+			mintController: mintController,
+			confirmations:  config.Confirmations,
+
+			client: client,
+
+			chain: chain,
+
+			logger: logger.WithField("runner", models.CheckpointRunnerRelayer),
+
+			db: database,
+		}
+
+		logger.Infof("Rescanning fulfillment events from block %d to %d", startBlockHeight, endBlockHeight)
+		result, err := service.Rescan(chain, models.CheckpointRunnerRelayer, startBlockHeight, endBlockHeight,
+			func() ([]models.Transaction, error) {
+				return database.GetTransactionsToInRange(chain, mintController.Address().Bytes(), startBlockHeight, endBlockHeight)
+			},
+			func() bool {
+				return SyncBlocksInChunks(client, startBlockHeight, endBlockHeight, relayer.SyncBlocks)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,163 @@
+package ethereum
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/db/mocks"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	clientMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func TestSyncBlocksInChunks(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(10))
+
+	ranges := [][2]uint64{}
+	success := SyncBlocksInChunks(mockClient, 1, 25, func(startBlockHeight uint64, endBlockHeight uint64) bool {
+		ranges = append(ranges, [2]uint64{startBlockHeight, endBlockHeight})
+		return true
+	})
+
+	assert.True(t, success)
+	assert.Equal(t, [][2]uint64{{1, 10}, {11, 20}, {21, 25}}, ranges)
+}
+
+func TestSyncBlocksInChunks_Failure(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(10))
+
+	calls := 0
+	success := SyncBlocksInChunks(mockClient, 1, 25, func(startBlockHeight uint64, endBlockHeight uint64) bool {
+		calls++
+		return false
+	})
+
+	assert.False(t, success)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRescan(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockMailbox := clientMocks.NewMockMailboxContract(t)
+	mockMintController := clientMocks.NewMockMintControllerContract(t)
+
+	config := models.EthereumNetworkConfig{
+		ChainID:               1,
+		ChainName:             "Ethereum",
+		MailboxAddress:        "0x0000000000000000000000000000000000000001",
+		MintControllerAddress: "0x0000000000000000000000000000000000000002",
+		MessageMonitor:        models.ServiceConfig{Enabled: true},
+		MessageRelayer:        models.ServiceConfig{Enabled: true},
+	}
+	chain := utilParseChain(config)
+	mintControllerMap := map[uint32][]byte{chain.ChainDomain: ethcommon.HexToAddress(config.MintControllerAddress).Bytes()}
+
+	ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+		return mockClient, nil
+	}
+	ethNewMailboxContract = func(ethcommon.Address, bind.ContractBackend) (eth.MailboxContract, error) {
+		return mockMailbox, nil
+	}
+	ethNewMintControllerContract = func(ethcommon.Address, bind.ContractBackend) (eth.MintControllerContract, error) {
+		return mockMintController, nil
+	}
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+	defer func() {
+		ethNewClient = eth.NewClient
+		ethNewMailboxContract = eth.NewMailboxContract
+		ethNewMintControllerContract = eth.NewMintControllerContract
+		dbNewDB = db.NewDB
+	}()
+
+	mailboxAddress := ethcommon.HexToAddress(config.MailboxAddress)
+	mintControllerAddress := ethcommon.HexToAddress(config.MintControllerAddress)
+
+	mockClient.EXPECT().GetClient().Return(nil)
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	mockClient.EXPECT().FinalityTag().Return("")
+	mockClient.EXPECT().MaxQueryBlocks().Return(eth.MaxQueryBlocks)
+	mockMailbox.EXPECT().Address().Return(mailboxAddress)
+	mockMintController.EXPECT().Address().Return(mintControllerAddress)
+
+	dispatchIterator := clientMocks.NewMockMailboxDispatchIterator(t)
+	mockMailbox.EXPECT().FilterDispatch(mock.Anything, []ethcommon.Address{mintControllerAddress}, []uint32{}, [][32]byte{}).Return(dispatchIterator, nil).Once()
+	dispatchIterator.EXPECT().Next().Return(false)
+	dispatchIterator.EXPECT().Error().Return(nil)
+	dispatchIterator.EXPECT().Close().Return(nil)
+
+	fulfillmentIterator := clientMocks.NewMockMintControllerFulfillmentIterator(t)
+	mockMintController.EXPECT().FilterFulfillment(mock.Anything, [][32]byte{}).Return(fulfillmentIterator, nil).Once()
+	fulfillmentIterator.EXPECT().Next().Return(false)
+	fulfillmentIterator.EXPECT().Error().Return(nil)
+	fulfillmentIterator.EXPECT().Close().Return(nil)
+
+	mockDB.EXPECT().GetTransactionsToInRange(chain, mailboxAddress.Bytes(), uint64(10), uint64(100)).
+		Return([]models.Transaction{{Hash: "0x01"}}, nil).Once()
+	mockDB.EXPECT().GetTransactionsToInRange(chain, mailboxAddress.Bytes(), uint64(10), uint64(100)).
+		Return([]models.Transaction{{Hash: "0x01"}, {Hash: "0x02"}}, nil).Once()
+	mockDB.EXPECT().GetTransactionsToInRange(chain, mintControllerAddress.Bytes(), uint64(10), uint64(100)).
+		Return([]models.Transaction{}, nil).Twice()
+
+	results, err := Rescan(config, mintControllerMap, 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	assert.Equal(t, models.CheckpointRunnerMonitor, results[0].Runner)
+	assert.True(t, results[0].Success)
+	assert.Equal(t, uint64(100), results[0].EndBlockHeight)
+	assert.Equal(t, []string{"0x02"}, results[0].Discovered)
+	assert.Equal(t, []string{"0x01"}, results[0].Known)
+
+	assert.Equal(t, models.CheckpointRunnerRelayer, results[1].Runner)
+	assert.True(t, results[1].Success)
+	assert.Empty(t, results[1].Discovered)
+}
+
+func TestRescan_Errors(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	config := models.EthereumNetworkConfig{ChainID: 1}
+
+	defer func() { ethNewClient = eth.NewClient }()
+
+	t.Run("client error", func(t *testing.T) {
+		ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+			return nil, errors.New("error")
+		}
+
+		results, err := Rescan(config, nil, 10, 20)
+		assert.ErrorContains(t, err, "error creating ethereum client")
+		assert.Nil(t, results)
+	})
+
+	ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+		return mockClient, nil
+	}
+
+	t.Run("block height error", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHeight().Return(0, errors.New("error")).Once()
+
+		results, err := Rescan(config, nil, 10, 0)
+		assert.ErrorContains(t, err, "error getting current block height")
+		assert.Nil(t, results)
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+		mockClient.EXPECT().FinalityTag().Return("").Once()
+
+		results, err := Rescan(config, nil, 30, 20)
+		assert.ErrorContains(t, err, "start block height 30 is after end block height 20")
+		assert.Nil(t, results)
+	})
+}
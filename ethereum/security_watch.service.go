@@ -0,0 +1,392 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+// securityEvents are the admin and configuration events watched on each contract the bridge depends on
+var securityEvents = map[string][]string{
+	"mailbox":         {"OwnershipTransferred", "DefaultIsmSet", "DefaultHookSet", "RequiredHookSet"},
+	"mint_controller": {"RoleGranted", "RoleRevoked", "MintCooldownSet"},
+	"omni_token":      {"RoleGranted", "RoleRevoked"},
+	"warp_ism":        {"OwnershipTransferred", "NewValidator", "RemovedValidator", "SignerThresholdSet"},
+}
+
+// roleNames labels the access control roles of the mint controller and the omni token
+var roleNames = map[ethcommon.Hash]string{
+	{}: "DEFAULT_ADMIN_ROLE",
+	crypto.Keccak256Hash([]byte("MAIL_BOX_ROLE")): "MAIL_BOX_ROLE",
+	crypto.Keccak256Hash([]byte("MINTER_ROLE")):   "MINTER_ROLE",
+	crypto.Keccak256Hash([]byte("PAUSER_ROLE")):   "PAUSER_ROLE",
+}
+
+// watchedContract is a bridge contract with its watched events by topic
+type watchedContract struct {
+	name    string
+	address ethcommon.Address
+	events  map[ethcommon.Hash]abi.Event
+}
+
+func newWatchedContract(name string, address string, metaData *bind.MetaData) (watchedContract, error) {
+	contractABI, err := metaData.GetAbi()
+	if err != nil {
+		return watchedContract{}, fmt.Errorf("error parsing %s abi: %w", name, err)
+	}
+
+	contract := watchedContract{
+		name:    name,
+		address: common.HexToAddress(address),
+		events:  make(map[ethcommon.Hash]abi.Event),
+	}
+	for _, eventName := range securityEvents[name] {
+		event, ok := contractABI.Events[eventName]
+		if !ok {
+			return watchedContract{}, fmt.Errorf("event %s not found in %s abi", eventName, name)
+		}
+		contract.events[event.ID] = event
+	}
+	return contract, nil
+}
+
+// EthSecurityWatchRunnable records the admin and configuration changes of the bridge contracts,
+// such as role grants, ownership transfers and a new ism or hook on the mailbox, and raises an alert for each
+type EthSecurityWatchRunnable struct {
+	startBlockHeight   uint64
+	currentBlockHeight uint64
+
+	contracts []watchedContract
+	client    eth.EthereumClient
+
+	confirmations uint64
+	finalityTag   string
+
+	chain models.Chain
+
+	logger *log.Entry
+
+	db db.DB
+}
+
+func (x *EthSecurityWatchRunnable) Run() {
+	x.UpdateCurrentBlockHeight()
+	x.WatchNewBlocks()
+}
+
+func (x *EthSecurityWatchRunnable) Height() uint64 {
+	return uint64(x.currentBlockHeight)
+}
+
+func (x *EthSecurityWatchRunnable) UpdateCurrentBlockHeight() {
+	res, err := x.client.GetBlockHeight()
+	if err != nil {
+		x.logger.
+			WithError(err).
+			Error("could not get current block height")
+		return
+	}
+	x.currentBlockHeight = res
+	x.logger.
+		WithField("current_block_height", x.currentBlockHeight).
+		Info("updated current block height")
+}
+
+// ConfirmedBlockHeight returns the latest block that can no longer be reorged,
+// so a change is only recorded once it is final
+func (x *EthSecurityWatchRunnable) ConfirmedBlockHeight() (uint64, error) {
+	if x.finalityTag != "" {
+		finalizedBlockHeight, err := x.client.GetFinalizedBlockHeight()
+		if err != nil {
+			return 0, fmt.Errorf("error getting %s block height: %w", x.finalityTag, err)
+		}
+		return finalizedBlockHeight, nil
+	}
+	if x.currentBlockHeight < x.confirmations {
+		return 0, nil
+	}
+	return x.currentBlockHeight - x.confirmations, nil
+}
+
+// FilterQuery matches the watched events of every contract in the block range with a single query
+func (x *EthSecurityWatchRunnable) FilterQuery(startBlockHeight uint64, endBlockHeight uint64) ethereum.FilterQuery {
+	var addresses []ethcommon.Address
+	var topics []ethcommon.Hash
+	seen := make(map[ethcommon.Hash]bool)
+	for _, contract := range x.contracts {
+		addresses = append(addresses, contract.address)
+		for topic := range contract.events {
+			if !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+
+	return ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(startBlockHeight),
+		ToBlock:   new(big.Int).SetUint64(endBlockHeight),
+		Addresses: addresses,
+		Topics:    [][]ethcommon.Hash{topics},
+	}
+}
+
+// DecodeEvent decodes a log of a watched contract into a contract event
+func (x *EthSecurityWatchRunnable) DecodeEvent(eventLog types.Log) (models.ContractEvent, error) {
+	if len(eventLog.Topics) == 0 {
+		return models.ContractEvent{}, fmt.Errorf("log has no topics")
+	}
+
+	for _, contract := range x.contracts {
+		if contract.address != eventLog.Address {
+			continue
+		}
+		event, ok := contract.events[eventLog.Topics[0]]
+		if !ok {
+			continue
+		}
+
+		values := make(map[string]interface{})
+		if err := event.Inputs.UnpackIntoMap(values, eventLog.Data); err != nil {
+			return models.ContractEvent{}, fmt.Errorf("error unpacking %s data: %w", event.Name, err)
+		}
+		var indexed abi.Arguments
+		for _, input := range event.Inputs {
+			if input.Indexed {
+				indexed = append(indexed, input)
+			}
+		}
+		if err := abi.ParseTopicsIntoMap(values, indexed, eventLog.Topics[1:]); err != nil {
+			return models.ContractEvent{}, fmt.Errorf("error parsing %s topics: %w", event.Name, err)
+		}
+
+		args := make(map[string]string)
+		for name, value := range values {
+			args[name] = formatEventArg(value)
+		}
+		if role, ok := values["role"].([32]byte); ok {
+			if roleName, ok := roleNames[role]; ok {
+				args["role_name"] = roleName
+			}
+		}
+
+		return models.ContractEvent{
+			Chain:           x.chain,
+			Contract:        strings.ToLower(contract.address.Hex()),
+			ContractName:    contract.name,
+			Event:           event.Name,
+			Args:            args,
+			TransactionHash: strings.ToLower(eventLog.TxHash.Hex()),
+			BlockHeight:     eventLog.BlockNumber,
+			LogIndex:        uint64(eventLog.Index),
+			CreatedAt:       time.Now(),
+		}, nil
+	}
+
+	return models.ContractEvent{}, fmt.Errorf("log is not a watched event")
+}
+
+func formatEventArg(value interface{}) string {
+	switch v := value.(type) {
+	case ethcommon.Address:
+		return v.Hex()
+	case [32]byte:
+		return common.HexFromBytes(v[:])
+	case *big.Int:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// RecordContractEvent stores the change and raises an alert on every oracle that sees it
+func (x *EthSecurityWatchRunnable) RecordContractEvent(event models.ContractEvent) bool {
+	logger := x.logger.
+		WithField("tx_hash", event.TransactionHash).
+		WithField("contract_name", event.ContractName).
+		WithField("contract", event.Contract).
+		WithField("event", event.Event).
+		WithField("args", event.Args)
+
+	logger.
+		WithField("alert", "contract_change").
+		Error("Admin or configuration change of a bridge contract")
+
+	if err := x.db.InsertContractEvent(event); err != nil {
+		logger.WithError(err).Error("Error inserting contract event")
+		return false
+	}
+	return true
+}
+
+// WatchBlocks records the watched events in the block range, inclusive
+func (x *EthSecurityWatchRunnable) WatchBlocks(startBlockHeight uint64, endBlockHeight uint64) bool {
+	logs, err := x.client.GetClient().FilterLogs(context.Background(), x.FilterQuery(startBlockHeight, endBlockHeight))
+	if err != nil {
+		if eth.IsRateLimitError(err) {
+			x.logger.WithError(err).Warn("Rate limited, retrying the block range on the next run")
+			return false
+		}
+		if eth.IsQueryRangeError(err) && endBlockHeight > startBlockHeight {
+			midBlockHeight := startBlockHeight + (endBlockHeight-startBlockHeight)/2
+			x.logger.WithError(err).Warnf("Block range %d to %d rejected, splitting at %d", startBlockHeight, endBlockHeight, midBlockHeight)
+			x.client.ReduceMaxQueryBlocks(midBlockHeight - startBlockHeight)
+			return x.WatchBlocks(startBlockHeight, midBlockHeight) && x.WatchBlocks(midBlockHeight+1, endBlockHeight)
+		}
+		x.logger.WithError(err).Error("Error filtering contract events")
+		return false
+	}
+
+	success := true
+	for _, eventLog := range logs {
+		if eventLog.Removed {
+			continue
+		}
+		event, err := x.DecodeEvent(eventLog)
+		if err != nil {
+			x.logger.WithError(err).WithField("tx_hash", eventLog.TxHash.Hex()).Error("Error decoding contract event")
+			success = false
+			continue
+		}
+		success = x.RecordContractEvent(event) && success
+	}
+	return success
+}
+
+func (x *EthSecurityWatchRunnable) UpdateCheckpoint(blockHeight uint64) bool {
+	err := x.db.UpsertCheckpoint(x.chain, models.CheckpointRunnerSecurityWatch, blockHeight)
+	if err != nil {
+		x.logger.WithError(err).Error("Error updating checkpoint")
+		return false
+	}
+	x.startBlockHeight = blockHeight
+	return true
+}
+
+// WatchNewBlocks watches the confirmed blocks after the checkpoint in chunks
+func (x *EthSecurityWatchRunnable) WatchNewBlocks() bool {
+	confirmedBlockHeight, err := x.ConfirmedBlockHeight()
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting confirmed block height")
+		return false
+	}
+
+	if confirmedBlockHeight <= x.startBlockHeight {
+		x.logger.Infof("No new blocks to watch")
+		return true
+	}
+
+	for x.startBlockHeight < confirmedBlockHeight {
+		endBlockHeight := x.startBlockHeight + x.client.MaxQueryBlocks()
+		if endBlockHeight > confirmedBlockHeight {
+			endBlockHeight = confirmedBlockHeight
+		}
+		x.logger.Info("Watching contract events from blockNumber: ", x.startBlockHeight, " to blockNumber: ", endBlockHeight)
+		if !x.WatchBlocks(x.startBlockHeight, endBlockHeight) {
+			return false
+		}
+		if !x.UpdateCheckpoint(endBlockHeight) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (x *EthSecurityWatchRunnable) InitStartBlockHeight() {
+	checkpoint, err := x.db.FindCheckpoint(x.chain, models.CheckpointRunnerSecurityWatch)
+	if err != nil {
+		x.logger.WithError(err).Fatalf("Error finding checkpoint")
+		return
+	}
+	if checkpoint == nil {
+		x.logger.Infof("No checkpoint found")
+	} else {
+		x.logger.Debugf("Checkpoint block height: %d", checkpoint.BlockHeight)
+		x.startBlockHeight = checkpoint.BlockHeight
+	}
+	if x.startBlockHeight == 0 || x.startBlockHeight > x.currentBlockHeight {
+		x.logger.Infof("Start block height is greater than current block height")
+		x.startBlockHeight = x.currentBlockHeight
+	}
+	x.logger.Infof("Initialized start block height: %d", x.startBlockHeight)
+}
+
+func NewSecurityWatch(config models.EthereumNetworkConfig) service.Runnable {
+	logger := log.
+		WithField("module", "ethereum").
+		WithField("service", "security_watch").
+		WithField("chain_name", strings.ToLower(config.ChainName)).
+		WithField("chain_id", config.ChainID)
+
+	if !config.SecurityWatch.Enabled {
+		logger.Fatalf("Security watch is not enabled")
+	}
+
+	logger.Debugf("Initializing")
+
+	client, err := ethNewClient(config)
+	if err != nil {
+		logger.Fatalf("Error creating ethereum client: %s", err)
+	}
+
+	var contracts []watchedContract
+	for _, c := range []struct {
+		name     string
+		address  string
+		metaData *bind.MetaData
+	}{
+		{"mailbox", config.MailboxAddress, autogen.MailboxMetaData},
+		{"mint_controller", config.MintControllerAddress, autogen.MintControllerMetaData},
+		{"omni_token", config.OmniTokenAddress, autogen.OmniTokenMetaData},
+		{"warp_ism", config.WarpISMAddress, autogen.WarpISMMetaData},
+	} {
+		contract, err := newWatchedContract(c.name, c.address, c.metaData)
+		if err != nil {
+			logger.WithError(err).Fatal("Error loading watched contract")
+		}
+		contracts = append(contracts, contract)
+	}
+
+	x := &EthSecurityWatchRunnable{
+		startBlockHeight:   config.StartBlockHeight,
+		currentBlockHeight: 0,
+
+		contracts: contracts,
+		client:    client,
+
+		confirmations: config.Confirmations,
+		finalityTag:   config.FinalityTag,
+
+		chain: utilParseChain(config),
+
+		logger: logger,
+
+		db: dbNewDB("security_watch"),
+	}
+
+	x.UpdateCurrentBlockHeight()
+
+	x.InitStartBlockHeight()
+
+	logger.Infof("Initialized")
+
+	return x
+}
@@ -0,0 +1,362 @@
+package ethereum
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/db/mocks"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	ethMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/client_mocks"
+	clientMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+var (
+	watchMintController = ethcommon.HexToAddress("0x0000000000000000000000000000000000000020")
+	watchWarpISM        = ethcommon.HexToAddress("0x0000000000000000000000000000000000000040")
+	watchAccount        = ethcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+	watchSender         = ethcommon.HexToAddress("0x00000000000000000000000000000000000000bb")
+)
+
+func newTestSecurityWatch(t *testing.T) (*EthSecurityWatchRunnable, *clientMocks.MockEthereumClient, *ethMocks.MockEthHTTPClient, *mocks.MockDB) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockHTTPClient := ethMocks.NewMockEthHTTPClient(t)
+	mockDB := mocks.NewMockDB(t)
+
+	mockClient.EXPECT().GetClient().Return(mockHTTPClient).Maybe()
+
+	mintController, err := newWatchedContract("mint_controller", watchMintController.Hex(), autogen.MintControllerMetaData)
+	assert.NoError(t, err)
+	warpISM, err := newWatchedContract("warp_ism", watchWarpISM.Hex(), autogen.WarpISMMetaData)
+	assert.NoError(t, err)
+
+	watch := &EthSecurityWatchRunnable{
+		contracts:     []watchedContract{mintController, warpISM},
+		client:        mockClient,
+		confirmations: 2,
+		chain:         models.Chain{ChainID: "1", ChainDomain: 1},
+		logger:        log.New().WithField("test", "security_watch"),
+		db:            mockDB,
+	}
+
+	return watch, mockClient, mockHTTPClient, mockDB
+}
+
+func roleGrantedLog(t *testing.T, role ethcommon.Hash, index uint) types.Log {
+	contractABI, err := autogen.MintControllerMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	return types.Log{
+		Address: watchMintController,
+		Topics: []ethcommon.Hash{
+			contractABI.Events["RoleGranted"].ID,
+			role,
+			ethcommon.BytesToHash(watchAccount.Bytes()),
+			ethcommon.BytesToHash(watchSender.Bytes()),
+		},
+		TxHash:      ethcommon.HexToHash("0x01"),
+		BlockNumber: 5,
+		Index:       index,
+	}
+}
+
+func mintCooldownSetLog(t *testing.T) types.Log {
+	contractABI, err := autogen.MintControllerMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	data, err := contractABI.Events["MintCooldownSet"].Inputs.Pack(big.NewInt(1000), big.NewInt(60))
+	assert.NoError(t, err)
+
+	return types.Log{
+		Address:     watchMintController,
+		Topics:      []ethcommon.Hash{contractABI.Events["MintCooldownSet"].ID},
+		Data:        data,
+		TxHash:      ethcommon.HexToHash("0x02"),
+		BlockNumber: 6,
+		Index:       1,
+	}
+}
+
+func TestSecurityWatchHeight(t *testing.T) {
+	watch := &EthSecurityWatchRunnable{currentBlockHeight: 100}
+
+	assert.Equal(t, uint64(100), watch.Height())
+}
+
+func TestSecurityWatchUpdateCurrentBlockHeight(t *testing.T) {
+	watch, mockClient, _, _ := newTestSecurityWatch(t)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	watch.UpdateCurrentBlockHeight()
+	assert.Equal(t, uint64(100), watch.currentBlockHeight)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(0), errors.New("error")).Once()
+	watch.UpdateCurrentBlockHeight()
+	assert.Equal(t, uint64(100), watch.currentBlockHeight)
+}
+
+func TestSecurityWatchConfirmedBlockHeight(t *testing.T) {
+	watch, mockClient, _, _ := newTestSecurityWatch(t)
+
+	watch.currentBlockHeight = 100
+	height, err := watch.ConfirmedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(98), height)
+
+	watch.currentBlockHeight = 1
+	height, err = watch.ConfirmedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	watch.finalityTag = models.FinalityTagFinalized
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(90), nil).Once()
+	height, err = watch.ConfirmedBlockHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(90), height)
+
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(0), errors.New("error")).Once()
+	_, err = watch.ConfirmedBlockHeight()
+	assert.Error(t, err)
+}
+
+func TestSecurityWatchFilterQuery(t *testing.T) {
+	watch, _, _, _ := newTestSecurityWatch(t)
+
+	query := watch.FilterQuery(10, 20)
+
+	assert.Equal(t, big.NewInt(10), query.FromBlock)
+	assert.Equal(t, big.NewInt(20), query.ToBlock)
+	assert.Equal(t, []ethcommon.Address{watchMintController, watchWarpISM}, query.Addresses)
+	assert.Len(t, query.Topics, 1)
+	// role events and ownership transfers share a topic across contracts
+	assert.Len(t, query.Topics[0], 7)
+}
+
+func TestSecurityWatchDecodeEvent_IndexedArgs(t *testing.T) {
+	watch, _, _, _ := newTestSecurityWatch(t)
+
+	minterRole := crypto.Keccak256Hash([]byte("MINTER_ROLE"))
+	event, err := watch.DecodeEvent(roleGrantedLog(t, minterRole, 3))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "mint_controller", event.ContractName)
+	assert.Equal(t, "0x0000000000000000000000000000000000000020", event.Contract)
+	assert.Equal(t, "RoleGranted", event.Event)
+	assert.Equal(t, minterRole.Hex(), event.Args["role"])
+	assert.Equal(t, "MINTER_ROLE", event.Args["role_name"])
+	assert.Equal(t, watchAccount.Hex(), event.Args["account"])
+	assert.Equal(t, watchSender.Hex(), event.Args["sender"])
+	assert.Equal(t, uint64(5), event.BlockHeight)
+	assert.Equal(t, uint64(3), event.LogIndex)
+	assert.Equal(t, watch.chain, event.Chain)
+}
+
+func TestSecurityWatchDecodeEvent_UnknownRole(t *testing.T) {
+	watch, _, _, _ := newTestSecurityWatch(t)
+
+	event, err := watch.DecodeEvent(roleGrantedLog(t, ethcommon.HexToHash("0x1234"), 0))
+
+	assert.NoError(t, err)
+	assert.NotContains(t, event.Args, "role_name")
+}
+
+func TestSecurityWatchDecodeEvent_DataArgs(t *testing.T) {
+	watch, _, _, _ := newTestSecurityWatch(t)
+
+	event, err := watch.DecodeEvent(mintCooldownSetLog(t))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "MintCooldownSet", event.Event)
+	assert.Equal(t, map[string]string{"newLimit": "1000", "newCooldown": "60"}, event.Args)
+}
+
+func TestSecurityWatchDecodeEvent_Errors(t *testing.T) {
+	watch, _, _, _ := newTestSecurityWatch(t)
+
+	_, err := watch.DecodeEvent(types.Log{Address: watchMintController})
+	assert.Error(t, err)
+
+	// a mint controller event emitted by the warp ism is not watched
+	eventLog := roleGrantedLog(t, ethcommon.Hash{}, 0)
+	eventLog.Address = watchWarpISM
+	_, err = watch.DecodeEvent(eventLog)
+	assert.Error(t, err)
+
+	eventLog = mintCooldownSetLog(t)
+	eventLog.Data = eventLog.Data[:10]
+	_, err = watch.DecodeEvent(eventLog)
+	assert.Error(t, err)
+}
+
+func TestSecurityWatchWatchBlocks(t *testing.T) {
+	watch, _, mockHTTPClient, mockDB := newTestSecurityWatch(t)
+
+	removed := roleGrantedLog(t, ethcommon.Hash{}, 1)
+	removed.Removed = true
+
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.MatchedBy(func(query ethereum.FilterQuery) bool {
+		return query.FromBlock.Uint64() == 1 && query.ToBlock.Uint64() == 100
+	})).Return([]types.Log{roleGrantedLog(t, ethcommon.Hash{}, 0), removed, mintCooldownSetLog(t)}, nil).Once()
+
+	mockDB.EXPECT().InsertContractEvent(mock.MatchedBy(func(event models.ContractEvent) bool {
+		return event.Event == "RoleGranted" && event.Args["role_name"] == "DEFAULT_ADMIN_ROLE"
+	})).Return(nil).Once()
+	mockDB.EXPECT().InsertContractEvent(mock.MatchedBy(func(event models.ContractEvent) bool {
+		return event.Event == "MintCooldownSet"
+	})).Return(nil).Once()
+
+	assert.True(t, watch.WatchBlocks(1, 100))
+}
+
+func TestSecurityWatchWatchBlocks_Errors(t *testing.T) {
+	watch, _, mockHTTPClient, mockDB := newTestSecurityWatch(t)
+
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+	assert.False(t, watch.WatchBlocks(1, 100))
+
+	undecodable := mintCooldownSetLog(t)
+	undecodable.Data = nil
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return([]types.Log{undecodable, roleGrantedLog(t, ethcommon.Hash{}, 0)}, nil).Once()
+	mockDB.EXPECT().InsertContractEvent(mock.Anything).Return(nil).Once()
+	assert.False(t, watch.WatchBlocks(1, 100))
+
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return([]types.Log{roleGrantedLog(t, ethcommon.Hash{}, 0)}, nil).Once()
+	mockDB.EXPECT().InsertContractEvent(mock.Anything).Return(errors.New("error")).Once()
+	assert.False(t, watch.WatchBlocks(1, 100))
+}
+
+func TestSecurityWatchWatchBlocks_RangeError(t *testing.T) {
+	watch, mockClient, mockHTTPClient, _ := newTestSecurityWatch(t)
+
+	matchRange := func(start, end uint64) interface{} {
+		return mock.MatchedBy(func(query ethereum.FilterQuery) bool {
+			return query.FromBlock.Uint64() == start && query.ToBlock.Uint64() == end
+		})
+	}
+
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, matchRange(1, 100)).Return(nil, errors.New("query returned more than 10000 results")).Once()
+	mockClient.EXPECT().ReduceMaxQueryBlocks(uint64(49)).Once()
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, matchRange(1, 50)).Return(nil, nil).Once()
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, matchRange(51, 100)).Return(nil, nil).Once()
+
+	assert.True(t, watch.WatchBlocks(1, 100))
+}
+
+func TestSecurityWatchWatchNewBlocks(t *testing.T) {
+	watch, mockClient, mockHTTPClient, mockDB := newTestSecurityWatch(t)
+	watch.startBlockHeight = 10
+	watch.currentBlockHeight = 42
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(20))
+
+	for _, r := range [][2]uint64{{10, 30}, {30, 40}} {
+		start, end := r[0], r[1]
+		mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.MatchedBy(func(query ethereum.FilterQuery) bool {
+			return query.FromBlock.Uint64() == start && query.ToBlock.Uint64() == end
+		})).Return(nil, nil).Once()
+		mockDB.EXPECT().UpsertCheckpoint(watch.chain, models.CheckpointRunnerSecurityWatch, end).Return(nil).Once()
+	}
+
+	assert.True(t, watch.WatchNewBlocks())
+	assert.Equal(t, uint64(40), watch.startBlockHeight)
+
+	// nothing new is confirmed
+	assert.True(t, watch.WatchNewBlocks())
+}
+
+func TestSecurityWatchWatchNewBlocks_Errors(t *testing.T) {
+	watch, mockClient, mockHTTPClient, mockDB := newTestSecurityWatch(t)
+	watch.startBlockHeight = 10
+	watch.currentBlockHeight = 22
+
+	mockClient.EXPECT().MaxQueryBlocks().Return(uint64(100))
+
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return(nil, errors.New("error")).Once()
+	assert.False(t, watch.WatchNewBlocks())
+	assert.Equal(t, uint64(10), watch.startBlockHeight)
+
+	mockHTTPClient.EXPECT().FilterLogs(mock.Anything, mock.Anything).Return(nil, nil).Once()
+	mockDB.EXPECT().UpsertCheckpoint(watch.chain, models.CheckpointRunnerSecurityWatch, uint64(20)).Return(errors.New("error")).Once()
+	assert.False(t, watch.WatchNewBlocks())
+	assert.Equal(t, uint64(10), watch.startBlockHeight)
+}
+
+func TestSecurityWatchInitStartBlockHeight(t *testing.T) {
+	watch, _, _, mockDB := newTestSecurityWatch(t)
+	watch.currentBlockHeight = 100
+
+	mockDB.EXPECT().FindCheckpoint(watch.chain, models.CheckpointRunnerSecurityWatch).Return(&models.Checkpoint{BlockHeight: 50}, nil).Once()
+	watch.InitStartBlockHeight()
+	assert.Equal(t, uint64(50), watch.startBlockHeight)
+
+	watch.startBlockHeight = 0
+	mockDB.EXPECT().FindCheckpoint(watch.chain, models.CheckpointRunnerSecurityWatch).Return(nil, nil).Once()
+	watch.InitStartBlockHeight()
+	assert.Equal(t, uint64(100), watch.startBlockHeight)
+}
+
+func TestNewSecurityWatch(t *testing.T) {
+	config := models.EthereumNetworkConfig{
+		StartBlockHeight:      1,
+		Confirmations:         3,
+		ChainID:               1,
+		ChainName:             "Ethereum",
+		MailboxAddress:        "0x0000000000000000000000000000000000000030",
+		OmniTokenAddress:      "0x0000000000000000000000000000000000000010",
+		MintControllerAddress: watchMintController.Hex(),
+		WarpISMAddress:        watchWarpISM.Hex(),
+		SecurityWatch:         models.ServiceConfig{Enabled: true, IntervalMS: 1000},
+	}
+
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockDB := mocks.NewMockDB(t)
+
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil).Once()
+	mockDB.EXPECT().FindCheckpoint(mock.Anything, models.CheckpointRunnerSecurityWatch).Return(nil, nil).Once()
+
+	originalEthNewClient := ethNewClient
+	originalDBNewDB := dbNewDB
+	defer func() {
+		ethNewClient = originalEthNewClient
+		dbNewDB = originalDBNewDB
+	}()
+
+	ethNewClient = func(models.EthereumNetworkConfig) (eth.EthereumClient, error) {
+		return mockClient, nil
+	}
+	dbNewDB = func(string) db.DB {
+		return mockDB
+	}
+
+	runnable := NewSecurityWatch(config)
+
+	watch, ok := runnable.(*EthSecurityWatchRunnable)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(100), watch.currentBlockHeight)
+	assert.Equal(t, uint64(1), watch.startBlockHeight)
+	assert.Equal(t, uint64(3), watch.confirmations)
+	assert.Len(t, watch.contracts, 4)
+	assert.Equal(t, "mailbox", watch.contracts[0].name)
+	assert.Equal(t, watchWarpISM, watch.contracts[3].address)
+}
+
+func TestNewSecurityWatch_Disabled(t *testing.T) {
+	defer func() { log.StandardLogger().ExitFunc = nil }()
+	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
+
+	assert.Panics(t, func() {
+		NewSecurityWatch(models.EthereumNetworkConfig{ChainName: "Ethereum"})
+	})
+}
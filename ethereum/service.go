@@ -1,7 +1,6 @@
 package ethereum
 
 import (
-	"fmt"
 	"sync"
 	"time"
 
@@ -13,7 +12,11 @@ import (
 
 var utilParseChain = util.ParseChain
 var utilSignMessage = util.SignMessage
+var utilRecoverSigner = util.RecoverSigner
+var utilEncodeMetadata = util.EncodeMetadata
 var ethValidateTransactionByHash = ValidateTransactionByHash
+var ethCheckTransactionReorg = CheckTransactionReorg
+var ethFetchTransactionReceipts = FetchTransactionReceipts
 var utilValidateTxToCosmosMultisig = cosmosUtil.ValidateTxToCosmosMultisig
 
 func NewEthereumChainService(
@@ -23,24 +26,13 @@ func NewEthereumChainService(
 	ethNetworks []models.EthereumNetworkConfig,
 	mnemonic string,
 	wg *sync.WaitGroup,
-	nodeHealth *models.Node,
 ) service.ChainService {
 
-	var chainHealth models.ChainServiceHealth
-	if nodeHealth != nil {
-		for _, health := range nodeHealth.Health {
-			if health.Chain.ChainID == fmt.Sprintf("%d", config.ChainID) && health.Chain.ChainType == models.ChainTypeEthereum {
-				chainHealth = health
-				break
-			}
-		}
-	}
-
 	chain := utilParseChain(config)
 
 	var monitorRunnable service.Runnable = &service.EmptyRunnable{}
 	if config.MessageMonitor.Enabled {
-		monitorRunnable = NewMessageMonitor(config, mintControllerMap, chainHealth.MessageMonitor)
+		monitorRunnable = NewMessageMonitor(config, mintControllerMap)
 	}
 	monitorRunnerService := service.NewRunnerService(
 		"monitor",
@@ -64,7 +56,7 @@ func NewEthereumChainService(
 
 	var relayerRunnable service.Runnable = &service.EmptyRunnable{}
 	if config.MessageRelayer.Enabled {
-		relayerRunnable = NewMessageRelayer(config, mintControllerMap, chainHealth.MessageRelayer)
+		relayerRunnable = NewMessageRelayer(config, mintControllerMap)
 	}
 
 	relayerRunnerService := service.NewRunnerService(
@@ -75,11 +67,54 @@ func NewEthereumChainService(
 		chain,
 	)
 
+	options := []service.ChainServiceOption{}
+
+	if config.MessageAuditor.Enabled {
+		options = append(options, service.WithAuditor(service.NewRunnerService(
+			"auditor",
+			NewMessageAuditor(config, mintControllerMap),
+			true,
+			time.Duration(config.MessageAuditor.IntervalMS)*time.Millisecond,
+			chain,
+		)))
+	}
+
+	if config.MessageFulfiller.Enabled {
+		options = append(options, service.WithFulfiller(service.NewRunnerService(
+			"fulfiller",
+			NewMessageFulfiller(config),
+			true,
+			time.Duration(config.MessageFulfiller.IntervalMS)*time.Millisecond,
+			chain,
+		)))
+	}
+
+	if config.MintAuditor.Enabled {
+		options = append(options, service.WithMintAuditor(service.NewRunnerService(
+			"mint_auditor",
+			NewMintAuditor(config),
+			true,
+			time.Duration(config.MintAuditor.IntervalMS)*time.Millisecond,
+			chain,
+		)))
+	}
+
+	if config.SecurityWatch.Enabled {
+		options = append(options, service.WithSecurityWatch(service.NewRunnerService(
+			"security_watch",
+			NewSecurityWatch(config),
+			true,
+			time.Duration(config.SecurityWatch.IntervalMS)*time.Millisecond,
+			chain,
+		)))
+	}
+
 	return service.NewChainService(
 		chain,
 		monitorRunnerService,
 		signerRunnerService,
 		relayerRunnerService,
 		wg,
+		options...,
 	)
 }
@@ -29,9 +29,11 @@ func TestNewEthereumService(t *testing.T) {
 
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
+	mockClient.EXPECT().FinalityTag().Return("")
 	mockMailbox := clientMocks.NewMockMailboxContract(t)
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	mockWarpISM := clientMocks.NewMockWarpISMContract(t)
+	mockOmniToken := clientMocks.NewMockOmniTokenContract(t)
 	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 
 	mnemonic := "infant apart enroll relief kangaroo patch awesome wagon trap feature armor approve"
@@ -112,11 +114,15 @@ func TestNewEthereumService(t *testing.T) {
 		return mockWarpISM, nil
 	}
 
+	ethNewOmniTokenContract = func(ethcommon.Address, bind.ContractBackend) (eth.OmniTokenContract, error) {
+		return mockOmniToken, nil
+	}
+
 	cosmosNewClient = func(models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
 		return mockCosmosClient, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -125,12 +131,15 @@ func TestNewEthereumService(t *testing.T) {
 		ethNewMailboxContract = eth.NewMailboxContract
 		ethNewMintControllerContract = eth.NewMintControllerContract
 		ethNewWarpISMContract = eth.NewWarpISMContract
+		ethNewOmniTokenContract = eth.NewOmniTokenContract
 		cosmosNewClient = cosmos.NewClient
 		dbNewDB = db.NewDB
 	}()
 
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 
+	mockDB.EXPECT().FindCheckpoint(mock.Anything, mock.Anything).Return(nil, nil)
+
 	mockCosmosClient.EXPECT().GetLatestBlockHeight().Return(int64(100), nil)
 
 	mockClient.EXPECT().GetClient().Return(nil)
@@ -139,6 +148,7 @@ func TestNewEthereumService(t *testing.T) {
 
 	mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil)
 	mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil)
+	mockWarpISM.EXPECT().Validators(mock.Anything, mock.Anything).Return(true, nil)
 	mockWarpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{ChainId: big.NewInt(1), VerifyingContract: ethcommon.HexToAddress(config.WarpISMAddress)}, nil)
 	mockMintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(100), nil)
 
@@ -155,27 +165,10 @@ func TestNewEthereumService(t *testing.T) {
 			ethNetworks,
 			mnemonic,
 			nil,
-			nil,
 		)
 	})
 
 	wg := &sync.WaitGroup{}
-	lastHealth := models.ChainServiceHealth{
-		Chain: models.Chain{
-			ChainID:     "1",
-			ChainDomain: 1,
-			ChainName:   "Ethereum",
-			ChainType:   models.ChainTypeEthereum,
-		},
-		MessageMonitor: &models.RunnerServiceStatus{BlockHeight: 100},
-		MessageSigner:  &models.RunnerServiceStatus{BlockHeight: 100},
-		MessageRelayer: &models.RunnerServiceStatus{BlockHeight: 100},
-	}
-
-	node := &models.Node{
-		Health: []models.ChainServiceHealth{lastHealth},
-	}
-
 	service := NewEthereumChainService(
 		config,
 		cosmosNetwork,
@@ -183,7 +176,6 @@ func TestNewEthereumService(t *testing.T) {
 		ethNetworks,
 		mnemonic,
 		wg,
-		node,
 	)
 	assert.NotNil(t, service)
 
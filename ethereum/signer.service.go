@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
@@ -35,6 +39,11 @@ type EthMessageSignerRunnable struct {
 
 	mintController eth.MintControllerContract
 	warpISM        eth.WarpISMContract
+	warpISMABI     *abi.ABI
+	omniToken      eth.OmniTokenContract
+
+	// destinationPaused is whether the omni token was paused when last checked
+	destinationPaused bool
 
 	client eth.EthereumClient
 
@@ -45,13 +54,25 @@ type EthMessageSignerRunnable struct {
 
 	privateKey *ecdsa.PrivateKey
 
-	// TODO: validate maximumAmount
-	maximumAmount *big.Int
+	maximumAmount    *big.Int
+	currentMintLimit *big.Int
+	// mintSchedule queues the amounts of the signed messages and the ones signed in this run
+	mintSchedule *MintSchedule
 
 	numSigners      int64
 	signerThreshold int64
 	domain          util.DomainData
 
+	// validators holds the lowercase addresses of the warp ism validators among the known candidates
+	validators      map[string]bool
+	oracleAddresses []string
+	// ismBlockHeight is the last block scanned for validator set and threshold changes
+	ismBlockHeight uint64
+
+	// receipts holds the receipts prefetched for the batch of messages being signed
+	// and the block heights of their origin chains, read once per run
+	receipts *eth.ReceiptCache
+
 	logger *log.Entry
 
 	db db.DB
@@ -59,6 +80,8 @@ type EthMessageSignerRunnable struct {
 
 func (x *EthMessageSignerRunnable) Run() {
 	x.UpdateCurrentBlockHeight()
+	x.SyncValidatorSet()
+	x.RetrySimulations()
 	x.SignMessages()
 }
 
@@ -103,7 +126,7 @@ func (x *EthMessageSignerRunnable) UpdateMessage(
 	message *models.Message,
 	update bson.M,
 ) bool {
-	err := x.db.UpdateMessage(message.ID, update)
+	err := x.db.UpdateMessage(message.ID, message.Status, update)
 	if err != nil {
 		x.logger.WithError(err).Errorf("Error updating message")
 		return false
@@ -123,23 +146,54 @@ func (x *EthMessageSignerRunnable) SignMessage(messageDoc *models.Message) bool
 		defer x.db.Unlock(lockID)
 	}
 
+	// the message may have been reorged or signed by another oracle since it was read
+	current, err := x.db.FindMessage(bson.M{"_id": messageDoc.ID})
+	if err != nil {
+		logger.WithError(err).Errorf("Error finding message")
+		return false
+	}
+	if current.Status == models.MessageStatusReorged {
+		logger.Warnf("Origin transaction was reorged, not signing message")
+		return false
+	}
+	if current.Status != messageDoc.Status {
+		logger.WithField("status", current.Status).Debugf("Message status changed, not signing message")
+		return false
+	}
+	messageDoc.Signatures = current.Signatures
+
 	if err := utilSignMessage(messageDoc, x.domain, x.privateKey); err != nil {
 		logger.WithError(err).Errorf("Error signing message")
 		return false
 	}
 
+	messageDoc.Signatures = x.ValidSignatures(messageDoc)
+
+	status, simulationError, err := x.ReadyStatus(messageDoc.Content, messageDoc.Signatures)
+	if err != nil {
+		// the signature is made again on the next run
+		logger.WithError(err).Errorf("Error simulating fulfillment")
+		return false
+	}
+	if simulationError != "" {
+		logger.WithField("reason", simulationError).Warnf("Signatures failed to verify on the warp ism")
+	}
+
 	update := bson.M{
-		"status":     models.MessageStatusPending,
-		"signatures": messageDoc.Signatures,
+		"status":           status,
+		"signatures":       messageDoc.Signatures,
+		"simulation_error": simulationError,
 	}
-	if len(messageDoc.Signatures) >= int(x.signerThreshold) {
-		update["status"] = models.MessageStatusSigned
+	if messageDoc.FulfillableAt != nil {
+		update["fulfillable_at"] = messageDoc.FulfillableAt
 	}
 
 	logger.Debugf("Message signed")
 	return x.UpdateMessage(messageDoc, update)
 }
 
+// ValidateCosmosMessage returns a models.ValidationError when the cosmos tx does not back the message,
+// and any other error when the tx could not be read
 func (x *EthMessageSignerRunnable) ValidateCosmosMessage(messageDoc *models.Message) (confirmed bool, err error) {
 	txResponse, err := x.cosmosClient.GetTx(messageDoc.OriginTransactionHash)
 	if err != nil {
@@ -150,25 +204,26 @@ func (x *EthMessageSignerRunnable) ValidateCosmosMessage(messageDoc *models.Mess
 
 	result, err := utilValidateTxToCosmosMultisig(txResponse, x.cosmosConfig, supportedChainIDsEthereum, x.currentCosmosBlockHeight)
 	if err != nil {
-		return false, fmt.Errorf("error validating tx response: %w", err)
+		// the tx was read, so an error parsing its events is permanent
+		return false, models.NewValidationError(models.StatusCodeInvalidTx, "error validating tx response: %s", err)
 	}
 
 	if result.NeedsRefund {
-		return false, fmt.Errorf("tx needs refund")
+		return false, models.NewValidationError(models.StatusCodeNeedsRefund, "tx needs refund: %s", result.StatusReason)
 	}
 
 	amount, ok := new(big.Int).SetString(messageDoc.Content.MessageBody.Amount, 10)
 
 	if ok && amount.Cmp(result.Amount.Amount.BigInt()) != 0 {
-		return false, fmt.Errorf("amount mismatch")
+		return false, models.NewValidationError(models.StatusCodeAmountMismatch, "amount mismatch")
 	}
 
 	if !strings.EqualFold(messageDoc.Content.MessageBody.SenderAddress, common.HexFromBytes(result.SenderAddress)) {
-		return false, fmt.Errorf("sender mismatch")
+		return false, models.NewValidationError(models.StatusCodeSenderMismatch, "sender mismatch")
 	}
 
 	if !strings.EqualFold(messageDoc.Content.MessageBody.RecipientAddress, result.Memo.Address) {
-		return false, fmt.Errorf("recipient mismatch")
+		return false, models.NewValidationError(models.StatusCodeRecipientMismatch, "recipient mismatch")
 	}
 
 	if result.TxStatus == models.TransactionStatusPending {
@@ -176,21 +231,36 @@ func (x *EthMessageSignerRunnable) ValidateCosmosMessage(messageDoc *models.Mess
 	}
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
-		return false, fmt.Errorf("tx is invalid")
+		return false, models.NewValidationError(result.StatusCode, "tx is invalid: %s", result.StatusReason)
 	}
 
 	return true, nil
 }
 
+// InvalidateMessage marks a message whose origin transaction provably does not back it as invalid
+func (x *EthMessageSignerRunnable) InvalidateMessage(messageDoc *models.Message, code models.StatusCode, reason string) bool {
+	x.logger.
+		WithField("tx_hash", messageDoc.OriginTransactionHash).
+		WithField("status_code", code).
+		WithField("reason", reason).
+		Warnf("Marking message invalid")
+	return x.UpdateMessage(messageDoc, db.StatusUpdate(models.MessageStatusInvalid, code, reason))
+}
+
 func (x *EthMessageSignerRunnable) ValidateCosmosTxAndSignMessage(messageDoc *models.Message) bool {
 	logger := x.logger.WithField("tx_hash", messageDoc.OriginTransactionHash).WithField("section", "sign-cosmos-message")
 	logger.Debugf("Signing cosmos message")
 
 	confirmed, err := x.ValidateCosmosMessage(messageDoc)
 
+	if validationErr, ok := models.AsValidationError(err); ok {
+		x.InvalidateMessage(messageDoc, validationErr.Code, validationErr.Reason)
+		return false
+	}
+
 	if err != nil {
+		// retried on the next run
 		logger.WithError(err).Errorf("Error validating cosmos message")
-		x.UpdateMessage(messageDoc, bson.M{"status": models.MessageStatusInvalid})
 		return false
 	}
 
@@ -206,6 +276,8 @@ type ValidateTransactionAndParseDispatchIDEventsResult struct {
 	Event         *autogen.MailboxDispatchId
 	Confirmations uint64
 	TxStatus      models.TransactionStatus
+	StatusCode    models.StatusCode // cause of a failed or invalid status
+	StatusReason  string
 }
 
 func (x *EthMessageSignerRunnable) ValidateAndFindDispatchIDEvent(messageDoc *models.Message) (*ValidateTransactionAndParseDispatchIDEventsResult, error) {
@@ -225,13 +297,15 @@ func (x *EthMessageSignerRunnable) ValidateAndFindDispatchIDEvent(messageDoc *mo
 		return nil, fmt.Errorf("mailbox not found")
 	}
 
-	receipt, err := ethClient.GetTransactionReceipt(txHash)
+	receipt, err := x.receipts.GetTransactionReceipt(chainDomain, ethClient, txHash)
 	if err != nil {
 		return nil, fmt.Errorf("error getting transaction receipt: %w", err)
 	}
 	if receipt == nil || receipt.Status != types.ReceiptStatusSuccessful {
 		return &ValidateTransactionAndParseDispatchIDEventsResult{
-			TxStatus: models.TransactionStatusFailed,
+			TxStatus:     models.TransactionStatusFailed,
+			StatusCode:   models.StatusCodeTxFailed,
+			StatusReason: "origin tx failed",
 		}, nil
 	}
 	var dispatchEvent *autogen.MailboxDispatchId
@@ -248,21 +322,23 @@ func (x *EthMessageSignerRunnable) ValidateAndFindDispatchIDEvent(messageDoc *mo
 		}
 	}
 
-	currentBlockHeight, err := ethClient.GetBlockHeight()
+	heights, err := x.receipts.GetBlockHeights(chainDomain, ethClient)
 	if err != nil {
-		return nil, fmt.Errorf("error getting current block height: %w", err)
+		return nil, err
 	}
 
 	result := &ValidateTransactionAndParseDispatchIDEventsResult{
 		Event:         dispatchEvent,
-		Confirmations: currentBlockHeight - receipt.BlockNumber.Uint64(),
+		Confirmations: eth.Confirmations(receipt.BlockNumber.Uint64(), heights.Current),
 		TxStatus:      models.TransactionStatusPending,
 	}
-	if result.Confirmations >= ethClient.Confirmations() {
+	if eth.IsBlockConfirmed(ethClient, receipt.BlockNumber.Uint64(), heights.Current, heights.Finalized, ethClient.Confirmations()) {
 		result.TxStatus = models.TransactionStatusConfirmed
 	}
 	if dispatchEvent == nil {
 		result.TxStatus = models.TransactionStatusInvalid
+		result.StatusCode = models.StatusCodeNoDispatchEvent
+		result.StatusReason = "origin tx has no dispatch event for the message"
 	}
 	return result, nil
 }
@@ -283,8 +359,7 @@ func (x *EthMessageSignerRunnable) ValidateEthereumTxAndSignMessage(messageDoc *
 	}
 
 	if result.TxStatus != models.TransactionStatusConfirmed {
-		logger.Debugf("Found tx with status %s", result.TxStatus)
-		x.UpdateMessage(messageDoc, bson.M{"status": models.MessageStatusInvalid})
+		x.InvalidateMessage(messageDoc, result.StatusCode, result.StatusReason)
 		return false
 	}
 
@@ -298,6 +373,21 @@ func (x *EthMessageSignerRunnable) SignMessages() bool {
 		x.logger.WithError(err).Errorf("Error getting address hex")
 		return false
 	}
+	if x.validators != nil && !x.validators[strings.ToLower(common.Ensure0xPrefix(addressHex))] {
+		x.logger.Warnf("Not signing messages, this oracle is not a validator of the warp ism")
+		return false
+	}
+	if !x.UpdateDestinationPaused() {
+		return false
+	}
+	if x.destinationPaused {
+		return x.HoldMessagesWhilePaused()
+	}
+	// a message that fails to be released here is still picked up below if this oracle has not signed it
+	released := x.ReleasePausedMessages()
+	if !x.UpdateMintSchedule() {
+		return false
+	}
 	messages, err := x.db.GetPendingMessages(common.Ensure0xPrefix(addressHex), x.chain)
 
 	if err != nil {
@@ -305,32 +395,57 @@ func (x *EthMessageSignerRunnable) SignMessages() bool {
 		return false
 	}
 	x.logger.Infof("Found %d pending messages", len(messages))
-	success := true
-	for _, messageDoc := range messages {
 
-		if messageDoc.Content.OriginDomain == x.cosmosClient.Chain().ChainDomain {
-			success = x.ValidateCosmosTxAndSignMessage(&messageDoc) && success
-			continue
+	heldMessages, err := x.db.GetHeldMessages(common.Ensure0xPrefix(addressHex), x.chain)
+	if err != nil {
+		x.logger.WithError(err).Errorf("Error getting held messages")
+		return false
+	}
+	x.logger.Infof("Found %d held messages", len(heldMessages))
+
+	// held messages are released in the order they were created once they fit the mint limit
+	messages = append(messages, heldMessages...)
+	sort.SliceStable(messages, func(i, j int) bool {
+		return queuedBefore(&messages[i], &messages[j])
+	})
+
+	scheduled := []models.Message{}
+	for i := range messages {
+		if x.ApplyMintLimit(&messages[i]) {
+			scheduled = append(scheduled, messages[i])
 		}
-
-		success = x.ValidateEthereumTxAndSignMessage(&messageDoc) && success
 	}
-
-	return success
+	// sign the messages that can be fulfilled first so the backlog drains in order
+	sort.SliceStable(scheduled, func(i, j int) bool {
+		return fulfillableBefore(scheduled[i].FulfillableAt, scheduled[j].FulfillableAt)
+	})
+	messages = scheduled
+	x.receipts = eth.NewReceiptCache()
+	defer func() { x.receipts = nil }()
+
+	signed := eth.ProcessInBatches(messages, func(batch []models.Message) {
+		x.receipts.PrefetchMessageReceipts(x.ethClientMap, batch, x.logger)
+	}, func(messageDoc *models.Message) bool {
+		if messageDoc.Content.OriginDomain == x.cosmosClient.Chain().ChainDomain {
+			return x.ValidateCosmosTxAndSignMessage(messageDoc)
+		}
+		return x.ValidateEthereumTxAndSignMessage(messageDoc)
+	})
+	return signed && released
 }
 
-func (x *EthMessageSignerRunnable) UpdateValidatorCountAndSignerThreshold() {
+// UpdateValidatorCountAndSignerThreshold reads the validator count and signer threshold of the warp ism.
+// Neither is updated unless both are read.
+func (x *EthMessageSignerRunnable) UpdateValidatorCountAndSignerThreshold() error {
 	x.logger.Debug("Fetching validator count")
 	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
 	defer cancel()
 	opts := &bind.CallOpts{Context: ctx, Pending: false}
 	count, err := x.warpISM.ValidatorCount(opts)
 	if err != nil {
-		x.logger.WithError(err).Error("Error fetching validator count")
-		return
+		return fmt.Errorf("error fetching validator count: %w", err)
 	}
 	x.logger.Debug("Fetched validator count")
-	x.numSigners = count.Int64()
 
 	x.logger.Debug("Fetching signer threshold")
 	ctx, cancel = context.WithTimeout(context.Background(), x.timeout)
@@ -338,12 +453,324 @@ func (x *EthMessageSignerRunnable) UpdateValidatorCountAndSignerThreshold() {
 	opts = &bind.CallOpts{Context: ctx, Pending: false}
 	threshold, err := x.warpISM.SignerThreshold(opts)
 	if err != nil {
-		x.logger.WithError(err).Error("Error fetching signer threshold")
-		return
+		return fmt.Errorf("error fetching signer threshold: %w", err)
 	}
 	x.logger.Debug("Fetched signer threshold")
 
+	x.numSigners = count.Int64()
 	x.signerThreshold = threshold.Int64()
+	return nil
+}
+
+// UpdateValidators checks which of the candidate addresses are validators of the warp ism
+func (x *EthMessageSignerRunnable) UpdateValidators(candidates []string) bool {
+	validators := make(map[string]bool)
+	checked := make(map[string]bool)
+	for _, candidate := range candidates {
+		address := strings.ToLower(ethcommon.HexToAddress(candidate).Hex())
+		if checked[address] {
+			continue
+		}
+		checked[address] = true
+
+		ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+		isValidator, err := x.warpISM.Validators(&bind.CallOpts{Context: ctx, Pending: false}, ethcommon.HexToAddress(candidate))
+		cancel()
+		if err != nil {
+			x.logger.WithError(err).WithField("validator", candidate).Error("Error fetching validator")
+			return false
+		}
+		if isValidator {
+			validators[address] = true
+		}
+	}
+	x.validators = validators
+	return true
+}
+
+// ValidSignatures returns the signatures on the message that recover to their signer, one per signer,
+// made by current validators of the warp ism, or by the oracle addresses while the validator set is unknown
+func (x *EthMessageSignerRunnable) ValidSignatures(messageDoc *models.Message) []models.Signature {
+	logger := x.logger.WithField("tx_hash", messageDoc.OriginTransactionHash).WithField("section", "valid-signatures")
+
+	valid := []models.Signature{}
+	seen := make(map[string]bool)
+	for _, signature := range messageDoc.Signatures {
+		signer := strings.ToLower(signature.Signer)
+
+		recovered, err := utilRecoverSigner(messageDoc.Content, x.domain, signature.Signature)
+		if err != nil || recovered != signer {
+			logger.WithError(err).WithField("signer", signer).Warn("Dropping invalid signature")
+			continue
+		}
+		if seen[signer] {
+			logger.WithField("signer", signer).Warn("Dropping duplicate signature")
+			continue
+		}
+		if !x.IsAllowedSigner(signer) {
+			logger.WithField("signer", signer).Warn("Dropping signature from a non validator")
+			continue
+		}
+
+		seen[signer] = true
+		valid = append(valid, signature)
+	}
+	return valid
+}
+
+// IsAllowedSigner returns whether the lowercase address may sign messages for the chain
+func (x *EthMessageSignerRunnable) IsAllowedSigner(signer string) bool {
+	if x.validators != nil {
+		return x.validators[signer]
+	}
+	if len(x.oracleAddresses) == 0 {
+		return true
+	}
+	for _, address := range x.oracleAddresses {
+		if strings.EqualFold(address, signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindValidatorSetChanges scans the blocks after ismBlockHeight for validators being added or removed
+// and for a new signer threshold, and returns whether anything changed along with the validators involved
+func (x *EthMessageSignerRunnable) FindValidatorSetChanges(startBlockHeight uint64, endBlockHeight uint64) (bool, []string, error) {
+	changed := false
+	validators := []string{}
+
+	opts := func() *bind.FilterOpts {
+		return &bind.FilterOpts{Start: startBlockHeight, End: &endBlockHeight, Context: context.Background()}
+	}
+
+	newValidators, err := x.warpISM.FilterNewValidator(opts(), nil)
+	if err != nil {
+		return false, nil, fmt.Errorf("error filtering new validator events: %w", err)
+	}
+	defer newValidators.Close()
+	for newValidators.Next() {
+		if event := newValidators.Event(); event != nil && !event.Raw.Removed {
+			changed = true
+			validators = append(validators, event.Validator.Hex())
+		}
+	}
+	if err := newValidators.Error(); err != nil {
+		return false, nil, fmt.Errorf("error processing new validator events: %w", err)
+	}
+
+	removedValidators, err := x.warpISM.FilterRemovedValidator(opts(), nil)
+	if err != nil {
+		return false, nil, fmt.Errorf("error filtering removed validator events: %w", err)
+	}
+	defer removedValidators.Close()
+	for removedValidators.Next() {
+		if event := removedValidators.Event(); event != nil && !event.Raw.Removed {
+			changed = true
+			validators = append(validators, event.Validator.Hex())
+		}
+	}
+	if err := removedValidators.Error(); err != nil {
+		return false, nil, fmt.Errorf("error processing removed validator events: %w", err)
+	}
+
+	thresholds, err := x.warpISM.FilterSignerThresholdSet(opts(), nil)
+	if err != nil {
+		return false, nil, fmt.Errorf("error filtering signer threshold events: %w", err)
+	}
+	defer thresholds.Close()
+	for thresholds.Next() {
+		if event := thresholds.Event(); event != nil && !event.Raw.Removed {
+			changed = true
+		}
+	}
+	if err := thresholds.Error(); err != nil {
+		return false, nil, fmt.Errorf("error processing signer threshold events: %w", err)
+	}
+
+	return changed, validators, nil
+}
+
+// SyncValidatorSet refreshes the validator set and signer threshold when the warp ism emitted
+// NewValidator, RemovedValidator or SignerThresholdSet since the last run,
+// and recomputes the signatures and status of the messages waiting to be fulfilled
+func (x *EthMessageSignerRunnable) SyncValidatorSet() bool {
+	logger := x.logger.WithField("section", "SyncValidatorSet")
+
+	if x.currentEthereumBlockHeight <= x.ismBlockHeight {
+		return true
+	}
+
+	changed := false
+	candidates := append([]string{}, x.oracleAddresses...)
+	for validator := range x.validators {
+		candidates = append(candidates, validator)
+	}
+
+	success := SyncBlocksInChunks(x.client, x.ismBlockHeight+1, x.currentEthereumBlockHeight, func(start uint64, end uint64) bool {
+		found, validators, err := x.FindValidatorSetChanges(start, end)
+		if err != nil {
+			logger.WithError(err).Error("Error finding validator set changes")
+			return false
+		}
+		changed = changed || found
+		candidates = append(candidates, validators...)
+		return true
+	})
+	if !success {
+		return false
+	}
+
+	if changed {
+		logger.Info("Warp ism validator set or signer threshold changed, refreshing")
+
+		if err := x.UpdateValidatorCountAndSignerThreshold(); err != nil {
+			logger.WithError(err).Error("Error fetching validator count and signer threshold")
+			return false
+		}
+		if !x.UpdateValidators(candidates) {
+			return false
+		}
+		if len(x.validators) != int(x.numSigners) {
+			logger.Warnf("Found %d of %d validators, some validators are not listed in oracle_addresses", len(x.validators), x.numSigners)
+		}
+		logger.
+			WithField("num_signers", x.numSigners).
+			WithField("signer_threshold", x.signerThreshold).
+			Info("Refreshed validator set")
+
+		if !x.RecomputeMessageStatuses() {
+			return false
+		}
+	}
+
+	x.ismBlockHeight = x.currentEthereumBlockHeight
+	return true
+}
+
+// RecomputeMessageStatuses strips the signatures of removed validators from the messages
+// that are not fulfilled yet and sets their status from the current signer threshold
+func (x *EthMessageSignerRunnable) RecomputeMessageStatuses() bool {
+	logger := x.logger.WithField("section", "RecomputeMessageStatuses")
+
+	messages, err := x.db.GetPendingAndSignedMessages(x.chain)
+	if err != nil {
+		logger.WithError(err).Error("Error getting pending and signed messages")
+		return false
+	}
+
+	success := true
+	for i := range messages {
+		success = x.RecomputeMessageStatus(&messages[i]) && success
+	}
+	return success
+}
+
+func (x *EthMessageSignerRunnable) RecomputeMessageStatus(messageDoc *models.Message) bool {
+	logger := x.logger.WithField("tx_hash", messageDoc.OriginTransactionHash).WithField("section", "RecomputeMessageStatus")
+
+	signatures := x.ValidSignatures(messageDoc)
+	status, simulationError, err := x.ReadyStatus(messageDoc.Content, signatures)
+	if err != nil {
+		logger.WithError(err).Error("Error simulating fulfillment")
+		return false
+	}
+	if len(signatures) == len(messageDoc.Signatures) && status == messageDoc.Status && simulationError == messageDoc.SimulationError {
+		return true
+	}
+
+	if lockID, err := x.db.LockWriteMessage(messageDoc); err != nil {
+		logger.WithError(err).Error("Error locking message")
+		return false
+	} else {
+		//nolint:errcheck
+		defer x.db.Unlock(lockID)
+	}
+
+	logger.
+		WithField("removed_signatures", len(messageDoc.Signatures)-len(signatures)).
+		WithField("status", status).
+		WithField("simulation_error", simulationError).
+		Info("Updating message status")
+
+	return x.UpdateMessage(messageDoc, bson.M{
+		"signatures":       signatures,
+		"status":           status,
+		"simulation_error": simulationError,
+	})
+}
+
+// RetrySimulations verifies again the messages whose signatures failed to verify,
+// since they are not picked up for signing once this oracle has signed them
+func (x *EthMessageSignerRunnable) RetrySimulations() bool {
+	logger := x.logger.WithField("section", "RetrySimulations")
+
+	messages, err := x.db.GetPendingAndSignedMessages(x.chain)
+	if err != nil {
+		logger.WithError(err).Error("Error getting pending and signed messages")
+		return false
+	}
+
+	success := true
+	for i := range messages {
+		if messages[i].Status != models.MessageStatusPending || messages[i].SimulationError == "" {
+			continue
+		}
+		success = x.RecomputeMessageStatus(&messages[i]) && success
+	}
+	return success
+}
+
+// ReadyStatus returns signed if the signatures meet the signer threshold and verify on the warp ism,
+// otherwise pending along with the reason the verification failed.
+// An error is returned if the warp ism could not be called, in which case the status should be kept
+func (x *EthMessageSignerRunnable) ReadyStatus(content models.MessageContent, signatures []models.Signature) (models.MessageStatus, string, error) {
+	if len(signatures) < int(x.signerThreshold) {
+		return models.MessageStatusPending, "", nil
+	}
+
+	err := x.SimulateFulfillment(content, signatures)
+	if errors.Is(err, errVerifyCall) {
+		return "", "", err
+	}
+	if err != nil {
+		return models.MessageStatusPending, util.RevertReason(err, x.warpISMABI), nil
+	}
+	return models.MessageStatusSigned, "", nil
+}
+
+// errVerifyCall is returned by SimulateFulfillment when the warp ism could not be called, as opposed to reverting
+var errVerifyCall = errors.New("error calling verify on the warp ism")
+
+// SimulateFulfillment calls verify on the warp ism with the message and the sorted signatures,
+// which is the check fulfillOrder makes on them; fulfillOrder itself is not simulated
+// since it also reverts for messages waiting on the mint limit.
+// Errors other than a revert from the call are wrapped in errVerifyCall
+func (x *EthMessageSignerRunnable) SimulateFulfillment(content models.MessageContent, signatures []models.Signature) error {
+	metadata, err := utilEncodeMetadata(signatures)
+	if err != nil {
+		return err
+	}
+	message, err := content.EncodeToBytes()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx, Pending: false}
+
+	verified, err := x.warpISM.Verify(opts, metadata, message)
+	if err != nil && !util.IsRevert(err) {
+		return fmt.Errorf("%w: %w", errVerifyCall, err)
+	}
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return fmt.Errorf("signatures not verified")
+	}
+	return nil
 }
 
 func (x *EthMessageSignerRunnable) UpdateDomainData() {
@@ -361,7 +788,7 @@ func (x *EthMessageSignerRunnable) UpdateDomainData() {
 	x.domain = domain
 }
 
-func (x *EthMessageSignerRunnable) UpdateMaxMintLimit() {
+func (x *EthMessageSignerRunnable) UpdateMaxMintLimit() bool {
 	x.logger.Debug("Fetching max mint limit")
 	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
 	defer cancel()
@@ -370,14 +797,226 @@ func (x *EthMessageSignerRunnable) UpdateMaxMintLimit() {
 
 	if err != nil {
 		x.logger.WithError(err).Error("Error fetching max mint limit")
-		return
+		return false
 	}
 	x.logger.Debug("Fetched max mint limit")
 	x.maximumAmount = mintLimit
+	return true
+}
+
+// UpdateMintSchedule reads the max mint limit, current mint limit and mint rate of the mint controller
+// and queues the signed messages that are not fulfilled yet, storing when each can be fulfilled
+func (x *EthMessageSignerRunnable) UpdateMintSchedule() bool {
+	// the max mint limit can be changed by the mint controller owner at any time
+	if !x.UpdateMaxMintLimit() {
+		return false
+	}
+
+	x.logger.Debug("Fetching current mint limit")
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx, Pending: false}
+	currentMintLimit, err := x.mintController.CurrentMintLimit(opts)
+	if err != nil {
+		x.logger.WithError(err).Error("Error fetching current mint limit")
+		return false
+	}
+	mintPerSecond, err := x.mintController.MintPerSecond(opts)
+	if err != nil {
+		x.logger.WithError(err).Error("Error fetching mint per second")
+		return false
+	}
+
+	signedMessages, err := x.db.GetSignedMessages(x.chain)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting signed messages")
+		return false
+	}
+	sort.SliceStable(signedMessages, func(i, j int) bool {
+		return queuedBefore(&signedMessages[i], &signedMessages[j])
+	})
+
+	x.currentMintLimit = currentMintLimit
+	x.mintSchedule = NewMintSchedule(time.Now(), currentMintLimit, x.maximumAmount, mintPerSecond)
+
+	success := true
+	for i := range signedMessages {
+		messageDoc := &signedMessages[i]
+		amount, ok := new(big.Int).SetString(messageDoc.Content.MessageBody.Amount, 10)
+		if !ok {
+			continue
+		}
+		fulfillableAt := x.ScheduleMint(amount)
+		if fulfillableBefore(fulfillableAt, messageDoc.FulfillableAt) || fulfillableBefore(messageDoc.FulfillableAt, fulfillableAt) {
+			success = x.UpdateMessage(messageDoc, bson.M{"fulfillable_at": fulfillableAt}) && success
+		}
+	}
+
+	x.logger.
+		WithField("current_mint_limit", currentMintLimit.String()).
+		WithField("mint_per_second", mintPerSecond.String()).
+		WithField("signed_messages", len(signedMessages)).
+		Debug("Updated mint schedule")
+	return success
+}
+
+// ScheduleMint queues the amount behind the messages already scheduled
+// and returns when it can be fulfilled, or nil if the mint limit never allows it
+func (x *EthMessageSignerRunnable) ScheduleMint(amount *big.Int) *time.Time {
+	fulfillableAt, ok := x.mintSchedule.Reserve(amount)
+	if !ok {
+		return nil
+	}
+	fulfillableAt = fulfillableAt.UTC().Truncate(time.Second)
+	return &fulfillableAt
+}
+
+// fulfillableBefore orders fulfillment times with the unknown ones last
+func fulfillableBefore(a *time.Time, b *time.Time) bool {
+	if a == nil {
+		return false
+	}
+	if b == nil {
+		return true
+	}
+	return a.Before(*b)
+}
+
+// queuedBefore orders messages by when they were created.
+// Nonces are only ordered within an origin, so they break ties per origin domain
+func queuedBefore(a *models.Message, b *models.Message) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	if a.Content.OriginDomain != b.Content.OriginDomain {
+		return a.Content.OriginDomain < b.Content.OriginDomain
+	}
+	return a.Content.Nonce < b.Content.Nonce
+}
+
+// ApplyMintLimit returns whether the message can be signed within the mint limits,
+// queueing its amount to estimate when it can be fulfilled, and holds it as over limit otherwise
+func (x *EthMessageSignerRunnable) ApplyMintLimit(messageDoc *models.Message) bool {
+	logger := x.logger.WithField("tx_hash", messageDoc.OriginTransactionHash).WithField("section", "apply-mint-limit")
+
+	if messageDoc.Status == models.MessageStatusSigned {
+		// already queued with the signed messages
+		return true
+	}
+
+	amount, ok := new(big.Int).SetString(messageDoc.Content.MessageBody.Amount, 10)
+	if !ok {
+		x.InvalidateMessage(messageDoc, models.StatusCodeInvalidAmount, "invalid message amount")
+		return false
+	}
+
+	overMaximum := x.maximumAmount != nil && amount.Cmp(x.maximumAmount) > 0
+	overCurrent := x.currentMintLimit != nil && amount.Cmp(x.currentMintLimit) > 0
+
+	if !overMaximum && !overCurrent {
+		if x.mintSchedule != nil {
+			messageDoc.FulfillableAt = x.ScheduleMint(amount)
+		}
+		return true
+	}
+
+	if messageDoc.Status == models.MessageStatusOverLimit {
+		logger.Debugf("Message is still over the mint limit")
+		return false
+	}
+
+	logger.
+		WithField("amount", amount.String()).
+		WithField("max_mint_limit", x.maximumAmount).
+		WithField("current_mint_limit", x.currentMintLimit).
+		Warnf("Message amount is over the mint limit, holding message")
+
+	x.HoldMessage(messageDoc, models.MessageStatusOverLimit)
+	return false
+}
+
+// HoldMessage sets the status of a message that cannot be signed yet
+func (x *EthMessageSignerRunnable) HoldMessage(messageDoc *models.Message, status models.MessageStatus) bool {
+	logger := x.logger.WithField("tx_hash", messageDoc.OriginTransactionHash).WithField("section", "hold-message")
+
+	if lockID, err := x.db.LockWriteMessage(messageDoc); err != nil {
+		logger.WithError(err).Errorf("Error locking message")
+		return false
+	} else {
+		//nolint:errcheck
+		defer x.db.Unlock(lockID)
+	}
+
+	return x.UpdateMessage(messageDoc, bson.M{"status": status})
+}
+
+// HoldMessagesWhilePaused holds the pending and signed messages until the omni token is unpaused,
+// since their signatures could not be used and the fulfiller does not submit them while it is paused
+func (x *EthMessageSignerRunnable) HoldMessagesWhilePaused() bool {
+	messages, err := x.db.GetPendingAndSignedMessages(x.chain)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting pending and signed messages")
+		return false
+	}
+
+	success := true
+	held := 0
+	for i := range messages {
+		if x.HoldMessage(&messages[i], models.MessageStatusDestinationPaused) {
+			held++
+		} else {
+			success = false
+		}
+	}
+	x.logger.Warnf("Destination token is paused, held %d messages", held)
+	return success
+}
+
+// ReleasePausedMessages moves the messages held while the omni token was paused
+// back to pending or signed, depending on the signatures they already have
+func (x *EthMessageSignerRunnable) ReleasePausedMessages() bool {
+	messages, err := x.db.GetPausedMessages(x.chain)
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting paused messages")
+		return false
+	}
+	if len(messages) == 0 {
+		return true
+	}
+	x.logger.Infof("Releasing %d messages held while the destination token was paused", len(messages))
+
+	success := true
+	for i := range messages {
+		success = x.RecomputeMessageStatus(&messages[i]) && success
+	}
+	return success
+}
+
+// UpdateDestinationPaused checks whether the omni token is paused,
+// logging when it gets paused or unpaused
+func (x *EthMessageSignerRunnable) UpdateDestinationPaused() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), x.timeout)
+	defer cancel()
+	opts := &bind.CallOpts{Context: ctx, Pending: false}
+	paused, err := x.omniToken.Paused(opts)
+	if err != nil {
+		x.logger.WithError(err).Error("Error fetching omni token paused state")
+		return false
+	}
+
+	if paused && !x.destinationPaused {
+		x.logger.Warn("Omni token is paused, holding messages until it is unpaused")
+	}
+	if !paused && x.destinationPaused {
+		x.logger.Info("Omni token is unpaused, resuming signing")
+	}
+	x.destinationPaused = paused
+	return true
 }
 
 var ethNewMintControllerContract = eth.NewMintControllerContract
 var ethNewWarpISMContract = eth.NewWarpISMContract
+var ethNewOmniTokenContract = eth.NewOmniTokenContract
 var cosmosNewClient = cosmos.NewClient
 
 func NewMessageSigner(
@@ -417,6 +1056,18 @@ func NewMessageSigner(
 	}
 	logger.Debug("Connected to warp ism contract")
 
+	warpISMABI, err := autogen.WarpISMMetaData.GetAbi()
+	if err != nil {
+		logger.Fatal("Error parsing warp ism abi: ", err)
+	}
+
+	logger.Debug("Connecting to omni token contract at: ", config.OmniTokenAddress)
+	omniToken, err := ethNewOmniTokenContract(common.HexToAddress(config.OmniTokenAddress), client.GetClient())
+	if err != nil {
+		logger.Fatal("Error connecting to omni token contract: ", err)
+	}
+	logger.Debug("Connected to omni token contract")
+
 	privateKey, err := common.EthereumPrivateKeyFromMnemonic(mnemonic)
 	if err != nil {
 		logger.Fatalf("Error getting private key from mnemonic: %s", err)
@@ -462,6 +1113,8 @@ func NewMessageSigner(
 
 		mintController: mintController,
 		warpISM:        warpISM,
+		warpISMABI:     warpISMABI,
+		omniToken:      omniToken,
 
 		privateKey: privateKey,
 
@@ -474,24 +1127,35 @@ func NewMessageSigner(
 
 		numSigners:      0,
 		signerThreshold: 0,
+		oracleAddresses: config.OracleAddresses,
 
 		logger: logger,
 
-		db: dbNewDB(),
+		db: dbNewDB("signer"),
 	}
 
 	x.UpdateCurrentBlockHeight()
 
-	x.UpdateValidatorCountAndSignerThreshold()
+	if err := x.UpdateValidatorCountAndSignerThreshold(); err != nil {
+		x.logger.WithError(err).Fatal("Error fetching validator count and signer threshold")
+	}
 
+	// the warp ism is the source of truth for the validator set
 	if x.numSigners != int64(len(config.OracleAddresses)) {
-		x.logger.Fatalf("Invalid number of signers")
+		x.logger.Warnf("Warp ism has %d validators but %d oracle addresses are configured", x.numSigners, len(config.OracleAddresses))
 	}
 
 	if x.signerThreshold < 1 || x.signerThreshold > x.numSigners {
 		x.logger.Fatalf("Invalid signer threshold")
 	}
 
+	if !x.UpdateValidators(config.OracleAddresses) {
+		x.logger.Fatalf("Error fetching validators")
+	}
+
+	// later changes to the validator set are picked up from warp ism events
+	x.ismBlockHeight = x.currentEthereumBlockHeight
+
 	x.UpdateDomainData()
 
 	chainID := big.NewInt(int64(config.ChainID))
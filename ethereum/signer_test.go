@@ -4,12 +4,14 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	cosmos "github.com/dan13ram/wpokt-oracle/cosmos/client"
@@ -123,6 +125,79 @@ func TestSignerUpdateCurrentBlockHeight(t *testing.T) {
 	assert.Equal(t, uint64(200), signer.currentCosmosBlockHeight)
 }
 
+func TestSignMessage_Reorged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+
+	message := &models.Message{
+		ID:     &primitive.ObjectID{},
+		Status: models.MessageStatusPending,
+	}
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+	}
+
+	// the origin transaction was reorged after the message was read
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(models.Message{ID: message.ID, Status: models.MessageStatusReorged}, nil)
+
+	success := signer.SignMessage(message)
+	assert.False(t, success)
+}
+
+func TestSignMessage_StatusChanged(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+
+	message := &models.Message{
+		ID:     &primitive.ObjectID{},
+		Status: models.MessageStatusPending,
+	}
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+	}
+
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(models.Message{ID: message.ID, Status: models.MessageStatusSigned}, nil)
+
+	success := signer.SignMessage(message)
+	assert.False(t, success)
+}
+
+func TestSignMessage_FindError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+
+	message := &models.Message{
+		ID:     &primitive.ObjectID{},
+		Status: models.MessageStatusPending,
+	}
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+	}
+
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(models.Message{}, assert.AnError)
+
+	success := signer.SignMessage(message)
+	assert.False(t, success)
+}
+
 func TestSignMessage_LockError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	logger := log.New().WithField("test", "signer")
@@ -170,6 +245,7 @@ func TestSignMessage_SignError(t *testing.T) {
 	}
 
 	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
 
 	utilSignMessage = func(
@@ -208,8 +284,9 @@ func TestSignMessage_UpdateError(t *testing.T) {
 	}
 
 	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(assert.AnError)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(assert.AnError)
 
 	utilSignMessage = func(
 		msg *models.Message,
@@ -247,8 +324,9 @@ func TestSignMessage(t *testing.T) {
 	}
 
 	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	utilSignMessage = func(
 		msg *models.Message,
@@ -300,6 +378,7 @@ func TestValidateCosmosMessage_GetTxError(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "error getting tx")
+	assert.False(t, models.IsValidationError(err))
 }
 func TestValidateCosmosMessage_ValidationError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
@@ -356,6 +435,7 @@ func TestValidateCosmosMessage_ValidationError(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "error validating tx response")
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateCosmosMessage_NeedsRefund(t *testing.T) {
@@ -414,6 +494,7 @@ func TestValidateCosmosMessage_NeedsRefund(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "tx needs refund")
+	assert.True(t, models.IsValidationError(err))
 }
 
 func TestValidateCosmosMessage_AmountMismatch(t *testing.T) {
@@ -471,6 +552,7 @@ func TestValidateCosmosMessage_AmountMismatch(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "amount mismatch")
+	assert.True(t, models.IsValidationError(err))
 }
 func TestValidateCosmosMessage_SenderMismatch(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
@@ -527,6 +609,7 @@ func TestValidateCosmosMessage_SenderMismatch(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "sender mismatch")
+	assert.True(t, models.IsValidationError(err))
 }
 func TestValidateCosmosMessage_RecipientMismatch(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
@@ -583,6 +666,7 @@ func TestValidateCosmosMessage_RecipientMismatch(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "recipient mismatch")
+	assert.True(t, models.IsValidationError(err))
 }
 func TestValidateCosmosMessage_TxPending(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
@@ -694,6 +778,7 @@ func TestValidateCosmosMessage_TxInvalid(t *testing.T) {
 	assert.False(t, confirmed)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "tx is invalid")
+	assert.True(t, models.IsValidationError(err))
 }
 func TestValidateCosmosMessage(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
@@ -798,12 +883,38 @@ func TestValidateCosmosTxAndSignMessage_ValidationFailed(t *testing.T) {
 			Amount:        sdk.NewInt64Coin("uatom", 100),
 			SenderAddress: senderAddress.Bytes(),
 			TxStatus:      models.TransactionStatusFailed,
+			StatusCode:    models.StatusCodeTxFailed,
+			StatusReason:  "tx failed with code 1",
 			Memo: models.MintMemo{
 				Address: recipientAddress.Hex(),
 			},
 		}, nil
 	}
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, db.StatusUpdate(models.MessageStatusInvalid, models.StatusCodeTxFailed, "tx is invalid: tx failed with code 1")).Return(nil)
+
+	success := signer.ValidateCosmosTxAndSignMessage(message)
+	assert.False(t, success)
+}
+
+func TestValidateCosmosTxAndSignMessage_GetTxError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "signer")
+
+	message := &models.Message{
+		ID:                    &primitive.ObjectID{},
+		OriginTransactionHash: "hash1",
+	}
+
+	signer := &EthMessageSignerRunnable{
+		db:                       mockDB,
+		cosmosClient:             mockCosmosClient,
+		logger:                   logger,
+		currentCosmosBlockHeight: 100,
+	}
+
+	// a flaky rpc call leaves the message pending for the next run
+	mockCosmosClient.EXPECT().GetTx(message.OriginTransactionHash).Return(nil, assert.AnError)
 
 	success := signer.ValidateCosmosTxAndSignMessage(message)
 	assert.False(t, success)
@@ -929,8 +1040,9 @@ func TestValidateCosmosTxAndSignMessage(t *testing.T) {
 	}
 
 	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	success := signer.ValidateCosmosTxAndSignMessage(message)
 	assert.True(t, success)
@@ -1196,6 +1308,7 @@ func TestValidateAndFindDispatchIDEvents_NoEventsError(t *testing.T) {
 	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(101), nil)
 	mockClient.EXPECT().Confirmations().Return(uint64(1))
+	mockClient.EXPECT().FinalityTag().Return("")
 
 	result, err := signer.ValidateAndFindDispatchIDEvent(message)
 
@@ -1314,6 +1427,7 @@ func TestValidateAndFindDispatchIDEvents(t *testing.T) {
 	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(101), nil)
 	mockClient.EXPECT().Confirmations().Return(uint64(1))
+	mockClient.EXPECT().FinalityTag().Return("")
 
 	result, err := signer.ValidateAndFindDispatchIDEvent(message)
 
@@ -1323,6 +1437,74 @@ func TestValidateAndFindDispatchIDEvents(t *testing.T) {
 	assert.Equal(t, uint64(1), result.Confirmations)
 }
 
+func TestValidateAndFindDispatchIDEvents_FinalityTag(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	logger := log.New().WithField("test", "signer")
+	senderAddress := ethcommon.BytesToAddress([]byte("cosmos1"))
+	recipientAddress := ethcommon.BytesToAddress([]byte("eth1"))
+	mailbox := clientMocks.NewMockMailboxContract(t)
+	mailboxAddress := ethcommon.BytesToAddress([]byte("mailbox1"))
+	mailbox.EXPECT().Address().Return(mailboxAddress)
+
+	ethClientMap := map[uint32]eth.EthereumClient{1: mockClient}
+	mailboxMap := map[uint32]eth.MailboxContract{1: mailbox}
+
+	signer := &EthMessageSignerRunnable{
+		client:       mockClient,
+		logger:       logger,
+		ethClientMap: ethClientMap,
+		mailboxMap:   mailboxMap,
+	}
+
+	receipt := &types.Receipt{
+		BlockNumber: big.NewInt(100),
+		Status:      types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{
+			{
+				Address: mailboxAddress,
+			},
+		},
+	}
+
+	txHash := "0x01"
+	messageID := ethcommon.BytesToHash([]byte("message1"))
+
+	mailbox.EXPECT().ParseDispatchId(mock.Anything).Return(&autogen.MailboxDispatchId{MessageId: messageID}, nil)
+
+	message := &models.Message{
+		ID:                    &primitive.ObjectID{},
+		MessageID:             messageID.Hex(),
+		OriginTransactionHash: txHash,
+		Content: models.MessageContent{
+			OriginDomain: 1,
+			MessageBody: models.MessageBody{
+				SenderAddress:    senderAddress.Hex(),
+				RecipientAddress: recipientAddress.Hex(),
+				Amount:           "100",
+			},
+		},
+	}
+
+	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
+	mockClient.EXPECT().GetBlockHeight().Return(uint64(101), nil)
+	mockClient.EXPECT().Confirmations().Return(uint64(1))
+	mockClient.EXPECT().FinalityTag().Return(models.FinalityTagFinalized)
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(99), nil).Once()
+
+	result, err := signer.ValidateAndFindDispatchIDEvent(message)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, models.TransactionStatusPending, result.TxStatus)
+
+	mockClient.EXPECT().GetFinalizedBlockHeight().Return(uint64(0), assert.AnError).Once()
+
+	result, err = signer.ValidateAndFindDispatchIDEvent(message)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
 func TestValidateEthereumTxAndSignMessage_ValidateError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	mockClient := clientMocks.NewMockEthereumClient(t)
@@ -1419,6 +1601,7 @@ func TestValidateEthereumTxAndSignMessage_PendingTx(t *testing.T) {
 	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(101), nil)
 	mockClient.EXPECT().Confirmations().Return(uint64(1000))
+	mockClient.EXPECT().FinalityTag().Return("")
 
 	success := signer.ValidateEthereumTxAndSignMessage(message)
 	assert.False(t, success)
@@ -1474,7 +1657,7 @@ func TestValidateEthereumTxAndSignMessage_NotConfirmedError(t *testing.T) {
 
 	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
 
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	success := signer.ValidateEthereumTxAndSignMessage(message)
 	assert.False(t, success)
@@ -1534,10 +1717,12 @@ func TestValidateEthereumTxAndSignMessage(t *testing.T) {
 	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(101), nil)
 	mockClient.EXPECT().Confirmations().Return(uint64(1))
+	mockClient.EXPECT().FinalityTag().Return("")
 
 	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	utilSignMessage = func(
 		msg *models.Message,
@@ -1571,14 +1756,20 @@ func TestSignMessages_EthereumTx(t *testing.T) {
 	privateKey, err := crypto.GenerateKey()
 	assert.NoError(t, err)
 
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+
 	signer := &EthMessageSignerRunnable{
-		cosmosClient: cosmosClient,
-		client:       mockClient,
-		logger:       logger,
-		ethClientMap: ethClientMap,
-		mailboxMap:   mailboxMap,
-		db:           mockDB,
-		privateKey:   privateKey,
+		cosmosClient:   cosmosClient,
+		client:         mockClient,
+		logger:         logger,
+		ethClientMap:   ethClientMap,
+		mailboxMap:     mailboxMap,
+		db:             mockDB,
+		privateKey:     privateKey,
+		mintController: mintController,
+		omniToken:      omniToken,
+		timeout:        10 * time.Second,
 	}
 
 	receipt := &types.Receipt{
@@ -1612,26 +1803,40 @@ func TestSignMessages_EthereumTx(t *testing.T) {
 
 	cosmosClient.EXPECT().Chain().Return(models.Chain{ChainDomain: uint32(500)})
 
-	mockClient.EXPECT().GetTransactionReceipt(txHash).Return(receipt, nil)
+	mockClient.EXPECT().GetTransactionReceipts([]string{txHash}).Return(map[ethcommon.Hash]*types.Receipt{ethcommon.HexToHash(txHash): receipt}, nil)
 	mockClient.EXPECT().GetBlockHeight().Return(uint64(101), nil)
 	mockClient.EXPECT().Confirmations().Return(uint64(1))
+	mockClient.EXPECT().FinalityTag().Return("")
 
-	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	// the message is scheduled on a copy before it is signed
+	mockDB.EXPECT().LockWriteMessage(mock.MatchedBy(func(msg *models.Message) bool {
+		return msg.ID == message.ID
+	})).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
 
 	utilSignMessage = func(
 		msg *models.Message,
 		domain util.DomainData,
 		privateKey *ecdsa.PrivateKey,
 	) error {
-		assert.Equal(t, message, msg)
+		assert.Equal(t, message.ID, msg.ID)
+		assert.NotNil(t, msg.FulfillableAt)
 		assert.NotNil(t, domain)
 		assert.NotNil(t, privateKey)
 		return nil
 	}
 
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil)
+
+	mockDB.EXPECT().GetPausedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil)
+	mockDB.EXPECT().GetSignedMessages(mock.Anything).Return([]models.Message{}, nil)
 	mockDB.EXPECT().GetPendingMessages(mock.Anything, mock.Anything).Return([]models.Message{*message}, nil)
+	mockDB.EXPECT().GetHeldMessages(mock.Anything, mock.Anything).Return([]models.Message{}, nil)
 
 	success := signer.SignMessages()
 	assert.True(t, success)
@@ -1657,6 +1862,8 @@ func TestSignMessages_PrivKeyError(t *testing.T) {
 
 func TestSignMessages_ClientError(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
 	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 	logger := log.New().WithField("test", "signer")
 
@@ -1670,8 +1877,17 @@ func TestSignMessages_ClientError(t *testing.T) {
 		signerThreshold:          1,
 		privateKey:               privateKey,
 		currentCosmosBlockHeight: 100,
+		mintController:           mintController,
+		omniToken:                omniToken,
 	}
 
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil)
+
+	mockDB.EXPECT().GetPausedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil)
+	mockDB.EXPECT().GetSignedMessages(mock.Anything).Return([]models.Message{}, nil)
 	mockDB.EXPECT().GetPendingMessages(mock.Anything, mock.Anything).Return(nil, assert.AnError)
 
 	success := signer.SignMessages()
@@ -1701,6 +1917,9 @@ func TestSignMessages_CosmosTx(t *testing.T) {
 	privateKey, err := crypto.GenerateKey()
 	assert.NoError(t, err)
 
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+
 	signer := &EthMessageSignerRunnable{
 		db:                       mockDB,
 		cosmosClient:             mockCosmosClient,
@@ -1708,6 +1927,8 @@ func TestSignMessages_CosmosTx(t *testing.T) {
 		signerThreshold:          1,
 		privateKey:               privateKey,
 		currentCosmosBlockHeight: 100,
+		mintController:           mintController,
+		omniToken:                omniToken,
 	}
 
 	mockCosmosClient.EXPECT().Chain().Return(models.Chain{ChainDomain: uint32(5)})
@@ -1740,17 +1961,30 @@ func TestSignMessages_CosmosTx(t *testing.T) {
 		domain util.DomainData,
 		privateKey *ecdsa.PrivateKey,
 	) error {
-		assert.Equal(t, message, msg)
+		assert.Equal(t, message.ID, msg.ID)
+		assert.NotNil(t, msg.FulfillableAt)
 		assert.NotNil(t, domain)
 		assert.NotNil(t, privateKey)
 		return nil
 	}
 
-	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	// the message is scheduled on a copy before it is signed
+	mockDB.EXPECT().LockWriteMessage(mock.MatchedBy(func(msg *models.Message) bool {
+		return msg.ID == message.ID
+	})).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
 	mockDB.EXPECT().Unlock("lock-id").Return(nil)
-	mockDB.EXPECT().UpdateMessage(message.ID, mock.Anything).Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(nil)
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil)
 
+	mockDB.EXPECT().GetPausedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil)
+	mockDB.EXPECT().GetSignedMessages(mock.Anything).Return([]models.Message{}, nil)
 	mockDB.EXPECT().GetPendingMessages(mock.Anything, mock.Anything).Return([]models.Message{*message}, nil)
+	mockDB.EXPECT().GetHeldMessages(mock.Anything, mock.Anything).Return([]models.Message{}, nil)
 
 	success := signer.SignMessages()
 	assert.True(t, success)
@@ -1778,8 +2012,9 @@ func TestUpdateValidatorCountAndSignerThreshold(t *testing.T) {
 	warpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(100), nil)
 	warpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(50), nil)
 
-	signer.UpdateValidatorCountAndSignerThreshold()
+	err := signer.UpdateValidatorCountAndSignerThreshold()
 
+	assert.NoError(t, err)
 	assert.Equal(t, signer.numSigners, int64(100))
 	assert.Equal(t, signer.signerThreshold, int64(50))
 }
@@ -1805,8 +2040,9 @@ func TestUpdateValidatorCountAndSignerThreshold_ValidatorCountError(t *testing.T
 
 	warpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(100), assert.AnError)
 
-	signer.UpdateValidatorCountAndSignerThreshold()
+	err := signer.UpdateValidatorCountAndSignerThreshold()
 
+	assert.Error(t, err)
 	assert.Equal(t, signer.numSigners, int64(0))
 	assert.Equal(t, signer.signerThreshold, int64(1))
 }
@@ -1833,95 +2069,1495 @@ func TestUpdateValidatorCountAndSignerThreshold_SignerThresholdError(t *testing.
 	warpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(100), nil)
 	warpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(50), assert.AnError)
 
-	signer.UpdateValidatorCountAndSignerThreshold()
+	err := signer.UpdateValidatorCountAndSignerThreshold()
 
-	assert.Equal(t, signer.numSigners, int64(100))
+	assert.Error(t, err)
+	assert.Equal(t, signer.numSigners, int64(0))
 	assert.Equal(t, signer.signerThreshold, int64(1))
 }
 
-func TestUpdateMaxMintLimit(t *testing.T) {
+func TestSignMessage_RemovedValidatorSignature(t *testing.T) {
+	recoverSignatureAsSigner(t)
+
 	mockDB := mocks.NewMockDB(t)
-	mockEthClient := clientMocks.NewMockEthereumClient(t)
-	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 	logger := log.New().WithField("test", "signer")
 
-	mintController := clientMocks.NewMockMintControllerContract(t)
+	message := &models.Message{
+		ID: &primitive.ObjectID{},
+		Content: models.MessageContent{
+			MessageBody: models.MessageBody{
+				Amount: "100",
+			},
+		},
+		Signatures: []models.Signature{
+			{Signer: "0x0000000000000000000000000000000000000001", Signature: "0x0000000000000000000000000000000000000001"},
+			{Signer: "0x0000000000000000000000000000000000000002", Signature: "0x0000000000000000000000000000000000000002"},
+		},
+	}
 
 	signer := &EthMessageSignerRunnable{
 		db:              mockDB,
-		client:          mockEthClient,
-		cosmosClient:    mockCosmosClient,
 		logger:          logger,
-		timeout:         10 * time.Second,
-		signerThreshold: 1,
+		signerThreshold: 2,
 		privateKey:      &ecdsa.PrivateKey{},
-		mintController:  mintController,
+		validators:      map[string]bool{"0x0000000000000000000000000000000000000001": true},
 	}
 
-	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(100), nil)
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{
+		"status":           models.MessageStatusPending,
+		"signatures":       []models.Signature{{Signer: "0x0000000000000000000000000000000000000001", Signature: "0x0000000000000000000000000000000000000001"}},
+		"simulation_error": "",
+	}).Return(nil)
 
-	signer.UpdateMaxMintLimit()
+	utilSignMessage = func(*models.Message, util.DomainData, *ecdsa.PrivateKey) error {
+		return nil
+	}
+	defer func() { utilSignMessage = util.SignMessage }()
 
-	assert.Equal(t, signer.maximumAmount, big.NewInt(100))
+	success := signer.SignMessage(message)
+	assert.True(t, success)
 }
 
-func TestUpdateMaxMintLimit_Error(t *testing.T) {
+func TestSignMessage_InvalidStoredSignatures(t *testing.T) {
+	recoverSignatureAsSigner(t)
+
 	mockDB := mocks.NewMockDB(t)
-	mockEthClient := clientMocks.NewMockEthereumClient(t)
-	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 	logger := log.New().WithField("test", "signer")
 
-	mintController := clientMocks.NewMockMintControllerContract(t)
+	one := "0x0000000000000000000000000000000000000001"
+	two := "0x0000000000000000000000000000000000000002"
+	ours := "0x0000000000000000000000000000000000000003"
+
+	message := &models.Message{
+		ID: &primitive.ObjectID{},
+		Content: models.MessageContent{
+			MessageBody: models.MessageBody{
+				Amount: "100",
+			},
+		},
+		Signatures: []models.Signature{
+			{Signer: one, Signature: one},
+			{Signer: one, Signature: one},
+			{Signer: two, Signature: one},
+		},
+	}
 
 	signer := &EthMessageSignerRunnable{
 		db:              mockDB,
-		client:          mockEthClient,
-		cosmosClient:    mockCosmosClient,
 		logger:          logger,
-		timeout:         10 * time.Second,
-		signerThreshold: 1,
+		signerThreshold: 3,
 		privateKey:      &ecdsa.PrivateKey{},
-		mintController:  mintController,
 	}
 
-	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(100), assert.AnError)
-
-	signer.UpdateMaxMintLimit()
+	// the duplicate and the entry that does not recover to its signer do not count toward the threshold
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{
+		"status": models.MessageStatusPending,
+		"signatures": []models.Signature{
+			{Signer: one, Signature: one},
+			{Signer: ours, Signature: ours},
+		},
+		"simulation_error": "",
+	}).Return(nil)
 
-	var nilAmount *big.Int = nil
+	utilSignMessage = func(msg *models.Message, _ util.DomainData, _ *ecdsa.PrivateKey) error {
+		msg.Signatures = append(msg.Signatures, models.Signature{Signer: ours, Signature: ours})
+		return nil
+	}
+	defer func() { utilSignMessage = util.SignMessage }()
 
-	assert.Equal(t, nilAmount, signer.maximumAmount)
+	success := signer.SignMessage(message)
+	assert.True(t, success)
 }
 
-func TestUpdateDomainData_Error(t *testing.T) {
+func TestSignMessage_SimulationReverted(t *testing.T) {
+	recoverSignatureAsSigner(t)
+	encodeSignaturesAsMetadata(t)
+
 	mockDB := mocks.NewMockDB(t)
-	mockEthClient := clientMocks.NewMockEthereumClient(t)
-	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
 	logger := log.New().WithField("test", "signer")
 
-	warpISM := clientMocks.NewMockWarpISMContract(t)
+	one := "0x0000000000000000000000000000000000000001"
+	ours := "0x0000000000000000000000000000000000000003"
+
+	message := &models.Message{
+		ID: &primitive.ObjectID{},
+		Content: models.MessageContent{
+			Sender:    one,
+			Recipient: one,
+			MessageBody: models.MessageBody{
+				SenderAddress:    one,
+				Amount:           "100",
+				RecipientAddress: one,
+			},
+		},
+		Signatures: []models.Signature{{Signer: one, Signature: one}},
+	}
 
 	signer := &EthMessageSignerRunnable{
 		db:              mockDB,
-		client:          mockEthClient,
-		cosmosClient:    mockCosmosClient,
 		logger:          logger,
 		timeout:         10 * time.Second,
-		signerThreshold: 1,
-		privateKey:      &ecdsa.PrivateKey{},
 		warpISM:         warpISM,
+		signerThreshold: 2,
+		privateKey:      &ecdsa.PrivateKey{},
 	}
 
-	warpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{Version: "6"}, assert.AnError)
+	warpISM.EXPECT().Verify(mock.Anything, []byte(one+ours), mock.Anything).Return(false, verifyRevertError{}).Once()
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{
+		"status": models.MessageStatusPending,
+		"signatures": []models.Signature{
+			{Signer: one, Signature: one},
+			{Signer: ours, Signature: ours},
+		},
+		"simulation_error": "execution reverted",
+	}).Return(nil)
 
-	signer.UpdateDomainData()
+	utilSignMessage = func(msg *models.Message, _ util.DomainData, _ *ecdsa.PrivateKey) error {
+		msg.Signatures = append(msg.Signatures, models.Signature{Signer: ours, Signature: ours})
+		return nil
+	}
+	defer func() { utilSignMessage = util.SignMessage }()
 
-	assert.Equal(t, signer.domain, util.DomainData{})
+	success := signer.SignMessage(message)
+	assert.True(t, success)
 }
 
-func TestUpdateDomainData(t *testing.T) {
+func TestSignMessage_SimulationCallError(t *testing.T) {
+	recoverSignatureAsSigner(t)
+	encodeSignaturesAsMetadata(t)
+
 	mockDB := mocks.NewMockDB(t)
-	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	ours := "0x0000000000000000000000000000000000000003"
+
+	message := &models.Message{
+		ID: &primitive.ObjectID{},
+		Content: models.MessageContent{
+			Sender:    ours,
+			Recipient: ours,
+			MessageBody: models.MessageBody{
+				SenderAddress:    ours,
+				Amount:           "100",
+				RecipientAddress: ours,
+			},
+		},
+		Status: models.MessageStatusPending,
+	}
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		warpISM:         warpISM,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+	}
+
+	// the message is not updated, so it is signed and simulated again on the next run
+	warpISM.EXPECT().Verify(mock.Anything, []byte(ours), mock.Anything).Return(false, assert.AnError).Once()
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+
+	utilSignMessage = func(msg *models.Message, _ util.DomainData, _ *ecdsa.PrivateKey) error {
+		msg.Signatures = append(msg.Signatures, models.Signature{Signer: ours, Signature: ours})
+		return nil
+	}
+	defer func() { utilSignMessage = util.SignMessage }()
+
+	success := signer.SignMessage(message)
+	assert.False(t, success)
+}
+
+func TestSignMessage_SimulationNotVerified(t *testing.T) {
+	recoverSignatureAsSigner(t)
+	encodeSignaturesAsMetadata(t)
+
+	mockDB := mocks.NewMockDB(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	ours := "0x0000000000000000000000000000000000000003"
+
+	message := &models.Message{
+		ID: &primitive.ObjectID{},
+		Content: models.MessageContent{
+			Sender:    ours,
+			Recipient: ours,
+			MessageBody: models.MessageBody{
+				SenderAddress:    ours,
+				Amount:           "100",
+				RecipientAddress: ours,
+			},
+		},
+	}
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		warpISM:         warpISM,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+	}
+
+	warpISM.EXPECT().Verify(mock.Anything, []byte(ours), mock.Anything).Return(false, nil).Once()
+	mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil)
+	mockDB.EXPECT().FindMessage(bson.M{"_id": message.ID}).Return(*message, nil)
+	mockDB.EXPECT().Unlock("lock-id").Return(nil)
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{
+		"status":           models.MessageStatusPending,
+		"signatures":       []models.Signature{{Signer: ours, Signature: ours}},
+		"simulation_error": "signatures not verified",
+	}).Return(nil)
+
+	utilSignMessage = func(msg *models.Message, _ util.DomainData, _ *ecdsa.PrivateKey) error {
+		msg.Signatures = append(msg.Signatures, models.Signature{Signer: ours, Signature: ours})
+		return nil
+	}
+	defer func() { utilSignMessage = util.SignMessage }()
+
+	success := signer.SignMessage(message)
+	assert.True(t, success)
+}
+
+func TestRetrySimulations(t *testing.T) {
+	recoverSignatureAsSigner(t)
+	encodeSignaturesAsMetadata(t)
+
+	logger := log.New().WithField("test", "signer")
+	mockDB := mocks.NewMockDB(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	one := "0x0000000000000000000000000000000000000001"
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		warpISM:         warpISM,
+		signerThreshold: 1,
+	}
+
+	content := models.MessageContent{
+		Sender:    one,
+		Recipient: one,
+		MessageBody: models.MessageBody{
+			SenderAddress:    one,
+			Amount:           "100",
+			RecipientAddress: one,
+		},
+	}
+
+	// only the pending message with a failed simulation is verified again
+	failed := models.Message{
+		ID:              &primitive.ObjectID{1},
+		Content:         content,
+		Status:          models.MessageStatusPending,
+		Signatures:      []models.Signature{{Signer: one, Signature: one}},
+		SimulationError: "CountBelowThreshold",
+	}
+	pending := models.Message{
+		ID:         &primitive.ObjectID{2},
+		Content:    content,
+		Status:     models.MessageStatusPending,
+		Signatures: []models.Signature{},
+	}
+	signed := models.Message{
+		ID:         &primitive.ObjectID{3},
+		Content:    content,
+		Status:     models.MessageStatusSigned,
+		Signatures: []models.Signature{{Signer: one, Signature: one}},
+	}
+
+	mockDB.EXPECT().GetPendingAndSignedMessages(signer.chain).Return([]models.Message{failed, pending, signed}, nil).Once()
+	warpISM.EXPECT().Verify(mock.Anything, []byte(one), mock.Anything).Return(true, nil).Once()
+	mockDB.EXPECT().LockWriteMessage(&failed).Return("lock-1", nil).Once()
+	mockDB.EXPECT().Unlock("lock-1").Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(failed.ID, failed.Status, bson.M{
+		"signatures":       []models.Signature{{Signer: one, Signature: one}},
+		"status":           models.MessageStatusSigned,
+		"simulation_error": "",
+	}).Return(nil).Once()
+
+	success := signer.RetrySimulations()
+
+	assert.True(t, success)
+}
+
+func TestRetrySimulations_Error(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	mockDB := mocks.NewMockDB(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:     mockDB,
+		logger: logger,
+	}
+
+	mockDB.EXPECT().GetPendingAndSignedMessages(signer.chain).Return(nil, assert.AnError).Once()
+
+	success := signer.RetrySimulations()
+
+	assert.False(t, success)
+}
+
+func TestSignMessages_NotValidator(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	signer := &EthMessageSignerRunnable{
+		db:         mockDB,
+		logger:     logger,
+		privateKey: privateKey,
+		validators: map[string]bool{"0x0000000000000000000000000000000000000001": true},
+	}
+
+	success := signer.SignMessages()
+	assert.False(t, success)
+	mockDB.AssertNotCalled(t, "GetPendingMessages", mock.Anything, mock.Anything)
+}
+
+func TestUpdateValidators(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		logger:  logger,
+		timeout: 10 * time.Second,
+		warpISM: warpISM,
+	}
+
+	one := ethcommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	two := ethcommon.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	warpISM.EXPECT().Validators(mock.Anything, one).Return(true, nil).Once()
+	warpISM.EXPECT().Validators(mock.Anything, two).Return(false, nil).Once()
+
+	success := signer.UpdateValidators([]string{one.Hex(), two.Hex(), strings.ToLower(one.Hex())})
+
+	assert.True(t, success)
+	assert.Equal(t, map[string]bool{strings.ToLower(one.Hex()): true}, signer.validators)
+}
+
+func TestUpdateValidators_Error(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	validators := map[string]bool{"0x0000000000000000000000000000000000000001": true}
+	signer := &EthMessageSignerRunnable{
+		logger:     logger,
+		timeout:    10 * time.Second,
+		warpISM:    warpISM,
+		validators: validators,
+	}
+
+	warpISM.EXPECT().Validators(mock.Anything, mock.Anything).Return(false, assert.AnError).Once()
+
+	success := signer.UpdateValidators([]string{"0x0000000000000000000000000000000000000002"})
+
+	assert.False(t, success)
+	assert.Equal(t, validators, signer.validators)
+}
+
+func TestValidSignatures(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+
+	message := &models.Message{
+		Content: models.MessageContent{
+			Version:           1,
+			Nonce:             1,
+			OriginDomain:      1,
+			Sender:            "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			DestinationDomain: 1,
+			Recipient:         "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			MessageBody: models.MessageBody{
+				SenderAddress:    "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+				Amount:           "100",
+				RecipientAddress: "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			},
+		},
+	}
+	domain := util.DomainData{
+		Name:              "Test",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: ethcommon.HexToAddress("0xAb5801a7D398351b8bE11C439e05C5b3259aec9B"),
+	}
+
+	keyOne, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	keyTwo, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+	assert.NoError(t, util.SignMessage(message, domain, keyOne))
+	assert.NoError(t, util.SignMessage(message, domain, keyTwo))
+
+	one := strings.ToLower(crypto.PubkeyToAddress(keyOne.PublicKey).Hex())
+	two := strings.ToLower(crypto.PubkeyToAddress(keyTwo.PublicKey).Hex())
+	signatures := map[string]models.Signature{}
+	for _, signature := range message.Signatures {
+		signatures[signature.Signer] = signature
+	}
+
+	signer := &EthMessageSignerRunnable{logger: logger, domain: domain}
+
+	t.Run("Valid", func(t *testing.T) {
+		assert.Equal(t, message.Signatures, signer.ValidSignatures(message))
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		duplicated := *message
+		duplicated.Signatures = []models.Signature{signatures[one], signatures[one], signatures[two]}
+		assert.Equal(t, []models.Signature{signatures[one], signatures[two]}, signer.ValidSignatures(&duplicated))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		invalid := *message
+		invalid.Signatures = []models.Signature{
+			{Signer: one, Signature: signatures[two].Signature},
+			{Signer: two, Signature: "0x1234"},
+			signatures[one],
+		}
+		assert.Equal(t, []models.Signature{signatures[one]}, signer.ValidSignatures(&invalid))
+	})
+
+	t.Run("OtherContent", func(t *testing.T) {
+		other := *message
+		other.Content.MessageBody.Amount = "200"
+		assert.Equal(t, []models.Signature{}, signer.ValidSignatures(&other))
+	})
+
+	t.Run("NonValidator", func(t *testing.T) {
+		signer := &EthMessageSignerRunnable{logger: logger, domain: domain, validators: map[string]bool{two: true}}
+		assert.Equal(t, []models.Signature{signatures[two]}, signer.ValidSignatures(message))
+
+		signer.validators = map[string]bool{}
+		assert.Equal(t, []models.Signature{}, signer.ValidSignatures(message))
+	})
+
+	t.Run("OracleAddresses", func(t *testing.T) {
+		signer := &EthMessageSignerRunnable{
+			logger:          logger,
+			domain:          domain,
+			oracleAddresses: []string{crypto.PubkeyToAddress(keyOne.PublicKey).Hex()},
+		}
+		assert.Equal(t, []models.Signature{signatures[one]}, signer.ValidSignatures(message))
+	})
+}
+
+// recoverSignatureAsSigner stubs signature recovery for messages whose signature is the signer address
+func recoverSignatureAsSigner(t *testing.T) {
+	utilRecoverSigner = func(_ models.MessageContent, _ util.DomainData, signature string) (string, error) {
+		return strings.ToLower(signature), nil
+	}
+	t.Cleanup(func() { utilRecoverSigner = util.RecoverSigner })
+}
+
+func encodeSignaturesAsMetadata(t *testing.T) {
+	utilEncodeMetadata = func(signatures []models.Signature) ([]byte, error) {
+		metadata := []byte{}
+		for _, sig := range signatures {
+			metadata = append(metadata, []byte(sig.Signature)...)
+		}
+		return metadata, nil
+	}
+	t.Cleanup(func() { utilEncodeMetadata = util.EncodeMetadata })
+}
+
+func expectValidatorSetEvents(
+	t *testing.T,
+	warpISM *clientMocks.MockWarpISMContract,
+	added []ethcommon.Address,
+	removed []ethcommon.Address,
+	thresholds []*big.Int,
+) {
+	newValidators := clientMocks.NewMockWarpISMNewValidatorIterator(t)
+	for _, validator := range added {
+		newValidators.EXPECT().Next().Return(true).Once()
+		newValidators.EXPECT().Event().Return(&autogen.WarpISMNewValidator{Validator: validator}).Once()
+	}
+	newValidators.EXPECT().Next().Return(false).Once()
+	newValidators.EXPECT().Error().Return(nil).Once()
+	newValidators.EXPECT().Close().Return(nil).Once()
+	warpISM.EXPECT().FilterNewValidator(mock.Anything, mock.Anything).Return(newValidators, nil).Once()
+
+	removedValidators := clientMocks.NewMockWarpISMRemovedValidatorIterator(t)
+	for _, validator := range removed {
+		removedValidators.EXPECT().Next().Return(true).Once()
+		removedValidators.EXPECT().Event().Return(&autogen.WarpISMRemovedValidator{Validator: validator}).Once()
+	}
+	removedValidators.EXPECT().Next().Return(false).Once()
+	removedValidators.EXPECT().Error().Return(nil).Once()
+	removedValidators.EXPECT().Close().Return(nil).Once()
+	warpISM.EXPECT().FilterRemovedValidator(mock.Anything, mock.Anything).Return(removedValidators, nil).Once()
+
+	thresholdSets := clientMocks.NewMockWarpISMSignerThresholdSetIterator(t)
+	for _, threshold := range thresholds {
+		thresholdSets.EXPECT().Next().Return(true).Once()
+		thresholdSets.EXPECT().Event().Return(&autogen.WarpISMSignerThresholdSet{Ratio: threshold}).Once()
+	}
+	thresholdSets.EXPECT().Next().Return(false).Once()
+	thresholdSets.EXPECT().Error().Return(nil).Once()
+	thresholdSets.EXPECT().Close().Return(nil).Once()
+	warpISM.EXPECT().FilterSignerThresholdSet(mock.Anything, mock.Anything).Return(thresholdSets, nil).Once()
+}
+
+func TestFindValidatorSetChanges(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		logger:  logger,
+		warpISM: warpISM,
+	}
+
+	added := ethcommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	removed := ethcommon.HexToAddress("0x0000000000000000000000000000000000000002")
+	expectValidatorSetEvents(t, warpISM, []ethcommon.Address{added}, []ethcommon.Address{removed}, nil)
+
+	changed, validators, err := signer.FindValidatorSetChanges(1, 10)
+
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{added.Hex(), removed.Hex()}, validators)
+}
+
+func TestFindValidatorSetChanges_FilterError(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		logger:  logger,
+		warpISM: warpISM,
+	}
+
+	warpISM.EXPECT().FilterNewValidator(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+	changed, validators, err := signer.FindValidatorSetChanges(1, 10)
+
+	assert.Error(t, err)
+	assert.False(t, changed)
+	assert.Nil(t, validators)
+}
+
+func TestSyncValidatorSet_NoChanges(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		client:                     mockEthClient,
+		logger:                     logger,
+		warpISM:                    warpISM,
+		ismBlockHeight:             90,
+		currentEthereumBlockHeight: 100,
+	}
+
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(uint64(100))
+	expectValidatorSetEvents(t, warpISM, nil, nil, nil)
+
+	success := signer.SyncValidatorSet()
+
+	assert.True(t, success)
+	assert.Equal(t, uint64(100), signer.ismBlockHeight)
+}
+
+func TestSyncValidatorSet_UpToDate(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+
+	signer := &EthMessageSignerRunnable{
+		logger:                     logger,
+		ismBlockHeight:             100,
+		currentEthereumBlockHeight: 100,
+	}
+
+	success := signer.SyncValidatorSet()
+
+	assert.True(t, success)
+	assert.Equal(t, uint64(100), signer.ismBlockHeight)
+}
+
+func TestSyncValidatorSet_FilterError(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		client:                     mockEthClient,
+		logger:                     logger,
+		warpISM:                    warpISM,
+		ismBlockHeight:             90,
+		currentEthereumBlockHeight: 100,
+	}
+
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(uint64(100))
+	warpISM.EXPECT().FilterNewValidator(mock.Anything, mock.Anything).Return(nil, assert.AnError).Once()
+
+	success := signer.SyncValidatorSet()
+
+	assert.False(t, success)
+	assert.Equal(t, uint64(90), signer.ismBlockHeight)
+}
+
+func TestSyncValidatorSet_ValidatorCountError(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	two := ethcommon.HexToAddress("0x0000000000000000000000000000000000000002")
+
+	signer := &EthMessageSignerRunnable{
+		client:                     mockEthClient,
+		logger:                     logger,
+		timeout:                    10 * time.Second,
+		warpISM:                    warpISM,
+		numSigners:                 2,
+		signerThreshold:            2,
+		ismBlockHeight:             90,
+		currentEthereumBlockHeight: 100,
+	}
+
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(uint64(100))
+	expectValidatorSetEvents(t, warpISM, nil, []ethcommon.Address{two}, nil)
+
+	warpISM.EXPECT().ValidatorCount(mock.Anything).Return(nil, assert.AnError).Once()
+
+	success := signer.SyncValidatorSet()
+
+	// the changes are read again on the next run
+	assert.False(t, success)
+	assert.Equal(t, uint64(90), signer.ismBlockHeight)
+	assert.Equal(t, int64(2), signer.numSigners)
+	assert.Equal(t, int64(2), signer.signerThreshold)
+}
+
+func TestSyncValidatorSet_Changed(t *testing.T) {
+	recoverSignatureAsSigner(t)
+
+	logger := log.New().WithField("test", "signer")
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	one := ethcommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	two := ethcommon.HexToAddress("0x0000000000000000000000000000000000000002")
+	three := ethcommon.HexToAddress("0x0000000000000000000000000000000000000003")
+
+	signer := &EthMessageSignerRunnable{
+		db:                         mockDB,
+		client:                     mockEthClient,
+		logger:                     logger,
+		timeout:                    10 * time.Second,
+		warpISM:                    warpISM,
+		numSigners:                 2,
+		signerThreshold:            2,
+		oracleAddresses:            []string{one.Hex()},
+		validators:                 map[string]bool{strings.ToLower(one.Hex()): true, strings.ToLower(two.Hex()): true},
+		ismBlockHeight:             90,
+		currentEthereumBlockHeight: 100,
+	}
+
+	mockEthClient.EXPECT().MaxQueryBlocks().Return(uint64(100))
+	expectValidatorSetEvents(t, warpISM, []ethcommon.Address{three}, []ethcommon.Address{two}, []*big.Int{big.NewInt(2)})
+
+	warpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(2), nil).Once()
+	warpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil).Once()
+	warpISM.EXPECT().Validators(mock.Anything, one).Return(true, nil).Once()
+	warpISM.EXPECT().Validators(mock.Anything, two).Return(false, nil).Once()
+	warpISM.EXPECT().Validators(mock.Anything, three).Return(true, nil).Once()
+
+	message := models.Message{
+		ID:     &primitive.ObjectID{},
+		Status: models.MessageStatusSigned,
+		Signatures: []models.Signature{
+			{Signer: strings.ToLower(one.Hex()), Signature: strings.ToLower(one.Hex())},
+			{Signer: strings.ToLower(two.Hex()), Signature: strings.ToLower(two.Hex())},
+		},
+	}
+	mockDB.EXPECT().GetPendingAndSignedMessages(signer.chain).Return([]models.Message{message}, nil).Once()
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{
+		"signatures":       []models.Signature{{Signer: strings.ToLower(one.Hex()), Signature: strings.ToLower(one.Hex())}},
+		"status":           models.MessageStatusPending,
+		"simulation_error": "",
+	}).Return(nil).Once()
+
+	success := signer.SyncValidatorSet()
+
+	assert.True(t, success)
+	assert.Equal(t, uint64(100), signer.ismBlockHeight)
+	assert.Equal(t, map[string]bool{strings.ToLower(one.Hex()): true, strings.ToLower(three.Hex()): true}, signer.validators)
+}
+
+func TestRecomputeMessageStatuses(t *testing.T) {
+	recoverSignatureAsSigner(t)
+	encodeSignaturesAsMetadata(t)
+
+	logger := log.New().WithField("test", "signer")
+	mockDB := mocks.NewMockDB(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	one := "0x0000000000000000000000000000000000000001"
+	two := "0x0000000000000000000000000000000000000002"
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		warpISM:         warpISM,
+		signerThreshold: 1,
+		validators:      map[string]bool{one: true},
+	}
+
+	content := models.MessageContent{
+		Sender:    one,
+		Recipient: one,
+		MessageBody: models.MessageBody{
+			SenderAddress:    one,
+			Amount:           "100",
+			RecipientAddress: one,
+		},
+	}
+	encoded, err := content.EncodeToBytes()
+	assert.NoError(t, err)
+	warpISM.EXPECT().Verify(mock.Anything, []byte(one), encoded).Return(true, nil).Twice()
+
+	unchanged := models.Message{
+		ID:         &primitive.ObjectID{1},
+		Content:    content,
+		Status:     models.MessageStatusSigned,
+		Signatures: []models.Signature{{Signer: one, Signature: one}},
+	}
+	thresholdMet := models.Message{
+		ID:         &primitive.ObjectID{2},
+		Content:    content,
+		Status:     models.MessageStatusPending,
+		Signatures: []models.Signature{{Signer: one, Signature: one}},
+	}
+	stripped := models.Message{
+		ID:         &primitive.ObjectID{3},
+		Content:    content,
+		Status:     models.MessageStatusPending,
+		Signatures: []models.Signature{{Signer: two, Signature: two}},
+	}
+	lockError := models.Message{
+		ID:         &primitive.ObjectID{4},
+		Content:    content,
+		Status:     models.MessageStatusSigned,
+		Signatures: []models.Signature{{Signer: two, Signature: two}},
+	}
+
+	mockDB.EXPECT().GetPendingAndSignedMessages(signer.chain).Return([]models.Message{unchanged, thresholdMet, stripped, lockError}, nil).Once()
+
+	mockDB.EXPECT().LockWriteMessage(&thresholdMet).Return("lock-2", nil).Once()
+	mockDB.EXPECT().Unlock("lock-2").Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(thresholdMet.ID, thresholdMet.Status, bson.M{
+		"signatures":       []models.Signature{{Signer: one, Signature: one}},
+		"status":           models.MessageStatusSigned,
+		"simulation_error": "",
+	}).Return(nil).Once()
+
+	mockDB.EXPECT().LockWriteMessage(&stripped).Return("lock-3", nil).Once()
+	mockDB.EXPECT().Unlock("lock-3").Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(stripped.ID, stripped.Status, bson.M{
+		"signatures":       []models.Signature{},
+		"status":           models.MessageStatusPending,
+		"simulation_error": "",
+	}).Return(nil).Once()
+
+	mockDB.EXPECT().LockWriteMessage(&lockError).Return("", assert.AnError).Once()
+
+	success := signer.RecomputeMessageStatuses()
+
+	assert.False(t, success)
+}
+
+func TestRecomputeMessageStatus_SimulationCallError(t *testing.T) {
+	recoverSignatureAsSigner(t)
+	encodeSignaturesAsMetadata(t)
+
+	logger := log.New().WithField("test", "signer")
+	mockDB := mocks.NewMockDB(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	one := "0x0000000000000000000000000000000000000001"
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		warpISM:         warpISM,
+		signerThreshold: 1,
+		validators:      map[string]bool{one: true},
+	}
+
+	message := models.Message{
+		ID: &primitive.ObjectID{1},
+		Content: models.MessageContent{
+			Sender:    one,
+			Recipient: one,
+			MessageBody: models.MessageBody{
+				SenderAddress:    one,
+				Amount:           "100",
+				RecipientAddress: one,
+			},
+		},
+		Status:     models.MessageStatusSigned,
+		Signatures: []models.Signature{{Signer: one, Signature: one}},
+	}
+
+	// a signed message is not moved back to pending when the warp ism cannot be called
+	warpISM.EXPECT().Verify(mock.Anything, []byte(one), mock.Anything).Return(false, assert.AnError).Once()
+
+	success := signer.RecomputeMessageStatus(&message)
+
+	assert.False(t, success)
+}
+
+func TestRecomputeMessageStatuses_Error(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	mockDB := mocks.NewMockDB(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:     mockDB,
+		logger: logger,
+	}
+
+	mockDB.EXPECT().GetPendingAndSignedMessages(signer.chain).Return(nil, assert.AnError).Once()
+
+	success := signer.RecomputeMessageStatuses()
+
+	assert.False(t, success)
+}
+
+func TestUpdateMintSchedule(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+	mintController := clientMocks.NewMockMintControllerContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:             mockDB,
+		logger:         logger,
+		timeout:        10 * time.Second,
+		mintController: mintController,
+		maximumAmount:  big.NewInt(1000),
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	later := now.Add(30 * time.Second)
+
+	// queued by nonce: the first fits the current limit, the second waits 30 seconds for 300 more
+	first := models.Message{
+		ID:            &primitive.ObjectID{1},
+		FulfillableAt: &now,
+		Content:       models.MessageContent{Nonce: 1, MessageBody: models.MessageBody{Amount: "400"}},
+	}
+	second := models.Message{
+		ID:      &primitive.ObjectID{2},
+		Content: models.MessageContent{Nonce: 2, MessageBody: models.MessageBody{Amount: "400"}},
+	}
+
+	// the max mint limit is read again on every run
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(2000), nil).Once()
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(500), nil).Once()
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil).Once()
+	mockDB.EXPECT().GetSignedMessages(signer.chain).Return([]models.Message{second, first}, nil).Once()
+	mockDB.EXPECT().UpdateMessage(second.ID, second.Status, mock.MatchedBy(func(update bson.M) bool {
+		fulfillableAt, ok := update["fulfillable_at"].(*time.Time)
+		return ok && !fulfillableAt.Before(later) && fulfillableAt.Sub(later) <= 2*time.Second
+	})).Return(nil).Once()
+
+	success := signer.UpdateMintSchedule()
+
+	assert.True(t, success)
+	assert.Equal(t, big.NewInt(2000), signer.maximumAmount)
+	assert.Equal(t, big.NewInt(500), signer.currentMintLimit)
+	assert.NotNil(t, signer.mintSchedule)
+}
+
+func TestUpdateMintSchedule_Errors(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+	mintController := clientMocks.NewMockMintControllerContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:             mockDB,
+		logger:         logger,
+		timeout:        10 * time.Second,
+		mintController: mintController,
+	}
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(nil, assert.AnError).Once()
+
+	assert.False(t, signer.UpdateMintSchedule())
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil).Once()
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(nil, assert.AnError).Once()
+
+	assert.False(t, signer.UpdateMintSchedule())
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil).Once()
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(1000), nil).Once()
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(nil, assert.AnError).Once()
+
+	assert.False(t, signer.UpdateMintSchedule())
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil).Once()
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(1000), nil).Once()
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil).Once()
+	mockDB.EXPECT().GetSignedMessages(signer.chain).Return(nil, assert.AnError).Once()
+
+	assert.False(t, signer.UpdateMintSchedule())
+	assert.Nil(t, signer.currentMintLimit)
+	assert.Nil(t, signer.mintSchedule)
+}
+
+func TestUpdateMintSchedule_UpdateError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	logger := log.New().WithField("test", "signer")
+	mintController := clientMocks.NewMockMintControllerContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:             mockDB,
+		logger:         logger,
+		timeout:        10 * time.Second,
+		mintController: mintController,
+	}
+
+	message := models.Message{
+		ID:      &primitive.ObjectID{1},
+		Content: models.MessageContent{Nonce: 1, MessageBody: models.MessageBody{Amount: "400"}},
+	}
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil).Once()
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(500), nil).Once()
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil).Once()
+	mockDB.EXPECT().GetSignedMessages(signer.chain).Return([]models.Message{message}, nil).Once()
+	mockDB.EXPECT().UpdateMessage(message.ID, message.Status, mock.Anything).Return(assert.AnError).Once()
+
+	success := signer.UpdateMintSchedule()
+
+	assert.False(t, success)
+}
+
+func TestQueuedBefore(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+
+	message := func(createdAt time.Time, originDomain uint32, nonce uint32) *models.Message {
+		return &models.Message{
+			CreatedAt: createdAt,
+			Content:   models.MessageContent{OriginDomain: originDomain, Nonce: nonce},
+		}
+	}
+
+	// a lower nonce from another origin does not jump the queue
+	assert.True(t, queuedBefore(message(earlier, 1, 10), message(now, 2, 1)))
+	assert.False(t, queuedBefore(message(now, 2, 1), message(earlier, 1, 10)))
+
+	assert.True(t, queuedBefore(message(now, 1, 10), message(now, 2, 1)))
+	assert.True(t, queuedBefore(message(now, 1, 1), message(now, 1, 2)))
+	assert.False(t, queuedBefore(message(now, 1, 1), message(now, 1, 1)))
+}
+
+func TestApplyMintLimit(t *testing.T) {
+	newMessage := func(amount string, status models.MessageStatus) *models.Message {
+		return &models.Message{
+			ID:     &primitive.ObjectID{},
+			Status: status,
+			Content: models.MessageContent{
+				MessageBody: models.MessageBody{Amount: amount},
+			},
+		}
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	newSigner := func(mockDB *mocks.MockDB) *EthMessageSignerRunnable {
+		return &EthMessageSignerRunnable{
+			db:               mockDB,
+			logger:           log.New().WithField("test", "signer"),
+			maximumAmount:    big.NewInt(500),
+			currentMintLimit: big.NewInt(300),
+			mintSchedule:     NewMintSchedule(now, big.NewInt(300), big.NewInt(500), big.NewInt(10)),
+		}
+	}
+
+	t.Run("WithinLimit", func(t *testing.T) {
+		signer := newSigner(mocks.NewMockDB(t))
+
+		first := newMessage("200", models.MessageStatusPending)
+		assert.True(t, signer.ApplyMintLimit(first))
+		assert.Equal(t, now, *first.FulfillableAt)
+
+		second := newMessage("200", models.MessageStatusPending)
+		assert.True(t, signer.ApplyMintLimit(second))
+		assert.Equal(t, now.Add(10*time.Second), *second.FulfillableAt)
+	})
+
+	t.Run("Signed", func(t *testing.T) {
+		signer := newSigner(mocks.NewMockDB(t))
+		message := newMessage("1000", models.MessageStatusSigned)
+
+		assert.True(t, signer.ApplyMintLimit(message))
+		assert.Nil(t, message.FulfillableAt)
+	})
+
+	t.Run("OverMaximum", func(t *testing.T) {
+		mockDB := mocks.NewMockDB(t)
+		signer := newSigner(mockDB)
+		signer.currentMintLimit = big.NewInt(1000)
+		message := newMessage("600", models.MessageStatusPending)
+
+		mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil).Once()
+		mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+		mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{"status": models.MessageStatusOverLimit}).Return(nil).Once()
+
+		assert.False(t, signer.ApplyMintLimit(message))
+	})
+
+	t.Run("OverCurrent", func(t *testing.T) {
+		mockDB := mocks.NewMockDB(t)
+		signer := newSigner(mockDB)
+		message := newMessage("400", models.MessageStatusPending)
+
+		mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil).Once()
+		mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+		mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{"status": models.MessageStatusOverLimit}).Return(nil).Once()
+
+		assert.False(t, signer.ApplyMintLimit(message))
+	})
+
+	t.Run("StillOverLimit", func(t *testing.T) {
+		signer := newSigner(mocks.NewMockDB(t))
+
+		assert.False(t, signer.ApplyMintLimit(newMessage("400", models.MessageStatusOverLimit)))
+	})
+
+	t.Run("Released", func(t *testing.T) {
+		signer := newSigner(mocks.NewMockDB(t))
+		message := newMessage("300", models.MessageStatusOverLimit)
+
+		assert.True(t, signer.ApplyMintLimit(message))
+		assert.Equal(t, now, *message.FulfillableAt)
+	})
+
+	t.Run("ReleasedFromPause", func(t *testing.T) {
+		signer := newSigner(mocks.NewMockDB(t))
+		message := newMessage("100", models.MessageStatusDestinationPaused)
+
+		assert.True(t, signer.ApplyMintLimit(message))
+		assert.Equal(t, now, *message.FulfillableAt)
+	})
+
+	t.Run("OverLimitAfterPause", func(t *testing.T) {
+		mockDB := mocks.NewMockDB(t)
+		signer := newSigner(mockDB)
+		message := newMessage("400", models.MessageStatusDestinationPaused)
+
+		mockDB.EXPECT().LockWriteMessage(message).Return("lock-id", nil).Once()
+		mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+		mockDB.EXPECT().UpdateMessage(message.ID, message.Status, bson.M{"status": models.MessageStatusOverLimit}).Return(nil).Once()
+
+		assert.False(t, signer.ApplyMintLimit(message))
+	})
+
+	t.Run("LockError", func(t *testing.T) {
+		mockDB := mocks.NewMockDB(t)
+		signer := newSigner(mockDB)
+		message := newMessage("400", models.MessageStatusPending)
+
+		mockDB.EXPECT().LockWriteMessage(message).Return("", assert.AnError).Once()
+
+		assert.False(t, signer.ApplyMintLimit(message))
+	})
+
+	t.Run("InvalidAmount", func(t *testing.T) {
+		mockDB := mocks.NewMockDB(t)
+		signer := newSigner(mockDB)
+		message := newMessage("invalid", models.MessageStatusPending)
+
+		mockDB.EXPECT().UpdateMessage(message.ID, message.Status, db.StatusUpdate(models.MessageStatusInvalid, models.StatusCodeInvalidAmount, "invalid message amount")).Return(nil).Once()
+
+		assert.False(t, signer.ApplyMintLimit(message))
+	})
+}
+
+func TestSignMessages_MintSchedule(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	signer := &EthMessageSignerRunnable{
+		db:                       mockDB,
+		cosmosClient:             mockCosmosClient,
+		logger:                   logger,
+		timeout:                  10 * time.Second,
+		signerThreshold:          1,
+		privateKey:               privateKey,
+		currentCosmosBlockHeight: 100,
+		mintController:           mintController,
+		omniToken:                omniToken,
+		maximumAmount:            big.NewInt(1000),
+	}
+
+	senderAddress := ethcommon.BytesToAddress([]byte("cosmos1"))
+	recipientAddress := ethcommon.BytesToAddress([]byte("eth1"))
+	newMessage := func(nonce uint32, amount string, status models.MessageStatus) models.Message {
+		return models.Message{
+			ID:                    &primitive.ObjectID{byte(nonce)},
+			OriginTransactionHash: fmt.Sprintf("0x%02d", nonce),
+			Status:                status,
+			Content: models.MessageContent{
+				Nonce:        nonce,
+				OriginDomain: 5,
+				MessageBody: models.MessageBody{
+					SenderAddress:    senderAddress.Hex(),
+					RecipientAddress: recipientAddress.Hex(),
+					Amount:           amount,
+				},
+			},
+		}
+	}
+
+	// the held message with the lowest nonce is released first, the pending one is queued behind it
+	released := newMessage(1, "400", models.MessageStatusOverLimit)
+	queued := newMessage(2, "200", models.MessageStatusPending)
+	held := newMessage(3, "600", models.MessageStatusOverLimit)
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil)
+
+	mockDB.EXPECT().GetPausedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(500), nil)
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil)
+	mockDB.EXPECT().GetSignedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mockDB.EXPECT().GetPendingMessages(mock.Anything, mock.Anything).Return([]models.Message{queued}, nil)
+	mockDB.EXPECT().GetHeldMessages(mock.Anything, mock.Anything).Return([]models.Message{held, released}, nil)
+
+	mockCosmosClient.EXPECT().Chain().Return(models.Chain{ChainDomain: uint32(5)})
+	amounts := map[string]int64{released.OriginTransactionHash: 400, queued.OriginTransactionHash: 200}
+	txHashes := []string{}
+	mockCosmosClient.EXPECT().GetTx(mock.Anything).RunAndReturn(func(txHash string) (*sdk.TxResponse, error) {
+		txHashes = append(txHashes, txHash)
+		return &sdk.TxResponse{TxHash: txHash, Height: 50}, nil
+	}).Twice()
+
+	utilValidateTxToCosmosMultisig = func(
+		txResponse *sdk.TxResponse,
+		_ models.CosmosNetworkConfig,
+		_ map[uint32]bool,
+		_ uint64,
+	) (*cosmosUtil.ValidateTxResult, error) {
+		return &cosmosUtil.ValidateTxResult{
+			Amount:        sdk.NewInt64Coin("uatom", amounts[txResponse.TxHash]),
+			SenderAddress: senderAddress.Bytes(),
+			TxStatus:      models.TransactionStatusConfirmed,
+			Memo: models.MintMemo{
+				Address: recipientAddress.Hex(),
+			},
+		}, nil
+	}
+	utilSignMessage = func(*models.Message, util.DomainData, *ecdsa.PrivateKey) error {
+		return nil
+	}
+	defer func() {
+		utilValidateTxToCosmosMultisig = cosmosUtil.ValidateTxToCosmosMultisig
+		utilSignMessage = util.SignMessage
+	}()
+
+	fulfillableAt := map[primitive.ObjectID]*time.Time{}
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).Return("lock-id", nil).Twice()
+	stored := map[primitive.ObjectID]models.Message{*released.ID: released, *queued.ID: queued}
+	mockDB.EXPECT().FindMessage(mock.Anything).RunAndReturn(func(filter bson.M) (models.Message, error) {
+		return stored[*filter["_id"].(*primitive.ObjectID)], nil
+	}).Twice()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Twice()
+	mockDB.EXPECT().UpdateMessage(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(id *primitive.ObjectID, _ models.MessageStatus, update bson.M) error {
+		fulfillableAt[*id] = update["fulfillable_at"].(*time.Time)
+		return nil
+	}).Twice()
+
+	success := signer.SignMessages()
+	assert.True(t, success)
+
+	assert.Equal(t, []string{released.OriginTransactionHash, queued.OriginTransactionHash}, txHashes)
+	assert.Equal(t, 10*time.Second, fulfillableAt[*queued.ID].Sub(*fulfillableAt[*released.ID]))
+}
+
+func TestSignMessages_CurrentMintLimitError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	signer := &EthMessageSignerRunnable{
+		db:             mockDB,
+		logger:         logger,
+		timeout:        10 * time.Second,
+		privateKey:     privateKey,
+		mintController: mintController,
+		omniToken:      omniToken,
+	}
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil)
+
+	mockDB.EXPECT().GetPausedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(nil, assert.AnError)
+
+	success := signer.SignMessages()
+	assert.False(t, success)
+}
+
+func TestUpdateDestinationPaused(t *testing.T) {
+	logger := log.New().WithField("test", "signer")
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		logger:    logger,
+		timeout:   10 * time.Second,
+		omniToken: omniToken,
+	}
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(true, nil).Once()
+	assert.True(t, signer.UpdateDestinationPaused())
+	assert.True(t, signer.destinationPaused)
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, assert.AnError).Once()
+	assert.False(t, signer.UpdateDestinationPaused())
+	assert.True(t, signer.destinationPaused)
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil).Once()
+	assert.True(t, signer.UpdateDestinationPaused())
+	assert.False(t, signer.destinationPaused)
+}
+
+func TestSignMessages_DestinationPaused(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	signer := &EthMessageSignerRunnable{
+		db:             mockDB,
+		logger:         logger,
+		timeout:        10 * time.Second,
+		privateKey:     privateKey,
+		mintController: mintController,
+		omniToken:      omniToken,
+	}
+
+	pending := models.Message{ID: &primitive.ObjectID{1}, Status: models.MessageStatusPending}
+	signed := models.Message{ID: &primitive.ObjectID{2}, Status: models.MessageStatusSigned}
+
+	// signed messages are held too, nothing is signed or scheduled while paused
+	omniToken.EXPECT().Paused(mock.Anything).Return(true, nil)
+	mockDB.EXPECT().GetPendingAndSignedMessages(mock.Anything).Return([]models.Message{pending, signed}, nil).Once()
+
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).Return("lock-id", nil).Twice()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Twice()
+	mockDB.EXPECT().UpdateMessage(pending.ID, pending.Status, bson.M{"status": models.MessageStatusDestinationPaused}).Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(signed.ID, signed.Status, bson.M{"status": models.MessageStatusDestinationPaused}).Return(nil).Once()
+
+	success := signer.SignMessages()
+	assert.True(t, success)
+}
+
+func TestSignMessages_DestinationPausedHoldError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	signer := &EthMessageSignerRunnable{
+		db:         mockDB,
+		logger:     logger,
+		timeout:    10 * time.Second,
+		privateKey: privateKey,
+		omniToken:  omniToken,
+	}
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(true, nil)
+	mockDB.EXPECT().GetPendingAndSignedMessages(mock.Anything).Return(nil, assert.AnError).Once()
+
+	assert.False(t, signer.SignMessages())
+
+	signed := models.Message{ID: &primitive.ObjectID{2}, Status: models.MessageStatusSigned}
+	mockDB.EXPECT().GetPendingAndSignedMessages(mock.Anything).Return([]models.Message{signed}, nil).Once()
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).Return("", assert.AnError).Once()
+
+	assert.False(t, signer.SignMessages())
+}
+
+func TestReleasePausedMessages(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		warpISM:         warpISM,
+		signerThreshold: 2,
+	}
+
+	// without signatures the message goes back to pending to be signed again
+	paused := models.Message{ID: &primitive.ObjectID{1}, Status: models.MessageStatusDestinationPaused}
+
+	mockDB.EXPECT().GetPausedMessages(signer.chain).Return([]models.Message{paused}, nil).Once()
+	mockDB.EXPECT().LockWriteMessage(mock.Anything).Return("lock-id", nil).Once()
+	mockDB.EXPECT().Unlock("lock-id").Return(nil).Once()
+	mockDB.EXPECT().UpdateMessage(paused.ID, paused.Status, bson.M{
+		"signatures":       []models.Signature{},
+		"status":           models.MessageStatusPending,
+		"simulation_error": "",
+	}).Return(nil).Once()
+
+	assert.True(t, signer.ReleasePausedMessages())
+
+	mockDB.EXPECT().GetPausedMessages(signer.chain).Return(nil, assert.AnError).Once()
+
+	assert.False(t, signer.ReleasePausedMessages())
+}
+
+func TestSignMessages_PausedError(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
+	logger := log.New().WithField("test", "signer")
+
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	signer := &EthMessageSignerRunnable{
+		db:         mockDB,
+		logger:     logger,
+		timeout:    10 * time.Second,
+		privateKey: privateKey,
+		omniToken:  omniToken,
+	}
+
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, assert.AnError)
+
+	success := signer.SignMessages()
+	assert.False(t, success)
+}
+
+func TestUpdateMaxMintLimit(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "signer")
+
+	mintController := clientMocks.NewMockMintControllerContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		client:          mockEthClient,
+		cosmosClient:    mockCosmosClient,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+		mintController:  mintController,
+	}
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(100), nil)
+
+	success := signer.UpdateMaxMintLimit()
+
+	assert.True(t, success)
+	assert.Equal(t, signer.maximumAmount, big.NewInt(100))
+}
+
+func TestUpdateMaxMintLimit_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "signer")
+
+	mintController := clientMocks.NewMockMintControllerContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		client:          mockEthClient,
+		cosmosClient:    mockCosmosClient,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+		mintController:  mintController,
+	}
+
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(100), assert.AnError)
+
+	success := signer.UpdateMaxMintLimit()
+
+	assert.False(t, success)
+
+	var nilAmount *big.Int = nil
+
+	assert.Equal(t, nilAmount, signer.maximumAmount)
+}
+
+func TestUpdateDomainData_Error(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
+	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
+	logger := log.New().WithField("test", "signer")
+
+	warpISM := clientMocks.NewMockWarpISMContract(t)
+
+	signer := &EthMessageSignerRunnable{
+		db:              mockDB,
+		client:          mockEthClient,
+		cosmosClient:    mockCosmosClient,
+		logger:          logger,
+		timeout:         10 * time.Second,
+		signerThreshold: 1,
+		privateKey:      &ecdsa.PrivateKey{},
+		warpISM:         warpISM,
+	}
+
+	warpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{Version: "6"}, assert.AnError)
+
+	signer.UpdateDomainData()
+
+	assert.Equal(t, signer.domain, util.DomainData{})
+}
+
+func TestUpdateDomainData(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	mockEthClient := clientMocks.NewMockEthereumClient(t)
 	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 	logger := log.New().WithField("test", "signer")
 
@@ -1952,6 +3588,7 @@ func TestSignerRun(t *testing.T) {
 	logger := log.New().WithField("test", "signer")
 
 	mintController := clientMocks.NewMockMintControllerContract(t)
+	omniToken := clientMocks.NewMockOmniTokenContract(t)
 	privateKey, err := crypto.GenerateKey()
 	assert.NoError(t, err)
 
@@ -1964,11 +3601,21 @@ func TestSignerRun(t *testing.T) {
 		signerThreshold: 1,
 		privateKey:      privateKey,
 		mintController:  mintController,
+		omniToken:       omniToken,
+		ismBlockHeight:  100,
 	}
 
 	mockEthClient.EXPECT().GetBlockHeight().Return(uint64(100), nil)
 	mockCosmosClient.EXPECT().GetLatestBlockHeight().Return(int64(200), nil)
+	omniToken.EXPECT().Paused(mock.Anything).Return(false, nil)
+	mockDB.EXPECT().GetPausedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().CurrentMintLimit(mock.Anything).Return(big.NewInt(1000), nil)
+	mintController.EXPECT().MintPerSecond(mock.Anything).Return(big.NewInt(10), nil)
+	mockDB.EXPECT().GetPendingAndSignedMessages(mock.Anything).Return([]models.Message{}, nil)
+	mockDB.EXPECT().GetSignedMessages(mock.Anything).Return([]models.Message{}, nil)
 	mockDB.EXPECT().GetPendingMessages(mock.Anything, mock.Anything).Return([]models.Message{}, nil)
+	mockDB.EXPECT().GetHeldMessages(mock.Anything, mock.Anything).Return([]models.Message{}, nil)
 
 	signer.Run()
 }
@@ -1979,6 +3626,7 @@ func TestNewMessageSigner(t *testing.T) {
 	mockMailbox := clientMocks.NewMockMailboxContract(t)
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	mockWarpISM := clientMocks.NewMockWarpISMContract(t)
+	mockOmniToken := clientMocks.NewMockOmniTokenContract(t)
 	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 
 	mnemonic := "infant apart enroll relief kangaroo patch awesome wagon trap feature armor approve"
@@ -2059,11 +3707,15 @@ func TestNewMessageSigner(t *testing.T) {
 		return mockWarpISM, nil
 	}
 
+	ethNewOmniTokenContract = func(ethcommon.Address, bind.ContractBackend) (eth.OmniTokenContract, error) {
+		return mockOmniToken, nil
+	}
+
 	cosmosNewClient = func(models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
 		return mockCosmosClient, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -2072,6 +3724,7 @@ func TestNewMessageSigner(t *testing.T) {
 		ethNewMailboxContract = eth.NewMailboxContract
 		ethNewMintControllerContract = eth.NewMintControllerContract
 		ethNewWarpISMContract = eth.NewWarpISMContract
+		ethNewOmniTokenContract = eth.NewOmniTokenContract
 		cosmosNewClient = cosmos.NewClient
 		dbNewDB = db.NewDB
 	}()
@@ -2086,6 +3739,9 @@ func TestNewMessageSigner(t *testing.T) {
 
 	mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil)
 	mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil)
+	mockWarpISM.EXPECT().Validators(mock.Anything, ethcommon.HexToAddress(config.OracleAddresses[0])).Return(true, nil)
+	mockWarpISM.EXPECT().Validators(mock.Anything, ethcommon.HexToAddress(config.OracleAddresses[1])).Return(true, nil)
+	mockWarpISM.EXPECT().Validators(mock.Anything, ethcommon.HexToAddress(config.OracleAddresses[2])).Return(false, nil)
 	mockWarpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{ChainId: big.NewInt(1), VerifyingContract: ethcommon.HexToAddress(config.WarpISMAddress)}, nil)
 	mockMintController.EXPECT().MaxMintLimit(mock.Anything).Return(big.NewInt(100), nil)
 
@@ -2098,9 +3754,15 @@ func TestNewMessageSigner(t *testing.T) {
 	assert.Equal(t, mockDB, monitor.db)
 	assert.Equal(t, mockClient, monitor.client)
 	assert.Equal(t, mockMintController, monitor.mintController)
+	assert.Equal(t, mockOmniToken, monitor.omniToken)
 	assert.Equal(t, mockCosmosClient, monitor.cosmosClient)
 	assert.Equal(t, uint64(100), monitor.currentEthereumBlockHeight)
 	assert.Equal(t, uint64(100), monitor.currentCosmosBlockHeight)
+	assert.Equal(t, uint64(100), monitor.ismBlockHeight)
+	assert.Equal(t, map[string]bool{
+		strings.ToLower(config.OracleAddresses[0]): true,
+		strings.ToLower(config.OracleAddresses[1]): true,
+	}, monitor.validators)
 
 }
 
@@ -2114,6 +3776,7 @@ func TestNewMessageSignerFailures(t *testing.T) {
 	mockMailbox := clientMocks.NewMockMailboxContract(t)
 	mockMintController := clientMocks.NewMockMintControllerContract(t)
 	mockWarpISM := clientMocks.NewMockWarpISMContract(t)
+	mockOmniToken := clientMocks.NewMockOmniTokenContract(t)
 	mockCosmosClient := cosmosMocks.NewMockCosmosClient(t)
 
 	mnemonic := "infant apart enroll relief kangaroo patch awesome wagon trap feature armor approve"
@@ -2194,11 +3857,15 @@ func TestNewMessageSignerFailures(t *testing.T) {
 		return mockWarpISM, nil
 	}
 
+	ethNewOmniTokenContract = func(ethcommon.Address, bind.ContractBackend) (eth.OmniTokenContract, error) {
+		return mockOmniToken, nil
+	}
+
 	cosmosNewClient = func(models.CosmosNetworkConfig) (cosmos.CosmosClient, error) {
 		return mockCosmosClient, nil
 	}
 
-	dbNewDB = func() db.DB {
+	dbNewDB = func(string) db.DB {
 		return mockDB
 	}
 
@@ -2207,6 +3874,7 @@ func TestNewMessageSignerFailures(t *testing.T) {
 		ethNewMailboxContract = eth.NewMailboxContract
 		ethNewMintControllerContract = eth.NewMintControllerContract
 		ethNewWarpISMContract = eth.NewWarpISMContract
+		ethNewOmniTokenContract = eth.NewOmniTokenContract
 		cosmosNewClient = cosmos.NewClient
 		dbNewDB = db.NewDB
 	}()
@@ -2277,6 +3945,22 @@ func TestNewMessageSignerFailures(t *testing.T) {
 
 	})
 
+	t.Run("OmniTokenError", func(t *testing.T) {
+
+		ethNewOmniTokenContract = func(ethcommon.Address, bind.ContractBackend) (eth.OmniTokenContract, error) {
+			return nil, assert.AnError
+		}
+
+		assert.Panics(t, func() {
+			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
+		})
+
+		ethNewOmniTokenContract = func(ethcommon.Address, bind.ContractBackend) (eth.OmniTokenContract, error) {
+			return mockOmniToken, nil
+		}
+
+	})
+
 	t.Run("MnemonicError", func(t *testing.T) {
 
 		mnemonic = "invalid"
@@ -2346,9 +4030,28 @@ func TestNewMessageSignerFailures(t *testing.T) {
 
 	})
 
-	t.Run("InvalidSigners", func(t *testing.T) {
-		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(100), nil).Once()
-		mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(20), nil).Once()
+	t.Run("ValidatorCountError", func(t *testing.T) {
+		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(nil, assert.AnError).Once()
+		assert.Panics(t, func() {
+			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
+		})
+	})
+
+	t.Run("SignerThresholdError", func(t *testing.T) {
+		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil).Once()
+		mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(nil, assert.AnError).Once()
+		assert.Panics(t, func() {
+			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
+		})
+	})
+
+	t.Run("MismatchedSigners", func(t *testing.T) {
+		// a validator count different from the oracle addresses only warns,
+		// so the signer goes on to read the validators and the domain data
+		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(4), nil).Once()
+		mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil).Once()
+		mockWarpISM.EXPECT().Validators(mock.Anything, mock.Anything).Return(true, nil).Times(3)
+		mockWarpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{}, assert.AnError).Once()
 		assert.Panics(t, func() {
 			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
 		})
@@ -2362,9 +4065,19 @@ func TestNewMessageSignerFailures(t *testing.T) {
 		})
 	})
 
+	t.Run("ValidatorsError", func(t *testing.T) {
+		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil).Once()
+		mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil).Once()
+		mockWarpISM.EXPECT().Validators(mock.Anything, mock.Anything).Return(false, assert.AnError).Once()
+		assert.Panics(t, func() {
+			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
+		})
+	})
+
 	t.Run("DomainDataErrorChainID", func(t *testing.T) {
 		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil).Once()
 		mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil).Once()
+		mockWarpISM.EXPECT().Validators(mock.Anything, mock.Anything).Return(true, nil).Times(3)
 		mockWarpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{ChainId: big.NewInt(2), VerifyingContract: ethcommon.HexToAddress(config.WarpISMAddress)}, nil).Once()
 		assert.Panics(t, func() {
 			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
@@ -2374,6 +4087,7 @@ func TestNewMessageSignerFailures(t *testing.T) {
 	t.Run("DomainDataErrorContract", func(t *testing.T) {
 		mockWarpISM.EXPECT().ValidatorCount(mock.Anything).Return(big.NewInt(3), nil).Once()
 		mockWarpISM.EXPECT().SignerThreshold(mock.Anything).Return(big.NewInt(2), nil).Once()
+		mockWarpISM.EXPECT().Validators(mock.Anything, mock.Anything).Return(true, nil).Times(3)
 		mockWarpISM.EXPECT().Eip712Domain(mock.Anything).Return(util.DomainData{ChainId: big.NewInt(1), VerifyingContract: ethcommon.BytesToAddress([]byte("invalid"))}, nil).Once()
 		assert.Panics(t, func() {
 			NewMessageSigner(mnemonic, config, cosmosNetwork, ethNetworks)
@@ -2381,3 +4095,9 @@ func TestNewMessageSignerFailures(t *testing.T) {
 	})
 
 }
+
+type verifyRevertError struct{}
+
+func (verifyRevertError) Error() string          { return "execution reverted" }
+func (verifyRevertError) ErrorCode() int         { return 3 }
+func (verifyRevertError) ErrorData() interface{} { return "0x" }
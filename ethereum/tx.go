@@ -1,11 +1,19 @@
 package ethereum
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	eth "github.com/dan13ram/wpokt-oracle/ethereum/client"
+	"github.com/dan13ram/wpokt-oracle/models"
+
+	log "github.com/sirupsen/logrus"
 )
 
 type ValidateTransactionByHashResult struct {
@@ -34,3 +42,109 @@ func ValidateTransactionByHash(client eth.EthereumClient, txHash string) (*Valid
 	}
 	return &ValidateTransactionByHashResult{tx, receipt}, nil
 }
+
+type CheckTransactionReorgResult struct {
+	Reorged bool
+	// Receipt is set when the transaction is no longer in its recorded block
+	// but has been included in a different canonical block
+	Receipt *types.Receipt
+}
+
+func CheckTransactionReorg(client eth.EthereumClient, txDoc *models.Transaction) (*CheckTransactionReorgResult, error) {
+	if txDoc == nil {
+		return nil, fmt.Errorf("txDoc is nil")
+	}
+
+	if txDoc.BlockHash == "" {
+		return &CheckTransactionReorgResult{Reorged: false}, nil
+	}
+
+	blockHash, err := client.GetBlockHash(txDoc.BlockHeight)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return nil, fmt.Errorf("error getting block hash: %w", err)
+	}
+	if err == nil && strings.EqualFold(blockHash.Hex(), txDoc.BlockHash) {
+		return &CheckTransactionReorgResult{Reorged: false}, nil
+	}
+
+	receipt, err := client.GetTransactionReceipt(txDoc.Hash)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return nil, fmt.Errorf("error getting transaction receipt: %w", err)
+	}
+	if err != nil || receipt == nil {
+		return &CheckTransactionReorgResult{Reorged: true}, nil
+	}
+
+	return &CheckTransactionReorgResult{Reorged: false, Receipt: receipt}, nil
+}
+
+// FetchTransactionReceipts fetches the receipts of txDocs with batch requests.
+// Receipts of transactions that share a block are taken from a single eth_getBlockReceipts call for that block.
+// Receipts that could not be fetched are left out, so callers fall back to GetTransactionReceipt for them.
+func FetchTransactionReceipts(client eth.EthereumClient, txDocs []models.Transaction) (map[ethcommon.Hash]*types.Receipt, error) {
+	receipts := make(map[ethcommon.Hash]*types.Receipt)
+
+	txsPerBlock := make(map[uint64]int)
+	for _, txDoc := range txDocs {
+		if txDoc.BlockHash != "" {
+			txsPerBlock[txDoc.BlockHeight]++
+		}
+	}
+
+	blockHeights := []uint64{}
+	for blockHeight, count := range txsPerBlock {
+		if count > 1 {
+			blockHeights = append(blockHeights, blockHeight)
+		}
+	}
+	sort.Slice(blockHeights, func(i, j int) bool { return blockHeights[i] < blockHeights[j] })
+
+	if len(blockHeights) > 0 {
+		blockReceipts, err := client.GetBlockReceipts(blockHeights)
+		if err != nil {
+			return nil, fmt.Errorf("error getting block receipts: %w", err)
+		}
+
+		wanted := make(map[ethcommon.Hash]bool)
+		for _, txDoc := range txDocs {
+			wanted[ethcommon.HexToHash(txDoc.Hash)] = true
+		}
+		for _, blockReceipt := range blockReceipts {
+			for _, receipt := range blockReceipt {
+				if receipt != nil && wanted[receipt.TxHash] {
+					receipts[receipt.TxHash] = receipt
+				}
+			}
+		}
+	}
+
+	txHashes := []string{}
+	for _, txDoc := range txDocs {
+		if _, ok := receipts[ethcommon.HexToHash(txDoc.Hash)]; !ok {
+			txHashes = append(txHashes, txDoc.Hash)
+		}
+	}
+
+	if len(txHashes) == 0 {
+		return receipts, nil
+	}
+
+	txReceipts, err := client.GetTransactionReceipts(txHashes)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction receipts: %w", err)
+	}
+	for txHash, receipt := range txReceipts {
+		receipts[txHash] = receipt
+	}
+
+	return receipts, nil
+}
+
+// prefetchTransactionReceipts fetches the receipts of txDocs with batch requests into receipts for the chain
+func prefetchTransactionReceipts(receipts *eth.ReceiptCache, client eth.EthereumClient, chainDomain uint32, txDocs []models.Transaction, logger *log.Entry) {
+	txReceipts, err := ethFetchTransactionReceipts(client, txDocs)
+	if err != nil {
+		logger.WithError(err).Warn("Error prefetching transaction receipts")
+	}
+	receipts.SetReceipts(chainDomain, txReceipts)
+}
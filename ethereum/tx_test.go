@@ -2,9 +2,13 @@ package ethereum
 
 import (
 	"errors"
+	"math/big"
 	"testing"
 
 	clientMocks "github.com/dan13ram/wpokt-oracle/ethereum/client/mocks"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -69,3 +73,138 @@ func TestValidateTransactionByHash(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestCheckTransactionReorg(t *testing.T) {
+	mockClient := clientMocks.NewMockEthereumClient(t)
+	blockHash := ethcommon.HexToHash("0x0a")
+	otherHash := ethcommon.HexToHash("0x0b")
+	txDoc := &models.Transaction{Hash: "0x123", BlockHeight: 100, BlockHash: blockHash.Hex()}
+
+	t.Run("nil transaction", func(t *testing.T) {
+		result, err := CheckTransactionReorg(mockClient, nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("no block hash", func(t *testing.T) {
+		result, err := CheckTransactionReorg(mockClient, &models.Transaction{Hash: "0x123"})
+		assert.NoError(t, err)
+		assert.False(t, result.Reorged)
+		assert.Nil(t, result.Receipt)
+	})
+
+	t.Run("block hash matches", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHash(uint64(100)).Return(blockHash, nil).Once()
+
+		result, err := CheckTransactionReorg(mockClient, txDoc)
+		assert.NoError(t, err)
+		assert.False(t, result.Reorged)
+		assert.Nil(t, result.Receipt)
+	})
+
+	t.Run("error getting block hash", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHash(uint64(100)).Return(ethcommon.Hash{}, errors.New("error")).Once()
+
+		result, err := CheckTransactionReorg(mockClient, txDoc)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("transaction moved to another block", func(t *testing.T) {
+		receipt := &types.Receipt{BlockNumber: big.NewInt(101), BlockHash: otherHash}
+		mockClient.EXPECT().GetBlockHash(uint64(100)).Return(otherHash, nil).Once()
+		mockClient.EXPECT().GetTransactionReceipt("0x123").Return(receipt, nil).Once()
+
+		result, err := CheckTransactionReorg(mockClient, txDoc)
+		assert.NoError(t, err)
+		assert.False(t, result.Reorged)
+		assert.Equal(t, receipt, result.Receipt)
+	})
+
+	t.Run("transaction dropped", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHash(uint64(100)).Return(otherHash, nil).Once()
+		mockClient.EXPECT().GetTransactionReceipt("0x123").Return(nil, ethereum.NotFound).Once()
+
+		result, err := CheckTransactionReorg(mockClient, txDoc)
+		assert.NoError(t, err)
+		assert.True(t, result.Reorged)
+		assert.Nil(t, result.Receipt)
+	})
+
+	t.Run("block not found", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHash(uint64(100)).Return(ethcommon.Hash{}, ethereum.NotFound).Once()
+		mockClient.EXPECT().GetTransactionReceipt("0x123").Return(nil, ethereum.NotFound).Once()
+
+		result, err := CheckTransactionReorg(mockClient, txDoc)
+		assert.NoError(t, err)
+		assert.True(t, result.Reorged)
+	})
+
+	t.Run("error getting transaction receipt", func(t *testing.T) {
+		mockClient.EXPECT().GetBlockHash(uint64(100)).Return(otherHash, nil).Once()
+		mockClient.EXPECT().GetTransactionReceipt("0x123").Return(nil, errors.New("error")).Once()
+
+		result, err := CheckTransactionReorg(mockClient, txDoc)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestFetchTransactionReceipts(t *testing.T) {
+	txDocs := []models.Transaction{
+		{Hash: "0x01", BlockHash: "0x0a", BlockHeight: 10},
+		{Hash: "0x02", BlockHash: "0x0a", BlockHeight: 10},
+		{Hash: "0x03", BlockHash: "0x0b", BlockHeight: 11},
+		{Hash: "0x04"},
+	}
+	receiptOne := &types.Receipt{TxHash: ethcommon.HexToHash("0x01")}
+	receiptThree := &types.Receipt{TxHash: ethcommon.HexToHash("0x03")}
+
+	t.Run("success", func(t *testing.T) {
+		mockClient := clientMocks.NewMockEthereumClient(t)
+		mockClient.EXPECT().GetBlockReceipts([]uint64{10}).Return(map[uint64][]*types.Receipt{
+			10: {receiptOne, {TxHash: ethcommon.HexToHash("0x05")}},
+		}, nil).Once()
+		mockClient.EXPECT().GetTransactionReceipts([]string{"0x02", "0x03", "0x04"}).Return(map[ethcommon.Hash]*types.Receipt{
+			ethcommon.HexToHash("0x03"): receiptThree,
+		}, nil).Once()
+
+		receipts, err := FetchTransactionReceipts(mockClient, txDocs)
+		assert.NoError(t, err)
+		assert.Equal(t, map[ethcommon.Hash]*types.Receipt{
+			ethcommon.HexToHash("0x01"): receiptOne,
+			ethcommon.HexToHash("0x03"): receiptThree,
+		}, receipts)
+	})
+
+	t.Run("all receipts from blocks", func(t *testing.T) {
+		receiptTwo := &types.Receipt{TxHash: ethcommon.HexToHash("0x02")}
+		mockClient := clientMocks.NewMockEthereumClient(t)
+		mockClient.EXPECT().GetBlockReceipts([]uint64{10}).Return(map[uint64][]*types.Receipt{
+			10: {receiptOne, receiptTwo},
+		}, nil).Once()
+
+		receipts, err := FetchTransactionReceipts(mockClient, txDocs[:2])
+		assert.NoError(t, err)
+		assert.Len(t, receipts, 2)
+		assert.Equal(t, receiptTwo, receipts[ethcommon.HexToHash("0x02")])
+	})
+
+	t.Run("error getting block receipts", func(t *testing.T) {
+		mockClient := clientMocks.NewMockEthereumClient(t)
+		mockClient.EXPECT().GetBlockReceipts([]uint64{10}).Return(nil, errors.New("error")).Once()
+
+		receipts, err := FetchTransactionReceipts(mockClient, txDocs)
+		assert.Error(t, err)
+		assert.Nil(t, receipts)
+	})
+
+	t.Run("error getting transaction receipts", func(t *testing.T) {
+		mockClient := clientMocks.NewMockEthereumClient(t)
+		mockClient.EXPECT().GetTransactionReceipts([]string{"0x04"}).Return(nil, errors.New("error")).Once()
+
+		receipts, err := FetchTransactionReceipts(mockClient, txDocs[3:])
+		assert.Error(t, err)
+		assert.Nil(t, receipts)
+	})
+}
@@ -0,0 +1,52 @@
+package util
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+)
+
+// RevertReason returns a readable reason for a failed contract call,
+// decoding the revert data against the custom errors of the contract abi when possible
+func RevertReason(err error, contractABI *abi.ABI) string {
+	if err == nil {
+		return ""
+	}
+
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return err.Error()
+	}
+	data, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return err.Error()
+	}
+	revertData, decodeErr := common.BytesFromHex(data)
+	if decodeErr != nil || len(revertData) < 4 {
+		return err.Error()
+	}
+
+	if reason, unpackErr := abi.UnpackRevert(revertData); unpackErr == nil {
+		return reason
+	}
+
+	if contractABI != nil {
+		for name, abiError := range contractABI.Errors {
+			if bytes.Equal(abiError.ID[:4], revertData[:4]) {
+				return name
+			}
+		}
+	}
+
+	return err.Error()
+}
+
+// IsRevert reports whether err is a revert answered by the node, as opposed to an error reaching it
+func IsRevert(err error) bool {
+	var dataErr rpc.DataError
+	return errors.As(err, &dataErr)
+}
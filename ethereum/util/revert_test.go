@@ -0,0 +1,61 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dan13ram/wpokt-oracle/common"
+	"github.com/dan13ram/wpokt-oracle/ethereum/autogen"
+)
+
+type revertError struct {
+	data interface{}
+}
+
+func (e revertError) Error() string {
+	return "execution reverted"
+}
+
+func (e revertError) ErrorData() interface{} {
+	return e.data
+}
+
+func TestRevertReason(t *testing.T) {
+	warpISMABI, err := autogen.WarpISMMetaData.GetAbi()
+	assert.NoError(t, err)
+
+	countBelowThreshold := warpISMABI.Errors["CountBelowThreshold"].ID
+	reason := RevertReason(revertError{data: common.HexFromBytes(countBelowThreshold[:4])}, warpISMABI)
+	assert.Equal(t, "CountBelowThreshold", reason)
+
+	// wrapped errors are unwrapped
+	reason = RevertReason(fmt.Errorf("call failed: %w", revertError{data: common.HexFromBytes(countBelowThreshold[:4])}), warpISMABI)
+	assert.Equal(t, "CountBelowThreshold", reason)
+
+	reasonString, err := abi.NewType("string", "", nil)
+	assert.NoError(t, err)
+	packed, err := abi.Arguments{{Type: reasonString}}.Pack("not allowed")
+	assert.NoError(t, err)
+	revertData := append([]byte{0x08, 0xc3, 0x79, 0xa0}, packed...)
+	reason = RevertReason(revertError{data: common.HexFromBytes(revertData)}, warpISMABI)
+	assert.Equal(t, "not allowed", reason)
+}
+
+func TestRevertReason_Fallback(t *testing.T) {
+	assert.Equal(t, "", RevertReason(nil, nil))
+	assert.Equal(t, "connection refused", RevertReason(errors.New("connection refused"), nil))
+	assert.Equal(t, "execution reverted", RevertReason(revertError{data: 1}, nil))
+	assert.Equal(t, "execution reverted", RevertReason(revertError{data: "0x12"}, nil))
+	assert.Equal(t, "execution reverted", RevertReason(revertError{data: "0x12345678"}, nil))
+}
+
+func TestIsRevert(t *testing.T) {
+	assert.True(t, IsRevert(revertError{data: "0x12345678"}))
+	assert.True(t, IsRevert(fmt.Errorf("call failed: %w", revertError{})))
+	assert.False(t, IsRevert(errors.New("connection refused")))
+	assert.False(t, IsRevert(nil))
+}
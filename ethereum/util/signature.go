@@ -88,14 +88,12 @@ func HexToBytes32(hexString string) string {
 
 var apitypesTypedDataAndHash = apitypes.TypedDataAndHash
 
-func signTypedData(
+func typedDataHash(
 	content models.MessageContent,
 	domainData DomainData,
-	key *ecdsa.PrivateKey,
 ) ([]byte, error) {
-
-	if key == nil {
-		return nil, errors.New("invalid key")
+	if domainData.ChainId == nil {
+		return nil, errors.New("invalid domain")
 	}
 
 	messageBodyBytes, err := content.MessageBody.EncodeToBytes()
@@ -132,6 +130,24 @@ func signTypedData(
 		return nil, err
 	}
 
+	return sighash, nil
+}
+
+func signTypedData(
+	content models.MessageContent,
+	domainData DomainData,
+	key *ecdsa.PrivateKey,
+) ([]byte, error) {
+
+	if key == nil {
+		return nil, errors.New("invalid key")
+	}
+
+	sighash, err := typedDataHash(content, domainData)
+	if err != nil {
+		return nil, err
+	}
+
 	signature, err := crypto.Sign(sighash, key)
 	if err != nil {
 		return nil, err
@@ -169,3 +185,58 @@ func SignMessage(
 	message.Signatures = signatures
 	return nil
 }
+
+// RecoverSigner returns the lowercase address that signed the message content for the domain
+func RecoverSigner(
+	content models.MessageContent,
+	domain DomainData,
+	signature string,
+) (string, error) {
+	signatureBytes, err := common.BytesFromHex(signature)
+	if err != nil {
+		return "", err
+	}
+	if len(signatureBytes) != crypto.SignatureLength {
+		return "", errors.New("invalid signature length")
+	}
+
+	sighash, err := typedDataHash(content, domain)
+	if err != nil {
+		return "", err
+	}
+
+	if signatureBytes[64] == 27 || signatureBytes[64] == 28 {
+		signatureBytes[64] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(sighash, signatureBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(crypto.PubkeyToAddress(*publicKey).Hex()), nil
+}
+
+// EncodeMetadata concatenates the signatures, sorted by signer, into the metadata
+// expected by the warp ism to verify a message
+func EncodeMetadata(signatures []models.Signature) ([]byte, error) {
+	sorted := make([]models.Signature, len(signatures))
+	copy(sorted, signatures)
+	sort.Slice(sorted, func(i, j int) bool {
+		return ethcommon.HexToAddress(sorted[i].Signer).Big().Cmp(ethcommon.HexToAddress(sorted[j].Signer).Big()) == -1
+	})
+
+	metadata := []byte{}
+	for _, sig := range sorted {
+		signatureBytes, err := common.BytesFromHex(sig.Signature)
+		if err != nil {
+			return nil, err
+		}
+		if len(signatureBytes) != crypto.SignatureLength {
+			return nil, errors.New("invalid signature length")
+		}
+		metadata = append(metadata, signatureBytes...)
+	}
+
+	return metadata, nil
+}
@@ -286,3 +286,98 @@ func TestSignMessage_Error(t *testing.T) {
 	err = SignMessage(message, domain, privateKey)
 	assert.Error(t, err)
 }
+
+func TestRecoverSigner(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	assert.NoError(t, err)
+
+	message := &models.Message{
+		Content: models.MessageContent{
+			Version:           1,
+			Nonce:             1,
+			OriginDomain:      1,
+			Sender:            "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			DestinationDomain: 1,
+			Recipient:         "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			MessageBody: models.MessageBody{
+				SenderAddress:    "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+				Amount:           "100",
+				RecipientAddress: "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			},
+		},
+	}
+
+	domain := DomainData{
+		Name:              "Test",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: common.HexToAddress("0xAb5801a7D398351b8bE11C439e05C5b3259aec9B"),
+	}
+
+	err = SignMessage(message, domain, privateKey)
+	assert.NoError(t, err)
+
+	signer, err := RecoverSigner(message.Content, domain, message.Signatures[0].Signature)
+	assert.NoError(t, err)
+	assert.Equal(t, message.Signatures[0].Signer, signer)
+
+	// a signature over other content recovers to another address
+	content := message.Content
+	content.MessageBody.Amount = "200"
+	signer, err = RecoverSigner(content, domain, message.Signatures[0].Signature)
+	assert.NoError(t, err)
+	assert.NotEqual(t, message.Signatures[0].Signer, signer)
+}
+
+func TestRecoverSigner_Errors(t *testing.T) {
+	content := models.MessageContent{
+		Version:           1,
+		Nonce:             1,
+		OriginDomain:      1,
+		Sender:            "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+		DestinationDomain: 1,
+		Recipient:         "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+		MessageBody: models.MessageBody{
+			SenderAddress:    "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+			Amount:           "100",
+			RecipientAddress: "0xAb5801a7D398351b8bE11C439e05C5b3259aec9B",
+		},
+	}
+
+	domain := DomainData{
+		Name:              "Test",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: common.HexToAddress("0xAb5801a7D398351b8bE11C439e05C5b3259aec9B"),
+	}
+
+	_, err := RecoverSigner(content, domain, "invalid")
+	assert.Error(t, err)
+
+	_, err = RecoverSigner(content, domain, "0x1234")
+	assert.Error(t, err)
+
+	_, err = RecoverSigner(content, domain, "0x"+strings.Repeat("00", 65))
+	assert.Error(t, err)
+
+	_, err = RecoverSigner(models.MessageContent{}, domain, "0x"+strings.Repeat("00", 65))
+	assert.Error(t, err)
+}
+
+func TestEncodeMetadata(t *testing.T) {
+	low := "0x" + strings.Repeat("11", 65)
+	high := "0x" + strings.Repeat("22", 65)
+
+	metadata, err := EncodeMetadata([]models.Signature{
+		{Signer: "0x0000000000000000000000000000000000000002", Signature: high},
+		{Signer: "0x0000000000000000000000000000000000000001", Signature: low},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "0x"+strings.Repeat("11", 65)+strings.Repeat("22", 65), common.HexFromBytes(metadata))
+
+	_, err = EncodeMetadata([]models.Signature{{Signer: "0x0000000000000000000000000000000000000001", Signature: "0x1234"}})
+	assert.Error(t, err)
+
+	_, err = EncodeMetadata([]models.Signature{{Signer: "0x0000000000000000000000000000000000000001", Signature: "invalid"}})
+	assert.Error(t, err)
+}
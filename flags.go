@@ -7,6 +7,24 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// absolutePath resolves path against the working directory, leaving an empty path empty
+func absolutePath(path string, kind string) string {
+	if path == "" {
+		return ""
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		logger.
+			WithFields(log.Fields{"error": err}).
+			Fatalf("Could not get absolute path for %s file", kind)
+		panic(err)
+	}
+	logger.
+		WithFields(log.Fields{kind: absPath}).
+		Debugf("Found %s file", kind)
+	return absPath
+}
+
 func parseFlags() (string, string) {
 	var yamlPath string
 	var envPath string
@@ -14,30 +32,5 @@ func parseFlags() (string, string) {
 	flag.StringVar(&envPath, "env", "", "path to env file")
 	flag.Parse()
 
-	var absYamlPath string
-	var err error
-	if yamlPath != "" {
-		absYamlPath, err = filepath.Abs(yamlPath)
-		if err != nil {
-			logger.
-				WithFields(log.Fields{"error": err}).
-				Fatal("Could not get absolute path for yaml file")
-			panic(err)
-		}
-		logger.
-			WithFields(log.Fields{"yaml": absYamlPath}).
-			Debug("Found yaml file")
-	}
-
-	var absEnvPath string
-	if envPath != "" {
-		absEnvPath, err = filepath.Abs(envPath)
-		if err != nil {
-			logger.WithFields(log.Fields{"error": err}).Fatal("Could not get absolute path for env file")
-			panic(err)
-		}
-		logger.WithFields(log.Fields{"env": absEnvPath}).Debug("Found env file")
-	}
-
-	return absYamlPath, absEnvPath
+	return absolutePath(yamlPath, "yaml"), absolutePath(envPath, "env")
 }
@@ -2,9 +2,7 @@ package health
 
 import (
 	"encoding/hex"
-	"fmt"
 	"os"
-	"strings"
 	"time"
 
 	"github.com/dan13ram/wpokt-oracle/common"
@@ -14,12 +12,13 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type HealthCheckRunnable interface {
 	Run()
 	AddServices(services []service.ChainService)
-	GetLastHealth() (*models.Node, error)
+	SeedCheckpoints()
 }
 
 type healthCheckRunnable struct {
@@ -41,15 +40,46 @@ func (x *healthCheckRunnable) AddServices(services []service.ChainService) {
 	x.services = services
 }
 
-func (x *healthCheckRunnable) GetLastHealth() (*models.Node, error) {
+// SeedCheckpoints stores the block heights of the last health record of this node
+// as the checkpoints of the monitors and relayers that have none yet,
+// so that a node upgraded from health based resumption does not rescan from the start
+func (x *healthCheckRunnable) SeedCheckpoints() {
 	filter := bson.M{
 		"cosmos_address": x.cosmosAddress,
 		"eth_address":    x.ethAddress,
 		"hostname":       x.hostname,
 		"oracle_id":      x.oracleID,
 	}
-	health, err := x.db.FindNode(filter)
-	return health, err
+	node, err := x.db.FindNode(filter)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			x.logger.WithError(err).Warn("Could not get last health")
+		}
+		return
+	}
+
+	for _, health := range node.Health {
+		runners := map[string]*models.RunnerServiceStatus{
+			models.CheckpointRunnerMonitor: health.MessageMonitor,
+		}
+		// the cosmos relayer does not scan blocks
+		if health.Chain.ChainType == models.ChainTypeEthereum {
+			runners[models.CheckpointRunnerRelayer] = health.MessageRelayer
+		}
+
+		for runner, status := range runners {
+			if status == nil || status.BlockHeight == 0 {
+				continue
+			}
+			if err := x.db.SeedCheckpoint(health.Chain, runner, status.BlockHeight); err != nil {
+				x.logger.
+					WithError(err).
+					WithField("chain_id", health.Chain.ChainID).
+					WithField("runner", runner).
+					Warn("Could not seed checkpoint from last health")
+			}
+		}
+	}
 }
 
 func (x *healthCheckRunnable) ServiceHealths() []models.ChainServiceHealth {
@@ -61,6 +91,23 @@ func (x *healthCheckRunnable) ServiceHealths() []models.ChainServiceHealth {
 	return serviceHealths
 }
 
+// UnresolvedAnomalies returns the number of anomalies no operator has resolved yet,
+// raising an alert while there are any
+func (x *healthCheckRunnable) UnresolvedAnomalies() (int, bool) {
+	anomalies, err := x.db.GetUnresolvedAnomalies()
+	if err != nil {
+		x.logger.WithError(err).Error("Error getting unresolved anomalies")
+		return 0, false
+	}
+	if len(anomalies) > 0 {
+		x.logger.
+			WithField("alert", "anomaly").
+			WithField("anomalies", len(anomalies)).
+			Error("Found unresolved anomalies")
+	}
+	return len(anomalies), true
+}
+
 func (x *healthCheckRunnable) PostHealth() bool {
 	x.logger.Debug("Posting health")
 
@@ -85,6 +132,11 @@ func (x *healthCheckRunnable) PostHealth() bool {
 		"updated_at":      time.Now(),
 	}
 
+	if anomalies, ok := x.UnresolvedAnomalies(); ok {
+		onUpdate["anomalies"] = anomalies
+		onUpdate["healthy"] = anomalies == 0
+	}
+
 	err := x.db.UpsertNode(filter, onUpdate, onInsert)
 
 	if err != nil {
@@ -113,8 +165,6 @@ func newHealthCheck(config models.Config) *healthCheckRunnable {
 
 	cosmosPubKey, _ := common.CosmosPublicKeyFromMnemonic(config.Mnemonic)
 
-	cosmosPubKeyHex := hex.EncodeToString(cosmosPubKey.Bytes())
-
 	cosmosAddress := cosmosPubKey.Address().Bytes()
 
 	cosmosAddressHex := hex.EncodeToString(cosmosAddress)
@@ -123,19 +173,11 @@ func newHealthCheck(config models.Config) *healthCheckRunnable {
 		WithField("cosmos_address", cosmosAddressHex).
 		Debug("Initialized cosmos address")
 
-	signerIndex := -1
-	for i, pk := range config.CosmosNetwork.MultisigPublicKeys {
-		if strings.EqualFold(pk, cosmosPubKeyHex) {
-			signerIndex = i
-		}
-	}
-
-	if signerIndex == -1 {
-		logger.Fatal("Multisig public keys do not contain signer")
+	oracleID, err := common.OracleIDFromMnemonic(config.Mnemonic, config.CosmosNetwork.MultisigPublicKeys)
+	if err != nil {
+		logger.WithError(err).Fatal("Multisig public keys do not contain signer")
 	}
 
-	oracleID := "oracle-" + fmt.Sprintf("%02d", signerIndex)
-
 	hostname, err := osHostname()
 	if err != nil {
 		logger.Fatal("Error getting hostname: ", err)
@@ -147,7 +189,7 @@ func newHealthCheck(config models.Config) *healthCheckRunnable {
 		hostname:      hostname,
 		oracleID:      oracleID,
 		logger:        logger,
-		db:            db.NewDB(),
+		db:            db.NewDB("health"),
 	}
 
 	x.logger.Info("Initialized health")
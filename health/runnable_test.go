@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -34,6 +35,7 @@ func Test_HealthCheckRunnable_Run(t *testing.T) {
 		db:     mockDB,
 	}
 
+	mockDB.EXPECT().GetUnresolvedAnomalies().Return([]models.Anomaly{}, nil).Once()
 	mockDB.EXPECT().UpsertNode(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 
 	healthCheck.Run()
@@ -49,7 +51,7 @@ func Test_HealthCheckRunnable_AddServices(t *testing.T) {
 	assert.Equal(t, services, healthCheck.services)
 }
 
-func Test_HealthCheckRunnable_GetLastHealth(t *testing.T) {
+func Test_HealthCheckRunnable_SeedCheckpoints(t *testing.T) {
 	mockDB := mocks.NewMockDB(t)
 	healthCheck := &healthCheckRunnable{
 		cosmosAddress: "cosmosAddress",
@@ -57,6 +59,7 @@ func Test_HealthCheckRunnable_GetLastHealth(t *testing.T) {
 		hostname:      "hostname",
 		oracleID:      "oracleID",
 		db:            mockDB,
+		logger:        log.NewEntry(log.New()),
 	}
 
 	expectedFilter := bson.M{
@@ -65,11 +68,41 @@ func Test_HealthCheckRunnable_GetLastHealth(t *testing.T) {
 		"hostname":       "hostname",
 		"oracle_id":      "oracleID",
 	}
-	mockDB.EXPECT().FindNode(expectedFilter).Return(&models.Node{}, nil).Once()
+	ethChain := models.Chain{ChainID: "1", ChainType: models.ChainTypeEthereum}
+	cosmosChain := models.Chain{ChainID: "poktroll", ChainType: models.ChainTypeCosmos}
+	node := &models.Node{
+		Health: []models.ChainServiceHealth{
+			{
+				Chain:          ethChain,
+				MessageMonitor: &models.RunnerServiceStatus{BlockHeight: 100},
+				MessageRelayer: &models.RunnerServiceStatus{BlockHeight: 90},
+			},
+			{
+				Chain:          cosmosChain,
+				MessageMonitor: &models.RunnerServiceStatus{BlockHeight: 200},
+				MessageRelayer: &models.RunnerServiceStatus{BlockHeight: 190},
+			},
+		},
+	}
+	mockDB.EXPECT().FindNode(expectedFilter).Return(node, nil).Once()
+	mockDB.EXPECT().SeedCheckpoint(ethChain, models.CheckpointRunnerMonitor, uint64(100)).Return(nil).Once()
+	mockDB.EXPECT().SeedCheckpoint(ethChain, models.CheckpointRunnerRelayer, uint64(90)).Return(nil).Once()
+	mockDB.EXPECT().SeedCheckpoint(cosmosChain, models.CheckpointRunnerMonitor, uint64(200)).Return(assert.AnError).Once()
 
-	health, err := healthCheck.GetLastHealth()
-	assert.NoError(t, err)
-	assert.NotNil(t, health)
+	healthCheck.SeedCheckpoints()
+	mockDB.AssertExpectations(t)
+}
+
+func Test_HealthCheckRunnable_SeedCheckpoints_NoHealth(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	healthCheck := &healthCheckRunnable{
+		db:     mockDB,
+		logger: log.NewEntry(log.New()),
+	}
+
+	mockDB.EXPECT().FindNode(mock.Anything).Return(&models.Node{}, mongo.ErrNoDocuments).Once()
+
+	healthCheck.SeedCheckpoints()
 	mockDB.AssertExpectations(t)
 }
 
@@ -119,10 +152,68 @@ func Test_HealthCheckRunnable_PostHealth(t *testing.T) {
 
 	expectedOnUpdate := bson.M{
 		"healthy":         true,
+		"anomalies":       0,
+		"service_healths": nil,
+		"updated_at":      nil,
+	}
+
+	mockDB.EXPECT().GetUnresolvedAnomalies().Return([]models.Anomaly{}, nil).Once()
+
+	mockDB.EXPECT().UpsertNode(expectedFilter, mock.Anything, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
+		onUpdate := args.Get(1).(bson.M)
+		onInsert := args.Get(2).(bson.M)
+		assert.NotNil(t, onUpdate)
+		assert.NotNil(t, onInsert)
+
+		onInsert["created_at"] = nil
+		onUpdate["service_healths"] = nil
+		onUpdate["updated_at"] = nil
+
+		assert.Equal(t, expectedOnUpdate, onUpdate)
+		assert.Equal(t, expectedOnInsert, onInsert)
+	})
+
+	success := healthCheck.PostHealth()
+	assert.True(t, success)
+	mockDB.AssertExpectations(t)
+}
+
+func Test_HealthCheckRunnable_PostHealth_Anomalies(t *testing.T) {
+	mockDB := mocks.NewMockDB(t)
+	healthCheck := &healthCheckRunnable{
+		cosmosAddress: "cosmosAddress",
+		ethAddress:    "ethAddress",
+		hostname:      "hostname",
+		oracleID:      "oracleID",
+		logger:        log.NewEntry(log.New()),
+		db:            mockDB,
+	}
+
+	expectedFilter := bson.M{
+		"cosmos_address": "cosmosAddress",
+		"eth_address":    "ethAddress",
+		"hostname":       "hostname",
+		"oracle_id":      "oracleID",
+	}
+
+	expectedOnInsert := bson.M{
+		"cosmos_address": "cosmosAddress",
+		"eth_address":    "ethAddress",
+		"hostname":       "hostname",
+		"oracle_id":      "oracleID",
+		"created_at":     nil,
+	}
+
+	expectedOnUpdate := bson.M{
+		"healthy":         false,
+		"anomalies":       2,
 		"service_healths": nil,
 		"updated_at":      nil,
 	}
 
+	// unresolved anomalies mark the node unhealthy
+	mockDB.EXPECT().GetUnresolvedAnomalies().Return([]models.Anomaly{{}, {}}, nil).Once()
+
 	mockDB.EXPECT().UpsertNode(expectedFilter, mock.Anything, mock.Anything).Return(nil).Once().Run(func(args mock.Arguments) {
 		onUpdate := args.Get(1).(bson.M)
 		onInsert := args.Get(2).(bson.M)
@@ -174,6 +265,9 @@ func Test_HealthCheckRunnable_PostHealth_Error(t *testing.T) {
 		"updated_at":      nil,
 	}
 
+	// the health is still posted without the anomalies
+	mockDB.EXPECT().GetUnresolvedAnomalies().Return(nil, errors.New("error")).Once()
+
 	mockDB.EXPECT().UpsertNode(expectedFilter, mock.Anything, mock.Anything).Return(errors.New("error")).Once().Run(func(args mock.Arguments) {
 		onUpdate := args.Get(1).(bson.M)
 		onInsert := args.Get(2).(bson.M)
@@ -21,7 +21,7 @@ type healthService struct {
 
 type HealthService interface {
 	Start(services []service.ChainService)
-	GetLastHealth() (*models.Node, error)
+	SeedCheckpoints()
 	Stop()
 }
 
@@ -48,8 +48,9 @@ func (x *healthService) Start(
 	}
 }
 
-func (x *healthService) GetLastHealth() (*models.Node, error) {
-	return x.runnable.GetLastHealth()
+// SeedCheckpoints must be called before the chain services are created, since their runners read the checkpoints on creation
+func (x *healthService) SeedCheckpoints() {
+	x.runnable.SeedCheckpoints()
 }
 
 func (x *healthService) Stop() {
@@ -13,9 +13,9 @@ import (
 )
 
 type mockHealthCheckRunnable struct {
-	addServicesFunc   func(services []service.ChainService)
-	runFunc           func()
-	getLastHealthFunc func() (*models.Node, error)
+	addServicesFunc     func(services []service.ChainService)
+	runFunc             func()
+	seedCheckpointsFunc func()
 }
 
 func (m *mockHealthCheckRunnable) AddServices(services []service.ChainService) {
@@ -30,11 +30,10 @@ func (m *mockHealthCheckRunnable) Run() {
 	}
 }
 
-func (m *mockHealthCheckRunnable) GetLastHealth() (*models.Node, error) {
-	if m.getLastHealthFunc != nil {
-		return m.getLastHealthFunc()
+func (m *mockHealthCheckRunnable) SeedCheckpoints() {
+	if m.seedCheckpointsFunc != nil {
+		m.seedCheckpointsFunc()
 	}
-	return nil, nil
 }
 
 func TestHealthService_Start(t *testing.T) {
@@ -63,10 +62,11 @@ func TestHealthService_Start(t *testing.T) {
 	wg.Wait()
 }
 
-func TestHealthService_GetLastHealth(t *testing.T) {
+func TestHealthService_SeedCheckpoints(t *testing.T) {
+	seeded := false
 	mockRunnable := &mockHealthCheckRunnable{
-		getLastHealthFunc: func() (*models.Node, error) {
-			return &models.Node{}, nil
+		seedCheckpointsFunc: func() {
+			seeded = true
 		},
 	}
 
@@ -75,9 +75,8 @@ func TestHealthService_GetLastHealth(t *testing.T) {
 		logger:   log.NewEntry(log.New()),
 	}
 
-	node, err := healthService.GetLastHealth()
-	assert.NoError(t, err)
-	assert.NotNil(t, node)
+	healthService.SeedCheckpoints()
+	assert.True(t, seeded)
 }
 
 func TestHealthService_Stop(t *testing.T) {
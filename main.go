@@ -6,8 +6,6 @@ import (
 	"sync"
 	"syscall"
 
-	log "github.com/sirupsen/logrus"
-
 	"github.com/dan13ram/wpokt-oracle/common"
 	cfg "github.com/dan13ram/wpokt-oracle/config"
 	"github.com/dan13ram/wpokt-oracle/cosmos"
@@ -55,6 +53,10 @@ func NewMintControllerMap(config models.Config) map[uint32][]byte {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == rescanCommand {
+		os.Exit(runRescan(os.Args[2:]))
+	}
+
 	absYamlPath, absEnvPath := parseFlags()
 
 	config := cfg.InitConfig(absYamlPath, absEnvPath)
@@ -64,34 +66,29 @@ func main() {
 	db.InitDB(config.MongoDB)
 	defer db.DisconnectDB()
 
+	oracleID, err := common.OracleIDFromMnemonic(config.Mnemonic, config.CosmosNetwork.MultisigPublicKeys)
+	if err != nil {
+		logger.WithError(err).Fatal("Error finding oracle id")
+	}
+	db.SetOracleID(oracleID)
+
 	logger.Debug("Starting server")
 
 	services := []service.ChainService{}
 	var wg sync.WaitGroup
 
 	healthService := health.NewHealthService(config, &wg)
-
-	var nodeHealth *models.Node
-	var err error
-
-	if config.HealthCheck.ReadLastHealth {
-		nodeHealth, err = healthService.GetLastHealth()
-		if err != nil {
-			logger.
-				WithFields(log.Fields{"error": err}).
-				Warn("Could not get last health")
-		}
-	}
+	healthService.SeedCheckpoints()
 
 	cosmosNetwork := config.CosmosNetwork
 	mintControllerMap := NewMintControllerMap(config)
 
 	for _, ethNetwork := range config.EthereumNetworks {
-		chainService := ethereum.NewEthereumChainService(ethNetwork, cosmosNetwork, mintControllerMap, config.EthereumNetworks, config.Mnemonic, &wg, nodeHealth)
+		chainService := ethereum.NewEthereumChainService(ethNetwork, cosmosNetwork, mintControllerMap, config.EthereumNetworks, config.Mnemonic, &wg)
 		services = append(services, chainService)
 	}
 
-	cosmosService := cosmos.NewCosmosChainService(cosmosNetwork, mintControllerMap, config.Mnemonic, config.EthereumNetworks, &wg, nodeHealth)
+	cosmosService := cosmos.NewCosmosChainService(cosmosNetwork, mintControllerMap, config.Mnemonic, config.EthereumNetworks, &wg)
 	services = append(services, cosmosService)
 
 	wg.Add(len(services) + 1)
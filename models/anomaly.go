@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AnomalyType string
+
+const (
+	// AnomalyTypeUnknownOrder is a fulfillment of an order that matches no message in the database
+	AnomalyTypeUnknownOrder AnomalyType = "unknown_order"
+	// AnomalyTypeUnsignedOrder is a fulfillment of a message that never reached the signed status
+	AnomalyTypeUnsignedOrder AnomalyType = "unsigned_order"
+	// AnomalyTypeUnauthorizedMint is a mint of the omni token that matches no fulfillment of a signed message
+	AnomalyTypeUnauthorizedMint AnomalyType = "unauthorized_mint"
+	// AnomalyTypeUnauthorizedSpend is a spend from the multisig that matches no message or refund signed with its sequence
+	AnomalyTypeUnauthorizedSpend AnomalyType = "unauthorized_spend"
+)
+
+// Anomaly is an on-chain event that the oracles did not expect, kept for an operator to investigate.
+// Operators set resolved once it has been looked into
+type Anomaly struct {
+	ID              *primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Chain           Chain               `json:"chain" bson:"chain"`
+	Type            AnomalyType         `json:"type" bson:"type"`
+	Contract        string              `json:"contract" bson:"contract"`
+	TransactionHash string              `json:"transaction_hash" bson:"transaction_hash"`
+	BlockHeight     uint64              `json:"block_height" bson:"block_height"`
+	LogIndex        uint64              `json:"log_index" bson:"log_index"`
+	OrderID         string              `json:"order_id" bson:"order_id"`
+	EventMessage    string              `json:"event_message" bson:"event_message"`   // hex of the message bytes emitted by the event
+	Content         *MessageContent     `json:"content" bson:"content"`               // nil if the message bytes could not be decoded
+	Message         *primitive.ObjectID `json:"message" bson:"message"`               // the message in the database, if any
+	MessageStatus   MessageStatus       `json:"message_status" bson:"message_status"` // status of the message when the event was seen
+	Recipient       string              `json:"recipient" bson:"recipient"`           // receiver of the transferred tokens, if any
+	Amount          string              `json:"amount" bson:"amount"`
+	Sequence        *uint64             `json:"sequence" bson:"sequence"` // account sequence the multisig signed the transaction with, if any
+	Resolved        bool                `json:"resolved" bson:"resolved"`
+	CreatedAt       time.Time           `json:"created_at" bson:"created_at"`
+}
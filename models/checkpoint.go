@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	CheckpointRunnerMonitor = "monitor"
+	CheckpointRunnerRelayer = "relayer"
+	// CheckpointRunnerMintAuditor is the last block whose mints were matched to fulfillments
+	CheckpointRunnerMintAuditor = "mint_auditor"
+	// CheckpointRunnerSpendAuditor is the last block whose spends from the multisig were matched to messages and refunds
+	CheckpointRunnerSpendAuditor = "spend_auditor"
+	// CheckpointRunnerSecurityWatch is the last block whose contract admin events were recorded
+	CheckpointRunnerSecurityWatch = "security_watch"
+)
+
+// Checkpoint is the persisted sync cursor of a runner on a chain
+type Checkpoint struct {
+	ID          *primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Chain       Chain               `json:"chain" bson:"chain"`
+	Runner      string              `json:"runner" bson:"runner"`
+	BlockHeight uint64              `json:"block_height" bson:"block_height"`
+	CreatedAt   time.Time           `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at" bson:"updated_at"`
+}
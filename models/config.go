@@ -1,5 +1,7 @@
 package models
 
+import "fmt"
+
 type Config struct {
 	Mnemonic         string                  `yaml:"mnemonic" json:"mnemonic"`
 	HealthCheck      HealthCheckConfig       `yaml:"health_check" json:"health_check"`
@@ -10,8 +12,7 @@ type Config struct {
 }
 
 type HealthCheckConfig struct {
-	IntervalMS     uint64 `yaml:"interval_ms" json:"interval_ms"`
-	ReadLastHealth bool   `yaml:"read_last_health" json:"read_last_health"`
+	IntervalMS uint64 `yaml:"interval_ms" json:"interval_ms"`
 }
 
 type LoggerConfig struct {
@@ -25,45 +26,119 @@ type MongoConfig struct {
 	TimeoutMS uint64 `yaml:"timeout_ms" json:"timeout_ms"`
 }
 
+const (
+	FinalityTagSafe      = "safe"
+	FinalityTagFinalized = "finalized"
+)
+
 type EthereumNetworkConfig struct {
-	StartBlockHeight      uint64        `yaml:"start_block_height" json:"start_block_height"`
-	Confirmations         uint64        `yaml:"confirmations" json:"confirmations"`
-	RPCURL                string        `yaml:"rpc_url" json:"rpcurl"`
-	TimeoutMS             uint64        `yaml:"timeout_ms" json:"timeout_ms"`
-	ChainID               uint64        `yaml:"chain_id" json:"chain_id"`
-	ChainName             string        `yaml:"chain_name" json:"chain_name"`
-	MailboxAddress        string        `yaml:"mailbox_address" json:"mailbox_address"`
-	MintControllerAddress string        `yaml:"mint_controller_address" json:"mint_controller_address"`
-	OmniTokenAddress      string        `yaml:"omni_token_address" json:"omni_token_address"`
-	WarpISMAddress        string        `yaml:"warp_ism_address" json:"warp_ism_address"`
-	OracleAddresses       []string      `yaml:"oracle_addresses" json:"oracle_addresses"`
-	MessageMonitor        ServiceConfig `yaml:"message_monitor" json:"message_monitor"`
-	MessageSigner         ServiceConfig `yaml:"message_signer" json:"message_signer"`
-	MessageRelayer        ServiceConfig `yaml:"message_relayer" json:"message_relayer"`
+	StartBlockHeight      uint64          `yaml:"start_block_height" json:"start_block_height"`
+	Confirmations         uint64          `yaml:"confirmations" json:"confirmations"`
+	FinalityTag           string          `yaml:"finality_tag" json:"finality_tag"` // empty, safe or finalized
+	RPCURL                string          `yaml:"rpc_url" json:"rpcurl"`
+	RPCURLs               []string        `yaml:"rpc_urls" json:"rpc_urls"`     // additional endpoints used for failover
+	RPCQuorum             uint64          `yaml:"rpc_quorum" json:"rpc_quorum"` // endpoints that must agree, 0 or 1 disables quorum
+	TimeoutMS             uint64          `yaml:"timeout_ms" json:"timeout_ms"`
+	MaxQueryBlocks        uint64          `yaml:"max_query_blocks" json:"max_query_blocks"` // initial getLogs range, 0 uses the default
+	ChainID               uint64          `yaml:"chain_id" json:"chain_id"`
+	ChainName             string          `yaml:"chain_name" json:"chain_name"`
+	MailboxAddress        string          `yaml:"mailbox_address" json:"mailbox_address"`
+	MintControllerAddress string          `yaml:"mint_controller_address" json:"mint_controller_address"`
+	OmniTokenAddress      string          `yaml:"omni_token_address" json:"omni_token_address"`
+	WarpISMAddress        string          `yaml:"warp_ism_address" json:"warp_ism_address"`
+	OracleAddresses       []string        `yaml:"oracle_addresses" json:"oracle_addresses"`
+	MessageMonitor        ServiceConfig   `yaml:"message_monitor" json:"message_monitor"`
+	MessageSigner         ServiceConfig   `yaml:"message_signer" json:"message_signer"`
+	MessageRelayer        ServiceConfig   `yaml:"message_relayer" json:"message_relayer"`
+	MessageAuditor        ServiceConfig   `yaml:"message_auditor" json:"message_auditor"`     // mailbox nonce audit, requires the monitor
+	MessageFulfiller      FulfillerConfig `yaml:"message_fulfiller" json:"message_fulfiller"` // submits fulfillOrder for signed messages, requires the relayer
+	MintAuditor           ServiceConfig   `yaml:"mint_auditor" json:"mint_auditor"`           // matches every omni token mint to a fulfillment of a signed message
+	SecurityWatch         ServiceConfig   `yaml:"security_watch" json:"security_watch"`       // records admin and configuration changes of the bridge contracts
+}
+
+// RPCEndpoints returns RPCURL followed by RPCURLs, skipping empty and duplicate urls
+func (config EthereumNetworkConfig) RPCEndpoints() []string {
+	return uniqueURLs(append([]string{config.RPCURL}, config.RPCURLs...))
 }
 
 type CosmosNetworkConfig struct {
-	StartBlockHeight   uint64        `yaml:"start_block_height" json:"start_block_height"`
-	Confirmations      uint64        `yaml:"confirmations" json:"confirmations"`
-	RPCURL             string        `yaml:"rpc_url" json:"rpcurl"`
-	GRPCEnabled        bool          `yaml:"grpc_enabled" json:"grpc_enabled"`
-	GRPCHost           string        `yaml:"grpc_host" json:"grpc_host"`
-	GRPCPort           uint64        `yaml:"grpc_port" json:"grpc_port"`
-	TimeoutMS          uint64        `yaml:"timeout_ms" json:"time_out_ms"`
-	ChainID            string        `yaml:"chain_id" json:"chain_id"`
-	ChainName          string        `yaml:"chain_name" json:"chain_name"`
-	TxFee              uint64        `yaml:"tx_fee" json:"tx_fee"`
-	Bech32Prefix       string        `yaml:"bech32_prefix" json:"bech32_prefix"`
-	CoinDenom          string        `yaml:"coin_denom" json:"coin_denom"`
-	MultisigAddress    string        `yaml:"multisig_address" json:"multisig_address"`
-	MultisigPublicKeys []string      `yaml:"multisig_public_keys" json:"multisig_public_keys"`
-	MultisigThreshold  uint64        `yaml:"multisig_threshold" json:"multisig_threshold"`
-	MessageMonitor     ServiceConfig `yaml:"message_monitor" json:"message_monitor"`
-	MessageSigner      ServiceConfig `yaml:"message_signer" json:"message_signer"`
-	MessageRelayer     ServiceConfig `yaml:"message_relayer" json:"message_relayer"`
+	StartBlockHeight   uint64           `yaml:"start_block_height" json:"start_block_height"`
+	Confirmations      uint64           `yaml:"confirmations" json:"confirmations"`
+	RPCURL             string           `yaml:"rpc_url" json:"rpcurl"`
+	GRPCEnabled        bool             `yaml:"grpc_enabled" json:"grpc_enabled"`
+	GRPCHost           string           `yaml:"grpc_host" json:"grpc_host"`
+	GRPCPort           uint64           `yaml:"grpc_port" json:"grpc_port"`
+	RPCURLs            []string         `yaml:"rpc_urls" json:"rpc_urls"`                 // additional rpc endpoints used for failover
+	GRPCURLs           []string         `yaml:"grpc_urls" json:"grpc_urls"`               // additional host:port grpc endpoints used for failover
+	VerifyResponses    bool             `yaml:"verify_responses" json:"verify_responses"` // cross-check txs, accounts, balances and heights against a second endpoint
+	TimeoutMS          uint64           `yaml:"timeout_ms" json:"time_out_ms"`
+	ChainID            string           `yaml:"chain_id" json:"chain_id"`
+	ChainName          string           `yaml:"chain_name" json:"chain_name"`
+	TxFee              uint64           `yaml:"tx_fee" json:"tx_fee"`
+	Bech32Prefix       string           `yaml:"bech32_prefix" json:"bech32_prefix"`
+	CoinDenom          string           `yaml:"coin_denom" json:"coin_denom"`
+	MultisigAddress    string           `yaml:"multisig_address" json:"multisig_address"`
+	MultisigPublicKeys []string         `yaml:"multisig_public_keys" json:"multisig_public_keys"`
+	MultisigThreshold  uint64           `yaml:"multisig_threshold" json:"multisig_threshold"`
+	MessageMonitor     ServiceConfig    `yaml:"message_monitor" json:"message_monitor"`
+	MessageSigner      ServiceConfig    `yaml:"message_signer" json:"message_signer"`
+	MessageRelayer     ServiceConfig    `yaml:"message_relayer" json:"message_relayer"`
+	SupplyReconciler   ReconcilerConfig `yaml:"supply_reconciler" json:"supply_reconciler"` // compares the multisig balance with the wPOKT supply
+	SpendAuditor       ServiceConfig    `yaml:"spend_auditor" json:"spend_auditor"`         // matches every spend from the multisig to a message or refund
+}
+
+// RPCEndpoints returns RPCURL followed by RPCURLs, skipping empty and duplicate urls
+func (config CosmosNetworkConfig) RPCEndpoints() []string {
+	return uniqueURLs(append([]string{config.RPCURL}, config.RPCURLs...))
+}
+
+// GRPCEndpoints returns GRPCHost:GRPCPort followed by GRPCURLs, skipping empty and duplicate urls.
+// GRPCHost:GRPCPort is skipped when GRPCHost is empty
+func (config CosmosNetworkConfig) GRPCEndpoints() []string {
+	urls := []string{}
+	if config.GRPCHost != "" {
+		urls = append(urls, fmt.Sprintf("%s:%d", config.GRPCHost, config.GRPCPort))
+	}
+	return uniqueURLs(append(urls, config.GRPCURLs...))
+}
+
+// Endpoints returns the grpc endpoints when grpc is enabled and the rpc endpoints otherwise
+func (config CosmosNetworkConfig) Endpoints() []string {
+	if config.GRPCEnabled {
+		return config.GRPCEndpoints()
+	}
+	return config.RPCEndpoints()
+}
+
+func uniqueURLs(urls []string) []string {
+	seen := make(map[string]bool)
+	unique := []string{}
+	for _, url := range urls {
+		if url == "" || seen[url] {
+			continue
+		}
+		seen[url] = true
+		unique = append(unique, url)
+	}
+	return unique
 }
 
 type ServiceConfig struct {
 	Enabled    bool   `yaml:"enabled" json:"enabled"`
 	IntervalMS uint64 `yaml:"interval_ms" json:"interval_ms"`
 }
+
+type FulfillerConfig struct {
+	Enabled             bool   `yaml:"enabled" json:"enabled"`
+	IntervalMS          uint64 `yaml:"interval_ms" json:"interval_ms"`
+	PrivateKey          string `yaml:"private_key" json:"private_key"`                       // hex key of the funded account sending the transactions
+	DailySpendingCapWei string `yaml:"daily_spending_cap_wei" json:"daily_spending_cap_wei"` // max gas fees over the last 24 hours
+	StuckTimeoutMS      uint64 `yaml:"stuck_timeout_ms" json:"stuck_timeout_ms"`             // time before an unmined transaction is replaced
+	GasBumpPercent      uint64 `yaml:"gas_bump_percent" json:"gas_bump_percent"`             // fee increase of a replacement, at least 10
+}
+
+type ReconcilerConfig struct {
+	Enabled    bool   `yaml:"enabled" json:"enabled"`
+	IntervalMS uint64 `yaml:"interval_ms" json:"interval_ms"`
+	Tolerance  string `yaml:"tolerance" json:"tolerance"` // max discrepancy in the smallest coin unit
+}
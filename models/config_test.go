@@ -0,0 +1,42 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEthereumNetworkConfig_RPCEndpoints(t *testing.T) {
+	config := EthereumNetworkConfig{}
+	assert.Equal(t, []string{}, config.RPCEndpoints())
+
+	config.RPCURL = "http://localhost:8545"
+	assert.Equal(t, []string{"http://localhost:8545"}, config.RPCEndpoints())
+
+	config.RPCURLs = []string{"http://localhost:8546", "", "http://localhost:8545", "http://localhost:8547"}
+	assert.Equal(t, []string{"http://localhost:8545", "http://localhost:8546", "http://localhost:8547"}, config.RPCEndpoints())
+
+	config.RPCURL = ""
+	assert.Equal(t, []string{"http://localhost:8546", "http://localhost:8545", "http://localhost:8547"}, config.RPCEndpoints())
+}
+
+func TestCosmosNetworkConfig_Endpoints(t *testing.T) {
+	config := CosmosNetworkConfig{
+		RPCURL:      "http://localhost:26657",
+		RPCURLs:     []string{"http://localhost:26658", "http://localhost:26657"},
+		GRPCHost:    "localhost",
+		GRPCPort:    9090,
+		GRPCURLs:    []string{"localhost:9091", ""},
+		GRPCEnabled: false,
+	}
+
+	assert.Equal(t, []string{"http://localhost:26657", "http://localhost:26658"}, config.RPCEndpoints())
+	assert.Equal(t, []string{"localhost:9090", "localhost:9091"}, config.GRPCEndpoints())
+	assert.Equal(t, config.RPCEndpoints(), config.Endpoints())
+
+	config.GRPCEnabled = true
+	assert.Equal(t, config.GRPCEndpoints(), config.Endpoints())
+
+	config.GRPCHost = ""
+	assert.Equal(t, []string{"localhost:9091"}, config.GRPCEndpoints())
+}
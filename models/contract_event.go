@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ContractEvent is an admin or configuration change of a contract the bridge depends on,
+// such as a role being granted or the default ism of the mailbox being replaced
+type ContractEvent struct {
+	ID              *primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Chain           Chain               `json:"chain" bson:"chain"`
+	Contract        string              `json:"contract" bson:"contract"`           // address of the contract that emitted the event
+	ContractName    string              `json:"contract_name" bson:"contract_name"` // mailbox, mint_controller, omni_token or warp_ism
+	Event           string              `json:"event" bson:"event"`
+	Args            map[string]string   `json:"args" bson:"args"` // decoded event arguments, addresses and hashes in hex
+	TransactionHash string              `json:"transaction_hash" bson:"transaction_hash"`
+	BlockHeight     uint64              `json:"block_height" bson:"block_height"`
+	LogIndex        uint64              `json:"log_index" bson:"log_index"`
+	CreatedAt       time.Time           `json:"created_at" bson:"created_at"`
+}
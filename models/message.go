@@ -128,11 +128,14 @@ func (content *MessageContent) DecodeFromBytes(data []byte) error {
 type MessageStatus string
 
 const (
-	MessageStatusPending     MessageStatus = "pending"
-	MessageStatusSigned      MessageStatus = "signed"
-	MessageStatusBroadcasted MessageStatus = "broadcasted"
-	MessageStatusSuccess     MessageStatus = "success"
-	MessageStatusInvalid     MessageStatus = "invalid"
+	MessageStatusPending           MessageStatus = "pending"
+	MessageStatusSigned            MessageStatus = "signed"
+	MessageStatusBroadcasted       MessageStatus = "broadcasted"
+	MessageStatusSuccess           MessageStatus = "success"
+	MessageStatusInvalid           MessageStatus = "invalid"
+	MessageStatusReorged           MessageStatus = "reorged"
+	MessageStatusOverLimit         MessageStatus = "over_limit"
+	MessageStatusDestinationPaused MessageStatus = "destination_paused"
 )
 
 type Message struct {
@@ -147,10 +150,23 @@ type Message struct {
 	Transaction           *primitive.ObjectID `json:"transaction" bson:"transaction"`
 	TransactionHash       string              `json:"transaction_hash" bson:"transaction_hash"`
 	Status                MessageStatus       `json:"status" bson:"status"`
+	StatusCode            StatusCode          `json:"status_code" bson:"status_code"`           // cause of the status, set when it is invalid
+	StatusReason          string              `json:"status_reason" bson:"status_reason"`       // readable detail of the status code
+	StatusOracleID        string              `json:"status_oracle_id" bson:"status_oracle_id"` // oracle that set the status
+	FulfillableAt         *time.Time          `json:"fulfillable_at" bson:"fulfillable_at"`     // earliest time the mint limit allows fulfilling the message
+	SimulationError       string              `json:"simulation_error" bson:"simulation_error"` // revert reason of the last failed verification of the signatures
+	Fulfillments          []Fulfillment       `json:"fulfillments" bson:"fulfillments"`         // fulfillOrder transactions submitted by the oracles, latest last
 	CreatedAt             time.Time           `bson:"created_at" json:"created_at"`
 	UpdatedAt             time.Time           `bson:"updated_at" json:"updated_at"`
 }
 
+// DispatchedNonce is the mailbox nonce of a message along with the block of its origin transaction
+type DispatchedNonce struct {
+	Nonce       uint32 `json:"nonce" bson:"nonce"`
+	MessageID   string `json:"message_id" bson:"message_id"`
+	BlockHeight uint64 `json:"block_height" bson:"block_height"`
+}
+
 type MessageBody struct {
 	SenderAddress    string `json:"sender_address" bson:"sender_address"`
 	Amount           string `json:"amount" bson:"amount"`
@@ -223,6 +239,20 @@ func (body *MessageBody) DecodeFromBytes(data []byte) error {
 	return nil
 }
 
+// Fulfillment is a fulfillOrder transaction submitted by the fulfiller of an oracle,
+// along with the transactions that replaced it at the same nonce
+type Fulfillment struct {
+	Submitter   string    `json:"submitter" bson:"submitter"`
+	Nonce       uint64    `json:"nonce" bson:"nonce"`
+	TxHashes    []string  `json:"tx_hashes" bson:"tx_hashes"` // latest last
+	GasLimit    uint64    `json:"gas_limit" bson:"gas_limit"`
+	GasFeeCap   string    `json:"gas_fee_cap" bson:"gas_fee_cap"`
+	GasTipCap   string    `json:"gas_tip_cap" bson:"gas_tip_cap"`
+	FeePaid     string    `json:"fee_paid" bson:"fee_paid"` // set once one of the transactions is mined
+	SubmittedAt time.Time `json:"submitted_at" bson:"submitted_at"`
+	SentAt      time.Time `json:"sent_at" bson:"sent_at"` // when the latest transaction was sent
+}
+
 type Signature struct {
 	Signer    string `json:"signer" bson:"signer"`
 	Signature string `json:"signature" bson:"signature"`
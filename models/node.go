@@ -14,6 +14,8 @@ type Node struct {
 	OracleID        string               `bson:"oracle_id" json:"oracle_id"`
 	SupportedChains []Chain              `bson:"supported_chains" json:"supported_chains"`
 	Health          []ChainServiceHealth `bson:"service_healths" json:"service_healths"`
+	Healthy         bool                 `bson:"healthy" json:"healthy"`
+	Anomalies       int                  `bson:"anomalies" json:"anomalies"` // unresolved anomalies in the database
 	CreatedAt       time.Time            `bson:"created_at" json:"created_at"`
 	UpdatedAt       time.Time            `bson:"updated_at" json:"updated_at"`
 }
@@ -33,10 +35,16 @@ type Chain struct {
 }
 
 type ChainServiceHealth struct {
-	Chain          Chain                `bson:"chain" json:"chain"`
-	MessageMonitor *RunnerServiceStatus `bson:"message_monitor" json:"message_monitor"`
-	MessageSigner  *RunnerServiceStatus `bson:"message_signer" json:"message_signer"`
-	MessageRelayer *RunnerServiceStatus `bson:"message_relayer" json:"message_relayer"`
+	Chain            Chain                `bson:"chain" json:"chain"`
+	MessageMonitor   *RunnerServiceStatus `bson:"message_monitor" json:"message_monitor"`
+	MessageSigner    *RunnerServiceStatus `bson:"message_signer" json:"message_signer"`
+	MessageRelayer   *RunnerServiceStatus `bson:"message_relayer" json:"message_relayer"`
+	MessageAuditor   *RunnerServiceStatus `bson:"message_auditor" json:"message_auditor"`
+	MessageFulfiller *RunnerServiceStatus `bson:"message_fulfiller" json:"message_fulfiller"`
+	MintAuditor      *RunnerServiceStatus `bson:"mint_auditor" json:"mint_auditor"`
+	SupplyReconciler *RunnerServiceStatus `bson:"supply_reconciler" json:"supply_reconciler"`
+	SpendAuditor     *RunnerServiceStatus `bson:"spend_auditor" json:"spend_auditor"`
+	SecurityWatch    *RunnerServiceStatus `bson:"security_watch" json:"security_watch"`
 }
 
 type RunnerServiceStatus struct {
@@ -28,6 +28,9 @@ type Refund struct {
 	Transaction           *primitive.ObjectID `json:"transaction" bson:"transaction"`
 	TransactionHash       string              `json:"transaction_hash" bson:"transaction_hash"`
 	Status                RefundStatus        `json:"status" bson:"status"`
+	StatusCode            StatusCode          `json:"status_code" bson:"status_code"`           // cause of the status, set when it is invalid
+	StatusReason          string              `json:"status_reason" bson:"status_reason"`       // readable detail of the status code
+	StatusOracleID        string              `json:"status_oracle_id" bson:"status_oracle_id"` // oracle that set the status
 	CreatedAt             time.Time           `bson:"created_at" json:"created_at"`
 	UpdatedAt             time.Time           `bson:"updated_at" json:"updated_at"`
 }
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// StatusCode is the machine readable cause of a status, stored in status_code
+// next to a readable status_reason and the id of the oracle that decided it
+type StatusCode string
+
+const (
+	StatusCodeTxFailed           StatusCode = "tx_failed"            // the tx reverted or returned a non-zero code
+	StatusCodeInvalidTx          StatusCode = "invalid_tx"           // the tx or its events could not be parsed
+	StatusCodeZeroAmount         StatusCode = "zero_amount"          // no coins were transferred to the multisig
+	StatusCodeAmountTooLow       StatusCode = "amount_too_low"       // the amount does not cover the tx fee
+	StatusCodeSpenderMismatch    StatusCode = "spender_mismatch"     // the coins were not spent by the tx sender
+	StatusCodeCoinsMismatch      StatusCode = "coins_mismatch"       // the coins spent and received differ, so the tx is refunded
+	StatusCodeInvalidMemo        StatusCode = "invalid_memo"         // the memo is not a valid mint memo, so the tx is refunded
+	StatusCodeNoDispatchEvent    StatusCode = "no_dispatch_event"    // the tx emitted no dispatch event for the message
+	StatusCodeNoFulfillmentEvent StatusCode = "no_fulfillment_event" // the tx emitted no fulfillment event
+	StatusCodeNeedsRefund        StatusCode = "needs_refund"         // the tx is refunded instead of bridged
+	StatusCodeRefundNotNeeded    StatusCode = "refund_not_needed"    // the tx is bridged instead of refunded
+	StatusCodeAmountMismatch     StatusCode = "amount_mismatch"      // the amount does not match the tx
+	StatusCodeSenderMismatch     StatusCode = "sender_mismatch"      // the sender does not match the tx
+	StatusCodeRecipientMismatch  StatusCode = "recipient_mismatch"   // the recipient does not match the tx
+	StatusCodeInvalidAmount      StatusCode = "invalid_amount"       // the amount could not be parsed
+	StatusCodeRefundMismatch     StatusCode = "refund_mismatch"      // the signed refund tx does not match the refund
+)
+
+// ValidationError means the origin transaction provably does not back a message or refund,
+// so it can be marked invalid. Any other error while validating is transient and retried.
+type ValidationError struct {
+	Code   StatusCode
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Reason
+}
+
+func NewValidationError(code StatusCode, format string, args ...interface{}) error {
+	return &ValidationError{Code: code, Reason: fmt.Sprintf(format, args...)}
+}
+
+// AsValidationError returns the ValidationError that err is or wraps, if any
+func AsValidationError(err error) (*ValidationError, bool) {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return validationErr, true
+	}
+	return nil, false
+}
+
+// IsValidationError reports whether err, or an error it wraps, is a ValidationError
+func IsValidationError(err error) bool {
+	_, ok := AsValidationError(err)
+	return ok
+}
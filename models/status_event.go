@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type StatusEventDocument string
+
+const (
+	StatusEventDocumentTransaction StatusEventDocument = "transaction"
+	StatusEventDocumentMessage     StatusEventDocument = "message"
+	StatusEventDocumentRefund      StatusEventDocument = "refund"
+)
+
+// StatusEvent is a change of status of a transaction, message or refund.
+// Events are only ever inserted, so they keep the full history of each document
+type StatusEvent struct {
+	ID              *primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Document        StatusEventDocument `json:"document" bson:"document"`
+	DocumentID      primitive.ObjectID  `json:"document_id" bson:"document_id"`
+	OldStatus       string              `json:"old_status" bson:"old_status"`
+	NewStatus       string              `json:"new_status" bson:"new_status"`
+	StatusCode      StatusCode          `json:"status_code" bson:"status_code"`
+	StatusReason    string              `json:"status_reason" bson:"status_reason"`
+	OracleID        string              `json:"oracle_id" bson:"oracle_id"`
+	Runner          string              `json:"runner" bson:"runner"`                     // runner that made the change, such as signer or relayer
+	TransactionHash string              `json:"transaction_hash" bson:"transaction_hash"` // chain tx the change relates to
+	CreatedAt       time.Time           `json:"created_at" bson:"created_at"`
+}
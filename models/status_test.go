@@ -0,0 +1,27 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValidationError(t *testing.T) {
+	err := NewValidationError(StatusCodeAmountMismatch, "amount mismatch: %d", 100)
+
+	assert.Equal(t, "amount mismatch: 100", err.Error())
+	assert.True(t, IsValidationError(err))
+}
+
+func TestAsValidationError(t *testing.T) {
+	validationErr, ok := AsValidationError(fmt.Errorf("error validating tx: %w", NewValidationError(StatusCodeSenderMismatch, "sender mismatch")))
+	assert.True(t, ok)
+	assert.Equal(t, StatusCodeSenderMismatch, validationErr.Code)
+	assert.Equal(t, "sender mismatch", validationErr.Reason)
+
+	_, ok = AsValidationError(errors.New("timeout"))
+	assert.False(t, ok)
+	assert.False(t, IsValidationError(nil))
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TokenSupply is the total supply of the OmniToken on an ethereum network
+type TokenSupply struct {
+	Chain       Chain  `json:"chain" bson:"chain"`
+	TotalSupply string `json:"total_supply" bson:"total_supply"`
+}
+
+// SupplySnapshot compares the POKT locked in the cosmos multisig with the wPOKT it backs.
+// All amounts are in the smallest coin unit.
+// Locked is the multisig balance less the releases and refunds it still has to pay out,
+// Expected is the total supply of the OmniTokens plus the mints still to be fulfilled
+type SupplySnapshot struct {
+	ID               *primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Chain            Chain               `json:"chain" bson:"chain"`
+	MultisigAddress  string              `json:"multisig_address" bson:"multisig_address"`
+	BlockHeight      uint64              `json:"block_height" bson:"block_height"` // height of the cosmos network when the balance was read
+	MultisigBalance  string              `json:"multisig_balance" bson:"multisig_balance"`
+	TokenSupplies    []TokenSupply       `json:"token_supplies" bson:"token_supplies"`
+	TotalSupply      string              `json:"total_supply" bson:"total_supply"`
+	InFlightMints    string              `json:"in_flight_mints" bson:"in_flight_mints"`       // messages to ethereum networks not fulfilled yet
+	InFlightReleases string              `json:"in_flight_releases" bson:"in_flight_releases"` // messages to the cosmos network not paid out yet
+	InFlightRefunds  string              `json:"in_flight_refunds" bson:"in_flight_refunds"`
+	Locked           string              `json:"locked" bson:"locked"`
+	Expected         string              `json:"expected" bson:"expected"`
+	Discrepancy      string              `json:"discrepancy" bson:"discrepancy"` // locked minus expected
+	Tolerance        string              `json:"tolerance" bson:"tolerance"`
+	WithinTolerance  bool                `json:"within_tolerance" bson:"within_tolerance"`
+	CreatedAt        time.Time           `json:"created_at" bson:"created_at"`
+}
@@ -13,21 +13,26 @@ const (
 	TransactionStatusConfirmed TransactionStatus = "confirmed"
 	TransactionStatusFailed    TransactionStatus = "failed"
 	TransactionStatusInvalid   TransactionStatus = "invalid"
+	TransactionStatusReorged   TransactionStatus = "reorged"
 )
 
 type Transaction struct {
-	ID            *primitive.ObjectID  `json:"_id,omitempty" bson:"_id,omitempty"`
-	Hash          string               `json:"hash" bson:"hash"`
-	FromAddress   string               `json:"from_address" bson:"from_address"`
-	ToAddress     string               `json:"to_address" bson:"to_address"`
-	BlockHeight   uint64               `json:"block_height" bson:"block_height"`
-	Confirmations uint64               `json:"confirmations" bson:"confirmations"`
-	Chain         Chain                `bson:"chain" json:"chain"`
-	Status        TransactionStatus    `json:"status" bson:"status"`
-	CreatedAt     time.Time            `bson:"created_at" json:"created_at"`
-	UpdatedAt     time.Time            `bson:"updated_at" json:"updated_at"`
-	Refund        *primitive.ObjectID  `json:"refund" bson:"refund"`
-	Messages      []primitive.ObjectID `json:"messages" bson:"messages"`
+	ID             *primitive.ObjectID  `json:"_id,omitempty" bson:"_id,omitempty"`
+	Hash           string               `json:"hash" bson:"hash"`
+	FromAddress    string               `json:"from_address" bson:"from_address"`
+	ToAddress      string               `json:"to_address" bson:"to_address"`
+	BlockHeight    uint64               `json:"block_height" bson:"block_height"`
+	BlockHash      string               `json:"block_hash" bson:"block_hash"`
+	Confirmations  uint64               `json:"confirmations" bson:"confirmations"`
+	Chain          Chain                `bson:"chain" json:"chain"`
+	Status         TransactionStatus    `json:"status" bson:"status"`
+	StatusCode     StatusCode           `json:"status_code" bson:"status_code"`           // cause of the status, set when it is failed, invalid or refunded
+	StatusReason   string               `json:"status_reason" bson:"status_reason"`       // readable detail of the status code
+	StatusOracleID string               `json:"status_oracle_id" bson:"status_oracle_id"` // oracle that set the status
+	CreatedAt      time.Time            `bson:"created_at" json:"created_at"`
+	UpdatedAt      time.Time            `bson:"updated_at" json:"updated_at"`
+	Refund         *primitive.ObjectID  `json:"refund" bson:"refund"`
+	Messages       []primitive.ObjectID `json:"messages" bson:"messages"`
 }
 
 type MintMemo struct {
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	cfg "github.com/dan13ram/wpokt-oracle/config"
+	"github.com/dan13ram/wpokt-oracle/cosmos"
+	"github.com/dan13ram/wpokt-oracle/db"
+	"github.com/dan13ram/wpokt-oracle/ethereum"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+const rescanCommand = "rescan"
+
+var ethereumRescan = ethereum.Rescan
+var cosmosRescan = cosmos.Rescan
+
+type rescanOptions struct {
+	yamlPath         string
+	envPath          string
+	chainID          string
+	startBlockHeight uint64
+	endBlockHeight   uint64
+}
+
+func parseRescanFlags(args []string) (rescanOptions, error) {
+	var options rescanOptions
+
+	flags := flag.NewFlagSet(rescanCommand, flag.ContinueOnError)
+	flags.StringVar(&options.yamlPath, "yaml", "", "path to yaml file")
+	flags.StringVar(&options.envPath, "env", "", "path to env file")
+	flags.StringVar(&options.chainID, "chain", "", "chain id of the network to rescan")
+	flags.Uint64Var(&options.startBlockHeight, "from", 0, "first block height to rescan")
+	flags.Uint64Var(&options.endBlockHeight, "to", 0, "last block height to rescan, defaults to the latest block")
+
+	if err := flags.Parse(args); err != nil {
+		return options, err
+	}
+
+	if options.chainID == "" {
+		return options, fmt.Errorf("--chain is required")
+	}
+	if options.startBlockHeight == 0 {
+		return options, fmt.Errorf("--from is required")
+	}
+	if options.endBlockHeight != 0 && options.startBlockHeight > options.endBlockHeight {
+		return options, fmt.Errorf("--from must not be after --to")
+	}
+
+	options.yamlPath = absolutePath(options.yamlPath, "yaml")
+	options.envPath = absolutePath(options.envPath, "env")
+
+	return options, nil
+}
+
+// rescanChain rescans the ethereum network or the cosmos network with the given chain id
+func rescanChain(config models.Config, options rescanOptions) ([]*service.RescanResult, error) {
+	mintControllerMap := NewMintControllerMap(config)
+
+	for _, ethNetwork := range config.EthereumNetworks {
+		if strconv.FormatUint(ethNetwork.ChainID, 10) == options.chainID {
+			return ethereumRescan(ethNetwork, mintControllerMap, options.startBlockHeight, options.endBlockHeight)
+		}
+	}
+
+	if strings.EqualFold(config.CosmosNetwork.ChainID, options.chainID) {
+		return cosmosRescan(config.CosmosNetwork, mintControllerMap, config.EthereumNetworks, options.startBlockHeight, options.endBlockHeight)
+	}
+
+	return nil, fmt.Errorf("chain %s not found in config", options.chainID)
+}
+
+// printRescanSummary writes the transactions found by each runner and returns whether every runner succeeded
+func printRescanSummary(w io.Writer, results []*service.RescanResult) bool {
+	success := true
+	for _, result := range results {
+		fmt.Fprintf(w, "%s %s blocks %d to %d: %d newly discovered, %d already known\n",
+			result.Chain.ChainName,
+			result.Runner,
+			result.StartBlockHeight,
+			result.EndBlockHeight,
+			len(result.Discovered),
+			len(result.Known),
+		)
+		for _, txHash := range result.Discovered {
+			fmt.Fprintf(w, "  new: %s\n", txHash)
+		}
+		if !result.Success {
+			fmt.Fprintf(w, "  incomplete: some events could not be synced, see the logs\n")
+			success = false
+		}
+	}
+	if len(results) == 0 {
+		fmt.Fprintf(w, "No enabled monitor or relayer to rescan\n")
+	}
+	return success
+}
+
+// runRescan runs the rescan subcommand and returns the exit code of the process
+func runRescan(args []string) int {
+	options, err := parseRescanFlags(args)
+	if err != nil {
+		logger.WithError(err).Error("Invalid rescan arguments")
+		return 2
+	}
+
+	config := cfg.InitConfig(options.yamlPath, options.envPath)
+
+	initLogger(config.Logger)
+
+	db.InitDB(config.MongoDB)
+	defer db.DisconnectDB()
+
+	results, err := rescanChain(config, options)
+	if err != nil {
+		logger.WithError(err).Error("Error rescanning chain")
+		return 1
+	}
+
+	if !printRescanSummary(os.Stdout, results) {
+		return 1
+	}
+	return 0
+}
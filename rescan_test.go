@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dan13ram/wpokt-oracle/cosmos"
+	"github.com/dan13ram/wpokt-oracle/ethereum"
+	"github.com/dan13ram/wpokt-oracle/models"
+	"github.com/dan13ram/wpokt-oracle/service"
+)
+
+func TestParseRescanFlags(t *testing.T) {
+	options, err := parseRescanFlags([]string{"--chain", "1", "--from", "100", "--to", "200"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", options.chainID)
+	assert.Equal(t, uint64(100), options.startBlockHeight)
+	assert.Equal(t, uint64(200), options.endBlockHeight)
+	assert.Equal(t, "", options.yamlPath)
+
+	options, err = parseRescanFlags([]string{"--chain", "poktroll", "--from", "100", "--yaml", "config.yml"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), options.endBlockHeight)
+	assert.NotEqual(t, "config.yml", options.yamlPath)
+	assert.Contains(t, options.yamlPath, "config.yml")
+}
+
+func TestParseRescanFlags_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []string
+		err  string
+	}{
+		{"missing chain", []string{"--from", "100"}, "--chain is required"},
+		{"missing from", []string{"--chain", "1"}, "--from is required"},
+		{"from after to", []string{"--chain", "1", "--from", "200", "--to", "100"}, "--from must not be after --to"},
+		{"invalid from", []string{"--chain", "1", "--from", "abc"}, "invalid value"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseRescanFlags(tc.args)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestRescanChain(t *testing.T) {
+	defer func() {
+		ethereumRescan = ethereum.Rescan
+		cosmosRescan = cosmos.Rescan
+	}()
+
+	config := models.Config{
+		EthereumNetworks: []models.EthereumNetworkConfig{
+			{ChainID: 1, MintControllerAddress: "0x1234567890abcdef1234567890abcdef12345678"},
+		},
+		CosmosNetwork: models.CosmosNetworkConfig{
+			ChainID:         "poktroll",
+			Bech32Prefix:    "pokt",
+			MultisigAddress: "pokt13tsl3aglfyzf02n7x28x2ajzw94muu6y57k2ar",
+		},
+	}
+	ethResults := []*service.RescanResult{{Runner: models.CheckpointRunnerMonitor}}
+	cosmosResults := []*service.RescanResult{{Runner: models.CheckpointRunnerMonitor}}
+
+	ethereumRescan = func(ethNetwork models.EthereumNetworkConfig, _ map[uint32][]byte, startBlockHeight uint64, endBlockHeight uint64) ([]*service.RescanResult, error) {
+		assert.Equal(t, uint64(1), ethNetwork.ChainID)
+		assert.Equal(t, uint64(10), startBlockHeight)
+		assert.Equal(t, uint64(20), endBlockHeight)
+		return ethResults, nil
+	}
+	cosmosRescan = func(cosmosNetwork models.CosmosNetworkConfig, _ map[uint32][]byte, _ []models.EthereumNetworkConfig, _ uint64, _ uint64) ([]*service.RescanResult, error) {
+		assert.Equal(t, "poktroll", cosmosNetwork.ChainID)
+		return cosmosResults, nil
+	}
+
+	results, err := rescanChain(config, rescanOptions{chainID: "1", startBlockHeight: 10, endBlockHeight: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, ethResults, results)
+
+	results, err = rescanChain(config, rescanOptions{chainID: "poktroll", startBlockHeight: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, cosmosResults, results)
+
+	results, err = rescanChain(config, rescanOptions{chainID: "2", startBlockHeight: 10})
+	assert.ErrorContains(t, err, "chain 2 not found")
+	assert.Nil(t, results)
+
+	ethereumRescan = func(models.EthereumNetworkConfig, map[uint32][]byte, uint64, uint64) ([]*service.RescanResult, error) {
+		return nil, errors.New("error")
+	}
+
+	_, err = rescanChain(config, rescanOptions{chainID: "1", startBlockHeight: 10})
+	assert.Error(t, err)
+}
+
+func TestPrintRescanSummary(t *testing.T) {
+	results := []*service.RescanResult{
+		{
+			Chain:            models.Chain{ChainName: "Ethereum"},
+			Runner:           models.CheckpointRunnerMonitor,
+			StartBlockHeight: 10,
+			EndBlockHeight:   20,
+			Success:          true,
+			Discovered:       []string{"0x02"},
+			Known:            []string{"0x01"},
+		},
+		{
+			Chain:            models.Chain{ChainName: "Ethereum"},
+			Runner:           models.CheckpointRunnerRelayer,
+			StartBlockHeight: 10,
+			EndBlockHeight:   20,
+			Success:          false,
+			Discovered:       []string{},
+			Known:            []string{},
+		},
+	}
+
+	var out bytes.Buffer
+	success := printRescanSummary(&out, results)
+
+	assert.False(t, success)
+	assert.Equal(t, "Ethereum monitor blocks 10 to 20: 1 newly discovered, 1 already known\n"+
+		"  new: 0x02\n"+
+		"Ethereum relayer blocks 10 to 20: 0 newly discovered, 0 already known\n"+
+		"  incomplete: some events could not be synced, see the logs\n", out.String())
+
+	out.Reset()
+	assert.True(t, printRescanSummary(&out, results[:1]))
+
+	out.Reset()
+	assert.True(t, printRescanSummary(&out, nil))
+	assert.Equal(t, "No enabled monitor or relayer to rescan\n", out.String())
+}
@@ -18,6 +18,19 @@ type chainService struct {
 	signerService  RunnerService
 	relayerService RunnerService
 
+	// the optional services are nil on networks that do not run them
+	auditorService RunnerService
+	// fulfillerService submits fulfillOrder for signed messages on ethereum networks
+	fulfillerService RunnerService
+	// reconcilerService compares the multisig balance with the wPOKT supply on the cosmos network
+	reconcilerService RunnerService
+	// mintAuditorService matches the omni token mints on ethereum networks to fulfillments
+	mintAuditorService RunnerService
+	// spendAuditorService matches the spends from the multisig on the cosmos network to messages and refunds
+	spendAuditorService RunnerService
+	// securityWatchService records admin and configuration changes of the bridge contracts on ethereum networks
+	securityWatchService RunnerService
+
 	stop   chan bool
 	logger *log.Entry
 }
@@ -28,12 +41,61 @@ type ChainService interface {
 	Health() models.ChainServiceHealth
 }
 
+// ChainServiceOption adds an optional runner service to a chain service
+type ChainServiceOption func(x *chainService)
+
+func WithAuditor(auditorService RunnerService) ChainServiceOption {
+	return func(x *chainService) { x.auditorService = auditorService }
+}
+
+func WithFulfiller(fulfillerService RunnerService) ChainServiceOption {
+	return func(x *chainService) { x.fulfillerService = fulfillerService }
+}
+
+func WithReconciler(reconcilerService RunnerService) ChainServiceOption {
+	return func(x *chainService) { x.reconcilerService = reconcilerService }
+}
+
+func WithMintAuditor(mintAuditorService RunnerService) ChainServiceOption {
+	return func(x *chainService) { x.mintAuditorService = mintAuditorService }
+}
+
+func WithSpendAuditor(spendAuditorService RunnerService) ChainServiceOption {
+	return func(x *chainService) { x.spendAuditorService = spendAuditorService }
+}
+
+func WithSecurityWatch(securityWatchService RunnerService) ChainServiceOption {
+	return func(x *chainService) { x.securityWatchService = securityWatchService }
+}
+
 func (x *chainService) Name() string {
 	return strings.ToUpper(x.chain.ChainName)
 }
 
+// enabledServices returns the services that are set and enabled
+func (x *chainService) enabledServices() []RunnerService {
+	services := []RunnerService{}
+	for _, service := range []RunnerService{
+		x.monitorService,
+		x.signerService,
+		x.relayerService,
+		x.auditorService,
+		x.fulfillerService,
+		x.reconcilerService,
+		x.mintAuditorService,
+		x.spendAuditorService,
+		x.securityWatchService,
+	} {
+		if service != nil && service.Enabled() {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
 func (x *chainService) Start() {
-	if !x.monitorService.Enabled() && !x.signerService.Enabled() && !x.relayerService.Enabled() {
+	services := x.enabledServices()
+	if len(services) == 0 {
 		x.logger.Debugf("ChainService not enabled")
 		x.wg.Done()
 		return
@@ -42,31 +104,15 @@ func (x *chainService) Start() {
 
 	var wg sync.WaitGroup
 
-	if x.monitorService.Enabled() {
-		wg.Add(1)
-		go x.monitorService.Start(&wg)
-	}
-
-	if x.signerService.Enabled() {
+	for _, service := range services {
 		wg.Add(1)
-		go x.signerService.Start(&wg)
-	}
-
-	if x.relayerService.Enabled() {
-		wg.Add(1)
-		go x.relayerService.Start(&wg)
+		go service.Start(&wg)
 	}
 
 	<-x.stop
 
-	if x.monitorService.Enabled() {
-		x.monitorService.Stop()
-	}
-	if x.signerService.Enabled() {
-		x.signerService.Stop()
-	}
-	if x.relayerService.Enabled() {
-		x.relayerService.Stop()
+	for _, service := range services {
+		service.Stop()
 	}
 
 	wg.Wait()
@@ -75,13 +121,26 @@ func (x *chainService) Start() {
 	x.wg.Done()
 }
 
+func status(service RunnerService) *models.RunnerServiceStatus {
+	if service == nil {
+		return nil
+	}
+	return service.Status()
+}
+
 func (x *chainService) Health() models.ChainServiceHealth {
 
 	return models.ChainServiceHealth{
-		Chain:          x.chain,
-		MessageMonitor: x.monitorService.Status(),
-		MessageSigner:  x.signerService.Status(),
-		MessageRelayer: x.relayerService.Status(),
+		Chain:            x.chain,
+		MessageMonitor:   x.monitorService.Status(),
+		MessageSigner:    x.signerService.Status(),
+		MessageRelayer:   x.relayerService.Status(),
+		MessageAuditor:   status(x.auditorService),
+		MessageFulfiller: status(x.fulfillerService),
+		SupplyReconciler: status(x.reconcilerService),
+		MintAuditor:      status(x.mintAuditorService),
+		SpendAuditor:     status(x.spendAuditorService),
+		SecurityWatch:    status(x.securityWatchService),
 	}
 
 }
@@ -97,6 +156,7 @@ func NewChainService(
 	signerService RunnerService,
 	relayerService RunnerService,
 	wg *sync.WaitGroup,
+	options ...ChainServiceOption,
 ) ChainService {
 	logger := log.
 		WithField("module", "service").
@@ -108,7 +168,7 @@ func NewChainService(
 		return nil
 	}
 
-	return &chainService{
+	x := &chainService{
 		chain:          chain,
 		monitorService: monitorService,
 		signerService:  signerService,
@@ -117,4 +177,9 @@ func NewChainService(
 		stop:           make(chan bool, 1),
 		logger:         logger,
 	}
+	for _, option := range options {
+		option(x)
+	}
+
+	return x
 }
@@ -53,6 +53,7 @@ func TestChainService_Start_Disabled(t *testing.T) {
 		monitorService: &mockRunnerService{enabled: false},
 		signerService:  &mockRunnerService{enabled: false},
 		relayerService: &mockRunnerService{enabled: false},
+		auditorService: &mockRunnerService{enabled: false},
 		logger:         log.NewEntry(log.New()),
 	}
 	go cs.Start()
@@ -69,11 +70,17 @@ func TestChainService_Start_And_Stop(t *testing.T) {
 		chain: models.Chain{
 			ChainName: "TestChain",
 		},
-		monitorService: &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
-		signerService:  &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
-		relayerService: &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
-		stop:           make(chan bool, 1),
-		logger:         log.NewEntry(log.New()),
+		monitorService:       &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		signerService:        &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		relayerService:       &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		auditorService:       &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		fulfillerService:     &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		reconcilerService:    &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		mintAuditorService:   &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		spendAuditorService:  &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		securityWatchService: &mockRunnerService{enabled: true, stop: make(chan bool, 1)},
+		stop:                 make(chan bool, 1),
+		logger:               log.NewEntry(log.New()),
 	}
 
 	go cs.Start()
@@ -88,10 +95,14 @@ func TestChainService_Health(t *testing.T) {
 			ChainName: "TestChain",
 			ChainID:   "1",
 		},
-		monitorService: &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "MonitorService"}},
-		signerService:  &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "SignerService"}},
-		relayerService: &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "RelayerService"}},
-		logger:         log.NewEntry(log.New()),
+		monitorService:       &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "MonitorService"}},
+		signerService:        &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "SignerService"}},
+		relayerService:       &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "RelayerService"}},
+		auditorService:       &mockRunnerService{enabled: false},
+		reconcilerService:    &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "ReconcilerService"}},
+		spendAuditorService:  &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "SpendAuditorService"}},
+		securityWatchService: &mockRunnerService{enabled: true, status: models.RunnerServiceStatus{Name: "SecurityWatchService"}},
+		logger:               log.NewEntry(log.New()),
 	}
 
 	health := cs.Health()
@@ -100,6 +111,12 @@ func TestChainService_Health(t *testing.T) {
 	assert.Equal(t, "MonitorService", health.MessageMonitor.Name)
 	assert.Equal(t, "SignerService", health.MessageSigner.Name)
 	assert.Equal(t, "RelayerService", health.MessageRelayer.Name)
+	assert.Nil(t, health.MessageAuditor)
+	assert.Nil(t, health.MessageFulfiller)
+	assert.Equal(t, "ReconcilerService", health.SupplyReconciler.Name)
+	assert.Nil(t, health.MintAuditor)
+	assert.Equal(t, "SpendAuditorService", health.SpendAuditor.Name)
+	assert.Equal(t, "SecurityWatchService", health.SecurityWatch.Name)
 }
 
 func TestNewChainService(t *testing.T) {
@@ -110,6 +127,28 @@ func TestNewChainService(t *testing.T) {
 	cs := NewChainService(chain, &mockRunnerService{}, &mockRunnerService{}, &mockRunnerService{}, &wg)
 	assert.NotNil(t, cs)
 	assert.Equal(t, "TESTCHAIN", cs.(*chainService).Name())
+	assert.Nil(t, cs.(*chainService).auditorService)
+
+	auditorService := &mockRunnerService{}
+	fulfillerService := &mockRunnerService{}
+	reconcilerService := &mockRunnerService{}
+	mintAuditorService := &mockRunnerService{}
+	spendAuditorService := &mockRunnerService{}
+	securityWatchService := &mockRunnerService{}
+	cs = NewChainService(chain, &mockRunnerService{}, &mockRunnerService{}, &mockRunnerService{}, &wg,
+		WithAuditor(auditorService),
+		WithFulfiller(fulfillerService),
+		WithReconciler(reconcilerService),
+		WithMintAuditor(mintAuditorService),
+		WithSpendAuditor(spendAuditorService),
+		WithSecurityWatch(securityWatchService),
+	)
+	assert.Same(t, auditorService, cs.(*chainService).auditorService)
+	assert.Same(t, fulfillerService, cs.(*chainService).fulfillerService)
+	assert.Same(t, reconcilerService, cs.(*chainService).reconcilerService)
+	assert.Same(t, mintAuditorService, cs.(*chainService).mintAuditorService)
+	assert.Same(t, spendAuditorService, cs.(*chainService).spendAuditorService)
+	assert.Same(t, securityWatchService, cs.(*chainService).securityWatchService)
 
 	defer func() { log.StandardLogger().ExitFunc = nil }()
 	log.StandardLogger().ExitFunc = func(num int) { panic(fmt.Sprintf("exit %d", num)) }
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+// RescanResult summarises the transactions found by one runner when rescanning a block range
+type RescanResult struct {
+	Chain            models.Chain
+	Runner           string
+	StartBlockHeight uint64
+	EndBlockHeight   uint64
+	Success          bool
+	Discovered       []string
+	Known            []string
+}
+
+// Rescan syncs a block range again with sync and compares the transactions stored for the range
+// before and after, telling apart newly discovered transactions from ones that were already known
+func Rescan(
+	chain models.Chain,
+	runner string,
+	startBlockHeight uint64,
+	endBlockHeight uint64,
+	getTxs func() ([]models.Transaction, error),
+	sync func() bool,
+) (*RescanResult, error) {
+	before, err := getTxs()
+	if err != nil {
+		return nil, fmt.Errorf("error getting transactions before rescan: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, tx := range before {
+		known[tx.Hash] = true
+	}
+
+	success := sync()
+
+	after, err := getTxs()
+	if err != nil {
+		return nil, fmt.Errorf("error getting transactions after rescan: %w", err)
+	}
+
+	result := &RescanResult{
+		Chain:            chain,
+		Runner:           runner,
+		StartBlockHeight: startBlockHeight,
+		EndBlockHeight:   endBlockHeight,
+		Success:          success,
+		Discovered:       []string{},
+		Known:            []string{},
+	}
+	for _, tx := range after {
+		if known[tx.Hash] {
+			result.Known = append(result.Known, tx.Hash)
+		} else {
+			result.Discovered = append(result.Discovered, tx.Hash)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dan13ram/wpokt-oracle/models"
+)
+
+func TestRescan(t *testing.T) {
+	chain := models.Chain{ChainID: "1"}
+
+	calls := 0
+	getTxs := func() ([]models.Transaction, error) {
+		calls++
+		if calls == 1 {
+			return []models.Transaction{{Hash: "0x01"}}, nil
+		}
+		return []models.Transaction{{Hash: "0x01"}, {Hash: "0x02"}}, nil
+	}
+	synced := false
+
+	result, err := Rescan(chain, models.CheckpointRunnerMonitor, 10, 20, getTxs, func() bool {
+		synced = true
+		return true
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, synced)
+	assert.Equal(t, &RescanResult{
+		Chain:            chain,
+		Runner:           models.CheckpointRunnerMonitor,
+		StartBlockHeight: 10,
+		EndBlockHeight:   20,
+		Success:          true,
+		Discovered:       []string{"0x02"},
+		Known:            []string{"0x01"},
+	}, result)
+}
+
+func TestRescan_SyncFailed(t *testing.T) {
+	result, err := Rescan(models.Chain{}, models.CheckpointRunnerRelayer, 10, 20, func() ([]models.Transaction, error) {
+		return []models.Transaction{}, nil
+	}, func() bool {
+		return false
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Empty(t, result.Discovered)
+	assert.Empty(t, result.Known)
+}
+
+func TestRescan_GetTxsError(t *testing.T) {
+	t.Run("before", func(t *testing.T) {
+		result, err := Rescan(models.Chain{}, models.CheckpointRunnerMonitor, 10, 20, func() ([]models.Transaction, error) {
+			return nil, errors.New("error")
+		}, func() bool {
+			t.Fatal("sync should not run")
+			return false
+		})
+
+		assert.ErrorContains(t, err, "before rescan")
+		assert.Nil(t, result)
+	})
+
+	t.Run("after", func(t *testing.T) {
+		calls := 0
+		result, err := Rescan(models.Chain{}, models.CheckpointRunnerMonitor, 10, 20, func() ([]models.Transaction, error) {
+			calls++
+			if calls == 2 {
+				return nil, errors.New("error")
+			}
+			return []models.Transaction{}, nil
+		}, func() bool {
+			return true
+		})
+
+		assert.ErrorContains(t, err, "after rescan")
+		assert.Nil(t, result)
+	})
+}